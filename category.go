@@ -0,0 +1,130 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+//go:generate go run gencategory.go
+
+// A coarse, architecture independent semantic category for an instruction,
+// so that statistics and heuristics (instruction mix, crypto detection,
+// "is this a leaf function") can be written once for every arch.
+//
+// Each instruction id maps to exactly one category. Where a mnemonic covers
+// several forms the most common one wins: shared VFP / NEON mnemonics on ARM
+// (vadd, vmul...) are Float, and x86 mov is Move even though it may touch
+// memory. Instruction.Category() uses the operands to refine these.
+type Category int
+
+const (
+	CategoryUnknown    Category = iota // invalid instruction or unknown id
+	CategoryMove                       // register / immediate moves, conditional moves and selects, extensions
+	CategoryLoad                       // memory to register
+	CategoryStore                      // register to memory
+	CategoryArithmetic                 // integer add, sub, mul, div, address generation
+	CategoryLogic                      // bitwise and, or, xor, not, bit counting and bitfields
+	CategoryShift                      // shifts and rotates
+	CategoryCompare                    // compares, tests and set-on-condition
+	CategoryBranch                     // jumps and conditional branches
+	CategoryCall                       // subroutine calls
+	CategoryReturn                     // subroutine and exception returns
+	CategoryStack                      // push, pop, frame setup and teardown
+	CategorySystem                     // privileged, cache, trap, I/O and processor control
+	CategoryFloat                      // scalar floating point
+	CategoryVector                     // SIMD, packed and vector unit instructions
+	CategoryCrypto                     // dedicated cryptographic instructions (AES, SHA...)
+	CategoryAtomic                     // exclusive / locked accesses, transactions and memory barriers
+	CategoryNop                        // nops and hints with no architectural effect
+)
+
+var categoryNames = [...]string{
+	CategoryUnknown:    "unknown",
+	CategoryMove:       "move",
+	CategoryLoad:       "load",
+	CategoryStore:      "store",
+	CategoryArithmetic: "arithmetic",
+	CategoryLogic:      "logic",
+	CategoryShift:      "shift",
+	CategoryCompare:    "compare",
+	CategoryBranch:     "branch",
+	CategoryCall:       "call",
+	CategoryReturn:     "return",
+	CategoryStack:      "stack",
+	CategorySystem:     "system",
+	CategoryFloat:      "floating point",
+	CategoryVector:     "vector",
+	CategoryCrypto:     "crypto",
+	CategoryAtomic:     "atomic",
+	CategoryNop:        "nop",
+}
+
+func (c Category) String() string {
+	if c >= 0 && int(c) < len(categoryNames) {
+		return categoryNames[c]
+	}
+	return "unknown"
+}
+
+// The static category of an instruction id for the given CS_ARCH_*. Unknown
+// arches and ids give CategoryUnknown.
+func InsnCategory(arch int, id uint) Category {
+	var table map[uint]Category
+	switch arch {
+	case CS_ARCH_X86:
+		table = x86Categories
+	case CS_ARCH_ARM:
+		table = armCategories
+	case CS_ARCH_ARM64:
+		table = arm64Categories
+	case CS_ARCH_MIPS:
+		table = mipsCategories
+	case CS_ARCH_PPC:
+		table = ppcCategories
+	case CS_ARCH_SPARC:
+		table = sparcCategories
+	case CS_ARCH_SYSZ:
+		table = syszCategories
+	case CS_ARCH_XCORE:
+		table = xcoreCategories
+	}
+	return table[id]
+}
+
+// The category of this Instruction. This starts from InsnCategory and, when
+// detail is available, refines it from the operands: moves with a memory
+// operand become Load or Store, and data processing instructions which write
+// the PC (ARM `pop {pc}` or `mov pc, lr`, MIPS `jr $ra`) take the category of
+// their Flow().
+func (insn Instruction) Category() Category {
+	c := InsnCategory(insn.archOf(), insn.Id)
+
+	switch insn.Flow().Kind {
+	case FlowReturn:
+		return CategoryReturn
+	case FlowCall:
+		return CategoryCall
+	case FlowJump, FlowCondBranch, FlowIndirect:
+		return CategoryBranch
+	}
+
+	if c == CategoryMove {
+		if ops, _, ok := insn.accessOperands(); ok {
+			for i, op := range ops {
+				if !op.mem {
+					continue
+				}
+				if insn.memAccess(i, len(ops))&AccessWrite != 0 {
+					return CategoryStore
+				}
+				return CategoryLoad
+			}
+		}
+	}
+	return c
+}
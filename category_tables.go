@@ -0,0 +1,4816 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+
+    THIS FILE WAS AUTO-GENERATED -- DO NOT EDIT!
+	Command: go run gencategory.go
+	2026-10-19T09:09:19Z
+
+*/
+
+package gapstone
+
+var x86Categories = map[uint]Category{
+	X86_INS_AAA:              CategoryArithmetic,
+	X86_INS_AAD:              CategoryArithmetic,
+	X86_INS_AAM:              CategoryArithmetic,
+	X86_INS_AAS:              CategoryArithmetic,
+	X86_INS_FABS:             CategoryFloat,
+	X86_INS_ADC:              CategoryArithmetic,
+	X86_INS_ADCX:             CategoryArithmetic,
+	X86_INS_ADD:              CategoryArithmetic,
+	X86_INS_ADDPD:            CategoryVector,
+	X86_INS_ADDPS:            CategoryVector,
+	X86_INS_ADDSD:            CategoryFloat,
+	X86_INS_ADDSS:            CategoryFloat,
+	X86_INS_ADDSUBPD:         CategoryVector,
+	X86_INS_ADDSUBPS:         CategoryVector,
+	X86_INS_FADD:             CategoryFloat,
+	X86_INS_FIADD:            CategoryFloat,
+	X86_INS_FADDP:            CategoryFloat,
+	X86_INS_ADOX:             CategoryArithmetic,
+	X86_INS_AESDECLAST:       CategoryCrypto,
+	X86_INS_AESDEC:           CategoryCrypto,
+	X86_INS_AESENCLAST:       CategoryCrypto,
+	X86_INS_AESENC:           CategoryCrypto,
+	X86_INS_AESIMC:           CategoryCrypto,
+	X86_INS_AESKEYGENASSIST:  CategoryCrypto,
+	X86_INS_AND:              CategoryLogic,
+	X86_INS_ANDN:             CategoryLogic,
+	X86_INS_ANDNPD:           CategoryVector,
+	X86_INS_ANDNPS:           CategoryVector,
+	X86_INS_ANDPD:            CategoryVector,
+	X86_INS_ANDPS:            CategoryVector,
+	X86_INS_ARPL:             CategorySystem,
+	X86_INS_BEXTR:            CategoryLogic,
+	X86_INS_BLCFILL:          CategoryLogic,
+	X86_INS_BLCI:             CategoryLogic,
+	X86_INS_BLCIC:            CategoryLogic,
+	X86_INS_BLCMSK:           CategoryLogic,
+	X86_INS_BLCS:             CategoryLogic,
+	X86_INS_BLENDPD:          CategoryVector,
+	X86_INS_BLENDPS:          CategoryVector,
+	X86_INS_BLENDVPD:         CategoryVector,
+	X86_INS_BLENDVPS:         CategoryVector,
+	X86_INS_BLSFILL:          CategoryLogic,
+	X86_INS_BLSI:             CategoryLogic,
+	X86_INS_BLSIC:            CategoryLogic,
+	X86_INS_BLSMSK:           CategoryLogic,
+	X86_INS_BLSR:             CategoryLogic,
+	X86_INS_BOUND:            CategoryCompare,
+	X86_INS_BSF:              CategoryLogic,
+	X86_INS_BSR:              CategoryLogic,
+	X86_INS_BSWAP:            CategoryMove,
+	X86_INS_BT:               CategoryCompare,
+	X86_INS_BTC:              CategoryLogic,
+	X86_INS_BTR:              CategoryLogic,
+	X86_INS_BTS:              CategoryLogic,
+	X86_INS_BZHI:             CategoryLogic,
+	X86_INS_CALL:             CategoryCall,
+	X86_INS_CBW:              CategoryMove,
+	X86_INS_CDQ:              CategoryMove,
+	X86_INS_CDQE:             CategoryMove,
+	X86_INS_FCHS:             CategoryFloat,
+	X86_INS_CLAC:             CategorySystem,
+	X86_INS_CLC:              CategorySystem,
+	X86_INS_CLD:              CategorySystem,
+	X86_INS_CLFLUSH:          CategorySystem,
+	X86_INS_CLGI:             CategorySystem,
+	X86_INS_CLI:              CategorySystem,
+	X86_INS_CLTS:             CategorySystem,
+	X86_INS_CMC:              CategorySystem,
+	X86_INS_CMOVA:            CategoryMove,
+	X86_INS_CMOVAE:           CategoryMove,
+	X86_INS_CMOVB:            CategoryMove,
+	X86_INS_CMOVBE:           CategoryMove,
+	X86_INS_FCMOVBE:          CategoryFloat,
+	X86_INS_FCMOVB:           CategoryFloat,
+	X86_INS_CMOVE:            CategoryMove,
+	X86_INS_FCMOVE:           CategoryFloat,
+	X86_INS_CMOVG:            CategoryMove,
+	X86_INS_CMOVGE:           CategoryMove,
+	X86_INS_CMOVL:            CategoryMove,
+	X86_INS_CMOVLE:           CategoryMove,
+	X86_INS_FCMOVNBE:         CategoryFloat,
+	X86_INS_FCMOVNB:          CategoryFloat,
+	X86_INS_CMOVNE:           CategoryMove,
+	X86_INS_FCMOVNE:          CategoryFloat,
+	X86_INS_CMOVNO:           CategoryMove,
+	X86_INS_CMOVNP:           CategoryMove,
+	X86_INS_FCMOVNU:          CategoryFloat,
+	X86_INS_CMOVNS:           CategoryMove,
+	X86_INS_CMOVO:            CategoryMove,
+	X86_INS_CMOVP:            CategoryMove,
+	X86_INS_FCMOVU:           CategoryFloat,
+	X86_INS_CMOVS:            CategoryMove,
+	X86_INS_CMP:              CategoryCompare,
+	X86_INS_CMPPD:            CategoryVector,
+	X86_INS_CMPPS:            CategoryVector,
+	X86_INS_CMPSB:            CategoryCompare,
+	X86_INS_CMPSD:            CategoryCompare,
+	X86_INS_CMPSQ:            CategoryCompare,
+	X86_INS_CMPSS:            CategoryFloat,
+	X86_INS_CMPSW:            CategoryCompare,
+	X86_INS_CMPXCHG16B:       CategoryAtomic,
+	X86_INS_CMPXCHG:          CategoryAtomic,
+	X86_INS_CMPXCHG8B:        CategoryAtomic,
+	X86_INS_COMISD:           CategoryFloat,
+	X86_INS_COMISS:           CategoryFloat,
+	X86_INS_FCOMP:            CategoryFloat,
+	X86_INS_FCOMPI:           CategoryFloat,
+	X86_INS_FCOMI:            CategoryFloat,
+	X86_INS_FCOM:             CategoryFloat,
+	X86_INS_FCOS:             CategoryFloat,
+	X86_INS_CPUID:            CategorySystem,
+	X86_INS_CQO:              CategoryMove,
+	X86_INS_CRC32:            CategoryArithmetic,
+	X86_INS_CVTDQ2PD:         CategoryVector,
+	X86_INS_CVTDQ2PS:         CategoryVector,
+	X86_INS_CVTPD2DQ:         CategoryVector,
+	X86_INS_CVTPD2PS:         CategoryVector,
+	X86_INS_CVTPS2DQ:         CategoryVector,
+	X86_INS_CVTPS2PD:         CategoryVector,
+	X86_INS_CVTSD2SI:         CategoryFloat,
+	X86_INS_CVTSD2SS:         CategoryFloat,
+	X86_INS_CVTSI2SD:         CategoryFloat,
+	X86_INS_CVTSI2SS:         CategoryFloat,
+	X86_INS_CVTSS2SD:         CategoryFloat,
+	X86_INS_CVTSS2SI:         CategoryFloat,
+	X86_INS_CVTTPD2DQ:        CategoryVector,
+	X86_INS_CVTTPS2DQ:        CategoryVector,
+	X86_INS_CVTTSD2SI:        CategoryFloat,
+	X86_INS_CVTTSS2SI:        CategoryFloat,
+	X86_INS_CWD:              CategoryMove,
+	X86_INS_CWDE:             CategoryMove,
+	X86_INS_DAA:              CategoryArithmetic,
+	X86_INS_DAS:              CategoryArithmetic,
+	X86_INS_DATA16:           CategoryNop,
+	X86_INS_DEC:              CategoryArithmetic,
+	X86_INS_DIV:              CategoryArithmetic,
+	X86_INS_DIVPD:            CategoryVector,
+	X86_INS_DIVPS:            CategoryVector,
+	X86_INS_FDIVR:            CategoryFloat,
+	X86_INS_FIDIVR:           CategoryFloat,
+	X86_INS_FDIVRP:           CategoryFloat,
+	X86_INS_DIVSD:            CategoryFloat,
+	X86_INS_DIVSS:            CategoryFloat,
+	X86_INS_FDIV:             CategoryFloat,
+	X86_INS_FIDIV:            CategoryFloat,
+	X86_INS_FDIVP:            CategoryFloat,
+	X86_INS_DPPD:             CategoryVector,
+	X86_INS_DPPS:             CategoryVector,
+	X86_INS_RET:              CategoryReturn,
+	X86_INS_ENCLS:            CategorySystem,
+	X86_INS_ENCLU:            CategorySystem,
+	X86_INS_ENTER:            CategoryStack,
+	X86_INS_EXTRACTPS:        CategoryVector,
+	X86_INS_EXTRQ:            CategoryVector,
+	X86_INS_F2XM1:            CategoryFloat,
+	X86_INS_LCALL:            CategoryCall,
+	X86_INS_LJMP:             CategoryBranch,
+	X86_INS_FBLD:             CategoryFloat,
+	X86_INS_FBSTP:            CategoryFloat,
+	X86_INS_FCOMPP:           CategoryFloat,
+	X86_INS_FDECSTP:          CategoryFloat,
+	X86_INS_FEMMS:            CategoryVector,
+	X86_INS_FFREE:            CategoryFloat,
+	X86_INS_FICOM:            CategoryFloat,
+	X86_INS_FICOMP:           CategoryFloat,
+	X86_INS_FINCSTP:          CategoryFloat,
+	X86_INS_FLDCW:            CategoryFloat,
+	X86_INS_FLDENV:           CategoryFloat,
+	X86_INS_FLDL2E:           CategoryFloat,
+	X86_INS_FLDL2T:           CategoryFloat,
+	X86_INS_FLDLG2:           CategoryFloat,
+	X86_INS_FLDLN2:           CategoryFloat,
+	X86_INS_FLDPI:            CategoryFloat,
+	X86_INS_FNCLEX:           CategoryFloat,
+	X86_INS_FNINIT:           CategoryFloat,
+	X86_INS_FNOP:             CategoryNop,
+	X86_INS_FNSTCW:           CategoryFloat,
+	X86_INS_FNSTSW:           CategoryFloat,
+	X86_INS_FPATAN:           CategoryFloat,
+	X86_INS_FPREM:            CategoryFloat,
+	X86_INS_FPREM1:           CategoryFloat,
+	X86_INS_FPTAN:            CategoryFloat,
+	X86_INS_FRNDINT:          CategoryFloat,
+	X86_INS_FRSTOR:           CategoryFloat,
+	X86_INS_FNSAVE:           CategoryFloat,
+	X86_INS_FSCALE:           CategoryFloat,
+	X86_INS_FSETPM:           CategoryFloat,
+	X86_INS_FSINCOS:          CategoryFloat,
+	X86_INS_FNSTENV:          CategoryFloat,
+	X86_INS_FXAM:             CategoryFloat,
+	X86_INS_FXRSTOR:          CategorySystem,
+	X86_INS_FXRSTOR64:        CategorySystem,
+	X86_INS_FXSAVE:           CategorySystem,
+	X86_INS_FXSAVE64:         CategorySystem,
+	X86_INS_FXTRACT:          CategoryFloat,
+	X86_INS_FYL2X:            CategoryFloat,
+	X86_INS_FYL2XP1:          CategoryFloat,
+	X86_INS_MOVAPD:           CategoryMove,
+	X86_INS_MOVAPS:           CategoryMove,
+	X86_INS_ORPD:             CategoryVector,
+	X86_INS_ORPS:             CategoryVector,
+	X86_INS_VMOVAPD:          CategoryMove,
+	X86_INS_VMOVAPS:          CategoryMove,
+	X86_INS_XORPD:            CategoryVector,
+	X86_INS_XORPS:            CategoryVector,
+	X86_INS_GETSEC:           CategorySystem,
+	X86_INS_HADDPD:           CategoryVector,
+	X86_INS_HADDPS:           CategoryVector,
+	X86_INS_HLT:              CategorySystem,
+	X86_INS_HSUBPD:           CategoryVector,
+	X86_INS_HSUBPS:           CategoryVector,
+	X86_INS_IDIV:             CategoryArithmetic,
+	X86_INS_FILD:             CategoryFloat,
+	X86_INS_IMUL:             CategoryArithmetic,
+	X86_INS_IN:               CategorySystem,
+	X86_INS_INC:              CategoryArithmetic,
+	X86_INS_INSB:             CategorySystem,
+	X86_INS_INSERTPS:         CategoryVector,
+	X86_INS_INSERTQ:          CategoryVector,
+	X86_INS_INSD:             CategorySystem,
+	X86_INS_INSW:             CategorySystem,
+	X86_INS_INT:              CategorySystem,
+	X86_INS_INT1:             CategorySystem,
+	X86_INS_INT3:             CategorySystem,
+	X86_INS_INTO:             CategorySystem,
+	X86_INS_INVD:             CategorySystem,
+	X86_INS_INVEPT:           CategorySystem,
+	X86_INS_INVLPG:           CategorySystem,
+	X86_INS_INVLPGA:          CategorySystem,
+	X86_INS_INVPCID:          CategorySystem,
+	X86_INS_INVVPID:          CategorySystem,
+	X86_INS_IRET:             CategoryReturn,
+	X86_INS_IRETD:            CategoryReturn,
+	X86_INS_IRETQ:            CategoryReturn,
+	X86_INS_FISTTP:           CategoryFloat,
+	X86_INS_FIST:             CategoryFloat,
+	X86_INS_FISTP:            CategoryFloat,
+	X86_INS_UCOMISD:          CategoryFloat,
+	X86_INS_UCOMISS:          CategoryFloat,
+	X86_INS_VCMP:             CategoryVector,
+	X86_INS_VCOMISD:          CategoryFloat,
+	X86_INS_VCOMISS:          CategoryFloat,
+	X86_INS_VCVTSD2SS:        CategoryFloat,
+	X86_INS_VCVTSI2SD:        CategoryFloat,
+	X86_INS_VCVTSI2SS:        CategoryFloat,
+	X86_INS_VCVTSS2SD:        CategoryFloat,
+	X86_INS_VCVTTSD2SI:       CategoryFloat,
+	X86_INS_VCVTTSD2USI:      CategoryFloat,
+	X86_INS_VCVTTSS2SI:       CategoryFloat,
+	X86_INS_VCVTTSS2USI:      CategoryFloat,
+	X86_INS_VCVTUSI2SD:       CategoryFloat,
+	X86_INS_VCVTUSI2SS:       CategoryFloat,
+	X86_INS_VUCOMISD:         CategoryFloat,
+	X86_INS_VUCOMISS:         CategoryFloat,
+	X86_INS_JAE:              CategoryBranch,
+	X86_INS_JA:               CategoryBranch,
+	X86_INS_JBE:              CategoryBranch,
+	X86_INS_JB:               CategoryBranch,
+	X86_INS_JCXZ:             CategoryBranch,
+	X86_INS_JECXZ:            CategoryBranch,
+	X86_INS_JE:               CategoryBranch,
+	X86_INS_JGE:              CategoryBranch,
+	X86_INS_JG:               CategoryBranch,
+	X86_INS_JLE:              CategoryBranch,
+	X86_INS_JL:               CategoryBranch,
+	X86_INS_JMP:              CategoryBranch,
+	X86_INS_JNE:              CategoryBranch,
+	X86_INS_JNO:              CategoryBranch,
+	X86_INS_JNP:              CategoryBranch,
+	X86_INS_JNS:              CategoryBranch,
+	X86_INS_JO:               CategoryBranch,
+	X86_INS_JP:               CategoryBranch,
+	X86_INS_JRCXZ:            CategoryBranch,
+	X86_INS_JS:               CategoryBranch,
+	X86_INS_KANDB:            CategoryVector,
+	X86_INS_KANDD:            CategoryVector,
+	X86_INS_KANDNB:           CategoryVector,
+	X86_INS_KANDND:           CategoryVector,
+	X86_INS_KANDNQ:           CategoryVector,
+	X86_INS_KANDNW:           CategoryVector,
+	X86_INS_KANDQ:            CategoryVector,
+	X86_INS_KANDW:            CategoryVector,
+	X86_INS_KMOVB:            CategoryVector,
+	X86_INS_KMOVD:            CategoryVector,
+	X86_INS_KMOVQ:            CategoryVector,
+	X86_INS_KMOVW:            CategoryVector,
+	X86_INS_KNOTB:            CategoryVector,
+	X86_INS_KNOTD:            CategoryVector,
+	X86_INS_KNOTQ:            CategoryVector,
+	X86_INS_KNOTW:            CategoryVector,
+	X86_INS_KORB:             CategoryVector,
+	X86_INS_KORD:             CategoryVector,
+	X86_INS_KORQ:             CategoryVector,
+	X86_INS_KORTESTW:         CategoryVector,
+	X86_INS_KORW:             CategoryVector,
+	X86_INS_KSHIFTLW:         CategoryVector,
+	X86_INS_KSHIFTRW:         CategoryVector,
+	X86_INS_KUNPCKBW:         CategoryVector,
+	X86_INS_KXNORB:           CategoryVector,
+	X86_INS_KXNORD:           CategoryVector,
+	X86_INS_KXNORQ:           CategoryVector,
+	X86_INS_KXNORW:           CategoryVector,
+	X86_INS_KXORB:            CategoryVector,
+	X86_INS_KXORD:            CategoryVector,
+	X86_INS_KXORQ:            CategoryVector,
+	X86_INS_KXORW:            CategoryVector,
+	X86_INS_LAHF:             CategoryMove,
+	X86_INS_LAR:              CategorySystem,
+	X86_INS_LDDQU:            CategoryLoad,
+	X86_INS_LDMXCSR:          CategoryFloat,
+	X86_INS_LDS:              CategoryLoad,
+	X86_INS_FLDZ:             CategoryFloat,
+	X86_INS_FLD1:             CategoryFloat,
+	X86_INS_FLD:              CategoryFloat,
+	X86_INS_LEA:              CategoryArithmetic,
+	X86_INS_LEAVE:            CategoryStack,
+	X86_INS_LES:              CategoryLoad,
+	X86_INS_LFENCE:           CategoryAtomic,
+	X86_INS_LFS:              CategoryLoad,
+	X86_INS_LGDT:             CategorySystem,
+	X86_INS_LGS:              CategoryLoad,
+	X86_INS_LIDT:             CategorySystem,
+	X86_INS_LLDT:             CategorySystem,
+	X86_INS_LMSW:             CategorySystem,
+	X86_INS_OR:               CategoryLogic,
+	X86_INS_SUB:              CategoryArithmetic,
+	X86_INS_XOR:              CategoryLogic,
+	X86_INS_LODSB:            CategoryLoad,
+	X86_INS_LODSD:            CategoryLoad,
+	X86_INS_LODSQ:            CategoryLoad,
+	X86_INS_LODSW:            CategoryLoad,
+	X86_INS_LOOP:             CategoryBranch,
+	X86_INS_LOOPE:            CategoryBranch,
+	X86_INS_LOOPNE:           CategoryBranch,
+	X86_INS_RETF:             CategoryReturn,
+	X86_INS_RETFQ:            CategoryReturn,
+	X86_INS_LSL:              CategorySystem,
+	X86_INS_LSS:              CategoryLoad,
+	X86_INS_LTR:              CategorySystem,
+	X86_INS_XADD:             CategoryAtomic,
+	X86_INS_LZCNT:            CategoryLogic,
+	X86_INS_MASKMOVDQU:       CategoryStore,
+	X86_INS_MAXPD:            CategoryVector,
+	X86_INS_MAXPS:            CategoryVector,
+	X86_INS_MAXSD:            CategoryFloat,
+	X86_INS_MAXSS:            CategoryFloat,
+	X86_INS_MFENCE:           CategoryAtomic,
+	X86_INS_MINPD:            CategoryVector,
+	X86_INS_MINPS:            CategoryVector,
+	X86_INS_MINSD:            CategoryFloat,
+	X86_INS_MINSS:            CategoryFloat,
+	X86_INS_CVTPD2PI:         CategoryVector,
+	X86_INS_CVTPI2PD:         CategoryVector,
+	X86_INS_CVTPI2PS:         CategoryVector,
+	X86_INS_CVTPS2PI:         CategoryVector,
+	X86_INS_CVTTPD2PI:        CategoryVector,
+	X86_INS_CVTTPS2PI:        CategoryVector,
+	X86_INS_EMMS:             CategoryVector,
+	X86_INS_MASKMOVQ:         CategoryStore,
+	X86_INS_MOVD:             CategoryMove,
+	X86_INS_MOVDQ2Q:          CategoryMove,
+	X86_INS_MOVNTQ:           CategoryStore,
+	X86_INS_MOVQ2DQ:          CategoryMove,
+	X86_INS_MOVQ:             CategoryMove,
+	X86_INS_PABSB:            CategoryVector,
+	X86_INS_PABSD:            CategoryVector,
+	X86_INS_PABSW:            CategoryVector,
+	X86_INS_PACKSSDW:         CategoryVector,
+	X86_INS_PACKSSWB:         CategoryVector,
+	X86_INS_PACKUSWB:         CategoryVector,
+	X86_INS_PADDB:            CategoryVector,
+	X86_INS_PADDD:            CategoryVector,
+	X86_INS_PADDQ:            CategoryVector,
+	X86_INS_PADDSB:           CategoryVector,
+	X86_INS_PADDSW:           CategoryVector,
+	X86_INS_PADDUSB:          CategoryVector,
+	X86_INS_PADDUSW:          CategoryVector,
+	X86_INS_PADDW:            CategoryVector,
+	X86_INS_PALIGNR:          CategoryVector,
+	X86_INS_PANDN:            CategoryVector,
+	X86_INS_PAND:             CategoryVector,
+	X86_INS_PAVGB:            CategoryVector,
+	X86_INS_PAVGW:            CategoryVector,
+	X86_INS_PCMPEQB:          CategoryVector,
+	X86_INS_PCMPEQD:          CategoryVector,
+	X86_INS_PCMPEQW:          CategoryVector,
+	X86_INS_PCMPGTB:          CategoryVector,
+	X86_INS_PCMPGTD:          CategoryVector,
+	X86_INS_PCMPGTW:          CategoryVector,
+	X86_INS_PEXTRW:           CategoryVector,
+	X86_INS_PHADDSW:          CategoryVector,
+	X86_INS_PHADDW:           CategoryVector,
+	X86_INS_PHADDD:           CategoryVector,
+	X86_INS_PHSUBD:           CategoryVector,
+	X86_INS_PHSUBSW:          CategoryVector,
+	X86_INS_PHSUBW:           CategoryVector,
+	X86_INS_PINSRW:           CategoryVector,
+	X86_INS_PMADDUBSW:        CategoryVector,
+	X86_INS_PMADDWD:          CategoryVector,
+	X86_INS_PMAXSW:           CategoryVector,
+	X86_INS_PMAXUB:           CategoryVector,
+	X86_INS_PMINSW:           CategoryVector,
+	X86_INS_PMINUB:           CategoryVector,
+	X86_INS_PMOVMSKB:         CategoryVector,
+	X86_INS_PMULHRSW:         CategoryVector,
+	X86_INS_PMULHUW:          CategoryVector,
+	X86_INS_PMULHW:           CategoryVector,
+	X86_INS_PMULLW:           CategoryVector,
+	X86_INS_PMULUDQ:          CategoryVector,
+	X86_INS_POR:              CategoryVector,
+	X86_INS_PSADBW:           CategoryVector,
+	X86_INS_PSHUFB:           CategoryVector,
+	X86_INS_PSHUFW:           CategoryVector,
+	X86_INS_PSIGNB:           CategoryVector,
+	X86_INS_PSIGND:           CategoryVector,
+	X86_INS_PSIGNW:           CategoryVector,
+	X86_INS_PSLLD:            CategoryVector,
+	X86_INS_PSLLQ:            CategoryVector,
+	X86_INS_PSLLW:            CategoryVector,
+	X86_INS_PSRAD:            CategoryVector,
+	X86_INS_PSRAW:            CategoryVector,
+	X86_INS_PSRLD:            CategoryVector,
+	X86_INS_PSRLQ:            CategoryVector,
+	X86_INS_PSRLW:            CategoryVector,
+	X86_INS_PSUBB:            CategoryVector,
+	X86_INS_PSUBD:            CategoryVector,
+	X86_INS_PSUBQ:            CategoryVector,
+	X86_INS_PSUBSB:           CategoryVector,
+	X86_INS_PSUBSW:           CategoryVector,
+	X86_INS_PSUBUSB:          CategoryVector,
+	X86_INS_PSUBUSW:          CategoryVector,
+	X86_INS_PSUBW:            CategoryVector,
+	X86_INS_PUNPCKHBW:        CategoryVector,
+	X86_INS_PUNPCKHDQ:        CategoryVector,
+	X86_INS_PUNPCKHWD:        CategoryVector,
+	X86_INS_PUNPCKLBW:        CategoryVector,
+	X86_INS_PUNPCKLDQ:        CategoryVector,
+	X86_INS_PUNPCKLWD:        CategoryVector,
+	X86_INS_PXOR:             CategoryVector,
+	X86_INS_MONITOR:          CategorySystem,
+	X86_INS_MONTMUL:          CategoryCrypto,
+	X86_INS_MOV:              CategoryMove,
+	X86_INS_MOVABS:           CategoryMove,
+	X86_INS_MOVBE:            CategoryMove,
+	X86_INS_MOVDDUP:          CategoryVector,
+	X86_INS_MOVDQA:           CategoryMove,
+	X86_INS_MOVDQU:           CategoryMove,
+	X86_INS_MOVHLPS:          CategoryVector,
+	X86_INS_MOVHPD:           CategoryMove,
+	X86_INS_MOVHPS:           CategoryMove,
+	X86_INS_MOVLHPS:          CategoryVector,
+	X86_INS_MOVLPD:           CategoryMove,
+	X86_INS_MOVLPS:           CategoryMove,
+	X86_INS_MOVMSKPD:         CategoryVector,
+	X86_INS_MOVMSKPS:         CategoryVector,
+	X86_INS_MOVNTDQA:         CategoryLoad,
+	X86_INS_MOVNTDQ:          CategoryStore,
+	X86_INS_MOVNTI:           CategoryStore,
+	X86_INS_MOVNTPD:          CategoryStore,
+	X86_INS_MOVNTPS:          CategoryStore,
+	X86_INS_MOVNTSD:          CategoryStore,
+	X86_INS_MOVNTSS:          CategoryStore,
+	X86_INS_MOVSB:            CategoryMove,
+	X86_INS_MOVSD:            CategoryMove,
+	X86_INS_MOVSHDUP:         CategoryVector,
+	X86_INS_MOVSLDUP:         CategoryVector,
+	X86_INS_MOVSQ:            CategoryMove,
+	X86_INS_MOVSS:            CategoryMove,
+	X86_INS_MOVSW:            CategoryMove,
+	X86_INS_MOVSX:            CategoryMove,
+	X86_INS_MOVSXD:           CategoryMove,
+	X86_INS_MOVUPD:           CategoryMove,
+	X86_INS_MOVUPS:           CategoryMove,
+	X86_INS_MOVZX:            CategoryMove,
+	X86_INS_MPSADBW:          CategoryVector,
+	X86_INS_MUL:              CategoryArithmetic,
+	X86_INS_MULPD:            CategoryVector,
+	X86_INS_MULPS:            CategoryVector,
+	X86_INS_MULSD:            CategoryFloat,
+	X86_INS_MULSS:            CategoryFloat,
+	X86_INS_MULX:             CategoryArithmetic,
+	X86_INS_FMUL:             CategoryFloat,
+	X86_INS_FIMUL:            CategoryFloat,
+	X86_INS_FMULP:            CategoryFloat,
+	X86_INS_MWAIT:            CategorySystem,
+	X86_INS_NEG:              CategoryArithmetic,
+	X86_INS_NOP:              CategoryNop,
+	X86_INS_NOT:              CategoryLogic,
+	X86_INS_OUT:              CategorySystem,
+	X86_INS_OUTSB:            CategorySystem,
+	X86_INS_OUTSD:            CategorySystem,
+	X86_INS_OUTSW:            CategorySystem,
+	X86_INS_PACKUSDW:         CategoryVector,
+	X86_INS_PAUSE:            CategoryNop,
+	X86_INS_PAVGUSB:          CategoryVector,
+	X86_INS_PBLENDVB:         CategoryVector,
+	X86_INS_PBLENDW:          CategoryVector,
+	X86_INS_PCLMULQDQ:        CategoryCrypto,
+	X86_INS_PCMPEQQ:          CategoryVector,
+	X86_INS_PCMPESTRI:        CategoryVector,
+	X86_INS_PCMPESTRM:        CategoryVector,
+	X86_INS_PCMPGTQ:          CategoryVector,
+	X86_INS_PCMPISTRI:        CategoryVector,
+	X86_INS_PCMPISTRM:        CategoryVector,
+	X86_INS_PDEP:             CategoryLogic,
+	X86_INS_PEXT:             CategoryLogic,
+	X86_INS_PEXTRB:           CategoryVector,
+	X86_INS_PEXTRD:           CategoryVector,
+	X86_INS_PEXTRQ:           CategoryVector,
+	X86_INS_PF2ID:            CategoryVector,
+	X86_INS_PF2IW:            CategoryVector,
+	X86_INS_PFACC:            CategoryVector,
+	X86_INS_PFADD:            CategoryVector,
+	X86_INS_PFCMPEQ:          CategoryVector,
+	X86_INS_PFCMPGE:          CategoryVector,
+	X86_INS_PFCMPGT:          CategoryVector,
+	X86_INS_PFMAX:            CategoryVector,
+	X86_INS_PFMIN:            CategoryVector,
+	X86_INS_PFMUL:            CategoryVector,
+	X86_INS_PFNACC:           CategoryVector,
+	X86_INS_PFPNACC:          CategoryVector,
+	X86_INS_PFRCPIT1:         CategoryVector,
+	X86_INS_PFRCPIT2:         CategoryVector,
+	X86_INS_PFRCP:            CategoryVector,
+	X86_INS_PFRSQIT1:         CategoryVector,
+	X86_INS_PFRSQRT:          CategoryVector,
+	X86_INS_PFSUBR:           CategoryVector,
+	X86_INS_PFSUB:            CategoryVector,
+	X86_INS_PHMINPOSUW:       CategoryVector,
+	X86_INS_PI2FD:            CategoryVector,
+	X86_INS_PI2FW:            CategoryVector,
+	X86_INS_PINSRB:           CategoryVector,
+	X86_INS_PINSRD:           CategoryVector,
+	X86_INS_PINSRQ:           CategoryVector,
+	X86_INS_PMAXSB:           CategoryVector,
+	X86_INS_PMAXSD:           CategoryVector,
+	X86_INS_PMAXUD:           CategoryVector,
+	X86_INS_PMAXUW:           CategoryVector,
+	X86_INS_PMINSB:           CategoryVector,
+	X86_INS_PMINSD:           CategoryVector,
+	X86_INS_PMINUD:           CategoryVector,
+	X86_INS_PMINUW:           CategoryVector,
+	X86_INS_PMOVSXBD:         CategoryVector,
+	X86_INS_PMOVSXBQ:         CategoryVector,
+	X86_INS_PMOVSXBW:         CategoryVector,
+	X86_INS_PMOVSXDQ:         CategoryVector,
+	X86_INS_PMOVSXWD:         CategoryVector,
+	X86_INS_PMOVSXWQ:         CategoryVector,
+	X86_INS_PMOVZXBD:         CategoryVector,
+	X86_INS_PMOVZXBQ:         CategoryVector,
+	X86_INS_PMOVZXBW:         CategoryVector,
+	X86_INS_PMOVZXDQ:         CategoryVector,
+	X86_INS_PMOVZXWD:         CategoryVector,
+	X86_INS_PMOVZXWQ:         CategoryVector,
+	X86_INS_PMULDQ:           CategoryVector,
+	X86_INS_PMULHRW:          CategoryVector,
+	X86_INS_PMULLD:           CategoryVector,
+	X86_INS_POP:              CategoryStack,
+	X86_INS_POPAW:            CategoryStack,
+	X86_INS_POPAL:            CategoryStack,
+	X86_INS_POPCNT:           CategoryLogic,
+	X86_INS_POPF:             CategoryStack,
+	X86_INS_POPFD:            CategoryStack,
+	X86_INS_POPFQ:            CategoryStack,
+	X86_INS_PREFETCH:         CategorySystem,
+	X86_INS_PREFETCHNTA:      CategorySystem,
+	X86_INS_PREFETCHT0:       CategorySystem,
+	X86_INS_PREFETCHT1:       CategorySystem,
+	X86_INS_PREFETCHT2:       CategorySystem,
+	X86_INS_PREFETCHW:        CategorySystem,
+	X86_INS_PSHUFD:           CategoryVector,
+	X86_INS_PSHUFHW:          CategoryVector,
+	X86_INS_PSHUFLW:          CategoryVector,
+	X86_INS_PSLLDQ:           CategoryVector,
+	X86_INS_PSRLDQ:           CategoryVector,
+	X86_INS_PSWAPD:           CategoryVector,
+	X86_INS_PTEST:            CategoryVector,
+	X86_INS_PUNPCKHQDQ:       CategoryVector,
+	X86_INS_PUNPCKLQDQ:       CategoryVector,
+	X86_INS_PUSH:             CategoryStack,
+	X86_INS_PUSHAW:           CategoryStack,
+	X86_INS_PUSHAL:           CategoryStack,
+	X86_INS_PUSHF:            CategoryStack,
+	X86_INS_PUSHFD:           CategoryStack,
+	X86_INS_PUSHFQ:           CategoryStack,
+	X86_INS_RCL:              CategoryShift,
+	X86_INS_RCPPS:            CategoryVector,
+	X86_INS_RCPSS:            CategoryFloat,
+	X86_INS_RCR:              CategoryShift,
+	X86_INS_RDFSBASE:         CategorySystem,
+	X86_INS_RDGSBASE:         CategorySystem,
+	X86_INS_RDMSR:            CategorySystem,
+	X86_INS_RDPMC:            CategorySystem,
+	X86_INS_RDRAND:           CategorySystem,
+	X86_INS_RDSEED:           CategorySystem,
+	X86_INS_RDTSC:            CategorySystem,
+	X86_INS_RDTSCP:           CategorySystem,
+	X86_INS_ROL:              CategoryShift,
+	X86_INS_ROR:              CategoryShift,
+	X86_INS_RORX:             CategoryShift,
+	X86_INS_ROUNDPD:          CategoryVector,
+	X86_INS_ROUNDPS:          CategoryVector,
+	X86_INS_ROUNDSD:          CategoryFloat,
+	X86_INS_ROUNDSS:          CategoryFloat,
+	X86_INS_RSM:              CategorySystem,
+	X86_INS_RSQRTPS:          CategoryVector,
+	X86_INS_RSQRTSS:          CategoryFloat,
+	X86_INS_SAHF:             CategoryMove,
+	X86_INS_SAL:              CategoryShift,
+	X86_INS_SALC:             CategoryMove,
+	X86_INS_SAR:              CategoryShift,
+	X86_INS_SARX:             CategoryShift,
+	X86_INS_SBB:              CategoryArithmetic,
+	X86_INS_SCASB:            CategoryCompare,
+	X86_INS_SCASD:            CategoryCompare,
+	X86_INS_SCASQ:            CategoryCompare,
+	X86_INS_SCASW:            CategoryCompare,
+	X86_INS_SETAE:            CategoryCompare,
+	X86_INS_SETA:             CategoryCompare,
+	X86_INS_SETBE:            CategoryCompare,
+	X86_INS_SETB:             CategoryCompare,
+	X86_INS_SETE:             CategoryCompare,
+	X86_INS_SETGE:            CategoryCompare,
+	X86_INS_SETG:             CategoryCompare,
+	X86_INS_SETLE:            CategoryCompare,
+	X86_INS_SETL:             CategoryCompare,
+	X86_INS_SETNE:            CategoryCompare,
+	X86_INS_SETNO:            CategoryCompare,
+	X86_INS_SETNP:            CategoryCompare,
+	X86_INS_SETNS:            CategoryCompare,
+	X86_INS_SETO:             CategoryCompare,
+	X86_INS_SETP:             CategoryCompare,
+	X86_INS_SETS:             CategoryCompare,
+	X86_INS_SFENCE:           CategoryAtomic,
+	X86_INS_SGDT:             CategorySystem,
+	X86_INS_SHA1MSG1:         CategoryCrypto,
+	X86_INS_SHA1MSG2:         CategoryCrypto,
+	X86_INS_SHA1NEXTE:        CategoryCrypto,
+	X86_INS_SHA1RNDS4:        CategoryCrypto,
+	X86_INS_SHA256MSG1:       CategoryCrypto,
+	X86_INS_SHA256MSG2:       CategoryCrypto,
+	X86_INS_SHA256RNDS2:      CategoryCrypto,
+	X86_INS_SHL:              CategoryShift,
+	X86_INS_SHLD:             CategoryShift,
+	X86_INS_SHLX:             CategoryShift,
+	X86_INS_SHR:              CategoryShift,
+	X86_INS_SHRD:             CategoryShift,
+	X86_INS_SHRX:             CategoryShift,
+	X86_INS_SHUFPD:           CategoryVector,
+	X86_INS_SHUFPS:           CategoryVector,
+	X86_INS_SIDT:             CategorySystem,
+	X86_INS_FSIN:             CategoryFloat,
+	X86_INS_SKINIT:           CategorySystem,
+	X86_INS_SLDT:             CategorySystem,
+	X86_INS_SMSW:             CategorySystem,
+	X86_INS_SQRTPD:           CategoryVector,
+	X86_INS_SQRTPS:           CategoryVector,
+	X86_INS_SQRTSD:           CategoryFloat,
+	X86_INS_SQRTSS:           CategoryFloat,
+	X86_INS_FSQRT:            CategoryFloat,
+	X86_INS_STAC:             CategorySystem,
+	X86_INS_STC:              CategorySystem,
+	X86_INS_STD:              CategorySystem,
+	X86_INS_STGI:             CategorySystem,
+	X86_INS_STI:              CategorySystem,
+	X86_INS_STMXCSR:          CategoryFloat,
+	X86_INS_STOSB:            CategoryStore,
+	X86_INS_STOSD:            CategoryStore,
+	X86_INS_STOSQ:            CategoryStore,
+	X86_INS_STOSW:            CategoryStore,
+	X86_INS_STR:              CategorySystem,
+	X86_INS_FST:              CategoryFloat,
+	X86_INS_FSTP:             CategoryFloat,
+	X86_INS_FSTPNCE:          CategoryFloat,
+	X86_INS_SUBPD:            CategoryVector,
+	X86_INS_SUBPS:            CategoryVector,
+	X86_INS_FSUBR:            CategoryFloat,
+	X86_INS_FISUBR:           CategoryFloat,
+	X86_INS_FSUBRP:           CategoryFloat,
+	X86_INS_SUBSD:            CategoryFloat,
+	X86_INS_SUBSS:            CategoryFloat,
+	X86_INS_FSUB:             CategoryFloat,
+	X86_INS_FISUB:            CategoryFloat,
+	X86_INS_FSUBP:            CategoryFloat,
+	X86_INS_SWAPGS:           CategorySystem,
+	X86_INS_SYSCALL:          CategorySystem,
+	X86_INS_SYSENTER:         CategorySystem,
+	X86_INS_SYSEXIT:          CategoryReturn,
+	X86_INS_SYSRET:           CategoryReturn,
+	X86_INS_T1MSKC:           CategoryLogic,
+	X86_INS_TEST:             CategoryCompare,
+	X86_INS_UD2:              CategorySystem,
+	X86_INS_FTST:             CategoryFloat,
+	X86_INS_TZCNT:            CategoryLogic,
+	X86_INS_TZMSK:            CategoryLogic,
+	X86_INS_FUCOMPI:          CategoryFloat,
+	X86_INS_FUCOMI:           CategoryFloat,
+	X86_INS_FUCOMPP:          CategoryFloat,
+	X86_INS_FUCOMP:           CategoryFloat,
+	X86_INS_FUCOM:            CategoryFloat,
+	X86_INS_UD2B:             CategorySystem,
+	X86_INS_UNPCKHPD:         CategoryVector,
+	X86_INS_UNPCKHPS:         CategoryVector,
+	X86_INS_UNPCKLPD:         CategoryVector,
+	X86_INS_UNPCKLPS:         CategoryVector,
+	X86_INS_VADDPD:           CategoryVector,
+	X86_INS_VADDPS:           CategoryVector,
+	X86_INS_VADDSD:           CategoryFloat,
+	X86_INS_VADDSS:           CategoryFloat,
+	X86_INS_VADDSUBPD:        CategoryVector,
+	X86_INS_VADDSUBPS:        CategoryVector,
+	X86_INS_VAESDECLAST:      CategoryCrypto,
+	X86_INS_VAESDEC:          CategoryCrypto,
+	X86_INS_VAESENCLAST:      CategoryCrypto,
+	X86_INS_VAESENC:          CategoryCrypto,
+	X86_INS_VAESIMC:          CategoryCrypto,
+	X86_INS_VAESKEYGENASSIST: CategoryCrypto,
+	X86_INS_VALIGND:          CategoryVector,
+	X86_INS_VALIGNQ:          CategoryVector,
+	X86_INS_VANDNPD:          CategoryVector,
+	X86_INS_VANDNPS:          CategoryVector,
+	X86_INS_VANDPD:           CategoryVector,
+	X86_INS_VANDPS:           CategoryVector,
+	X86_INS_VBLENDMPD:        CategoryVector,
+	X86_INS_VBLENDMPS:        CategoryVector,
+	X86_INS_VBLENDPD:         CategoryVector,
+	X86_INS_VBLENDPS:         CategoryVector,
+	X86_INS_VBLENDVPD:        CategoryVector,
+	X86_INS_VBLENDVPS:        CategoryVector,
+	X86_INS_VBROADCASTF128:   CategoryVector,
+	X86_INS_VBROADCASTI128:   CategoryVector,
+	X86_INS_VBROADCASTI32X4:  CategoryVector,
+	X86_INS_VBROADCASTI64X4:  CategoryVector,
+	X86_INS_VBROADCASTSD:     CategoryVector,
+	X86_INS_VBROADCASTSS:     CategoryVector,
+	X86_INS_VCMPPD:           CategoryVector,
+	X86_INS_VCMPPS:           CategoryVector,
+	X86_INS_VCMPSD:           CategoryFloat,
+	X86_INS_VCMPSS:           CategoryFloat,
+	X86_INS_VCVTDQ2PD:        CategoryVector,
+	X86_INS_VCVTDQ2PS:        CategoryVector,
+	X86_INS_VCVTPD2DQX:       CategoryVector,
+	X86_INS_VCVTPD2DQ:        CategoryVector,
+	X86_INS_VCVTPD2PSX:       CategoryVector,
+	X86_INS_VCVTPD2PS:        CategoryVector,
+	X86_INS_VCVTPD2UDQ:       CategoryVector,
+	X86_INS_VCVTPH2PS:        CategoryVector,
+	X86_INS_VCVTPS2DQ:        CategoryVector,
+	X86_INS_VCVTPS2PD:        CategoryVector,
+	X86_INS_VCVTPS2PH:        CategoryVector,
+	X86_INS_VCVTPS2UDQ:       CategoryVector,
+	X86_INS_VCVTSD2SI:        CategoryFloat,
+	X86_INS_VCVTSD2USI:       CategoryFloat,
+	X86_INS_VCVTSS2SI:        CategoryFloat,
+	X86_INS_VCVTSS2USI:       CategoryFloat,
+	X86_INS_VCVTTPD2DQX:      CategoryVector,
+	X86_INS_VCVTTPD2DQ:       CategoryVector,
+	X86_INS_VCVTTPD2UDQ:      CategoryVector,
+	X86_INS_VCVTTPS2DQ:       CategoryVector,
+	X86_INS_VCVTTPS2UDQ:      CategoryVector,
+	X86_INS_VCVTUDQ2PD:       CategoryVector,
+	X86_INS_VCVTUDQ2PS:       CategoryVector,
+	X86_INS_VDIVPD:           CategoryVector,
+	X86_INS_VDIVPS:           CategoryVector,
+	X86_INS_VDIVSD:           CategoryFloat,
+	X86_INS_VDIVSS:           CategoryFloat,
+	X86_INS_VDPPD:            CategoryVector,
+	X86_INS_VDPPS:            CategoryVector,
+	X86_INS_VERR:             CategorySystem,
+	X86_INS_VERW:             CategorySystem,
+	X86_INS_VEXTRACTF128:     CategoryVector,
+	X86_INS_VEXTRACTF32X4:    CategoryVector,
+	X86_INS_VEXTRACTF64X4:    CategoryVector,
+	X86_INS_VEXTRACTI128:     CategoryVector,
+	X86_INS_VEXTRACTI32X4:    CategoryVector,
+	X86_INS_VEXTRACTI64X4:    CategoryVector,
+	X86_INS_VEXTRACTPS:       CategoryVector,
+	X86_INS_VFMADD132PD:      CategoryVector,
+	X86_INS_VFMADD132PS:      CategoryVector,
+	X86_INS_VFMADD213PD:      CategoryVector,
+	X86_INS_VFMADD213PS:      CategoryVector,
+	X86_INS_VFMADDPD:         CategoryVector,
+	X86_INS_VFMADD231PD:      CategoryVector,
+	X86_INS_VFMADDPS:         CategoryVector,
+	X86_INS_VFMADD231PS:      CategoryVector,
+	X86_INS_VFMADDSD:         CategoryFloat,
+	X86_INS_VFMADD213SD:      CategoryFloat,
+	X86_INS_VFMADD132SD:      CategoryFloat,
+	X86_INS_VFMADD231SD:      CategoryFloat,
+	X86_INS_VFMADDSS:         CategoryFloat,
+	X86_INS_VFMADD213SS:      CategoryFloat,
+	X86_INS_VFMADD132SS:      CategoryFloat,
+	X86_INS_VFMADD231SS:      CategoryFloat,
+	X86_INS_VFMADDSUB132PD:   CategoryVector,
+	X86_INS_VFMADDSUB132PS:   CategoryVector,
+	X86_INS_VFMADDSUB213PD:   CategoryVector,
+	X86_INS_VFMADDSUB213PS:   CategoryVector,
+	X86_INS_VFMADDSUBPD:      CategoryVector,
+	X86_INS_VFMADDSUB231PD:   CategoryVector,
+	X86_INS_VFMADDSUBPS:      CategoryVector,
+	X86_INS_VFMADDSUB231PS:   CategoryVector,
+	X86_INS_VFMSUB132PD:      CategoryVector,
+	X86_INS_VFMSUB132PS:      CategoryVector,
+	X86_INS_VFMSUB213PD:      CategoryVector,
+	X86_INS_VFMSUB213PS:      CategoryVector,
+	X86_INS_VFMSUBADD132PD:   CategoryVector,
+	X86_INS_VFMSUBADD132PS:   CategoryVector,
+	X86_INS_VFMSUBADD213PD:   CategoryVector,
+	X86_INS_VFMSUBADD213PS:   CategoryVector,
+	X86_INS_VFMSUBADDPD:      CategoryVector,
+	X86_INS_VFMSUBADD231PD:   CategoryVector,
+	X86_INS_VFMSUBADDPS:      CategoryVector,
+	X86_INS_VFMSUBADD231PS:   CategoryVector,
+	X86_INS_VFMSUBPD:         CategoryVector,
+	X86_INS_VFMSUB231PD:      CategoryVector,
+	X86_INS_VFMSUBPS:         CategoryVector,
+	X86_INS_VFMSUB231PS:      CategoryVector,
+	X86_INS_VFMSUBSD:         CategoryFloat,
+	X86_INS_VFMSUB213SD:      CategoryFloat,
+	X86_INS_VFMSUB132SD:      CategoryFloat,
+	X86_INS_VFMSUB231SD:      CategoryFloat,
+	X86_INS_VFMSUBSS:         CategoryFloat,
+	X86_INS_VFMSUB213SS:      CategoryFloat,
+	X86_INS_VFMSUB132SS:      CategoryFloat,
+	X86_INS_VFMSUB231SS:      CategoryFloat,
+	X86_INS_VFNMADD132PD:     CategoryVector,
+	X86_INS_VFNMADD132PS:     CategoryVector,
+	X86_INS_VFNMADD213PD:     CategoryVector,
+	X86_INS_VFNMADD213PS:     CategoryVector,
+	X86_INS_VFNMADDPD:        CategoryVector,
+	X86_INS_VFNMADD231PD:     CategoryVector,
+	X86_INS_VFNMADDPS:        CategoryVector,
+	X86_INS_VFNMADD231PS:     CategoryVector,
+	X86_INS_VFNMADDSD:        CategoryFloat,
+	X86_INS_VFNMADD213SD:     CategoryFloat,
+	X86_INS_VFNMADD132SD:     CategoryFloat,
+	X86_INS_VFNMADD231SD:     CategoryFloat,
+	X86_INS_VFNMADDSS:        CategoryFloat,
+	X86_INS_VFNMADD213SS:     CategoryFloat,
+	X86_INS_VFNMADD132SS:     CategoryFloat,
+	X86_INS_VFNMADD231SS:     CategoryFloat,
+	X86_INS_VFNMSUB132PD:     CategoryVector,
+	X86_INS_VFNMSUB132PS:     CategoryVector,
+	X86_INS_VFNMSUB213PD:     CategoryVector,
+	X86_INS_VFNMSUB213PS:     CategoryVector,
+	X86_INS_VFNMSUBPD:        CategoryVector,
+	X86_INS_VFNMSUB231PD:     CategoryVector,
+	X86_INS_VFNMSUBPS:        CategoryVector,
+	X86_INS_VFNMSUB231PS:     CategoryVector,
+	X86_INS_VFNMSUBSD:        CategoryFloat,
+	X86_INS_VFNMSUB213SD:     CategoryFloat,
+	X86_INS_VFNMSUB132SD:     CategoryFloat,
+	X86_INS_VFNMSUB231SD:     CategoryFloat,
+	X86_INS_VFNMSUBSS:        CategoryFloat,
+	X86_INS_VFNMSUB213SS:     CategoryFloat,
+	X86_INS_VFNMSUB132SS:     CategoryFloat,
+	X86_INS_VFNMSUB231SS:     CategoryFloat,
+	X86_INS_VFRCZPD:          CategoryVector,
+	X86_INS_VFRCZPS:          CategoryVector,
+	X86_INS_VFRCZSD:          CategoryFloat,
+	X86_INS_VFRCZSS:          CategoryFloat,
+	X86_INS_VORPD:            CategoryVector,
+	X86_INS_VORPS:            CategoryVector,
+	X86_INS_VXORPD:           CategoryVector,
+	X86_INS_VXORPS:           CategoryVector,
+	X86_INS_VGATHERDPD:       CategoryVector,
+	X86_INS_VGATHERDPS:       CategoryVector,
+	X86_INS_VGATHERPF0DPD:    CategoryVector,
+	X86_INS_VGATHERPF0DPS:    CategoryVector,
+	X86_INS_VGATHERPF0QPD:    CategoryVector,
+	X86_INS_VGATHERPF0QPS:    CategoryVector,
+	X86_INS_VGATHERPF1DPD:    CategoryVector,
+	X86_INS_VGATHERPF1DPS:    CategoryVector,
+	X86_INS_VGATHERPF1QPD:    CategoryVector,
+	X86_INS_VGATHERPF1QPS:    CategoryVector,
+	X86_INS_VGATHERQPD:       CategoryVector,
+	X86_INS_VGATHERQPS:       CategoryVector,
+	X86_INS_VHADDPD:          CategoryVector,
+	X86_INS_VHADDPS:          CategoryVector,
+	X86_INS_VHSUBPD:          CategoryVector,
+	X86_INS_VHSUBPS:          CategoryVector,
+	X86_INS_VINSERTF128:      CategoryVector,
+	X86_INS_VINSERTF32X4:     CategoryVector,
+	X86_INS_VINSERTF64X4:     CategoryVector,
+	X86_INS_VINSERTI128:      CategoryVector,
+	X86_INS_VINSERTI32X4:     CategoryVector,
+	X86_INS_VINSERTI64X4:     CategoryVector,
+	X86_INS_VINSERTPS:        CategoryVector,
+	X86_INS_VLDDQU:           CategoryLoad,
+	X86_INS_VLDMXCSR:         CategoryFloat,
+	X86_INS_VMASKMOVDQU:      CategoryStore,
+	X86_INS_VMASKMOVPD:       CategoryVector,
+	X86_INS_VMASKMOVPS:       CategoryVector,
+	X86_INS_VMAXPD:           CategoryVector,
+	X86_INS_VMAXPS:           CategoryVector,
+	X86_INS_VMAXSD:           CategoryFloat,
+	X86_INS_VMAXSS:           CategoryFloat,
+	X86_INS_VMCALL:           CategorySystem,
+	X86_INS_VMCLEAR:          CategorySystem,
+	X86_INS_VMFUNC:           CategorySystem,
+	X86_INS_VMINPD:           CategoryVector,
+	X86_INS_VMINPS:           CategoryVector,
+	X86_INS_VMINSD:           CategoryFloat,
+	X86_INS_VMINSS:           CategoryFloat,
+	X86_INS_VMLAUNCH:         CategorySystem,
+	X86_INS_VMLOAD:           CategorySystem,
+	X86_INS_VMMCALL:          CategorySystem,
+	X86_INS_VMOVQ:            CategoryMove,
+	X86_INS_VMOVDDUP:         CategoryVector,
+	X86_INS_VMOVD:            CategoryMove,
+	X86_INS_VMOVDQA32:        CategoryMove,
+	X86_INS_VMOVDQA64:        CategoryMove,
+	X86_INS_VMOVDQA:          CategoryMove,
+	X86_INS_VMOVDQU16:        CategoryMove,
+	X86_INS_VMOVDQU32:        CategoryMove,
+	X86_INS_VMOVDQU64:        CategoryMove,
+	X86_INS_VMOVDQU8:         CategoryMove,
+	X86_INS_VMOVDQU:          CategoryMove,
+	X86_INS_VMOVHLPS:         CategoryVector,
+	X86_INS_VMOVHPD:          CategoryMove,
+	X86_INS_VMOVHPS:          CategoryMove,
+	X86_INS_VMOVLHPS:         CategoryVector,
+	X86_INS_VMOVLPD:          CategoryMove,
+	X86_INS_VMOVLPS:          CategoryMove,
+	X86_INS_VMOVMSKPD:        CategoryVector,
+	X86_INS_VMOVMSKPS:        CategoryVector,
+	X86_INS_VMOVNTDQA:        CategoryLoad,
+	X86_INS_VMOVNTDQ:         CategoryStore,
+	X86_INS_VMOVNTPD:         CategoryStore,
+	X86_INS_VMOVNTPS:         CategoryStore,
+	X86_INS_VMOVSD:           CategoryMove,
+	X86_INS_VMOVSHDUP:        CategoryVector,
+	X86_INS_VMOVSLDUP:        CategoryVector,
+	X86_INS_VMOVSS:           CategoryMove,
+	X86_INS_VMOVUPD:          CategoryMove,
+	X86_INS_VMOVUPS:          CategoryMove,
+	X86_INS_VMPSADBW:         CategoryVector,
+	X86_INS_VMPTRLD:          CategorySystem,
+	X86_INS_VMPTRST:          CategorySystem,
+	X86_INS_VMREAD:           CategorySystem,
+	X86_INS_VMRESUME:         CategorySystem,
+	X86_INS_VMRUN:            CategorySystem,
+	X86_INS_VMSAVE:           CategorySystem,
+	X86_INS_VMULPD:           CategoryVector,
+	X86_INS_VMULPS:           CategoryVector,
+	X86_INS_VMULSD:           CategoryFloat,
+	X86_INS_VMULSS:           CategoryFloat,
+	X86_INS_VMWRITE:          CategorySystem,
+	X86_INS_VMXOFF:           CategorySystem,
+	X86_INS_VMXON:            CategorySystem,
+	X86_INS_VPABSB:           CategoryVector,
+	X86_INS_VPABSD:           CategoryVector,
+	X86_INS_VPABSQ:           CategoryVector,
+	X86_INS_VPABSW:           CategoryVector,
+	X86_INS_VPACKSSDW:        CategoryVector,
+	X86_INS_VPACKSSWB:        CategoryVector,
+	X86_INS_VPACKUSDW:        CategoryVector,
+	X86_INS_VPACKUSWB:        CategoryVector,
+	X86_INS_VPADDB:           CategoryVector,
+	X86_INS_VPADDD:           CategoryVector,
+	X86_INS_VPADDQ:           CategoryVector,
+	X86_INS_VPADDSB:          CategoryVector,
+	X86_INS_VPADDSW:          CategoryVector,
+	X86_INS_VPADDUSB:         CategoryVector,
+	X86_INS_VPADDUSW:         CategoryVector,
+	X86_INS_VPADDW:           CategoryVector,
+	X86_INS_VPALIGNR:         CategoryVector,
+	X86_INS_VPANDD:           CategoryVector,
+	X86_INS_VPANDND:          CategoryVector,
+	X86_INS_VPANDNQ:          CategoryVector,
+	X86_INS_VPANDN:           CategoryVector,
+	X86_INS_VPANDQ:           CategoryVector,
+	X86_INS_VPAND:            CategoryVector,
+	X86_INS_VPAVGB:           CategoryVector,
+	X86_INS_VPAVGW:           CategoryVector,
+	X86_INS_VPBLENDD:         CategoryVector,
+	X86_INS_VPBLENDMD:        CategoryVector,
+	X86_INS_VPBLENDMQ:        CategoryVector,
+	X86_INS_VPBLENDVB:        CategoryVector,
+	X86_INS_VPBLENDW:         CategoryVector,
+	X86_INS_VPBROADCASTB:     CategoryVector,
+	X86_INS_VPBROADCASTD:     CategoryVector,
+	X86_INS_VPBROADCASTMB2Q:  CategoryVector,
+	X86_INS_VPBROADCASTMW2D:  CategoryVector,
+	X86_INS_VPBROADCASTQ:     CategoryVector,
+	X86_INS_VPBROADCASTW:     CategoryVector,
+	X86_INS_VPCLMULQDQ:       CategoryCrypto,
+	X86_INS_VPCMOV:           CategoryVector,
+	X86_INS_VPCMP:            CategoryVector,
+	X86_INS_VPCMPD:           CategoryVector,
+	X86_INS_VPCMPEQB:         CategoryVector,
+	X86_INS_VPCMPEQD:         CategoryVector,
+	X86_INS_VPCMPEQQ:         CategoryVector,
+	X86_INS_VPCMPEQW:         CategoryVector,
+	X86_INS_VPCMPESTRI:       CategoryVector,
+	X86_INS_VPCMPESTRM:       CategoryVector,
+	X86_INS_VPCMPGTB:         CategoryVector,
+	X86_INS_VPCMPGTD:         CategoryVector,
+	X86_INS_VPCMPGTQ:         CategoryVector,
+	X86_INS_VPCMPGTW:         CategoryVector,
+	X86_INS_VPCMPISTRI:       CategoryVector,
+	X86_INS_VPCMPISTRM:       CategoryVector,
+	X86_INS_VPCMPQ:           CategoryVector,
+	X86_INS_VPCMPUD:          CategoryVector,
+	X86_INS_VPCMPUQ:          CategoryVector,
+	X86_INS_VPCOMB:           CategoryVector,
+	X86_INS_VPCOMD:           CategoryVector,
+	X86_INS_VPCOMQ:           CategoryVector,
+	X86_INS_VPCOMUB:          CategoryVector,
+	X86_INS_VPCOMUD:          CategoryVector,
+	X86_INS_VPCOMUQ:          CategoryVector,
+	X86_INS_VPCOMUW:          CategoryVector,
+	X86_INS_VPCOMW:           CategoryVector,
+	X86_INS_VPCONFLICTD:      CategoryVector,
+	X86_INS_VPCONFLICTQ:      CategoryVector,
+	X86_INS_VPERM2F128:       CategoryVector,
+	X86_INS_VPERM2I128:       CategoryVector,
+	X86_INS_VPERMD:           CategoryVector,
+	X86_INS_VPERMI2D:         CategoryVector,
+	X86_INS_VPERMI2PD:        CategoryVector,
+	X86_INS_VPERMI2PS:        CategoryVector,
+	X86_INS_VPERMI2Q:         CategoryVector,
+	X86_INS_VPERMIL2PD:       CategoryVector,
+	X86_INS_VPERMIL2PS:       CategoryVector,
+	X86_INS_VPERMILPD:        CategoryVector,
+	X86_INS_VPERMILPS:        CategoryVector,
+	X86_INS_VPERMPD:          CategoryVector,
+	X86_INS_VPERMPS:          CategoryVector,
+	X86_INS_VPERMQ:           CategoryVector,
+	X86_INS_VPERMT2D:         CategoryVector,
+	X86_INS_VPERMT2PD:        CategoryVector,
+	X86_INS_VPERMT2PS:        CategoryVector,
+	X86_INS_VPERMT2Q:         CategoryVector,
+	X86_INS_VPEXTRB:          CategoryVector,
+	X86_INS_VPEXTRD:          CategoryVector,
+	X86_INS_VPEXTRQ:          CategoryVector,
+	X86_INS_VPEXTRW:          CategoryVector,
+	X86_INS_VPGATHERDD:       CategoryVector,
+	X86_INS_VPGATHERDQ:       CategoryVector,
+	X86_INS_VPGATHERQD:       CategoryVector,
+	X86_INS_VPGATHERQQ:       CategoryVector,
+	X86_INS_VPHADDBD:         CategoryVector,
+	X86_INS_VPHADDBQ:         CategoryVector,
+	X86_INS_VPHADDBW:         CategoryVector,
+	X86_INS_VPHADDDQ:         CategoryVector,
+	X86_INS_VPHADDD:          CategoryVector,
+	X86_INS_VPHADDSW:         CategoryVector,
+	X86_INS_VPHADDUBD:        CategoryVector,
+	X86_INS_VPHADDUBQ:        CategoryVector,
+	X86_INS_VPHADDUBW:        CategoryVector,
+	X86_INS_VPHADDUDQ:        CategoryVector,
+	X86_INS_VPHADDUWD:        CategoryVector,
+	X86_INS_VPHADDUWQ:        CategoryVector,
+	X86_INS_VPHADDWD:         CategoryVector,
+	X86_INS_VPHADDWQ:         CategoryVector,
+	X86_INS_VPHADDW:          CategoryVector,
+	X86_INS_VPHMINPOSUW:      CategoryVector,
+	X86_INS_VPHSUBBW:         CategoryVector,
+	X86_INS_VPHSUBDQ:         CategoryVector,
+	X86_INS_VPHSUBD:          CategoryVector,
+	X86_INS_VPHSUBSW:         CategoryVector,
+	X86_INS_VPHSUBWD:         CategoryVector,
+	X86_INS_VPHSUBW:          CategoryVector,
+	X86_INS_VPINSRB:          CategoryVector,
+	X86_INS_VPINSRD:          CategoryVector,
+	X86_INS_VPINSRQ:          CategoryVector,
+	X86_INS_VPINSRW:          CategoryVector,
+	X86_INS_VPLZCNTD:         CategoryVector,
+	X86_INS_VPLZCNTQ:         CategoryVector,
+	X86_INS_VPMACSDD:         CategoryVector,
+	X86_INS_VPMACSDQH:        CategoryVector,
+	X86_INS_VPMACSDQL:        CategoryVector,
+	X86_INS_VPMACSSDD:        CategoryVector,
+	X86_INS_VPMACSSDQH:       CategoryVector,
+	X86_INS_VPMACSSDQL:       CategoryVector,
+	X86_INS_VPMACSSWD:        CategoryVector,
+	X86_INS_VPMACSSWW:        CategoryVector,
+	X86_INS_VPMACSWD:         CategoryVector,
+	X86_INS_VPMACSWW:         CategoryVector,
+	X86_INS_VPMADCSSWD:       CategoryVector,
+	X86_INS_VPMADCSWD:        CategoryVector,
+	X86_INS_VPMADDUBSW:       CategoryVector,
+	X86_INS_VPMADDWD:         CategoryVector,
+	X86_INS_VPMASKMOVD:       CategoryVector,
+	X86_INS_VPMASKMOVQ:       CategoryVector,
+	X86_INS_VPMAXSB:          CategoryVector,
+	X86_INS_VPMAXSD:          CategoryVector,
+	X86_INS_VPMAXSQ:          CategoryVector,
+	X86_INS_VPMAXSW:          CategoryVector,
+	X86_INS_VPMAXUB:          CategoryVector,
+	X86_INS_VPMAXUD:          CategoryVector,
+	X86_INS_VPMAXUQ:          CategoryVector,
+	X86_INS_VPMAXUW:          CategoryVector,
+	X86_INS_VPMINSB:          CategoryVector,
+	X86_INS_VPMINSD:          CategoryVector,
+	X86_INS_VPMINSQ:          CategoryVector,
+	X86_INS_VPMINSW:          CategoryVector,
+	X86_INS_VPMINUB:          CategoryVector,
+	X86_INS_VPMINUD:          CategoryVector,
+	X86_INS_VPMINUQ:          CategoryVector,
+	X86_INS_VPMINUW:          CategoryVector,
+	X86_INS_VPMOVDB:          CategoryVector,
+	X86_INS_VPMOVDW:          CategoryVector,
+	X86_INS_VPMOVMSKB:        CategoryVector,
+	X86_INS_VPMOVQB:          CategoryVector,
+	X86_INS_VPMOVQD:          CategoryVector,
+	X86_INS_VPMOVQW:          CategoryVector,
+	X86_INS_VPMOVSDB:         CategoryVector,
+	X86_INS_VPMOVSDW:         CategoryVector,
+	X86_INS_VPMOVSQB:         CategoryVector,
+	X86_INS_VPMOVSQD:         CategoryVector,
+	X86_INS_VPMOVSQW:         CategoryVector,
+	X86_INS_VPMOVSXBD:        CategoryVector,
+	X86_INS_VPMOVSXBQ:        CategoryVector,
+	X86_INS_VPMOVSXBW:        CategoryVector,
+	X86_INS_VPMOVSXDQ:        CategoryVector,
+	X86_INS_VPMOVSXWD:        CategoryVector,
+	X86_INS_VPMOVSXWQ:        CategoryVector,
+	X86_INS_VPMOVUSDB:        CategoryVector,
+	X86_INS_VPMOVUSDW:        CategoryVector,
+	X86_INS_VPMOVUSQB:        CategoryVector,
+	X86_INS_VPMOVUSQD:        CategoryVector,
+	X86_INS_VPMOVUSQW:        CategoryVector,
+	X86_INS_VPMOVZXBD:        CategoryVector,
+	X86_INS_VPMOVZXBQ:        CategoryVector,
+	X86_INS_VPMOVZXBW:        CategoryVector,
+	X86_INS_VPMOVZXDQ:        CategoryVector,
+	X86_INS_VPMOVZXWD:        CategoryVector,
+	X86_INS_VPMOVZXWQ:        CategoryVector,
+	X86_INS_VPMULDQ:          CategoryVector,
+	X86_INS_VPMULHRSW:        CategoryVector,
+	X86_INS_VPMULHUW:         CategoryVector,
+	X86_INS_VPMULHW:          CategoryVector,
+	X86_INS_VPMULLD:          CategoryVector,
+	X86_INS_VPMULLW:          CategoryVector,
+	X86_INS_VPMULUDQ:         CategoryVector,
+	X86_INS_VPORD:            CategoryVector,
+	X86_INS_VPORQ:            CategoryVector,
+	X86_INS_VPOR:             CategoryVector,
+	X86_INS_VPPERM:           CategoryVector,
+	X86_INS_VPROTB:           CategoryVector,
+	X86_INS_VPROTD:           CategoryVector,
+	X86_INS_VPROTQ:           CategoryVector,
+	X86_INS_VPROTW:           CategoryVector,
+	X86_INS_VPSADBW:          CategoryVector,
+	X86_INS_VPSCATTERDD:      CategoryVector,
+	X86_INS_VPSCATTERDQ:      CategoryVector,
+	X86_INS_VPSCATTERQD:      CategoryVector,
+	X86_INS_VPSCATTERQQ:      CategoryVector,
+	X86_INS_VPSHAB:           CategoryVector,
+	X86_INS_VPSHAD:           CategoryVector,
+	X86_INS_VPSHAQ:           CategoryVector,
+	X86_INS_VPSHAW:           CategoryVector,
+	X86_INS_VPSHLB:           CategoryVector,
+	X86_INS_VPSHLD:           CategoryVector,
+	X86_INS_VPSHLQ:           CategoryVector,
+	X86_INS_VPSHLW:           CategoryVector,
+	X86_INS_VPSHUFB:          CategoryVector,
+	X86_INS_VPSHUFD:          CategoryVector,
+	X86_INS_VPSHUFHW:         CategoryVector,
+	X86_INS_VPSHUFLW:         CategoryVector,
+	X86_INS_VPSIGNB:          CategoryVector,
+	X86_INS_VPSIGND:          CategoryVector,
+	X86_INS_VPSIGNW:          CategoryVector,
+	X86_INS_VPSLLDQ:          CategoryVector,
+	X86_INS_VPSLLD:           CategoryVector,
+	X86_INS_VPSLLQ:           CategoryVector,
+	X86_INS_VPSLLVD:          CategoryVector,
+	X86_INS_VPSLLVQ:          CategoryVector,
+	X86_INS_VPSLLW:           CategoryVector,
+	X86_INS_VPSRAD:           CategoryVector,
+	X86_INS_VPSRAQ:           CategoryVector,
+	X86_INS_VPSRAVD:          CategoryVector,
+	X86_INS_VPSRAVQ:          CategoryVector,
+	X86_INS_VPSRAW:           CategoryVector,
+	X86_INS_VPSRLDQ:          CategoryVector,
+	X86_INS_VPSRLD:           CategoryVector,
+	X86_INS_VPSRLQ:           CategoryVector,
+	X86_INS_VPSRLVD:          CategoryVector,
+	X86_INS_VPSRLVQ:          CategoryVector,
+	X86_INS_VPSRLW:           CategoryVector,
+	X86_INS_VPSUBB:           CategoryVector,
+	X86_INS_VPSUBD:           CategoryVector,
+	X86_INS_VPSUBQ:           CategoryVector,
+	X86_INS_VPSUBSB:          CategoryVector,
+	X86_INS_VPSUBSW:          CategoryVector,
+	X86_INS_VPSUBUSB:         CategoryVector,
+	X86_INS_VPSUBUSW:         CategoryVector,
+	X86_INS_VPSUBW:           CategoryVector,
+	X86_INS_VPTESTMD:         CategoryVector,
+	X86_INS_VPTESTMQ:         CategoryVector,
+	X86_INS_VPTESTNMD:        CategoryVector,
+	X86_INS_VPTESTNMQ:        CategoryVector,
+	X86_INS_VPTEST:           CategoryVector,
+	X86_INS_VPUNPCKHBW:       CategoryVector,
+	X86_INS_VPUNPCKHDQ:       CategoryVector,
+	X86_INS_VPUNPCKHQDQ:      CategoryVector,
+	X86_INS_VPUNPCKHWD:       CategoryVector,
+	X86_INS_VPUNPCKLBW:       CategoryVector,
+	X86_INS_VPUNPCKLDQ:       CategoryVector,
+	X86_INS_VPUNPCKLQDQ:      CategoryVector,
+	X86_INS_VPUNPCKLWD:       CategoryVector,
+	X86_INS_VPXORD:           CategoryVector,
+	X86_INS_VPXORQ:           CategoryVector,
+	X86_INS_VPXOR:            CategoryVector,
+	X86_INS_VRCP14PD:         CategoryVector,
+	X86_INS_VRCP14PS:         CategoryVector,
+	X86_INS_VRCP14SD:         CategoryFloat,
+	X86_INS_VRCP14SS:         CategoryFloat,
+	X86_INS_VRCP28PD:         CategoryVector,
+	X86_INS_VRCP28PS:         CategoryVector,
+	X86_INS_VRCP28SD:         CategoryFloat,
+	X86_INS_VRCP28SS:         CategoryFloat,
+	X86_INS_VRCPPS:           CategoryVector,
+	X86_INS_VRCPSS:           CategoryFloat,
+	X86_INS_VRNDSCALEPD:      CategoryVector,
+	X86_INS_VRNDSCALEPS:      CategoryVector,
+	X86_INS_VRNDSCALESD:      CategoryFloat,
+	X86_INS_VRNDSCALESS:      CategoryFloat,
+	X86_INS_VROUNDPD:         CategoryVector,
+	X86_INS_VROUNDPS:         CategoryVector,
+	X86_INS_VROUNDSD:         CategoryFloat,
+	X86_INS_VROUNDSS:         CategoryFloat,
+	X86_INS_VRSQRT14PD:       CategoryVector,
+	X86_INS_VRSQRT14PS:       CategoryVector,
+	X86_INS_VRSQRT14SD:       CategoryFloat,
+	X86_INS_VRSQRT14SS:       CategoryFloat,
+	X86_INS_VRSQRT28PD:       CategoryVector,
+	X86_INS_VRSQRT28PS:       CategoryVector,
+	X86_INS_VRSQRT28SD:       CategoryFloat,
+	X86_INS_VRSQRT28SS:       CategoryFloat,
+	X86_INS_VRSQRTPS:         CategoryVector,
+	X86_INS_VRSQRTSS:         CategoryFloat,
+	X86_INS_VSCATTERDPD:      CategoryVector,
+	X86_INS_VSCATTERDPS:      CategoryVector,
+	X86_INS_VSCATTERPF0DPD:   CategoryVector,
+	X86_INS_VSCATTERPF0DPS:   CategoryVector,
+	X86_INS_VSCATTERPF0QPD:   CategoryVector,
+	X86_INS_VSCATTERPF0QPS:   CategoryVector,
+	X86_INS_VSCATTERPF1DPD:   CategoryVector,
+	X86_INS_VSCATTERPF1DPS:   CategoryVector,
+	X86_INS_VSCATTERPF1QPD:   CategoryVector,
+	X86_INS_VSCATTERPF1QPS:   CategoryVector,
+	X86_INS_VSCATTERQPD:      CategoryVector,
+	X86_INS_VSCATTERQPS:      CategoryVector,
+	X86_INS_VSHUFPD:          CategoryVector,
+	X86_INS_VSHUFPS:          CategoryVector,
+	X86_INS_VSQRTPD:          CategoryVector,
+	X86_INS_VSQRTPS:          CategoryVector,
+	X86_INS_VSQRTSD:          CategoryFloat,
+	X86_INS_VSQRTSS:          CategoryFloat,
+	X86_INS_VSTMXCSR:         CategoryFloat,
+	X86_INS_VSUBPD:           CategoryVector,
+	X86_INS_VSUBPS:           CategoryVector,
+	X86_INS_VSUBSD:           CategoryFloat,
+	X86_INS_VSUBSS:           CategoryFloat,
+	X86_INS_VTESTPD:          CategoryVector,
+	X86_INS_VTESTPS:          CategoryVector,
+	X86_INS_VUNPCKHPD:        CategoryVector,
+	X86_INS_VUNPCKHPS:        CategoryVector,
+	X86_INS_VUNPCKLPD:        CategoryVector,
+	X86_INS_VUNPCKLPS:        CategoryVector,
+	X86_INS_VZEROALL:         CategoryVector,
+	X86_INS_VZEROUPPER:       CategoryVector,
+	X86_INS_WAIT:             CategoryFloat,
+	X86_INS_WBINVD:           CategorySystem,
+	X86_INS_WRFSBASE:         CategorySystem,
+	X86_INS_WRGSBASE:         CategorySystem,
+	X86_INS_WRMSR:            CategorySystem,
+	X86_INS_XABORT:           CategoryAtomic,
+	X86_INS_XACQUIRE:         CategoryAtomic,
+	X86_INS_XBEGIN:           CategoryAtomic,
+	X86_INS_XCHG:             CategoryAtomic,
+	X86_INS_FXCH:             CategoryFloat,
+	X86_INS_XCRYPTCBC:        CategoryCrypto,
+	X86_INS_XCRYPTCFB:        CategoryCrypto,
+	X86_INS_XCRYPTCTR:        CategoryCrypto,
+	X86_INS_XCRYPTECB:        CategoryCrypto,
+	X86_INS_XCRYPTOFB:        CategoryCrypto,
+	X86_INS_XEND:             CategoryAtomic,
+	X86_INS_XGETBV:           CategorySystem,
+	X86_INS_XLATB:            CategoryLoad,
+	X86_INS_XRELEASE:         CategoryAtomic,
+	X86_INS_XRSTOR:           CategorySystem,
+	X86_INS_XRSTOR64:         CategorySystem,
+	X86_INS_XSAVE:            CategorySystem,
+	X86_INS_XSAVE64:          CategorySystem,
+	X86_INS_XSAVEOPT:         CategorySystem,
+	X86_INS_XSAVEOPT64:       CategorySystem,
+	X86_INS_XSETBV:           CategorySystem,
+	X86_INS_XSHA1:            CategoryCrypto,
+	X86_INS_XSHA256:          CategoryCrypto,
+	X86_INS_XSTORE:           CategoryCrypto,
+	X86_INS_XTEST:            CategoryAtomic,
+}
+
+var armCategories = map[uint]Category{
+	ARM_INS_ADC:       CategoryArithmetic,
+	ARM_INS_ADD:       CategoryArithmetic,
+	ARM_INS_ADR:       CategoryArithmetic,
+	ARM_INS_AESD:      CategoryCrypto,
+	ARM_INS_AESE:      CategoryCrypto,
+	ARM_INS_AESIMC:    CategoryCrypto,
+	ARM_INS_AESMC:     CategoryCrypto,
+	ARM_INS_AND:       CategoryLogic,
+	ARM_INS_BFC:       CategoryLogic,
+	ARM_INS_BFI:       CategoryLogic,
+	ARM_INS_BIC:       CategoryLogic,
+	ARM_INS_BKPT:      CategorySystem,
+	ARM_INS_BL:        CategoryCall,
+	ARM_INS_BLX:       CategoryCall,
+	ARM_INS_BX:        CategoryBranch,
+	ARM_INS_BXJ:       CategoryBranch,
+	ARM_INS_B:         CategoryBranch,
+	ARM_INS_CDP:       CategorySystem,
+	ARM_INS_CDP2:      CategorySystem,
+	ARM_INS_CLREX:     CategoryAtomic,
+	ARM_INS_CLZ:       CategoryLogic,
+	ARM_INS_CMN:       CategoryCompare,
+	ARM_INS_CMP:       CategoryCompare,
+	ARM_INS_CPS:       CategorySystem,
+	ARM_INS_CRC32B:    CategoryArithmetic,
+	ARM_INS_CRC32CB:   CategoryArithmetic,
+	ARM_INS_CRC32CH:   CategoryArithmetic,
+	ARM_INS_CRC32CW:   CategoryArithmetic,
+	ARM_INS_CRC32H:    CategoryArithmetic,
+	ARM_INS_CRC32W:    CategoryArithmetic,
+	ARM_INS_DBG:       CategorySystem,
+	ARM_INS_DMB:       CategoryAtomic,
+	ARM_INS_DSB:       CategoryAtomic,
+	ARM_INS_EOR:       CategoryLogic,
+	ARM_INS_VMOV:      CategoryMove,
+	ARM_INS_FLDMDBX:   CategoryLoad,
+	ARM_INS_FLDMIAX:   CategoryLoad,
+	ARM_INS_VMRS:      CategorySystem,
+	ARM_INS_FSTMDBX:   CategoryStore,
+	ARM_INS_FSTMIAX:   CategoryStore,
+	ARM_INS_HINT:      CategoryNop,
+	ARM_INS_HLT:       CategorySystem,
+	ARM_INS_ISB:       CategorySystem,
+	ARM_INS_LDA:       CategoryAtomic,
+	ARM_INS_LDAB:      CategoryAtomic,
+	ARM_INS_LDAEX:     CategoryAtomic,
+	ARM_INS_LDAEXB:    CategoryAtomic,
+	ARM_INS_LDAEXD:    CategoryAtomic,
+	ARM_INS_LDAEXH:    CategoryAtomic,
+	ARM_INS_LDAH:      CategoryAtomic,
+	ARM_INS_LDC2L:     CategoryLoad,
+	ARM_INS_LDC2:      CategoryLoad,
+	ARM_INS_LDCL:      CategoryLoad,
+	ARM_INS_LDC:       CategoryLoad,
+	ARM_INS_LDMDA:     CategoryLoad,
+	ARM_INS_LDMDB:     CategoryLoad,
+	ARM_INS_LDM:       CategoryLoad,
+	ARM_INS_LDMIB:     CategoryLoad,
+	ARM_INS_LDRBT:     CategoryLoad,
+	ARM_INS_LDRB:      CategoryLoad,
+	ARM_INS_LDRD:      CategoryLoad,
+	ARM_INS_LDREX:     CategoryAtomic,
+	ARM_INS_LDREXB:    CategoryAtomic,
+	ARM_INS_LDREXD:    CategoryAtomic,
+	ARM_INS_LDREXH:    CategoryAtomic,
+	ARM_INS_LDRH:      CategoryLoad,
+	ARM_INS_LDRHT:     CategoryLoad,
+	ARM_INS_LDRSB:     CategoryLoad,
+	ARM_INS_LDRSBT:    CategoryLoad,
+	ARM_INS_LDRSH:     CategoryLoad,
+	ARM_INS_LDRSHT:    CategoryLoad,
+	ARM_INS_LDRT:      CategoryLoad,
+	ARM_INS_LDR:       CategoryLoad,
+	ARM_INS_MCR:       CategorySystem,
+	ARM_INS_MCR2:      CategorySystem,
+	ARM_INS_MCRR:      CategorySystem,
+	ARM_INS_MCRR2:     CategorySystem,
+	ARM_INS_MLA:       CategoryArithmetic,
+	ARM_INS_MLS:       CategoryArithmetic,
+	ARM_INS_MOV:       CategoryMove,
+	ARM_INS_MOVT:      CategoryMove,
+	ARM_INS_MOVW:      CategoryMove,
+	ARM_INS_MRC:       CategorySystem,
+	ARM_INS_MRC2:      CategorySystem,
+	ARM_INS_MRRC:      CategorySystem,
+	ARM_INS_MRRC2:     CategorySystem,
+	ARM_INS_MRS:       CategorySystem,
+	ARM_INS_MSR:       CategorySystem,
+	ARM_INS_MUL:       CategoryArithmetic,
+	ARM_INS_MVN:       CategoryLogic,
+	ARM_INS_ORR:       CategoryLogic,
+	ARM_INS_PKHBT:     CategoryVector,
+	ARM_INS_PKHTB:     CategoryVector,
+	ARM_INS_PLDW:      CategorySystem,
+	ARM_INS_PLD:       CategorySystem,
+	ARM_INS_PLI:       CategorySystem,
+	ARM_INS_QADD:      CategoryArithmetic,
+	ARM_INS_QADD16:    CategoryVector,
+	ARM_INS_QADD8:     CategoryVector,
+	ARM_INS_QASX:      CategoryVector,
+	ARM_INS_QDADD:     CategoryArithmetic,
+	ARM_INS_QDSUB:     CategoryArithmetic,
+	ARM_INS_QSAX:      CategoryVector,
+	ARM_INS_QSUB:      CategoryArithmetic,
+	ARM_INS_QSUB16:    CategoryVector,
+	ARM_INS_QSUB8:     CategoryVector,
+	ARM_INS_RBIT:      CategoryLogic,
+	ARM_INS_REV:       CategoryMove,
+	ARM_INS_REV16:     CategoryMove,
+	ARM_INS_REVSH:     CategoryMove,
+	ARM_INS_RFEDA:     CategoryReturn,
+	ARM_INS_RFEDB:     CategoryReturn,
+	ARM_INS_RFEIA:     CategoryReturn,
+	ARM_INS_RFEIB:     CategoryReturn,
+	ARM_INS_RSB:       CategoryArithmetic,
+	ARM_INS_RSC:       CategoryArithmetic,
+	ARM_INS_SADD16:    CategoryVector,
+	ARM_INS_SADD8:     CategoryVector,
+	ARM_INS_SASX:      CategoryVector,
+	ARM_INS_SBC:       CategoryArithmetic,
+	ARM_INS_SBFX:      CategoryLogic,
+	ARM_INS_SDIV:      CategoryArithmetic,
+	ARM_INS_SEL:       CategoryVector,
+	ARM_INS_SETEND:    CategorySystem,
+	ARM_INS_SHA1C:     CategoryCrypto,
+	ARM_INS_SHA1H:     CategoryCrypto,
+	ARM_INS_SHA1M:     CategoryCrypto,
+	ARM_INS_SHA1P:     CategoryCrypto,
+	ARM_INS_SHA1SU0:   CategoryCrypto,
+	ARM_INS_SHA1SU1:   CategoryCrypto,
+	ARM_INS_SHA256H:   CategoryCrypto,
+	ARM_INS_SHA256H2:  CategoryCrypto,
+	ARM_INS_SHA256SU0: CategoryCrypto,
+	ARM_INS_SHA256SU1: CategoryCrypto,
+	ARM_INS_SHADD16:   CategoryVector,
+	ARM_INS_SHADD8:    CategoryVector,
+	ARM_INS_SHASX:     CategoryVector,
+	ARM_INS_SHSAX:     CategoryVector,
+	ARM_INS_SHSUB16:   CategoryVector,
+	ARM_INS_SHSUB8:    CategoryVector,
+	ARM_INS_SMC:       CategorySystem,
+	ARM_INS_SMLABB:    CategoryArithmetic,
+	ARM_INS_SMLABT:    CategoryArithmetic,
+	ARM_INS_SMLAD:     CategoryArithmetic,
+	ARM_INS_SMLADX:    CategoryArithmetic,
+	ARM_INS_SMLAL:     CategoryArithmetic,
+	ARM_INS_SMLALBB:   CategoryArithmetic,
+	ARM_INS_SMLALBT:   CategoryArithmetic,
+	ARM_INS_SMLALD:    CategoryArithmetic,
+	ARM_INS_SMLALDX:   CategoryArithmetic,
+	ARM_INS_SMLALTB:   CategoryArithmetic,
+	ARM_INS_SMLALTT:   CategoryArithmetic,
+	ARM_INS_SMLATB:    CategoryArithmetic,
+	ARM_INS_SMLATT:    CategoryArithmetic,
+	ARM_INS_SMLAWB:    CategoryArithmetic,
+	ARM_INS_SMLAWT:    CategoryArithmetic,
+	ARM_INS_SMLSD:     CategoryArithmetic,
+	ARM_INS_SMLSDX:    CategoryArithmetic,
+	ARM_INS_SMLSLD:    CategoryArithmetic,
+	ARM_INS_SMLSLDX:   CategoryArithmetic,
+	ARM_INS_SMMLA:     CategoryArithmetic,
+	ARM_INS_SMMLAR:    CategoryArithmetic,
+	ARM_INS_SMMLS:     CategoryArithmetic,
+	ARM_INS_SMMLSR:    CategoryArithmetic,
+	ARM_INS_SMMUL:     CategoryArithmetic,
+	ARM_INS_SMMULR:    CategoryArithmetic,
+	ARM_INS_SMUAD:     CategoryArithmetic,
+	ARM_INS_SMUADX:    CategoryArithmetic,
+	ARM_INS_SMULBB:    CategoryArithmetic,
+	ARM_INS_SMULBT:    CategoryArithmetic,
+	ARM_INS_SMULL:     CategoryArithmetic,
+	ARM_INS_SMULTB:    CategoryArithmetic,
+	ARM_INS_SMULTT:    CategoryArithmetic,
+	ARM_INS_SMULWB:    CategoryArithmetic,
+	ARM_INS_SMULWT:    CategoryArithmetic,
+	ARM_INS_SMUSD:     CategoryArithmetic,
+	ARM_INS_SMUSDX:    CategoryArithmetic,
+	ARM_INS_SRSDA:     CategorySystem,
+	ARM_INS_SRSDB:     CategorySystem,
+	ARM_INS_SRSIA:     CategorySystem,
+	ARM_INS_SRSIB:     CategorySystem,
+	ARM_INS_SSAT:      CategoryArithmetic,
+	ARM_INS_SSAT16:    CategoryVector,
+	ARM_INS_SSAX:      CategoryVector,
+	ARM_INS_SSUB16:    CategoryVector,
+	ARM_INS_SSUB8:     CategoryVector,
+	ARM_INS_STC2L:     CategoryStore,
+	ARM_INS_STC2:      CategoryStore,
+	ARM_INS_STCL:      CategoryStore,
+	ARM_INS_STC:       CategoryStore,
+	ARM_INS_STL:       CategoryAtomic,
+	ARM_INS_STLB:      CategoryAtomic,
+	ARM_INS_STLEX:     CategoryAtomic,
+	ARM_INS_STLEXB:    CategoryAtomic,
+	ARM_INS_STLEXD:    CategoryAtomic,
+	ARM_INS_STLEXH:    CategoryAtomic,
+	ARM_INS_STLH:      CategoryAtomic,
+	ARM_INS_STMDA:     CategoryStore,
+	ARM_INS_STMDB:     CategoryStore,
+	ARM_INS_STM:       CategoryStore,
+	ARM_INS_STMIB:     CategoryStore,
+	ARM_INS_STRBT:     CategoryStore,
+	ARM_INS_STRB:      CategoryStore,
+	ARM_INS_STRD:      CategoryStore,
+	ARM_INS_STREX:     CategoryAtomic,
+	ARM_INS_STREXB:    CategoryAtomic,
+	ARM_INS_STREXD:    CategoryAtomic,
+	ARM_INS_STREXH:    CategoryAtomic,
+	ARM_INS_STRH:      CategoryStore,
+	ARM_INS_STRHT:     CategoryStore,
+	ARM_INS_STRT:      CategoryStore,
+	ARM_INS_STR:       CategoryStore,
+	ARM_INS_SUB:       CategoryArithmetic,
+	ARM_INS_SVC:       CategorySystem,
+	ARM_INS_SWP:       CategoryAtomic,
+	ARM_INS_SWPB:      CategoryAtomic,
+	ARM_INS_SXTAB:     CategoryArithmetic,
+	ARM_INS_SXTAB16:   CategoryVector,
+	ARM_INS_SXTAH:     CategoryArithmetic,
+	ARM_INS_SXTB:      CategoryMove,
+	ARM_INS_SXTB16:    CategoryVector,
+	ARM_INS_SXTH:      CategoryMove,
+	ARM_INS_TEQ:       CategoryCompare,
+	ARM_INS_TRAP:      CategorySystem,
+	ARM_INS_TST:       CategoryCompare,
+	ARM_INS_UADD16:    CategoryVector,
+	ARM_INS_UADD8:     CategoryVector,
+	ARM_INS_UASX:      CategoryVector,
+	ARM_INS_UBFX:      CategoryLogic,
+	ARM_INS_UDF:       CategorySystem,
+	ARM_INS_UDIV:      CategoryArithmetic,
+	ARM_INS_UHADD16:   CategoryVector,
+	ARM_INS_UHADD8:    CategoryVector,
+	ARM_INS_UHASX:     CategoryVector,
+	ARM_INS_UHSAX:     CategoryVector,
+	ARM_INS_UHSUB16:   CategoryVector,
+	ARM_INS_UHSUB8:    CategoryVector,
+	ARM_INS_UMAAL:     CategoryArithmetic,
+	ARM_INS_UMLAL:     CategoryArithmetic,
+	ARM_INS_UMULL:     CategoryArithmetic,
+	ARM_INS_UQADD16:   CategoryVector,
+	ARM_INS_UQADD8:    CategoryVector,
+	ARM_INS_UQASX:     CategoryVector,
+	ARM_INS_UQSAX:     CategoryVector,
+	ARM_INS_UQSUB16:   CategoryVector,
+	ARM_INS_UQSUB8:    CategoryVector,
+	ARM_INS_USAD8:     CategoryVector,
+	ARM_INS_USADA8:    CategoryVector,
+	ARM_INS_USAT:      CategoryArithmetic,
+	ARM_INS_USAT16:    CategoryVector,
+	ARM_INS_USAX:      CategoryVector,
+	ARM_INS_USUB16:    CategoryVector,
+	ARM_INS_USUB8:     CategoryVector,
+	ARM_INS_UXTAB:     CategoryArithmetic,
+	ARM_INS_UXTAB16:   CategoryVector,
+	ARM_INS_UXTAH:     CategoryArithmetic,
+	ARM_INS_UXTB:      CategoryMove,
+	ARM_INS_UXTB16:    CategoryVector,
+	ARM_INS_UXTH:      CategoryMove,
+	ARM_INS_VABAL:     CategoryVector,
+	ARM_INS_VABA:      CategoryVector,
+	ARM_INS_VABDL:     CategoryVector,
+	ARM_INS_VABD:      CategoryVector,
+	ARM_INS_VABS:      CategoryFloat,
+	ARM_INS_VACGE:     CategoryVector,
+	ARM_INS_VACGT:     CategoryVector,
+	ARM_INS_VADD:      CategoryFloat,
+	ARM_INS_VADDHN:    CategoryVector,
+	ARM_INS_VADDL:     CategoryVector,
+	ARM_INS_VADDW:     CategoryVector,
+	ARM_INS_VAND:      CategoryVector,
+	ARM_INS_VBIC:      CategoryVector,
+	ARM_INS_VBIF:      CategoryVector,
+	ARM_INS_VBIT:      CategoryVector,
+	ARM_INS_VBSL:      CategoryVector,
+	ARM_INS_VCEQ:      CategoryVector,
+	ARM_INS_VCGE:      CategoryVector,
+	ARM_INS_VCGT:      CategoryVector,
+	ARM_INS_VCLE:      CategoryVector,
+	ARM_INS_VCLS:      CategoryVector,
+	ARM_INS_VCLT:      CategoryVector,
+	ARM_INS_VCLZ:      CategoryVector,
+	ARM_INS_VCMP:      CategoryFloat,
+	ARM_INS_VCMPE:     CategoryFloat,
+	ARM_INS_VCNT:      CategoryVector,
+	ARM_INS_VCVTA:     CategoryFloat,
+	ARM_INS_VCVTB:     CategoryFloat,
+	ARM_INS_VCVT:      CategoryFloat,
+	ARM_INS_VCVTM:     CategoryFloat,
+	ARM_INS_VCVTN:     CategoryFloat,
+	ARM_INS_VCVTP:     CategoryFloat,
+	ARM_INS_VCVTT:     CategoryFloat,
+	ARM_INS_VDIV:      CategoryFloat,
+	ARM_INS_VDUP:      CategoryVector,
+	ARM_INS_VEOR:      CategoryVector,
+	ARM_INS_VEXT:      CategoryVector,
+	ARM_INS_VFMA:      CategoryFloat,
+	ARM_INS_VFMS:      CategoryFloat,
+	ARM_INS_VFNMA:     CategoryFloat,
+	ARM_INS_VFNMS:     CategoryFloat,
+	ARM_INS_VHADD:     CategoryVector,
+	ARM_INS_VHSUB:     CategoryVector,
+	ARM_INS_VLD1:      CategoryVector,
+	ARM_INS_VLD2:      CategoryVector,
+	ARM_INS_VLD3:      CategoryVector,
+	ARM_INS_VLD4:      CategoryVector,
+	ARM_INS_VLDMDB:    CategoryLoad,
+	ARM_INS_VLDMIA:    CategoryLoad,
+	ARM_INS_VLDR:      CategoryLoad,
+	ARM_INS_VMAXNM:    CategoryFloat,
+	ARM_INS_VMAX:      CategoryVector,
+	ARM_INS_VMINNM:    CategoryFloat,
+	ARM_INS_VMIN:      CategoryVector,
+	ARM_INS_VMLA:      CategoryFloat,
+	ARM_INS_VMLAL:     CategoryVector,
+	ARM_INS_VMLS:      CategoryFloat,
+	ARM_INS_VMLSL:     CategoryVector,
+	ARM_INS_VMOVL:     CategoryVector,
+	ARM_INS_VMOVN:     CategoryVector,
+	ARM_INS_VMSR:      CategorySystem,
+	ARM_INS_VMUL:      CategoryFloat,
+	ARM_INS_VMULL:     CategoryVector,
+	ARM_INS_VMVN:      CategoryVector,
+	ARM_INS_VNEG:      CategoryFloat,
+	ARM_INS_VNMLA:     CategoryFloat,
+	ARM_INS_VNMLS:     CategoryFloat,
+	ARM_INS_VNMUL:     CategoryFloat,
+	ARM_INS_VORN:      CategoryVector,
+	ARM_INS_VORR:      CategoryVector,
+	ARM_INS_VPADAL:    CategoryVector,
+	ARM_INS_VPADDL:    CategoryVector,
+	ARM_INS_VPADD:     CategoryVector,
+	ARM_INS_VPMAX:     CategoryVector,
+	ARM_INS_VPMIN:     CategoryVector,
+	ARM_INS_VQABS:     CategoryVector,
+	ARM_INS_VQADD:     CategoryVector,
+	ARM_INS_VQDMLAL:   CategoryVector,
+	ARM_INS_VQDMLSL:   CategoryVector,
+	ARM_INS_VQDMULH:   CategoryVector,
+	ARM_INS_VQDMULL:   CategoryVector,
+	ARM_INS_VQMOVUN:   CategoryVector,
+	ARM_INS_VQMOVN:    CategoryVector,
+	ARM_INS_VQNEG:     CategoryVector,
+	ARM_INS_VQRDMULH:  CategoryVector,
+	ARM_INS_VQRSHL:    CategoryVector,
+	ARM_INS_VQRSHRN:   CategoryVector,
+	ARM_INS_VQRSHRUN:  CategoryVector,
+	ARM_INS_VQSHL:     CategoryVector,
+	ARM_INS_VQSHLU:    CategoryVector,
+	ARM_INS_VQSHRN:    CategoryVector,
+	ARM_INS_VQSHRUN:   CategoryVector,
+	ARM_INS_VQSUB:     CategoryVector,
+	ARM_INS_VRADDHN:   CategoryVector,
+	ARM_INS_VRECPE:    CategoryVector,
+	ARM_INS_VRECPS:    CategoryVector,
+	ARM_INS_VREV16:    CategoryVector,
+	ARM_INS_VREV32:    CategoryVector,
+	ARM_INS_VREV64:    CategoryVector,
+	ARM_INS_VRHADD:    CategoryVector,
+	ARM_INS_VRINTA:    CategoryFloat,
+	ARM_INS_VRINTM:    CategoryFloat,
+	ARM_INS_VRINTN:    CategoryFloat,
+	ARM_INS_VRINTP:    CategoryFloat,
+	ARM_INS_VRINTR:    CategoryFloat,
+	ARM_INS_VRINTX:    CategoryFloat,
+	ARM_INS_VRINTZ:    CategoryFloat,
+	ARM_INS_VRSHL:     CategoryVector,
+	ARM_INS_VRSHRN:    CategoryVector,
+	ARM_INS_VRSHR:     CategoryVector,
+	ARM_INS_VRSQRTE:   CategoryVector,
+	ARM_INS_VRSQRTS:   CategoryVector,
+	ARM_INS_VRSRA:     CategoryVector,
+	ARM_INS_VRSUBHN:   CategoryVector,
+	ARM_INS_VSELEQ:    CategoryFloat,
+	ARM_INS_VSELGE:    CategoryFloat,
+	ARM_INS_VSELGT:    CategoryFloat,
+	ARM_INS_VSELVS:    CategoryFloat,
+	ARM_INS_VSHLL:     CategoryVector,
+	ARM_INS_VSHL:      CategoryVector,
+	ARM_INS_VSHRN:     CategoryVector,
+	ARM_INS_VSHR:      CategoryVector,
+	ARM_INS_VSLI:      CategoryVector,
+	ARM_INS_VSQRT:     CategoryFloat,
+	ARM_INS_VSRA:      CategoryVector,
+	ARM_INS_VSRI:      CategoryVector,
+	ARM_INS_VST1:      CategoryVector,
+	ARM_INS_VST2:      CategoryVector,
+	ARM_INS_VST3:      CategoryVector,
+	ARM_INS_VST4:      CategoryVector,
+	ARM_INS_VSTMDB:    CategoryStore,
+	ARM_INS_VSTMIA:    CategoryStore,
+	ARM_INS_VSTR:      CategoryStore,
+	ARM_INS_VSUB:      CategoryFloat,
+	ARM_INS_VSUBHN:    CategoryVector,
+	ARM_INS_VSUBL:     CategoryVector,
+	ARM_INS_VSUBW:     CategoryVector,
+	ARM_INS_VSWP:      CategoryVector,
+	ARM_INS_VTBL:      CategoryVector,
+	ARM_INS_VTBX:      CategoryVector,
+	ARM_INS_VCVTR:     CategoryFloat,
+	ARM_INS_VTRN:      CategoryVector,
+	ARM_INS_VTST:      CategoryVector,
+	ARM_INS_VUZP:      CategoryVector,
+	ARM_INS_VZIP:      CategoryVector,
+	ARM_INS_ADDW:      CategoryArithmetic,
+	ARM_INS_ASR:       CategoryShift,
+	ARM_INS_DCPS1:     CategorySystem,
+	ARM_INS_DCPS2:     CategorySystem,
+	ARM_INS_DCPS3:     CategorySystem,
+	ARM_INS_IT:        CategorySystem,
+	ARM_INS_LSL:       CategoryShift,
+	ARM_INS_LSR:       CategoryShift,
+	ARM_INS_ASRS:      CategoryShift,
+	ARM_INS_LSRS:      CategoryShift,
+	ARM_INS_ORN:       CategoryLogic,
+	ARM_INS_ROR:       CategoryShift,
+	ARM_INS_RRX:       CategoryShift,
+	ARM_INS_SUBS:      CategoryArithmetic,
+	ARM_INS_SUBW:      CategoryArithmetic,
+	ARM_INS_TBB:       CategoryBranch,
+	ARM_INS_TBH:       CategoryBranch,
+	ARM_INS_CBNZ:      CategoryBranch,
+	ARM_INS_CBZ:       CategoryBranch,
+	ARM_INS_MOVS:      CategoryMove,
+	ARM_INS_POP:       CategoryStack,
+	ARM_INS_PUSH:      CategoryStack,
+	ARM_INS_NOP:       CategoryNop,
+	ARM_INS_YIELD:     CategoryNop,
+	ARM_INS_WFE:       CategorySystem,
+	ARM_INS_WFI:       CategorySystem,
+	ARM_INS_SEV:       CategorySystem,
+	ARM_INS_SEVL:      CategorySystem,
+	ARM_INS_VPUSH:     CategoryStack,
+	ARM_INS_VPOP:      CategoryStack,
+}
+
+var arm64Categories = map[uint]Category{
+	ARM64_INS_ABS:       CategoryVector,
+	ARM64_INS_ADC:       CategoryArithmetic,
+	ARM64_INS_ADDHN:     CategoryVector,
+	ARM64_INS_ADDHN2:    CategoryVector,
+	ARM64_INS_ADDP:      CategoryVector,
+	ARM64_INS_ADD:       CategoryArithmetic,
+	ARM64_INS_ADDV:      CategoryVector,
+	ARM64_INS_ADR:       CategoryArithmetic,
+	ARM64_INS_ADRP:      CategoryArithmetic,
+	ARM64_INS_AESD:      CategoryCrypto,
+	ARM64_INS_AESE:      CategoryCrypto,
+	ARM64_INS_AESIMC:    CategoryCrypto,
+	ARM64_INS_AESMC:     CategoryCrypto,
+	ARM64_INS_AND:       CategoryLogic,
+	ARM64_INS_ASR:       CategoryShift,
+	ARM64_INS_B:         CategoryBranch,
+	ARM64_INS_BFM:       CategoryLogic,
+	ARM64_INS_BIC:       CategoryLogic,
+	ARM64_INS_BIF:       CategoryVector,
+	ARM64_INS_BIT:       CategoryVector,
+	ARM64_INS_BL:        CategoryCall,
+	ARM64_INS_BLR:       CategoryCall,
+	ARM64_INS_BR:        CategoryBranch,
+	ARM64_INS_BRK:       CategorySystem,
+	ARM64_INS_BSL:       CategoryVector,
+	ARM64_INS_CBNZ:      CategoryBranch,
+	ARM64_INS_CBZ:       CategoryBranch,
+	ARM64_INS_CCMN:      CategoryCompare,
+	ARM64_INS_CCMP:      CategoryCompare,
+	ARM64_INS_CLREX:     CategoryAtomic,
+	ARM64_INS_CLS:       CategoryLogic,
+	ARM64_INS_CLZ:       CategoryLogic,
+	ARM64_INS_CMEQ:      CategoryVector,
+	ARM64_INS_CMGE:      CategoryVector,
+	ARM64_INS_CMGT:      CategoryVector,
+	ARM64_INS_CMHI:      CategoryVector,
+	ARM64_INS_CMHS:      CategoryVector,
+	ARM64_INS_CMLE:      CategoryVector,
+	ARM64_INS_CMLT:      CategoryVector,
+	ARM64_INS_CMTST:     CategoryVector,
+	ARM64_INS_CNT:       CategoryVector,
+	ARM64_INS_MOV:       CategoryMove,
+	ARM64_INS_CRC32B:    CategoryArithmetic,
+	ARM64_INS_CRC32CB:   CategoryArithmetic,
+	ARM64_INS_CRC32CH:   CategoryArithmetic,
+	ARM64_INS_CRC32CW:   CategoryArithmetic,
+	ARM64_INS_CRC32CX:   CategoryArithmetic,
+	ARM64_INS_CRC32H:    CategoryArithmetic,
+	ARM64_INS_CRC32W:    CategoryArithmetic,
+	ARM64_INS_CRC32X:    CategoryArithmetic,
+	ARM64_INS_CSEL:      CategoryMove,
+	ARM64_INS_CSINC:     CategoryMove,
+	ARM64_INS_CSINV:     CategoryMove,
+	ARM64_INS_CSNEG:     CategoryMove,
+	ARM64_INS_DCPS1:     CategorySystem,
+	ARM64_INS_DCPS2:     CategorySystem,
+	ARM64_INS_DCPS3:     CategorySystem,
+	ARM64_INS_DMB:       CategoryAtomic,
+	ARM64_INS_DRPS:      CategoryReturn,
+	ARM64_INS_DSB:       CategoryAtomic,
+	ARM64_INS_DUP:       CategoryVector,
+	ARM64_INS_EON:       CategoryLogic,
+	ARM64_INS_EOR:       CategoryLogic,
+	ARM64_INS_ERET:      CategoryReturn,
+	ARM64_INS_EXTR:      CategoryShift,
+	ARM64_INS_EXT:       CategoryVector,
+	ARM64_INS_FABD:      CategoryFloat,
+	ARM64_INS_FABS:      CategoryFloat,
+	ARM64_INS_FACGE:     CategoryFloat,
+	ARM64_INS_FACGT:     CategoryFloat,
+	ARM64_INS_FADD:      CategoryFloat,
+	ARM64_INS_FADDP:     CategoryVector,
+	ARM64_INS_FCCMP:     CategoryFloat,
+	ARM64_INS_FCCMPE:    CategoryFloat,
+	ARM64_INS_FCMEQ:     CategoryFloat,
+	ARM64_INS_FCMGE:     CategoryFloat,
+	ARM64_INS_FCMGT:     CategoryFloat,
+	ARM64_INS_FCMLE:     CategoryFloat,
+	ARM64_INS_FCMLT:     CategoryFloat,
+	ARM64_INS_FCMP:      CategoryFloat,
+	ARM64_INS_FCMPE:     CategoryFloat,
+	ARM64_INS_FCSEL:     CategoryFloat,
+	ARM64_INS_FCVTAS:    CategoryFloat,
+	ARM64_INS_FCVTAU:    CategoryFloat,
+	ARM64_INS_FCVT:      CategoryFloat,
+	ARM64_INS_FCVTL:     CategoryVector,
+	ARM64_INS_FCVTL2:    CategoryVector,
+	ARM64_INS_FCVTMS:    CategoryFloat,
+	ARM64_INS_FCVTMU:    CategoryFloat,
+	ARM64_INS_FCVTNS:    CategoryFloat,
+	ARM64_INS_FCVTNU:    CategoryFloat,
+	ARM64_INS_FCVTN:     CategoryVector,
+	ARM64_INS_FCVTN2:    CategoryVector,
+	ARM64_INS_FCVTPS:    CategoryFloat,
+	ARM64_INS_FCVTPU:    CategoryFloat,
+	ARM64_INS_FCVTXN:    CategoryVector,
+	ARM64_INS_FCVTXN2:   CategoryVector,
+	ARM64_INS_FCVTZS:    CategoryFloat,
+	ARM64_INS_FCVTZU:    CategoryFloat,
+	ARM64_INS_FDIV:      CategoryFloat,
+	ARM64_INS_FMADD:     CategoryFloat,
+	ARM64_INS_FMAX:      CategoryFloat,
+	ARM64_INS_FMAXNM:    CategoryFloat,
+	ARM64_INS_FMAXNMP:   CategoryVector,
+	ARM64_INS_FMAXNMV:   CategoryVector,
+	ARM64_INS_FMAXP:     CategoryVector,
+	ARM64_INS_FMAXV:     CategoryVector,
+	ARM64_INS_FMIN:      CategoryFloat,
+	ARM64_INS_FMINNM:    CategoryFloat,
+	ARM64_INS_FMINNMP:   CategoryVector,
+	ARM64_INS_FMINNMV:   CategoryVector,
+	ARM64_INS_FMINP:     CategoryVector,
+	ARM64_INS_FMINV:     CategoryVector,
+	ARM64_INS_FMLA:      CategoryFloat,
+	ARM64_INS_FMLS:      CategoryFloat,
+	ARM64_INS_FMOV:      CategoryMove,
+	ARM64_INS_FMSUB:     CategoryFloat,
+	ARM64_INS_FMUL:      CategoryFloat,
+	ARM64_INS_FMULX:     CategoryFloat,
+	ARM64_INS_FNEG:      CategoryFloat,
+	ARM64_INS_FNMADD:    CategoryFloat,
+	ARM64_INS_FNMSUB:    CategoryFloat,
+	ARM64_INS_FNMUL:     CategoryFloat,
+	ARM64_INS_FRECPE:    CategoryFloat,
+	ARM64_INS_FRECPS:    CategoryFloat,
+	ARM64_INS_FRECPX:    CategoryFloat,
+	ARM64_INS_FRINTA:    CategoryFloat,
+	ARM64_INS_FRINTI:    CategoryFloat,
+	ARM64_INS_FRINTM:    CategoryFloat,
+	ARM64_INS_FRINTN:    CategoryFloat,
+	ARM64_INS_FRINTP:    CategoryFloat,
+	ARM64_INS_FRINTX:    CategoryFloat,
+	ARM64_INS_FRINTZ:    CategoryFloat,
+	ARM64_INS_FRSQRTE:   CategoryFloat,
+	ARM64_INS_FRSQRTS:   CategoryFloat,
+	ARM64_INS_FSQRT:     CategoryFloat,
+	ARM64_INS_FSUB:      CategoryFloat,
+	ARM64_INS_HINT:      CategoryNop,
+	ARM64_INS_HLT:       CategorySystem,
+	ARM64_INS_HVC:       CategorySystem,
+	ARM64_INS_INS:       CategoryVector,
+	ARM64_INS_ISB:       CategorySystem,
+	ARM64_INS_LD1:       CategoryVector,
+	ARM64_INS_LD1R:      CategoryVector,
+	ARM64_INS_LD2R:      CategoryVector,
+	ARM64_INS_LD2:       CategoryVector,
+	ARM64_INS_LD3R:      CategoryVector,
+	ARM64_INS_LD3:       CategoryVector,
+	ARM64_INS_LD4:       CategoryVector,
+	ARM64_INS_LD4R:      CategoryVector,
+	ARM64_INS_LDARB:     CategoryAtomic,
+	ARM64_INS_LDARH:     CategoryAtomic,
+	ARM64_INS_LDAR:      CategoryAtomic,
+	ARM64_INS_LDAXP:     CategoryAtomic,
+	ARM64_INS_LDAXRB:    CategoryAtomic,
+	ARM64_INS_LDAXRH:    CategoryAtomic,
+	ARM64_INS_LDAXR:     CategoryAtomic,
+	ARM64_INS_LDNP:      CategoryLoad,
+	ARM64_INS_LDP:       CategoryLoad,
+	ARM64_INS_LDPSW:     CategoryLoad,
+	ARM64_INS_LDRB:      CategoryLoad,
+	ARM64_INS_LDR:       CategoryLoad,
+	ARM64_INS_LDRH:      CategoryLoad,
+	ARM64_INS_LDRSB:     CategoryLoad,
+	ARM64_INS_LDRSH:     CategoryLoad,
+	ARM64_INS_LDRSW:     CategoryLoad,
+	ARM64_INS_LDTRB:     CategoryLoad,
+	ARM64_INS_LDTRH:     CategoryLoad,
+	ARM64_INS_LDTRSB:    CategoryLoad,
+	ARM64_INS_LDTRSH:    CategoryLoad,
+	ARM64_INS_LDTRSW:    CategoryLoad,
+	ARM64_INS_LDTR:      CategoryLoad,
+	ARM64_INS_LDURB:     CategoryLoad,
+	ARM64_INS_LDUR:      CategoryLoad,
+	ARM64_INS_LDURH:     CategoryLoad,
+	ARM64_INS_LDURSB:    CategoryLoad,
+	ARM64_INS_LDURSH:    CategoryLoad,
+	ARM64_INS_LDURSW:    CategoryLoad,
+	ARM64_INS_LDXP:      CategoryAtomic,
+	ARM64_INS_LDXRB:     CategoryAtomic,
+	ARM64_INS_LDXRH:     CategoryAtomic,
+	ARM64_INS_LDXR:      CategoryAtomic,
+	ARM64_INS_LSL:       CategoryShift,
+	ARM64_INS_LSR:       CategoryShift,
+	ARM64_INS_MADD:      CategoryArithmetic,
+	ARM64_INS_MLA:       CategoryVector,
+	ARM64_INS_MLS:       CategoryVector,
+	ARM64_INS_MOVI:      CategoryVector,
+	ARM64_INS_MOVK:      CategoryMove,
+	ARM64_INS_MOVN:      CategoryMove,
+	ARM64_INS_MOVZ:      CategoryMove,
+	ARM64_INS_MRS:       CategorySystem,
+	ARM64_INS_MSR:       CategorySystem,
+	ARM64_INS_MSUB:      CategoryArithmetic,
+	ARM64_INS_MUL:       CategoryArithmetic,
+	ARM64_INS_MVNI:      CategoryVector,
+	ARM64_INS_NEG:       CategoryArithmetic,
+	ARM64_INS_NOT:       CategoryVector,
+	ARM64_INS_ORN:       CategoryLogic,
+	ARM64_INS_ORR:       CategoryLogic,
+	ARM64_INS_PMULL2:    CategoryVector,
+	ARM64_INS_PMULL:     CategoryVector,
+	ARM64_INS_PMUL:      CategoryVector,
+	ARM64_INS_PRFM:      CategorySystem,
+	ARM64_INS_PRFUM:     CategorySystem,
+	ARM64_INS_RADDHN:    CategoryVector,
+	ARM64_INS_RADDHN2:   CategoryVector,
+	ARM64_INS_RBIT:      CategoryLogic,
+	ARM64_INS_RET:       CategoryReturn,
+	ARM64_INS_REV16:     CategoryMove,
+	ARM64_INS_REV32:     CategoryMove,
+	ARM64_INS_REV64:     CategoryVector,
+	ARM64_INS_REV:       CategoryMove,
+	ARM64_INS_ROR:       CategoryShift,
+	ARM64_INS_RSHRN2:    CategoryVector,
+	ARM64_INS_RSHRN:     CategoryVector,
+	ARM64_INS_RSUBHN:    CategoryVector,
+	ARM64_INS_RSUBHN2:   CategoryVector,
+	ARM64_INS_SABAL2:    CategoryVector,
+	ARM64_INS_SABAL:     CategoryVector,
+	ARM64_INS_SABA:      CategoryVector,
+	ARM64_INS_SABDL2:    CategoryVector,
+	ARM64_INS_SABDL:     CategoryVector,
+	ARM64_INS_SABD:      CategoryVector,
+	ARM64_INS_SADALP:    CategoryVector,
+	ARM64_INS_SADDLP:    CategoryVector,
+	ARM64_INS_SADDLV:    CategoryVector,
+	ARM64_INS_SADDL2:    CategoryVector,
+	ARM64_INS_SADDL:     CategoryVector,
+	ARM64_INS_SADDW2:    CategoryVector,
+	ARM64_INS_SADDW:     CategoryVector,
+	ARM64_INS_SBC:       CategoryArithmetic,
+	ARM64_INS_SBFM:      CategoryLogic,
+	ARM64_INS_SCVTF:     CategoryFloat,
+	ARM64_INS_SDIV:      CategoryArithmetic,
+	ARM64_INS_SHA1C:     CategoryCrypto,
+	ARM64_INS_SHA1H:     CategoryCrypto,
+	ARM64_INS_SHA1M:     CategoryCrypto,
+	ARM64_INS_SHA1P:     CategoryCrypto,
+	ARM64_INS_SHA1SU0:   CategoryCrypto,
+	ARM64_INS_SHA1SU1:   CategoryCrypto,
+	ARM64_INS_SHA256H2:  CategoryCrypto,
+	ARM64_INS_SHA256H:   CategoryCrypto,
+	ARM64_INS_SHA256SU0: CategoryCrypto,
+	ARM64_INS_SHA256SU1: CategoryCrypto,
+	ARM64_INS_SHADD:     CategoryVector,
+	ARM64_INS_SHLL2:     CategoryVector,
+	ARM64_INS_SHLL:      CategoryVector,
+	ARM64_INS_SHL:       CategoryVector,
+	ARM64_INS_SHRN2:     CategoryVector,
+	ARM64_INS_SHRN:      CategoryVector,
+	ARM64_INS_SHSUB:     CategoryVector,
+	ARM64_INS_SLI:       CategoryVector,
+	ARM64_INS_SMADDL:    CategoryArithmetic,
+	ARM64_INS_SMAXP:     CategoryVector,
+	ARM64_INS_SMAXV:     CategoryVector,
+	ARM64_INS_SMAX:      CategoryVector,
+	ARM64_INS_SMC:       CategorySystem,
+	ARM64_INS_SMINP:     CategoryVector,
+	ARM64_INS_SMINV:     CategoryVector,
+	ARM64_INS_SMIN:      CategoryVector,
+	ARM64_INS_SMLAL2:    CategoryVector,
+	ARM64_INS_SMLAL:     CategoryVector,
+	ARM64_INS_SMLSL2:    CategoryVector,
+	ARM64_INS_SMLSL:     CategoryVector,
+	ARM64_INS_SMOV:      CategoryVector,
+	ARM64_INS_SMSUBL:    CategoryArithmetic,
+	ARM64_INS_SMULH:     CategoryArithmetic,
+	ARM64_INS_SMULL2:    CategoryVector,
+	ARM64_INS_SMULL:     CategoryArithmetic,
+	ARM64_INS_SQABS:     CategoryVector,
+	ARM64_INS_SQADD:     CategoryVector,
+	ARM64_INS_SQDMLAL:   CategoryVector,
+	ARM64_INS_SQDMLAL2:  CategoryVector,
+	ARM64_INS_SQDMLSL:   CategoryVector,
+	ARM64_INS_SQDMLSL2:  CategoryVector,
+	ARM64_INS_SQDMULH:   CategoryVector,
+	ARM64_INS_SQDMULL:   CategoryVector,
+	ARM64_INS_SQDMULL2:  CategoryVector,
+	ARM64_INS_SQNEG:     CategoryVector,
+	ARM64_INS_SQRDMULH:  CategoryVector,
+	ARM64_INS_SQRSHL:    CategoryVector,
+	ARM64_INS_SQRSHRN:   CategoryVector,
+	ARM64_INS_SQRSHRN2:  CategoryVector,
+	ARM64_INS_SQRSHRUN:  CategoryVector,
+	ARM64_INS_SQRSHRUN2: CategoryVector,
+	ARM64_INS_SQSHLU:    CategoryVector,
+	ARM64_INS_SQSHL:     CategoryVector,
+	ARM64_INS_SQSHRN:    CategoryVector,
+	ARM64_INS_SQSHRN2:   CategoryVector,
+	ARM64_INS_SQSHRUN:   CategoryVector,
+	ARM64_INS_SQSHRUN2:  CategoryVector,
+	ARM64_INS_SQSUB:     CategoryVector,
+	ARM64_INS_SQXTN2:    CategoryVector,
+	ARM64_INS_SQXTN:     CategoryVector,
+	ARM64_INS_SQXTUN2:   CategoryVector,
+	ARM64_INS_SQXTUN:    CategoryVector,
+	ARM64_INS_SRHADD:    CategoryVector,
+	ARM64_INS_SRI:       CategoryVector,
+	ARM64_INS_SRSHL:     CategoryVector,
+	ARM64_INS_SRSHR:     CategoryVector,
+	ARM64_INS_SRSRA:     CategoryVector,
+	ARM64_INS_SSHLL2:    CategoryVector,
+	ARM64_INS_SSHLL:     CategoryVector,
+	ARM64_INS_SSHL:      CategoryVector,
+	ARM64_INS_SSHR:      CategoryVector,
+	ARM64_INS_SSRA:      CategoryVector,
+	ARM64_INS_SSUBL2:    CategoryVector,
+	ARM64_INS_SSUBL:     CategoryVector,
+	ARM64_INS_SSUBW2:    CategoryVector,
+	ARM64_INS_SSUBW:     CategoryVector,
+	ARM64_INS_ST1:       CategoryVector,
+	ARM64_INS_ST2:       CategoryVector,
+	ARM64_INS_ST3:       CategoryVector,
+	ARM64_INS_ST4:       CategoryVector,
+	ARM64_INS_STLRB:     CategoryAtomic,
+	ARM64_INS_STLRH:     CategoryAtomic,
+	ARM64_INS_STLR:      CategoryAtomic,
+	ARM64_INS_STLXP:     CategoryAtomic,
+	ARM64_INS_STLXRB:    CategoryAtomic,
+	ARM64_INS_STLXRH:    CategoryAtomic,
+	ARM64_INS_STLXR:     CategoryAtomic,
+	ARM64_INS_STNP:      CategoryStore,
+	ARM64_INS_STP:       CategoryStore,
+	ARM64_INS_STRB:      CategoryStore,
+	ARM64_INS_STR:       CategoryStore,
+	ARM64_INS_STRH:      CategoryStore,
+	ARM64_INS_STTRB:     CategoryStore,
+	ARM64_INS_STTRH:     CategoryStore,
+	ARM64_INS_STTR:      CategoryStore,
+	ARM64_INS_STURB:     CategoryStore,
+	ARM64_INS_STUR:      CategoryStore,
+	ARM64_INS_STURH:     CategoryStore,
+	ARM64_INS_STXP:      CategoryAtomic,
+	ARM64_INS_STXRB:     CategoryAtomic,
+	ARM64_INS_STXRH:     CategoryAtomic,
+	ARM64_INS_STXR:      CategoryAtomic,
+	ARM64_INS_SUBHN:     CategoryVector,
+	ARM64_INS_SUBHN2:    CategoryVector,
+	ARM64_INS_SUB:       CategoryArithmetic,
+	ARM64_INS_SUQADD:    CategoryVector,
+	ARM64_INS_SVC:       CategorySystem,
+	ARM64_INS_SYSL:      CategorySystem,
+	ARM64_INS_SYS:       CategorySystem,
+	ARM64_INS_TBL:       CategoryVector,
+	ARM64_INS_TBNZ:      CategoryBranch,
+	ARM64_INS_TBX:       CategoryVector,
+	ARM64_INS_TBZ:       CategoryBranch,
+	ARM64_INS_TRN1:      CategoryVector,
+	ARM64_INS_TRN2:      CategoryVector,
+	ARM64_INS_UABAL2:    CategoryVector,
+	ARM64_INS_UABAL:     CategoryVector,
+	ARM64_INS_UABA:      CategoryVector,
+	ARM64_INS_UABDL2:    CategoryVector,
+	ARM64_INS_UABDL:     CategoryVector,
+	ARM64_INS_UABD:      CategoryVector,
+	ARM64_INS_UADALP:    CategoryVector,
+	ARM64_INS_UADDLP:    CategoryVector,
+	ARM64_INS_UADDLV:    CategoryVector,
+	ARM64_INS_UADDL2:    CategoryVector,
+	ARM64_INS_UADDL:     CategoryVector,
+	ARM64_INS_UADDW2:    CategoryVector,
+	ARM64_INS_UADDW:     CategoryVector,
+	ARM64_INS_UBFM:      CategoryLogic,
+	ARM64_INS_UCVTF:     CategoryFloat,
+	ARM64_INS_UDIV:      CategoryArithmetic,
+	ARM64_INS_UHADD:     CategoryVector,
+	ARM64_INS_UHSUB:     CategoryVector,
+	ARM64_INS_UMADDL:    CategoryArithmetic,
+	ARM64_INS_UMAXP:     CategoryVector,
+	ARM64_INS_UMAXV:     CategoryVector,
+	ARM64_INS_UMAX:      CategoryVector,
+	ARM64_INS_UMINP:     CategoryVector,
+	ARM64_INS_UMINV:     CategoryVector,
+	ARM64_INS_UMIN:      CategoryVector,
+	ARM64_INS_UMLAL2:    CategoryVector,
+	ARM64_INS_UMLAL:     CategoryVector,
+	ARM64_INS_UMLSL2:    CategoryVector,
+	ARM64_INS_UMLSL:     CategoryVector,
+	ARM64_INS_UMOV:      CategoryVector,
+	ARM64_INS_UMSUBL:    CategoryArithmetic,
+	ARM64_INS_UMULH:     CategoryArithmetic,
+	ARM64_INS_UMULL2:    CategoryVector,
+	ARM64_INS_UMULL:     CategoryArithmetic,
+	ARM64_INS_UQADD:     CategoryVector,
+	ARM64_INS_UQRSHL:    CategoryVector,
+	ARM64_INS_UQRSHRN:   CategoryVector,
+	ARM64_INS_UQRSHRN2:  CategoryVector,
+	ARM64_INS_UQSHL:     CategoryVector,
+	ARM64_INS_UQSHRN:    CategoryVector,
+	ARM64_INS_UQSHRN2:   CategoryVector,
+	ARM64_INS_UQSUB:     CategoryVector,
+	ARM64_INS_UQXTN2:    CategoryVector,
+	ARM64_INS_UQXTN:     CategoryVector,
+	ARM64_INS_URECPE:    CategoryVector,
+	ARM64_INS_URHADD:    CategoryVector,
+	ARM64_INS_URSHL:     CategoryVector,
+	ARM64_INS_URSHR:     CategoryVector,
+	ARM64_INS_URSQRTE:   CategoryVector,
+	ARM64_INS_URSRA:     CategoryVector,
+	ARM64_INS_USHLL2:    CategoryVector,
+	ARM64_INS_USHLL:     CategoryVector,
+	ARM64_INS_USHL:      CategoryVector,
+	ARM64_INS_USHR:      CategoryVector,
+	ARM64_INS_USQADD:    CategoryVector,
+	ARM64_INS_USRA:      CategoryVector,
+	ARM64_INS_USUBL2:    CategoryVector,
+	ARM64_INS_USUBL:     CategoryVector,
+	ARM64_INS_USUBW2:    CategoryVector,
+	ARM64_INS_USUBW:     CategoryVector,
+	ARM64_INS_UZP1:      CategoryVector,
+	ARM64_INS_UZP2:      CategoryVector,
+	ARM64_INS_XTN2:      CategoryVector,
+	ARM64_INS_XTN:       CategoryVector,
+	ARM64_INS_ZIP1:      CategoryVector,
+	ARM64_INS_ZIP2:      CategoryVector,
+	ARM64_INS_MNEG:      CategoryArithmetic,
+	ARM64_INS_UMNEGL:    CategoryArithmetic,
+	ARM64_INS_SMNEGL:    CategoryArithmetic,
+	ARM64_INS_NOP:       CategoryNop,
+	ARM64_INS_YIELD:     CategoryNop,
+	ARM64_INS_WFE:       CategorySystem,
+	ARM64_INS_WFI:       CategorySystem,
+	ARM64_INS_SEV:       CategorySystem,
+	ARM64_INS_SEVL:      CategorySystem,
+	ARM64_INS_NGC:       CategoryArithmetic,
+	ARM64_INS_SBFIZ:     CategoryLogic,
+	ARM64_INS_UBFIZ:     CategoryLogic,
+	ARM64_INS_SBFX:      CategoryLogic,
+	ARM64_INS_UBFX:      CategoryLogic,
+	ARM64_INS_BFI:       CategoryLogic,
+	ARM64_INS_BFXIL:     CategoryLogic,
+	ARM64_INS_CMN:       CategoryCompare,
+	ARM64_INS_MVN:       CategoryLogic,
+	ARM64_INS_TST:       CategoryCompare,
+	ARM64_INS_CSET:      CategoryCompare,
+	ARM64_INS_CINC:      CategoryMove,
+	ARM64_INS_CSETM:     CategoryCompare,
+	ARM64_INS_CINV:      CategoryMove,
+	ARM64_INS_CNEG:      CategoryMove,
+	ARM64_INS_SXTB:      CategoryMove,
+	ARM64_INS_SXTH:      CategoryMove,
+	ARM64_INS_SXTW:      CategoryMove,
+	ARM64_INS_CMP:       CategoryCompare,
+	ARM64_INS_UXTB:      CategoryMove,
+	ARM64_INS_UXTH:      CategoryMove,
+	ARM64_INS_UXTW:      CategoryMove,
+	ARM64_INS_IC:        CategorySystem,
+	ARM64_INS_DC:        CategorySystem,
+	ARM64_INS_AT:        CategorySystem,
+	ARM64_INS_TLBI:      CategorySystem,
+}
+
+var mipsCategories = map[uint]Category{
+	MIPS_INS_ABSQ_S:      CategoryVector,
+	MIPS_INS_ADD:         CategoryArithmetic,
+	MIPS_INS_ADDIUPC:     CategoryArithmetic,
+	MIPS_INS_ADDQH:       CategoryVector,
+	MIPS_INS_ADDQH_R:     CategoryVector,
+	MIPS_INS_ADDQ:        CategoryVector,
+	MIPS_INS_ADDQ_S:      CategoryVector,
+	MIPS_INS_ADDSC:       CategoryVector,
+	MIPS_INS_ADDS_A:      CategoryVector,
+	MIPS_INS_ADDS_S:      CategoryVector,
+	MIPS_INS_ADDS_U:      CategoryVector,
+	MIPS_INS_ADDUH:       CategoryVector,
+	MIPS_INS_ADDUH_R:     CategoryVector,
+	MIPS_INS_ADDU:        CategoryArithmetic,
+	MIPS_INS_ADDU_S:      CategoryVector,
+	MIPS_INS_ADDVI:       CategoryVector,
+	MIPS_INS_ADDV:        CategoryVector,
+	MIPS_INS_ADDWC:       CategoryVector,
+	MIPS_INS_ADD_A:       CategoryVector,
+	MIPS_INS_ADDI:        CategoryArithmetic,
+	MIPS_INS_ADDIU:       CategoryArithmetic,
+	MIPS_INS_ALIGN:       CategoryShift,
+	MIPS_INS_ALUIPC:      CategoryArithmetic,
+	MIPS_INS_AND:         CategoryLogic,
+	MIPS_INS_ANDI:        CategoryLogic,
+	MIPS_INS_APPEND:      CategoryVector,
+	MIPS_INS_ASUB_S:      CategoryVector,
+	MIPS_INS_ASUB_U:      CategoryVector,
+	MIPS_INS_AUI:         CategoryArithmetic,
+	MIPS_INS_AUIPC:       CategoryArithmetic,
+	MIPS_INS_AVER_S:      CategoryVector,
+	MIPS_INS_AVER_U:      CategoryVector,
+	MIPS_INS_AVE_S:       CategoryVector,
+	MIPS_INS_AVE_U:       CategoryVector,
+	MIPS_INS_BADDU:       CategoryArithmetic,
+	MIPS_INS_BAL:         CategoryCall,
+	MIPS_INS_BALC:        CategoryCall,
+	MIPS_INS_BALIGN:      CategoryVector,
+	MIPS_INS_BC:          CategoryBranch,
+	MIPS_INS_BC0F:        CategoryBranch,
+	MIPS_INS_BC0FL:       CategoryBranch,
+	MIPS_INS_BC0T:        CategoryBranch,
+	MIPS_INS_BC0TL:       CategoryBranch,
+	MIPS_INS_BC1EQZ:      CategoryBranch,
+	MIPS_INS_BC1F:        CategoryBranch,
+	MIPS_INS_BC1FL:       CategoryBranch,
+	MIPS_INS_BC1NEZ:      CategoryBranch,
+	MIPS_INS_BC1T:        CategoryBranch,
+	MIPS_INS_BC1TL:       CategoryBranch,
+	MIPS_INS_BC2EQZ:      CategoryBranch,
+	MIPS_INS_BC2F:        CategoryBranch,
+	MIPS_INS_BC2FL:       CategoryBranch,
+	MIPS_INS_BC2NEZ:      CategoryBranch,
+	MIPS_INS_BC2T:        CategoryBranch,
+	MIPS_INS_BC2TL:       CategoryBranch,
+	MIPS_INS_BC3F:        CategoryBranch,
+	MIPS_INS_BC3FL:       CategoryBranch,
+	MIPS_INS_BC3T:        CategoryBranch,
+	MIPS_INS_BC3TL:       CategoryBranch,
+	MIPS_INS_BCLRI:       CategoryVector,
+	MIPS_INS_BCLR:        CategoryVector,
+	MIPS_INS_BEQ:         CategoryBranch,
+	MIPS_INS_BEQC:        CategoryBranch,
+	MIPS_INS_BEQL:        CategoryBranch,
+	MIPS_INS_BEQZALC:     CategoryCall,
+	MIPS_INS_BEQZC:       CategoryBranch,
+	MIPS_INS_BGEC:        CategoryBranch,
+	MIPS_INS_BGEUC:       CategoryBranch,
+	MIPS_INS_BGEZ:        CategoryBranch,
+	MIPS_INS_BGEZAL:      CategoryCall,
+	MIPS_INS_BGEZALC:     CategoryCall,
+	MIPS_INS_BGEZALL:     CategoryCall,
+	MIPS_INS_BGEZALS:     CategoryCall,
+	MIPS_INS_BGEZC:       CategoryBranch,
+	MIPS_INS_BGEZL:       CategoryBranch,
+	MIPS_INS_BGTZ:        CategoryBranch,
+	MIPS_INS_BGTZALC:     CategoryCall,
+	MIPS_INS_BGTZC:       CategoryBranch,
+	MIPS_INS_BGTZL:       CategoryBranch,
+	MIPS_INS_BINSLI:      CategoryVector,
+	MIPS_INS_BINSL:       CategoryVector,
+	MIPS_INS_BINSRI:      CategoryVector,
+	MIPS_INS_BINSR:       CategoryVector,
+	MIPS_INS_BITREV:      CategoryLogic,
+	MIPS_INS_BITSWAP:     CategoryLogic,
+	MIPS_INS_BLEZ:        CategoryBranch,
+	MIPS_INS_BLEZALC:     CategoryCall,
+	MIPS_INS_BLEZC:       CategoryBranch,
+	MIPS_INS_BLEZL:       CategoryBranch,
+	MIPS_INS_BLTC:        CategoryBranch,
+	MIPS_INS_BLTUC:       CategoryBranch,
+	MIPS_INS_BLTZ:        CategoryBranch,
+	MIPS_INS_BLTZAL:      CategoryCall,
+	MIPS_INS_BLTZALC:     CategoryCall,
+	MIPS_INS_BLTZALL:     CategoryCall,
+	MIPS_INS_BLTZALS:     CategoryCall,
+	MIPS_INS_BLTZC:       CategoryBranch,
+	MIPS_INS_BLTZL:       CategoryBranch,
+	MIPS_INS_BMNZI:       CategoryVector,
+	MIPS_INS_BMNZ:        CategoryVector,
+	MIPS_INS_BMZI:        CategoryVector,
+	MIPS_INS_BMZ:         CategoryVector,
+	MIPS_INS_BNE:         CategoryBranch,
+	MIPS_INS_BNEC:        CategoryBranch,
+	MIPS_INS_BNEGI:       CategoryVector,
+	MIPS_INS_BNEG:        CategoryVector,
+	MIPS_INS_BNEL:        CategoryBranch,
+	MIPS_INS_BNEZALC:     CategoryCall,
+	MIPS_INS_BNEZC:       CategoryBranch,
+	MIPS_INS_BNVC:        CategoryBranch,
+	MIPS_INS_BNZ:         CategoryBranch,
+	MIPS_INS_BOVC:        CategoryBranch,
+	MIPS_INS_BPOSGE32:    CategoryBranch,
+	MIPS_INS_BREAK:       CategorySystem,
+	MIPS_INS_BSELI:       CategoryVector,
+	MIPS_INS_BSEL:        CategoryVector,
+	MIPS_INS_BSETI:       CategoryVector,
+	MIPS_INS_BSET:        CategoryVector,
+	MIPS_INS_BZ:          CategoryBranch,
+	MIPS_INS_BEQZ:        CategoryBranch,
+	MIPS_INS_B:           CategoryBranch,
+	MIPS_INS_BNEZ:        CategoryBranch,
+	MIPS_INS_BTEQZ:       CategoryBranch,
+	MIPS_INS_BTNEZ:       CategoryBranch,
+	MIPS_INS_CACHE:       CategorySystem,
+	MIPS_INS_CEIL:        CategoryFloat,
+	MIPS_INS_CEQI:        CategoryVector,
+	MIPS_INS_CEQ:         CategoryVector,
+	MIPS_INS_CFC1:        CategoryFloat,
+	MIPS_INS_CFCMSA:      CategoryVector,
+	MIPS_INS_CINS:        CategoryLogic,
+	MIPS_INS_CINS32:      CategoryLogic,
+	MIPS_INS_CLASS:       CategoryFloat,
+	MIPS_INS_CLEI_S:      CategoryVector,
+	MIPS_INS_CLEI_U:      CategoryVector,
+	MIPS_INS_CLE_S:       CategoryVector,
+	MIPS_INS_CLE_U:       CategoryVector,
+	MIPS_INS_CLO:         CategoryLogic,
+	MIPS_INS_CLTI_S:      CategoryVector,
+	MIPS_INS_CLTI_U:      CategoryVector,
+	MIPS_INS_CLT_S:       CategoryVector,
+	MIPS_INS_CLT_U:       CategoryVector,
+	MIPS_INS_CLZ:         CategoryLogic,
+	MIPS_INS_CMPGDU:      CategoryVector,
+	MIPS_INS_CMPGU:       CategoryVector,
+	MIPS_INS_CMPU:        CategoryVector,
+	MIPS_INS_CMP:         CategoryFloat,
+	MIPS_INS_COPY_S:      CategoryVector,
+	MIPS_INS_COPY_U:      CategoryVector,
+	MIPS_INS_CTC1:        CategoryFloat,
+	MIPS_INS_CTCMSA:      CategoryVector,
+	MIPS_INS_CVT:         CategoryFloat,
+	MIPS_INS_C:           CategoryFloat,
+	MIPS_INS_CMPI:        CategoryCompare,
+	MIPS_INS_DADD:        CategoryArithmetic,
+	MIPS_INS_DADDI:       CategoryArithmetic,
+	MIPS_INS_DADDIU:      CategoryArithmetic,
+	MIPS_INS_DADDU:       CategoryArithmetic,
+	MIPS_INS_DAHI:        CategoryArithmetic,
+	MIPS_INS_DALIGN:      CategoryShift,
+	MIPS_INS_DATI:        CategoryArithmetic,
+	MIPS_INS_DAUI:        CategoryArithmetic,
+	MIPS_INS_DBITSWAP:    CategoryLogic,
+	MIPS_INS_DCLO:        CategoryLogic,
+	MIPS_INS_DCLZ:        CategoryLogic,
+	MIPS_INS_DDIV:        CategoryArithmetic,
+	MIPS_INS_DDIVU:       CategoryArithmetic,
+	MIPS_INS_DERET:       CategoryReturn,
+	MIPS_INS_DEXT:        CategoryLogic,
+	MIPS_INS_DEXTM:       CategoryLogic,
+	MIPS_INS_DEXTU:       CategoryLogic,
+	MIPS_INS_DI:          CategorySystem,
+	MIPS_INS_DINS:        CategoryLogic,
+	MIPS_INS_DINSM:       CategoryLogic,
+	MIPS_INS_DINSU:       CategoryLogic,
+	MIPS_INS_DIV:         CategoryArithmetic,
+	MIPS_INS_DIVU:        CategoryArithmetic,
+	MIPS_INS_DIV_S:       CategoryVector,
+	MIPS_INS_DIV_U:       CategoryVector,
+	MIPS_INS_DLSA:        CategoryArithmetic,
+	MIPS_INS_DMFC0:       CategorySystem,
+	MIPS_INS_DMFC1:       CategoryMove,
+	MIPS_INS_DMFC2:       CategorySystem,
+	MIPS_INS_DMOD:        CategoryArithmetic,
+	MIPS_INS_DMODU:       CategoryArithmetic,
+	MIPS_INS_DMTC0:       CategorySystem,
+	MIPS_INS_DMTC1:       CategoryMove,
+	MIPS_INS_DMTC2:       CategorySystem,
+	MIPS_INS_DMUH:        CategoryArithmetic,
+	MIPS_INS_DMUHU:       CategoryArithmetic,
+	MIPS_INS_DMUL:        CategoryArithmetic,
+	MIPS_INS_DMULT:       CategoryArithmetic,
+	MIPS_INS_DMULTU:      CategoryArithmetic,
+	MIPS_INS_DMULU:       CategoryArithmetic,
+	MIPS_INS_DOTP_S:      CategoryVector,
+	MIPS_INS_DOTP_U:      CategoryVector,
+	MIPS_INS_DPADD_S:     CategoryVector,
+	MIPS_INS_DPADD_U:     CategoryVector,
+	MIPS_INS_DPAQX_SA:    CategoryVector,
+	MIPS_INS_DPAQX_S:     CategoryVector,
+	MIPS_INS_DPAQ_SA:     CategoryVector,
+	MIPS_INS_DPAQ_S:      CategoryVector,
+	MIPS_INS_DPAU:        CategoryVector,
+	MIPS_INS_DPAX:        CategoryVector,
+	MIPS_INS_DPA:         CategoryVector,
+	MIPS_INS_DPOP:        CategoryLogic,
+	MIPS_INS_DPSQX_SA:    CategoryVector,
+	MIPS_INS_DPSQX_S:     CategoryVector,
+	MIPS_INS_DPSQ_SA:     CategoryVector,
+	MIPS_INS_DPSQ_S:      CategoryVector,
+	MIPS_INS_DPSUB_S:     CategoryVector,
+	MIPS_INS_DPSUB_U:     CategoryVector,
+	MIPS_INS_DPSU:        CategoryVector,
+	MIPS_INS_DPSX:        CategoryVector,
+	MIPS_INS_DPS:         CategoryVector,
+	MIPS_INS_DROTR:       CategoryShift,
+	MIPS_INS_DROTR32:     CategoryShift,
+	MIPS_INS_DROTRV:      CategoryShift,
+	MIPS_INS_DSBH:        CategoryMove,
+	MIPS_INS_DSHD:        CategoryMove,
+	MIPS_INS_DSLL:        CategoryShift,
+	MIPS_INS_DSLL32:      CategoryShift,
+	MIPS_INS_DSLLV:       CategoryShift,
+	MIPS_INS_DSRA:        CategoryShift,
+	MIPS_INS_DSRA32:      CategoryShift,
+	MIPS_INS_DSRAV:       CategoryShift,
+	MIPS_INS_DSRL:        CategoryShift,
+	MIPS_INS_DSRL32:      CategoryShift,
+	MIPS_INS_DSRLV:       CategoryShift,
+	MIPS_INS_DSUB:        CategoryArithmetic,
+	MIPS_INS_DSUBU:       CategoryArithmetic,
+	MIPS_INS_EHB:         CategorySystem,
+	MIPS_INS_EI:          CategorySystem,
+	MIPS_INS_ERET:        CategoryReturn,
+	MIPS_INS_EXT:         CategoryLogic,
+	MIPS_INS_EXTP:        CategoryVector,
+	MIPS_INS_EXTPDP:      CategoryVector,
+	MIPS_INS_EXTPDPV:     CategoryVector,
+	MIPS_INS_EXTPV:       CategoryVector,
+	MIPS_INS_EXTRV_RS:    CategoryVector,
+	MIPS_INS_EXTRV_R:     CategoryVector,
+	MIPS_INS_EXTRV_S:     CategoryVector,
+	MIPS_INS_EXTRV:       CategoryVector,
+	MIPS_INS_EXTR_RS:     CategoryVector,
+	MIPS_INS_EXTR_R:      CategoryVector,
+	MIPS_INS_EXTR_S:      CategoryVector,
+	MIPS_INS_EXTR:        CategoryVector,
+	MIPS_INS_EXTS:        CategoryLogic,
+	MIPS_INS_EXTS32:      CategoryLogic,
+	MIPS_INS_ABS:         CategoryFloat,
+	MIPS_INS_FADD:        CategoryVector,
+	MIPS_INS_FCAF:        CategoryVector,
+	MIPS_INS_FCEQ:        CategoryVector,
+	MIPS_INS_FCLASS:      CategoryVector,
+	MIPS_INS_FCLE:        CategoryVector,
+	MIPS_INS_FCLT:        CategoryVector,
+	MIPS_INS_FCNE:        CategoryVector,
+	MIPS_INS_FCOR:        CategoryVector,
+	MIPS_INS_FCUEQ:       CategoryVector,
+	MIPS_INS_FCULE:       CategoryVector,
+	MIPS_INS_FCULT:       CategoryVector,
+	MIPS_INS_FCUNE:       CategoryVector,
+	MIPS_INS_FCUN:        CategoryVector,
+	MIPS_INS_FDIV:        CategoryVector,
+	MIPS_INS_FEXDO:       CategoryVector,
+	MIPS_INS_FEXP2:       CategoryVector,
+	MIPS_INS_FEXUPL:      CategoryVector,
+	MIPS_INS_FEXUPR:      CategoryVector,
+	MIPS_INS_FFINT_S:     CategoryVector,
+	MIPS_INS_FFINT_U:     CategoryVector,
+	MIPS_INS_FFQL:        CategoryVector,
+	MIPS_INS_FFQR:        CategoryVector,
+	MIPS_INS_FILL:        CategoryVector,
+	MIPS_INS_FLOG2:       CategoryVector,
+	MIPS_INS_FLOOR:       CategoryFloat,
+	MIPS_INS_FMADD:       CategoryVector,
+	MIPS_INS_FMAX_A:      CategoryVector,
+	MIPS_INS_FMAX:        CategoryVector,
+	MIPS_INS_FMIN_A:      CategoryVector,
+	MIPS_INS_FMIN:        CategoryVector,
+	MIPS_INS_MOV:         CategoryMove,
+	MIPS_INS_FMSUB:       CategoryVector,
+	MIPS_INS_FMUL:        CategoryVector,
+	MIPS_INS_MUL:         CategoryArithmetic,
+	MIPS_INS_NEG:         CategoryArithmetic,
+	MIPS_INS_FRCP:        CategoryVector,
+	MIPS_INS_FRINT:       CategoryVector,
+	MIPS_INS_FRSQRT:      CategoryVector,
+	MIPS_INS_FSAF:        CategoryVector,
+	MIPS_INS_FSEQ:        CategoryVector,
+	MIPS_INS_FSLE:        CategoryVector,
+	MIPS_INS_FSLT:        CategoryVector,
+	MIPS_INS_FSNE:        CategoryVector,
+	MIPS_INS_FSOR:        CategoryVector,
+	MIPS_INS_FSQRT:       CategoryVector,
+	MIPS_INS_SQRT:        CategoryFloat,
+	MIPS_INS_FSUB:        CategoryVector,
+	MIPS_INS_SUB:         CategoryArithmetic,
+	MIPS_INS_FSUEQ:       CategoryVector,
+	MIPS_INS_FSULE:       CategoryVector,
+	MIPS_INS_FSULT:       CategoryVector,
+	MIPS_INS_FSUNE:       CategoryVector,
+	MIPS_INS_FSUN:        CategoryVector,
+	MIPS_INS_FTINT_S:     CategoryVector,
+	MIPS_INS_FTINT_U:     CategoryVector,
+	MIPS_INS_FTQ:         CategoryVector,
+	MIPS_INS_FTRUNC_S:    CategoryVector,
+	MIPS_INS_FTRUNC_U:    CategoryVector,
+	MIPS_INS_HADD_S:      CategoryVector,
+	MIPS_INS_HADD_U:      CategoryVector,
+	MIPS_INS_HSUB_S:      CategoryVector,
+	MIPS_INS_HSUB_U:      CategoryVector,
+	MIPS_INS_ILVEV:       CategoryVector,
+	MIPS_INS_ILVL:        CategoryVector,
+	MIPS_INS_ILVOD:       CategoryVector,
+	MIPS_INS_ILVR:        CategoryVector,
+	MIPS_INS_INS:         CategoryLogic,
+	MIPS_INS_INSERT:      CategoryVector,
+	MIPS_INS_INSV:        CategoryVector,
+	MIPS_INS_INSVE:       CategoryVector,
+	MIPS_INS_J:           CategoryBranch,
+	MIPS_INS_JAL:         CategoryCall,
+	MIPS_INS_JALR:        CategoryCall,
+	MIPS_INS_JALRS:       CategoryCall,
+	MIPS_INS_JALS:        CategoryCall,
+	MIPS_INS_JALX:        CategoryCall,
+	MIPS_INS_JIALC:       CategoryCall,
+	MIPS_INS_JIC:         CategoryBranch,
+	MIPS_INS_JR:          CategoryBranch,
+	MIPS_INS_JRADDIUSP:   CategoryReturn,
+	MIPS_INS_JRC:         CategoryBranch,
+	MIPS_INS_JALRC:       CategoryCall,
+	MIPS_INS_LB:          CategoryLoad,
+	MIPS_INS_LBUX:        CategoryLoad,
+	MIPS_INS_LBU:         CategoryLoad,
+	MIPS_INS_LD:          CategoryLoad,
+	MIPS_INS_LDC1:        CategoryLoad,
+	MIPS_INS_LDC2:        CategoryLoad,
+	MIPS_INS_LDC3:        CategoryLoad,
+	MIPS_INS_LDI:         CategoryVector,
+	MIPS_INS_LDL:         CategoryLoad,
+	MIPS_INS_LDPC:        CategoryLoad,
+	MIPS_INS_LDR:         CategoryLoad,
+	MIPS_INS_LDXC1:       CategoryLoad,
+	MIPS_INS_LH:          CategoryLoad,
+	MIPS_INS_LHX:         CategoryLoad,
+	MIPS_INS_LHU:         CategoryLoad,
+	MIPS_INS_LL:          CategoryAtomic,
+	MIPS_INS_LLD:         CategoryAtomic,
+	MIPS_INS_LSA:         CategoryArithmetic,
+	MIPS_INS_LUXC1:       CategoryLoad,
+	MIPS_INS_LUI:         CategoryMove,
+	MIPS_INS_LW:          CategoryLoad,
+	MIPS_INS_LWC1:        CategoryLoad,
+	MIPS_INS_LWC2:        CategoryLoad,
+	MIPS_INS_LWC3:        CategoryLoad,
+	MIPS_INS_LWL:         CategoryLoad,
+	MIPS_INS_LWPC:        CategoryLoad,
+	MIPS_INS_LWR:         CategoryLoad,
+	MIPS_INS_LWUPC:       CategoryLoad,
+	MIPS_INS_LWU:         CategoryLoad,
+	MIPS_INS_LWX:         CategoryLoad,
+	MIPS_INS_LWXC1:       CategoryLoad,
+	MIPS_INS_LI:          CategoryMove,
+	MIPS_INS_MADD:        CategoryArithmetic,
+	MIPS_INS_MADDF:       CategoryFloat,
+	MIPS_INS_MADDR_Q:     CategoryVector,
+	MIPS_INS_MADDU:       CategoryArithmetic,
+	MIPS_INS_MADDV:       CategoryVector,
+	MIPS_INS_MADD_Q:      CategoryVector,
+	MIPS_INS_MAQ_SA:      CategoryVector,
+	MIPS_INS_MAQ_S:       CategoryVector,
+	MIPS_INS_MAXA:        CategoryFloat,
+	MIPS_INS_MAXI_S:      CategoryVector,
+	MIPS_INS_MAXI_U:      CategoryVector,
+	MIPS_INS_MAX_A:       CategoryVector,
+	MIPS_INS_MAX:         CategoryFloat,
+	MIPS_INS_MAX_S:       CategoryVector,
+	MIPS_INS_MAX_U:       CategoryVector,
+	MIPS_INS_MFC0:        CategorySystem,
+	MIPS_INS_MFC1:        CategoryMove,
+	MIPS_INS_MFC2:        CategorySystem,
+	MIPS_INS_MFHC1:       CategoryMove,
+	MIPS_INS_MFHI:        CategoryMove,
+	MIPS_INS_MFLO:        CategoryMove,
+	MIPS_INS_MINA:        CategoryFloat,
+	MIPS_INS_MINI_S:      CategoryVector,
+	MIPS_INS_MINI_U:      CategoryVector,
+	MIPS_INS_MIN_A:       CategoryVector,
+	MIPS_INS_MIN:         CategoryFloat,
+	MIPS_INS_MIN_S:       CategoryVector,
+	MIPS_INS_MIN_U:       CategoryVector,
+	MIPS_INS_MOD:         CategoryArithmetic,
+	MIPS_INS_MODSUB:      CategoryVector,
+	MIPS_INS_MODU:        CategoryArithmetic,
+	MIPS_INS_MOD_S:       CategoryVector,
+	MIPS_INS_MOD_U:       CategoryVector,
+	MIPS_INS_MOVE:        CategoryMove,
+	MIPS_INS_MOVF:        CategoryMove,
+	MIPS_INS_MOVN:        CategoryMove,
+	MIPS_INS_MOVT:        CategoryMove,
+	MIPS_INS_MOVZ:        CategoryMove,
+	MIPS_INS_MSUB:        CategoryArithmetic,
+	MIPS_INS_MSUBF:       CategoryFloat,
+	MIPS_INS_MSUBR_Q:     CategoryVector,
+	MIPS_INS_MSUBU:       CategoryArithmetic,
+	MIPS_INS_MSUBV:       CategoryVector,
+	MIPS_INS_MSUB_Q:      CategoryVector,
+	MIPS_INS_MTC0:        CategorySystem,
+	MIPS_INS_MTC1:        CategoryMove,
+	MIPS_INS_MTC2:        CategorySystem,
+	MIPS_INS_MTHC1:       CategoryMove,
+	MIPS_INS_MTHI:        CategoryMove,
+	MIPS_INS_MTHLIP:      CategoryVector,
+	MIPS_INS_MTLO:        CategoryMove,
+	MIPS_INS_MTM0:        CategoryArithmetic,
+	MIPS_INS_MTM1:        CategoryArithmetic,
+	MIPS_INS_MTM2:        CategoryArithmetic,
+	MIPS_INS_MTP0:        CategoryArithmetic,
+	MIPS_INS_MTP1:        CategoryArithmetic,
+	MIPS_INS_MTP2:        CategoryArithmetic,
+	MIPS_INS_MUH:         CategoryArithmetic,
+	MIPS_INS_MUHU:        CategoryArithmetic,
+	MIPS_INS_MULEQ_S:     CategoryVector,
+	MIPS_INS_MULEU_S:     CategoryVector,
+	MIPS_INS_MULQ_RS:     CategoryVector,
+	MIPS_INS_MULQ_S:      CategoryVector,
+	MIPS_INS_MULR_Q:      CategoryVector,
+	MIPS_INS_MULSAQ_S:    CategoryVector,
+	MIPS_INS_MULSA:       CategoryVector,
+	MIPS_INS_MULT:        CategoryArithmetic,
+	MIPS_INS_MULTU:       CategoryArithmetic,
+	MIPS_INS_MULU:        CategoryArithmetic,
+	MIPS_INS_MULV:        CategoryVector,
+	MIPS_INS_MUL_Q:       CategoryVector,
+	MIPS_INS_MUL_S:       CategoryVector,
+	MIPS_INS_NLOC:        CategoryVector,
+	MIPS_INS_NLZC:        CategoryVector,
+	MIPS_INS_NMADD:       CategoryFloat,
+	MIPS_INS_NMSUB:       CategoryFloat,
+	MIPS_INS_NOR:         CategoryLogic,
+	MIPS_INS_NORI:        CategoryLogic,
+	MIPS_INS_NOT:         CategoryLogic,
+	MIPS_INS_OR:          CategoryLogic,
+	MIPS_INS_ORI:         CategoryLogic,
+	MIPS_INS_PACKRL:      CategoryVector,
+	MIPS_INS_PAUSE:       CategoryNop,
+	MIPS_INS_PCKEV:       CategoryVector,
+	MIPS_INS_PCKOD:       CategoryVector,
+	MIPS_INS_PCNT:        CategoryVector,
+	MIPS_INS_PICK:        CategoryVector,
+	MIPS_INS_POP:         CategoryLogic,
+	MIPS_INS_PRECEQU:     CategoryVector,
+	MIPS_INS_PRECEQ:      CategoryVector,
+	MIPS_INS_PRECEU:      CategoryVector,
+	MIPS_INS_PRECRQU_S:   CategoryVector,
+	MIPS_INS_PRECRQ:      CategoryVector,
+	MIPS_INS_PRECRQ_RS:   CategoryVector,
+	MIPS_INS_PRECR:       CategoryVector,
+	MIPS_INS_PRECR_SRA:   CategoryVector,
+	MIPS_INS_PRECR_SRA_R: CategoryVector,
+	MIPS_INS_PREF:        CategorySystem,
+	MIPS_INS_PREPEND:     CategoryVector,
+	MIPS_INS_RADDU:       CategoryVector,
+	MIPS_INS_RDDSP:       CategoryMove,
+	MIPS_INS_RDHWR:       CategorySystem,
+	MIPS_INS_REPLV:       CategoryVector,
+	MIPS_INS_REPL:        CategoryVector,
+	MIPS_INS_RINT:        CategoryFloat,
+	MIPS_INS_ROTR:        CategoryShift,
+	MIPS_INS_ROTRV:       CategoryShift,
+	MIPS_INS_ROUND:       CategoryFloat,
+	MIPS_INS_SAT_S:       CategoryVector,
+	MIPS_INS_SAT_U:       CategoryVector,
+	MIPS_INS_SB:          CategoryStore,
+	MIPS_INS_SC:          CategoryAtomic,
+	MIPS_INS_SCD:         CategoryAtomic,
+	MIPS_INS_SD:          CategoryStore,
+	MIPS_INS_SDBBP:       CategorySystem,
+	MIPS_INS_SDC1:        CategoryStore,
+	MIPS_INS_SDC2:        CategoryStore,
+	MIPS_INS_SDC3:        CategoryStore,
+	MIPS_INS_SDL:         CategoryStore,
+	MIPS_INS_SDR:         CategoryStore,
+	MIPS_INS_SDXC1:       CategoryStore,
+	MIPS_INS_SEB:         CategoryMove,
+	MIPS_INS_SEH:         CategoryMove,
+	MIPS_INS_SELEQZ:      CategoryMove,
+	MIPS_INS_SELNEZ:      CategoryMove,
+	MIPS_INS_SEL:         CategoryFloat,
+	MIPS_INS_SEQ:         CategoryCompare,
+	MIPS_INS_SEQI:        CategoryCompare,
+	MIPS_INS_SH:          CategoryStore,
+	MIPS_INS_SHF:         CategoryVector,
+	MIPS_INS_SHILO:       CategoryVector,
+	MIPS_INS_SHILOV:      CategoryVector,
+	MIPS_INS_SHLLV:       CategoryVector,
+	MIPS_INS_SHLLV_S:     CategoryVector,
+	MIPS_INS_SHLL:        CategoryVector,
+	MIPS_INS_SHLL_S:      CategoryVector,
+	MIPS_INS_SHRAV:       CategoryVector,
+	MIPS_INS_SHRAV_R:     CategoryVector,
+	MIPS_INS_SHRA:        CategoryVector,
+	MIPS_INS_SHRA_R:      CategoryVector,
+	MIPS_INS_SHRLV:       CategoryVector,
+	MIPS_INS_SHRL:        CategoryVector,
+	MIPS_INS_SLDI:        CategoryVector,
+	MIPS_INS_SLD:         CategoryVector,
+	MIPS_INS_SLL:         CategoryShift,
+	MIPS_INS_SLLI:        CategoryVector,
+	MIPS_INS_SLLV:        CategoryShift,
+	MIPS_INS_SLT:         CategoryCompare,
+	MIPS_INS_SLTI:        CategoryCompare,
+	MIPS_INS_SLTIU:       CategoryCompare,
+	MIPS_INS_SLTU:        CategoryCompare,
+	MIPS_INS_SNE:         CategoryCompare,
+	MIPS_INS_SNEI:        CategoryCompare,
+	MIPS_INS_SPLATI:      CategoryVector,
+	MIPS_INS_SPLAT:       CategoryVector,
+	MIPS_INS_SRA:         CategoryShift,
+	MIPS_INS_SRAI:        CategoryVector,
+	MIPS_INS_SRARI:       CategoryVector,
+	MIPS_INS_SRAR:        CategoryVector,
+	MIPS_INS_SRAV:        CategoryShift,
+	MIPS_INS_SRL:         CategoryShift,
+	MIPS_INS_SRLI:        CategoryVector,
+	MIPS_INS_SRLRI:       CategoryVector,
+	MIPS_INS_SRLR:        CategoryVector,
+	MIPS_INS_SRLV:        CategoryShift,
+	MIPS_INS_SSNOP:       CategoryNop,
+	MIPS_INS_ST:          CategoryStore,
+	MIPS_INS_SUBQH:       CategoryVector,
+	MIPS_INS_SUBQH_R:     CategoryVector,
+	MIPS_INS_SUBQ:        CategoryVector,
+	MIPS_INS_SUBQ_S:      CategoryVector,
+	MIPS_INS_SUBSUS_U:    CategoryVector,
+	MIPS_INS_SUBSUU_S:    CategoryVector,
+	MIPS_INS_SUBS_S:      CategoryVector,
+	MIPS_INS_SUBS_U:      CategoryVector,
+	MIPS_INS_SUBUH:       CategoryVector,
+	MIPS_INS_SUBUH_R:     CategoryVector,
+	MIPS_INS_SUBU:        CategoryArithmetic,
+	MIPS_INS_SUBU_S:      CategoryVector,
+	MIPS_INS_SUBVI:       CategoryVector,
+	MIPS_INS_SUBV:        CategoryVector,
+	MIPS_INS_SUXC1:       CategoryStore,
+	MIPS_INS_SW:          CategoryStore,
+	MIPS_INS_SWC1:        CategoryStore,
+	MIPS_INS_SWC2:        CategoryStore,
+	MIPS_INS_SWC3:        CategoryStore,
+	MIPS_INS_SWL:         CategoryStore,
+	MIPS_INS_SWR:         CategoryStore,
+	MIPS_INS_SWXC1:       CategoryStore,
+	MIPS_INS_SYNC:        CategoryAtomic,
+	MIPS_INS_SYSCALL:     CategorySystem,
+	MIPS_INS_TEQ:         CategorySystem,
+	MIPS_INS_TEQI:        CategorySystem,
+	MIPS_INS_TGE:         CategorySystem,
+	MIPS_INS_TGEI:        CategorySystem,
+	MIPS_INS_TGEIU:       CategorySystem,
+	MIPS_INS_TGEU:        CategorySystem,
+	MIPS_INS_TLBP:        CategorySystem,
+	MIPS_INS_TLBR:        CategorySystem,
+	MIPS_INS_TLBWI:       CategorySystem,
+	MIPS_INS_TLBWR:       CategorySystem,
+	MIPS_INS_TLT:         CategorySystem,
+	MIPS_INS_TLTI:        CategorySystem,
+	MIPS_INS_TLTIU:       CategorySystem,
+	MIPS_INS_TLTU:        CategorySystem,
+	MIPS_INS_TNE:         CategorySystem,
+	MIPS_INS_TNEI:        CategorySystem,
+	MIPS_INS_TRUNC:       CategoryFloat,
+	MIPS_INS_V3MULU:      CategoryArithmetic,
+	MIPS_INS_VMM0:        CategoryArithmetic,
+	MIPS_INS_VMULU:       CategoryArithmetic,
+	MIPS_INS_VSHF:        CategoryVector,
+	MIPS_INS_WAIT:        CategorySystem,
+	MIPS_INS_WRDSP:       CategoryMove,
+	MIPS_INS_WSBH:        CategoryMove,
+	MIPS_INS_XOR:         CategoryLogic,
+	MIPS_INS_XORI:        CategoryLogic,
+	MIPS_INS_NOP:         CategoryNop,
+	MIPS_INS_NEGU:        CategoryArithmetic,
+	MIPS_INS_JALR_HB:     CategoryCall,
+	MIPS_INS_JR_HB:       CategoryBranch,
+}
+
+var ppcCategories = map[uint]Category{
+	PPC_INS_ADD:           CategoryArithmetic,
+	PPC_INS_ADDC:          CategoryArithmetic,
+	PPC_INS_ADDE:          CategoryArithmetic,
+	PPC_INS_ADDI:          CategoryArithmetic,
+	PPC_INS_ADDIC:         CategoryArithmetic,
+	PPC_INS_ADDIS:         CategoryArithmetic,
+	PPC_INS_ADDME:         CategoryArithmetic,
+	PPC_INS_ADDZE:         CategoryArithmetic,
+	PPC_INS_AND:           CategoryLogic,
+	PPC_INS_ANDC:          CategoryLogic,
+	PPC_INS_ANDIS:         CategoryLogic,
+	PPC_INS_ANDI:          CategoryLogic,
+	PPC_INS_B:             CategoryBranch,
+	PPC_INS_BA:            CategoryBranch,
+	PPC_INS_BC:            CategoryBranch,
+	PPC_INS_BCCTR:         CategoryBranch,
+	PPC_INS_BCCTRL:        CategoryCall,
+	PPC_INS_BCL:           CategoryCall,
+	PPC_INS_BCLR:          CategoryReturn,
+	PPC_INS_BCLRL:         CategoryCall,
+	PPC_INS_BCTR:          CategoryBranch,
+	PPC_INS_BCTRL:         CategoryCall,
+	PPC_INS_BDNZ:          CategoryBranch,
+	PPC_INS_BDNZA:         CategoryBranch,
+	PPC_INS_BDNZL:         CategoryCall,
+	PPC_INS_BDNZLA:        CategoryCall,
+	PPC_INS_BDNZLR:        CategoryReturn,
+	PPC_INS_BDNZLRL:       CategoryCall,
+	PPC_INS_BDZ:           CategoryBranch,
+	PPC_INS_BDZA:          CategoryBranch,
+	PPC_INS_BDZL:          CategoryCall,
+	PPC_INS_BDZLA:         CategoryCall,
+	PPC_INS_BDZLR:         CategoryReturn,
+	PPC_INS_BDZLRL:        CategoryCall,
+	PPC_INS_BL:            CategoryCall,
+	PPC_INS_BLA:           CategoryCall,
+	PPC_INS_BLR:           CategoryReturn,
+	PPC_INS_BLRL:          CategoryCall,
+	PPC_INS_BRINC:         CategoryVector,
+	PPC_INS_CMPD:          CategoryCompare,
+	PPC_INS_CMPDI:         CategoryCompare,
+	PPC_INS_CMPLD:         CategoryCompare,
+	PPC_INS_CMPLDI:        CategoryCompare,
+	PPC_INS_CMPLW:         CategoryCompare,
+	PPC_INS_CMPLWI:        CategoryCompare,
+	PPC_INS_CMPW:          CategoryCompare,
+	PPC_INS_CMPWI:         CategoryCompare,
+	PPC_INS_CNTLZD:        CategoryLogic,
+	PPC_INS_CNTLZW:        CategoryLogic,
+	PPC_INS_CREQV:         CategoryLogic,
+	PPC_INS_CRXOR:         CategoryLogic,
+	PPC_INS_CRAND:         CategoryLogic,
+	PPC_INS_CRANDC:        CategoryLogic,
+	PPC_INS_CRNAND:        CategoryLogic,
+	PPC_INS_CRNOR:         CategoryLogic,
+	PPC_INS_CROR:          CategoryLogic,
+	PPC_INS_CRORC:         CategoryLogic,
+	PPC_INS_DCBA:          CategorySystem,
+	PPC_INS_DCBF:          CategorySystem,
+	PPC_INS_DCBI:          CategorySystem,
+	PPC_INS_DCBST:         CategorySystem,
+	PPC_INS_DCBT:          CategorySystem,
+	PPC_INS_DCBTST:        CategorySystem,
+	PPC_INS_DCBZ:          CategorySystem,
+	PPC_INS_DCBZL:         CategorySystem,
+	PPC_INS_DCCCI:         CategorySystem,
+	PPC_INS_DIVD:          CategoryArithmetic,
+	PPC_INS_DIVDU:         CategoryArithmetic,
+	PPC_INS_DIVW:          CategoryArithmetic,
+	PPC_INS_DIVWU:         CategoryArithmetic,
+	PPC_INS_DSS:           CategorySystem,
+	PPC_INS_DSSALL:        CategorySystem,
+	PPC_INS_DST:           CategorySystem,
+	PPC_INS_DSTST:         CategorySystem,
+	PPC_INS_DSTSTT:        CategorySystem,
+	PPC_INS_DSTT:          CategorySystem,
+	PPC_INS_EIEIO:         CategoryAtomic,
+	PPC_INS_EQV:           CategoryLogic,
+	PPC_INS_EVABS:         CategoryVector,
+	PPC_INS_EVADDIW:       CategoryVector,
+	PPC_INS_EVADDSMIAAW:   CategoryVector,
+	PPC_INS_EVADDSSIAAW:   CategoryVector,
+	PPC_INS_EVADDUMIAAW:   CategoryVector,
+	PPC_INS_EVADDUSIAAW:   CategoryVector,
+	PPC_INS_EVADDW:        CategoryVector,
+	PPC_INS_EVAND:         CategoryVector,
+	PPC_INS_EVANDC:        CategoryVector,
+	PPC_INS_EVCMPEQ:       CategoryVector,
+	PPC_INS_EVCMPGTS:      CategoryVector,
+	PPC_INS_EVCMPGTU:      CategoryVector,
+	PPC_INS_EVCMPLTS:      CategoryVector,
+	PPC_INS_EVCMPLTU:      CategoryVector,
+	PPC_INS_EVCNTLSW:      CategoryVector,
+	PPC_INS_EVCNTLZW:      CategoryVector,
+	PPC_INS_EVDIVWS:       CategoryVector,
+	PPC_INS_EVDIVWU:       CategoryVector,
+	PPC_INS_EVEQV:         CategoryVector,
+	PPC_INS_EVEXTSB:       CategoryVector,
+	PPC_INS_EVEXTSH:       CategoryVector,
+	PPC_INS_EVLDD:         CategoryVector,
+	PPC_INS_EVLDDX:        CategoryVector,
+	PPC_INS_EVLDH:         CategoryVector,
+	PPC_INS_EVLDHX:        CategoryVector,
+	PPC_INS_EVLDW:         CategoryVector,
+	PPC_INS_EVLDWX:        CategoryVector,
+	PPC_INS_EVLHHESPLAT:   CategoryVector,
+	PPC_INS_EVLHHESPLATX:  CategoryVector,
+	PPC_INS_EVLHHOSSPLAT:  CategoryVector,
+	PPC_INS_EVLHHOSSPLATX: CategoryVector,
+	PPC_INS_EVLHHOUSPLAT:  CategoryVector,
+	PPC_INS_EVLHHOUSPLATX: CategoryVector,
+	PPC_INS_EVLWHE:        CategoryVector,
+	PPC_INS_EVLWHEX:       CategoryVector,
+	PPC_INS_EVLWHOS:       CategoryVector,
+	PPC_INS_EVLWHOSX:      CategoryVector,
+	PPC_INS_EVLWHOU:       CategoryVector,
+	PPC_INS_EVLWHOUX:      CategoryVector,
+	PPC_INS_EVLWHSPLAT:    CategoryVector,
+	PPC_INS_EVLWHSPLATX:   CategoryVector,
+	PPC_INS_EVLWWSPLAT:    CategoryVector,
+	PPC_INS_EVLWWSPLATX:   CategoryVector,
+	PPC_INS_EVMERGEHI:     CategoryVector,
+	PPC_INS_EVMERGEHILO:   CategoryVector,
+	PPC_INS_EVMERGELO:     CategoryVector,
+	PPC_INS_EVMERGELOHI:   CategoryVector,
+	PPC_INS_EVMHEGSMFAA:   CategoryVector,
+	PPC_INS_EVMHEGSMFAN:   CategoryVector,
+	PPC_INS_EVMHEGSMIAA:   CategoryVector,
+	PPC_INS_EVMHEGSMIAN:   CategoryVector,
+	PPC_INS_EVMHEGUMIAA:   CategoryVector,
+	PPC_INS_EVMHEGUMIAN:   CategoryVector,
+	PPC_INS_EVMHESMF:      CategoryVector,
+	PPC_INS_EVMHESMFA:     CategoryVector,
+	PPC_INS_EVMHESMFAAW:   CategoryVector,
+	PPC_INS_EVMHESMFANW:   CategoryVector,
+	PPC_INS_EVMHESMI:      CategoryVector,
+	PPC_INS_EVMHESMIA:     CategoryVector,
+	PPC_INS_EVMHESMIAAW:   CategoryVector,
+	PPC_INS_EVMHESMIANW:   CategoryVector,
+	PPC_INS_EVMHESSF:      CategoryVector,
+	PPC_INS_EVMHESSFA:     CategoryVector,
+	PPC_INS_EVMHESSFAAW:   CategoryVector,
+	PPC_INS_EVMHESSFANW:   CategoryVector,
+	PPC_INS_EVMHESSIAAW:   CategoryVector,
+	PPC_INS_EVMHESSIANW:   CategoryVector,
+	PPC_INS_EVMHEUMI:      CategoryVector,
+	PPC_INS_EVMHEUMIA:     CategoryVector,
+	PPC_INS_EVMHEUMIAAW:   CategoryVector,
+	PPC_INS_EVMHEUMIANW:   CategoryVector,
+	PPC_INS_EVMHEUSIAAW:   CategoryVector,
+	PPC_INS_EVMHEUSIANW:   CategoryVector,
+	PPC_INS_EVMHOGSMFAA:   CategoryVector,
+	PPC_INS_EVMHOGSMFAN:   CategoryVector,
+	PPC_INS_EVMHOGSMIAA:   CategoryVector,
+	PPC_INS_EVMHOGSMIAN:   CategoryVector,
+	PPC_INS_EVMHOGUMIAA:   CategoryVector,
+	PPC_INS_EVMHOGUMIAN:   CategoryVector,
+	PPC_INS_EVMHOSMF:      CategoryVector,
+	PPC_INS_EVMHOSMFA:     CategoryVector,
+	PPC_INS_EVMHOSMFAAW:   CategoryVector,
+	PPC_INS_EVMHOSMFANW:   CategoryVector,
+	PPC_INS_EVMHOSMI:      CategoryVector,
+	PPC_INS_EVMHOSMIA:     CategoryVector,
+	PPC_INS_EVMHOSMIAAW:   CategoryVector,
+	PPC_INS_EVMHOSMIANW:   CategoryVector,
+	PPC_INS_EVMHOSSF:      CategoryVector,
+	PPC_INS_EVMHOSSFA:     CategoryVector,
+	PPC_INS_EVMHOSSFAAW:   CategoryVector,
+	PPC_INS_EVMHOSSFANW:   CategoryVector,
+	PPC_INS_EVMHOSSIAAW:   CategoryVector,
+	PPC_INS_EVMHOSSIANW:   CategoryVector,
+	PPC_INS_EVMHOUMI:      CategoryVector,
+	PPC_INS_EVMHOUMIA:     CategoryVector,
+	PPC_INS_EVMHOUMIAAW:   CategoryVector,
+	PPC_INS_EVMHOUMIANW:   CategoryVector,
+	PPC_INS_EVMHOUSIAAW:   CategoryVector,
+	PPC_INS_EVMHOUSIANW:   CategoryVector,
+	PPC_INS_EVMRA:         CategoryVector,
+	PPC_INS_EVMWHSMF:      CategoryVector,
+	PPC_INS_EVMWHSMFA:     CategoryVector,
+	PPC_INS_EVMWHSMI:      CategoryVector,
+	PPC_INS_EVMWHSMIA:     CategoryVector,
+	PPC_INS_EVMWHSSF:      CategoryVector,
+	PPC_INS_EVMWHSSFA:     CategoryVector,
+	PPC_INS_EVMWHUMI:      CategoryVector,
+	PPC_INS_EVMWHUMIA:     CategoryVector,
+	PPC_INS_EVMWLSMIAAW:   CategoryVector,
+	PPC_INS_EVMWLSMIANW:   CategoryVector,
+	PPC_INS_EVMWLSSIAAW:   CategoryVector,
+	PPC_INS_EVMWLSSIANW:   CategoryVector,
+	PPC_INS_EVMWLUMI:      CategoryVector,
+	PPC_INS_EVMWLUMIA:     CategoryVector,
+	PPC_INS_EVMWLUMIAAW:   CategoryVector,
+	PPC_INS_EVMWLUMIANW:   CategoryVector,
+	PPC_INS_EVMWLUSIAAW:   CategoryVector,
+	PPC_INS_EVMWLUSIANW:   CategoryVector,
+	PPC_INS_EVMWSMF:       CategoryVector,
+	PPC_INS_EVMWSMFA:      CategoryVector,
+	PPC_INS_EVMWSMFAA:     CategoryVector,
+	PPC_INS_EVMWSMFAN:     CategoryVector,
+	PPC_INS_EVMWSMI:       CategoryVector,
+	PPC_INS_EVMWSMIA:      CategoryVector,
+	PPC_INS_EVMWSMIAA:     CategoryVector,
+	PPC_INS_EVMWSMIAN:     CategoryVector,
+	PPC_INS_EVMWSSF:       CategoryVector,
+	PPC_INS_EVMWSSFA:      CategoryVector,
+	PPC_INS_EVMWSSFAA:     CategoryVector,
+	PPC_INS_EVMWSSFAN:     CategoryVector,
+	PPC_INS_EVMWUMI:       CategoryVector,
+	PPC_INS_EVMWUMIA:      CategoryVector,
+	PPC_INS_EVMWUMIAA:     CategoryVector,
+	PPC_INS_EVMWUMIAN:     CategoryVector,
+	PPC_INS_EVNAND:        CategoryVector,
+	PPC_INS_EVNEG:         CategoryVector,
+	PPC_INS_EVNOR:         CategoryVector,
+	PPC_INS_EVOR:          CategoryVector,
+	PPC_INS_EVORC:         CategoryVector,
+	PPC_INS_EVRLW:         CategoryVector,
+	PPC_INS_EVRLWI:        CategoryVector,
+	PPC_INS_EVRNDW:        CategoryVector,
+	PPC_INS_EVSLW:         CategoryVector,
+	PPC_INS_EVSLWI:        CategoryVector,
+	PPC_INS_EVSPLATFI:     CategoryVector,
+	PPC_INS_EVSPLATI:      CategoryVector,
+	PPC_INS_EVSRWIS:       CategoryVector,
+	PPC_INS_EVSRWIU:       CategoryVector,
+	PPC_INS_EVSRWS:        CategoryVector,
+	PPC_INS_EVSRWU:        CategoryVector,
+	PPC_INS_EVSTDD:        CategoryVector,
+	PPC_INS_EVSTDDX:       CategoryVector,
+	PPC_INS_EVSTDH:        CategoryVector,
+	PPC_INS_EVSTDHX:       CategoryVector,
+	PPC_INS_EVSTDW:        CategoryVector,
+	PPC_INS_EVSTDWX:       CategoryVector,
+	PPC_INS_EVSTWHE:       CategoryVector,
+	PPC_INS_EVSTWHEX:      CategoryVector,
+	PPC_INS_EVSTWHO:       CategoryVector,
+	PPC_INS_EVSTWHOX:      CategoryVector,
+	PPC_INS_EVSTWWE:       CategoryVector,
+	PPC_INS_EVSTWWEX:      CategoryVector,
+	PPC_INS_EVSTWWO:       CategoryVector,
+	PPC_INS_EVSTWWOX:      CategoryVector,
+	PPC_INS_EVSUBFSMIAAW:  CategoryVector,
+	PPC_INS_EVSUBFSSIAAW:  CategoryVector,
+	PPC_INS_EVSUBFUMIAAW:  CategoryVector,
+	PPC_INS_EVSUBFUSIAAW:  CategoryVector,
+	PPC_INS_EVSUBFW:       CategoryVector,
+	PPC_INS_EVSUBIFW:      CategoryVector,
+	PPC_INS_EVXOR:         CategoryVector,
+	PPC_INS_EXTSB:         CategoryMove,
+	PPC_INS_EXTSH:         CategoryMove,
+	PPC_INS_EXTSW:         CategoryMove,
+	PPC_INS_FABS:          CategoryFloat,
+	PPC_INS_FADD:          CategoryFloat,
+	PPC_INS_FADDS:         CategoryFloat,
+	PPC_INS_FCFID:         CategoryFloat,
+	PPC_INS_FCFIDS:        CategoryFloat,
+	PPC_INS_FCFIDU:        CategoryFloat,
+	PPC_INS_FCFIDUS:       CategoryFloat,
+	PPC_INS_FCMPU:         CategoryFloat,
+	PPC_INS_FCPSGN:        CategoryFloat,
+	PPC_INS_FCTID:         CategoryFloat,
+	PPC_INS_FCTIDUZ:       CategoryFloat,
+	PPC_INS_FCTIDZ:        CategoryFloat,
+	PPC_INS_FCTIW:         CategoryFloat,
+	PPC_INS_FCTIWUZ:       CategoryFloat,
+	PPC_INS_FCTIWZ:        CategoryFloat,
+	PPC_INS_FDIV:          CategoryFloat,
+	PPC_INS_FDIVS:         CategoryFloat,
+	PPC_INS_FMADD:         CategoryFloat,
+	PPC_INS_FMADDS:        CategoryFloat,
+	PPC_INS_FMR:           CategoryMove,
+	PPC_INS_FMSUB:         CategoryFloat,
+	PPC_INS_FMSUBS:        CategoryFloat,
+	PPC_INS_FMUL:          CategoryFloat,
+	PPC_INS_FMULS:         CategoryFloat,
+	PPC_INS_FNABS:         CategoryFloat,
+	PPC_INS_FNEG:          CategoryFloat,
+	PPC_INS_FNMADD:        CategoryFloat,
+	PPC_INS_FNMADDS:       CategoryFloat,
+	PPC_INS_FNMSUB:        CategoryFloat,
+	PPC_INS_FNMSUBS:       CategoryFloat,
+	PPC_INS_FRE:           CategoryFloat,
+	PPC_INS_FRES:          CategoryFloat,
+	PPC_INS_FRIM:          CategoryFloat,
+	PPC_INS_FRIN:          CategoryFloat,
+	PPC_INS_FRIP:          CategoryFloat,
+	PPC_INS_FRIZ:          CategoryFloat,
+	PPC_INS_FRSP:          CategoryFloat,
+	PPC_INS_FRSQRTE:       CategoryFloat,
+	PPC_INS_FRSQRTES:      CategoryFloat,
+	PPC_INS_FSEL:          CategoryFloat,
+	PPC_INS_FSQRT:         CategoryFloat,
+	PPC_INS_FSQRTS:        CategoryFloat,
+	PPC_INS_FSUB:          CategoryFloat,
+	PPC_INS_FSUBS:         CategoryFloat,
+	PPC_INS_ICBI:          CategorySystem,
+	PPC_INS_ICCCI:         CategorySystem,
+	PPC_INS_ISEL:          CategoryMove,
+	PPC_INS_ISYNC:         CategorySystem,
+	PPC_INS_LA:            CategoryArithmetic,
+	PPC_INS_LBZ:           CategoryLoad,
+	PPC_INS_LBZU:          CategoryLoad,
+	PPC_INS_LBZUX:         CategoryLoad,
+	PPC_INS_LBZX:          CategoryLoad,
+	PPC_INS_LD:            CategoryLoad,
+	PPC_INS_LDARX:         CategoryAtomic,
+	PPC_INS_LDBRX:         CategoryLoad,
+	PPC_INS_LDU:           CategoryLoad,
+	PPC_INS_LDUX:          CategoryLoad,
+	PPC_INS_LDX:           CategoryLoad,
+	PPC_INS_LFD:           CategoryLoad,
+	PPC_INS_LFDU:          CategoryLoad,
+	PPC_INS_LFDUX:         CategoryLoad,
+	PPC_INS_LFDX:          CategoryLoad,
+	PPC_INS_LFIWAX:        CategoryLoad,
+	PPC_INS_LFIWZX:        CategoryLoad,
+	PPC_INS_LFS:           CategoryLoad,
+	PPC_INS_LFSU:          CategoryLoad,
+	PPC_INS_LFSUX:         CategoryLoad,
+	PPC_INS_LFSX:          CategoryLoad,
+	PPC_INS_LHA:           CategoryLoad,
+	PPC_INS_LHAU:          CategoryLoad,
+	PPC_INS_LHAUX:         CategoryLoad,
+	PPC_INS_LHAX:          CategoryLoad,
+	PPC_INS_LHBRX:         CategoryLoad,
+	PPC_INS_LHZ:           CategoryLoad,
+	PPC_INS_LHZU:          CategoryLoad,
+	PPC_INS_LHZUX:         CategoryLoad,
+	PPC_INS_LHZX:          CategoryLoad,
+	PPC_INS_LI:            CategoryMove,
+	PPC_INS_LIS:           CategoryMove,
+	PPC_INS_LMW:           CategoryLoad,
+	PPC_INS_LSWI:          CategoryLoad,
+	PPC_INS_LVEBX:         CategoryVector,
+	PPC_INS_LVEHX:         CategoryVector,
+	PPC_INS_LVEWX:         CategoryVector,
+	PPC_INS_LVSL:          CategoryVector,
+	PPC_INS_LVSR:          CategoryVector,
+	PPC_INS_LVX:           CategoryVector,
+	PPC_INS_LVXL:          CategoryVector,
+	PPC_INS_LWA:           CategoryLoad,
+	PPC_INS_LWARX:         CategoryAtomic,
+	PPC_INS_LWAUX:         CategoryLoad,
+	PPC_INS_LWAX:          CategoryLoad,
+	PPC_INS_LWBRX:         CategoryLoad,
+	PPC_INS_LWZ:           CategoryLoad,
+	PPC_INS_LWZU:          CategoryLoad,
+	PPC_INS_LWZUX:         CategoryLoad,
+	PPC_INS_LWZX:          CategoryLoad,
+	PPC_INS_LXSDX:         CategoryLoad,
+	PPC_INS_LXVD2X:        CategoryVector,
+	PPC_INS_LXVDSX:        CategoryVector,
+	PPC_INS_LXVW4X:        CategoryVector,
+	PPC_INS_MBAR:          CategoryAtomic,
+	PPC_INS_MCRF:          CategoryMove,
+	PPC_INS_MFCR:          CategoryMove,
+	PPC_INS_MFCTR:         CategoryMove,
+	PPC_INS_MFDCR:         CategorySystem,
+	PPC_INS_MFFS:          CategoryFloat,
+	PPC_INS_MFLR:          CategoryMove,
+	PPC_INS_MFMSR:         CategorySystem,
+	PPC_INS_MFOCRF:        CategoryMove,
+	PPC_INS_MFSPR:         CategorySystem,
+	PPC_INS_MFSR:          CategorySystem,
+	PPC_INS_MFSRIN:        CategorySystem,
+	PPC_INS_MFTB:          CategorySystem,
+	PPC_INS_MFVSCR:        CategoryVector,
+	PPC_INS_MSYNC:         CategoryAtomic,
+	PPC_INS_MTCRF:         CategoryMove,
+	PPC_INS_MTCTR:         CategoryMove,
+	PPC_INS_MTDCR:         CategorySystem,
+	PPC_INS_MTFSB0:        CategoryFloat,
+	PPC_INS_MTFSB1:        CategoryFloat,
+	PPC_INS_MTFSF:         CategoryFloat,
+	PPC_INS_MTLR:          CategoryMove,
+	PPC_INS_MTMSR:         CategorySystem,
+	PPC_INS_MTMSRD:        CategorySystem,
+	PPC_INS_MTOCRF:        CategoryMove,
+	PPC_INS_MTSPR:         CategorySystem,
+	PPC_INS_MTSR:          CategorySystem,
+	PPC_INS_MTSRIN:        CategorySystem,
+	PPC_INS_MTVSCR:        CategoryVector,
+	PPC_INS_MULHD:         CategoryArithmetic,
+	PPC_INS_MULHDU:        CategoryArithmetic,
+	PPC_INS_MULHW:         CategoryArithmetic,
+	PPC_INS_MULHWU:        CategoryArithmetic,
+	PPC_INS_MULLD:         CategoryArithmetic,
+	PPC_INS_MULLI:         CategoryArithmetic,
+	PPC_INS_MULLW:         CategoryArithmetic,
+	PPC_INS_NAND:          CategoryLogic,
+	PPC_INS_NEG:           CategoryArithmetic,
+	PPC_INS_NOP:           CategoryNop,
+	PPC_INS_ORI:           CategoryLogic,
+	PPC_INS_NOR:           CategoryLogic,
+	PPC_INS_OR:            CategoryLogic,
+	PPC_INS_ORC:           CategoryLogic,
+	PPC_INS_ORIS:          CategoryLogic,
+	PPC_INS_POPCNTD:       CategoryLogic,
+	PPC_INS_POPCNTW:       CategoryLogic,
+	PPC_INS_RFCI:          CategoryReturn,
+	PPC_INS_RFDI:          CategoryReturn,
+	PPC_INS_RFI:           CategoryReturn,
+	PPC_INS_RFID:          CategoryReturn,
+	PPC_INS_RFMCI:         CategoryReturn,
+	PPC_INS_RLDCL:         CategoryShift,
+	PPC_INS_RLDCR:         CategoryShift,
+	PPC_INS_RLDIC:         CategoryShift,
+	PPC_INS_RLDICL:        CategoryShift,
+	PPC_INS_RLDICR:        CategoryShift,
+	PPC_INS_RLDIMI:        CategoryShift,
+	PPC_INS_RLWIMI:        CategoryShift,
+	PPC_INS_RLWINM:        CategoryShift,
+	PPC_INS_RLWNM:         CategoryShift,
+	PPC_INS_SC:            CategorySystem,
+	PPC_INS_SLBIA:         CategorySystem,
+	PPC_INS_SLBIE:         CategorySystem,
+	PPC_INS_SLBMFEE:       CategorySystem,
+	PPC_INS_SLBMTE:        CategorySystem,
+	PPC_INS_SLD:           CategoryShift,
+	PPC_INS_SLW:           CategoryShift,
+	PPC_INS_SRAD:          CategoryShift,
+	PPC_INS_SRADI:         CategoryShift,
+	PPC_INS_SRAW:          CategoryShift,
+	PPC_INS_SRAWI:         CategoryShift,
+	PPC_INS_SRD:           CategoryShift,
+	PPC_INS_SRW:           CategoryShift,
+	PPC_INS_STB:           CategoryStore,
+	PPC_INS_STBU:          CategoryStore,
+	PPC_INS_STBUX:         CategoryStore,
+	PPC_INS_STBX:          CategoryStore,
+	PPC_INS_STD:           CategoryStore,
+	PPC_INS_STDBRX:        CategoryStore,
+	PPC_INS_STDCX:         CategoryAtomic,
+	PPC_INS_STDU:          CategoryStore,
+	PPC_INS_STDUX:         CategoryStore,
+	PPC_INS_STDX:          CategoryStore,
+	PPC_INS_STFD:          CategoryStore,
+	PPC_INS_STFDU:         CategoryStore,
+	PPC_INS_STFDUX:        CategoryStore,
+	PPC_INS_STFDX:         CategoryStore,
+	PPC_INS_STFIWX:        CategoryStore,
+	PPC_INS_STFS:          CategoryStore,
+	PPC_INS_STFSU:         CategoryStore,
+	PPC_INS_STFSUX:        CategoryStore,
+	PPC_INS_STFSX:         CategoryStore,
+	PPC_INS_STH:           CategoryStore,
+	PPC_INS_STHBRX:        CategoryStore,
+	PPC_INS_STHU:          CategoryStore,
+	PPC_INS_STHUX:         CategoryStore,
+	PPC_INS_STHX:          CategoryStore,
+	PPC_INS_STMW:          CategoryStore,
+	PPC_INS_STSWI:         CategoryStore,
+	PPC_INS_STVEBX:        CategoryVector,
+	PPC_INS_STVEHX:        CategoryVector,
+	PPC_INS_STVEWX:        CategoryVector,
+	PPC_INS_STVX:          CategoryVector,
+	PPC_INS_STVXL:         CategoryVector,
+	PPC_INS_STW:           CategoryStore,
+	PPC_INS_STWBRX:        CategoryStore,
+	PPC_INS_STWCX:         CategoryAtomic,
+	PPC_INS_STWU:          CategoryStore,
+	PPC_INS_STWUX:         CategoryStore,
+	PPC_INS_STWX:          CategoryStore,
+	PPC_INS_STXSDX:        CategoryStore,
+	PPC_INS_STXVD2X:       CategoryVector,
+	PPC_INS_STXVW4X:       CategoryVector,
+	PPC_INS_SUBF:          CategoryArithmetic,
+	PPC_INS_SUBFC:         CategoryArithmetic,
+	PPC_INS_SUBFE:         CategoryArithmetic,
+	PPC_INS_SUBFIC:        CategoryArithmetic,
+	PPC_INS_SUBFME:        CategoryArithmetic,
+	PPC_INS_SUBFZE:        CategoryArithmetic,
+	PPC_INS_SYNC:          CategoryAtomic,
+	PPC_INS_TD:            CategorySystem,
+	PPC_INS_TDI:           CategorySystem,
+	PPC_INS_TLBIA:         CategorySystem,
+	PPC_INS_TLBIE:         CategorySystem,
+	PPC_INS_TLBIEL:        CategorySystem,
+	PPC_INS_TLBIVAX:       CategorySystem,
+	PPC_INS_TLBLD:         CategorySystem,
+	PPC_INS_TLBLI:         CategorySystem,
+	PPC_INS_TLBRE:         CategorySystem,
+	PPC_INS_TLBSX:         CategorySystem,
+	PPC_INS_TLBSYNC:       CategorySystem,
+	PPC_INS_TLBWE:         CategorySystem,
+	PPC_INS_TRAP:          CategorySystem,
+	PPC_INS_TW:            CategorySystem,
+	PPC_INS_TWI:           CategorySystem,
+	PPC_INS_VADDCUW:       CategoryVector,
+	PPC_INS_VADDFP:        CategoryVector,
+	PPC_INS_VADDSBS:       CategoryVector,
+	PPC_INS_VADDSHS:       CategoryVector,
+	PPC_INS_VADDSWS:       CategoryVector,
+	PPC_INS_VADDUBM:       CategoryVector,
+	PPC_INS_VADDUBS:       CategoryVector,
+	PPC_INS_VADDUHM:       CategoryVector,
+	PPC_INS_VADDUHS:       CategoryVector,
+	PPC_INS_VADDUWM:       CategoryVector,
+	PPC_INS_VADDUWS:       CategoryVector,
+	PPC_INS_VAND:          CategoryVector,
+	PPC_INS_VANDC:         CategoryVector,
+	PPC_INS_VAVGSB:        CategoryVector,
+	PPC_INS_VAVGSH:        CategoryVector,
+	PPC_INS_VAVGSW:        CategoryVector,
+	PPC_INS_VAVGUB:        CategoryVector,
+	PPC_INS_VAVGUH:        CategoryVector,
+	PPC_INS_VAVGUW:        CategoryVector,
+	PPC_INS_VCFSX:         CategoryVector,
+	PPC_INS_VCFUX:         CategoryVector,
+	PPC_INS_VCMPBFP:       CategoryVector,
+	PPC_INS_VCMPEQFP:      CategoryVector,
+	PPC_INS_VCMPEQUB:      CategoryVector,
+	PPC_INS_VCMPEQUH:      CategoryVector,
+	PPC_INS_VCMPEQUW:      CategoryVector,
+	PPC_INS_VCMPGEFP:      CategoryVector,
+	PPC_INS_VCMPGTFP:      CategoryVector,
+	PPC_INS_VCMPGTSB:      CategoryVector,
+	PPC_INS_VCMPGTSH:      CategoryVector,
+	PPC_INS_VCMPGTSW:      CategoryVector,
+	PPC_INS_VCMPGTUB:      CategoryVector,
+	PPC_INS_VCMPGTUH:      CategoryVector,
+	PPC_INS_VCMPGTUW:      CategoryVector,
+	PPC_INS_VCTSXS:        CategoryVector,
+	PPC_INS_VCTUXS:        CategoryVector,
+	PPC_INS_VEXPTEFP:      CategoryVector,
+	PPC_INS_VLOGEFP:       CategoryVector,
+	PPC_INS_VMADDFP:       CategoryVector,
+	PPC_INS_VMAXFP:        CategoryVector,
+	PPC_INS_VMAXSB:        CategoryVector,
+	PPC_INS_VMAXSH:        CategoryVector,
+	PPC_INS_VMAXSW:        CategoryVector,
+	PPC_INS_VMAXUB:        CategoryVector,
+	PPC_INS_VMAXUH:        CategoryVector,
+	PPC_INS_VMAXUW:        CategoryVector,
+	PPC_INS_VMHADDSHS:     CategoryVector,
+	PPC_INS_VMHRADDSHS:    CategoryVector,
+	PPC_INS_VMINFP:        CategoryVector,
+	PPC_INS_VMINSB:        CategoryVector,
+	PPC_INS_VMINSH:        CategoryVector,
+	PPC_INS_VMINSW:        CategoryVector,
+	PPC_INS_VMINUB:        CategoryVector,
+	PPC_INS_VMINUH:        CategoryVector,
+	PPC_INS_VMINUW:        CategoryVector,
+	PPC_INS_VMLADDUHM:     CategoryVector,
+	PPC_INS_VMRGHB:        CategoryVector,
+	PPC_INS_VMRGHH:        CategoryVector,
+	PPC_INS_VMRGHW:        CategoryVector,
+	PPC_INS_VMRGLB:        CategoryVector,
+	PPC_INS_VMRGLH:        CategoryVector,
+	PPC_INS_VMRGLW:        CategoryVector,
+	PPC_INS_VMSUMMBM:      CategoryVector,
+	PPC_INS_VMSUMSHM:      CategoryVector,
+	PPC_INS_VMSUMSHS:      CategoryVector,
+	PPC_INS_VMSUMUBM:      CategoryVector,
+	PPC_INS_VMSUMUHM:      CategoryVector,
+	PPC_INS_VMSUMUHS:      CategoryVector,
+	PPC_INS_VMULESB:       CategoryVector,
+	PPC_INS_VMULESH:       CategoryVector,
+	PPC_INS_VMULEUB:       CategoryVector,
+	PPC_INS_VMULEUH:       CategoryVector,
+	PPC_INS_VMULOSB:       CategoryVector,
+	PPC_INS_VMULOSH:       CategoryVector,
+	PPC_INS_VMULOUB:       CategoryVector,
+	PPC_INS_VMULOUH:       CategoryVector,
+	PPC_INS_VNMSUBFP:      CategoryVector,
+	PPC_INS_VNOR:          CategoryVector,
+	PPC_INS_VOR:           CategoryVector,
+	PPC_INS_VPERM:         CategoryVector,
+	PPC_INS_VPKPX:         CategoryVector,
+	PPC_INS_VPKSHSS:       CategoryVector,
+	PPC_INS_VPKSHUS:       CategoryVector,
+	PPC_INS_VPKSWSS:       CategoryVector,
+	PPC_INS_VPKSWUS:       CategoryVector,
+	PPC_INS_VPKUHUM:       CategoryVector,
+	PPC_INS_VPKUHUS:       CategoryVector,
+	PPC_INS_VPKUWUM:       CategoryVector,
+	PPC_INS_VPKUWUS:       CategoryVector,
+	PPC_INS_VREFP:         CategoryVector,
+	PPC_INS_VRFIM:         CategoryVector,
+	PPC_INS_VRFIN:         CategoryVector,
+	PPC_INS_VRFIP:         CategoryVector,
+	PPC_INS_VRFIZ:         CategoryVector,
+	PPC_INS_VRLB:          CategoryVector,
+	PPC_INS_VRLH:          CategoryVector,
+	PPC_INS_VRLW:          CategoryVector,
+	PPC_INS_VRSQRTEFP:     CategoryVector,
+	PPC_INS_VSEL:          CategoryVector,
+	PPC_INS_VSL:           CategoryVector,
+	PPC_INS_VSLB:          CategoryVector,
+	PPC_INS_VSLDOI:        CategoryVector,
+	PPC_INS_VSLH:          CategoryVector,
+	PPC_INS_VSLO:          CategoryVector,
+	PPC_INS_VSLW:          CategoryVector,
+	PPC_INS_VSPLTB:        CategoryVector,
+	PPC_INS_VSPLTH:        CategoryVector,
+	PPC_INS_VSPLTISB:      CategoryVector,
+	PPC_INS_VSPLTISH:      CategoryVector,
+	PPC_INS_VSPLTISW:      CategoryVector,
+	PPC_INS_VSPLTW:        CategoryVector,
+	PPC_INS_VSR:           CategoryVector,
+	PPC_INS_VSRAB:         CategoryVector,
+	PPC_INS_VSRAH:         CategoryVector,
+	PPC_INS_VSRAW:         CategoryVector,
+	PPC_INS_VSRB:          CategoryVector,
+	PPC_INS_VSRH:          CategoryVector,
+	PPC_INS_VSRO:          CategoryVector,
+	PPC_INS_VSRW:          CategoryVector,
+	PPC_INS_VSUBCUW:       CategoryVector,
+	PPC_INS_VSUBFP:        CategoryVector,
+	PPC_INS_VSUBSBS:       CategoryVector,
+	PPC_INS_VSUBSHS:       CategoryVector,
+	PPC_INS_VSUBSWS:       CategoryVector,
+	PPC_INS_VSUBUBM:       CategoryVector,
+	PPC_INS_VSUBUBS:       CategoryVector,
+	PPC_INS_VSUBUHM:       CategoryVector,
+	PPC_INS_VSUBUHS:       CategoryVector,
+	PPC_INS_VSUBUWM:       CategoryVector,
+	PPC_INS_VSUBUWS:       CategoryVector,
+	PPC_INS_VSUM2SWS:      CategoryVector,
+	PPC_INS_VSUM4SBS:      CategoryVector,
+	PPC_INS_VSUM4SHS:      CategoryVector,
+	PPC_INS_VSUM4UBS:      CategoryVector,
+	PPC_INS_VSUMSWS:       CategoryVector,
+	PPC_INS_VUPKHPX:       CategoryVector,
+	PPC_INS_VUPKHSB:       CategoryVector,
+	PPC_INS_VUPKHSH:       CategoryVector,
+	PPC_INS_VUPKLPX:       CategoryVector,
+	PPC_INS_VUPKLSB:       CategoryVector,
+	PPC_INS_VUPKLSH:       CategoryVector,
+	PPC_INS_VXOR:          CategoryVector,
+	PPC_INS_WAIT:          CategorySystem,
+	PPC_INS_WRTEE:         CategorySystem,
+	PPC_INS_WRTEEI:        CategorySystem,
+	PPC_INS_XOR:           CategoryLogic,
+	PPC_INS_XORI:          CategoryLogic,
+	PPC_INS_XORIS:         CategoryLogic,
+	PPC_INS_XSABSDP:       CategoryFloat,
+	PPC_INS_XSADDDP:       CategoryFloat,
+	PPC_INS_XSCMPODP:      CategoryFloat,
+	PPC_INS_XSCMPUDP:      CategoryFloat,
+	PPC_INS_XSCPSGNDP:     CategoryFloat,
+	PPC_INS_XSCVDPSP:      CategoryFloat,
+	PPC_INS_XSCVDPSXDS:    CategoryFloat,
+	PPC_INS_XSCVDPSXWS:    CategoryFloat,
+	PPC_INS_XSCVDPUXDS:    CategoryFloat,
+	PPC_INS_XSCVDPUXWS:    CategoryFloat,
+	PPC_INS_XSCVSPDP:      CategoryFloat,
+	PPC_INS_XSCVSXDDP:     CategoryFloat,
+	PPC_INS_XSCVUXDDP:     CategoryFloat,
+	PPC_INS_XSDIVDP:       CategoryFloat,
+	PPC_INS_XSMADDADP:     CategoryFloat,
+	PPC_INS_XSMADDMDP:     CategoryFloat,
+	PPC_INS_XSMAXDP:       CategoryFloat,
+	PPC_INS_XSMINDP:       CategoryFloat,
+	PPC_INS_XSMSUBADP:     CategoryFloat,
+	PPC_INS_XSMSUBMDP:     CategoryFloat,
+	PPC_INS_XSMULDP:       CategoryFloat,
+	PPC_INS_XSNABSDP:      CategoryFloat,
+	PPC_INS_XSNEGDP:       CategoryFloat,
+	PPC_INS_XSNMADDADP:    CategoryFloat,
+	PPC_INS_XSNMADDMDP:    CategoryFloat,
+	PPC_INS_XSNMSUBADP:    CategoryFloat,
+	PPC_INS_XSNMSUBMDP:    CategoryFloat,
+	PPC_INS_XSRDPI:        CategoryFloat,
+	PPC_INS_XSRDPIC:       CategoryFloat,
+	PPC_INS_XSRDPIM:       CategoryFloat,
+	PPC_INS_XSRDPIP:       CategoryFloat,
+	PPC_INS_XSRDPIZ:       CategoryFloat,
+	PPC_INS_XSREDP:        CategoryFloat,
+	PPC_INS_XSRSQRTEDP:    CategoryFloat,
+	PPC_INS_XSSQRTDP:      CategoryFloat,
+	PPC_INS_XSSUBDP:       CategoryFloat,
+	PPC_INS_XSTDIVDP:      CategoryFloat,
+	PPC_INS_XSTSQRTDP:     CategoryFloat,
+	PPC_INS_XVABSDP:       CategoryVector,
+	PPC_INS_XVABSSP:       CategoryVector,
+	PPC_INS_XVADDDP:       CategoryVector,
+	PPC_INS_XVADDSP:       CategoryVector,
+	PPC_INS_XVCMPEQDP:     CategoryVector,
+	PPC_INS_XVCMPEQSP:     CategoryVector,
+	PPC_INS_XVCMPGEDP:     CategoryVector,
+	PPC_INS_XVCMPGESP:     CategoryVector,
+	PPC_INS_XVCMPGTDP:     CategoryVector,
+	PPC_INS_XVCMPGTSP:     CategoryVector,
+	PPC_INS_XVCPSGNDP:     CategoryVector,
+	PPC_INS_XVCPSGNSP:     CategoryVector,
+	PPC_INS_XVCVDPSP:      CategoryVector,
+	PPC_INS_XVCVDPSXDS:    CategoryVector,
+	PPC_INS_XVCVDPSXWS:    CategoryVector,
+	PPC_INS_XVCVDPUXDS:    CategoryVector,
+	PPC_INS_XVCVDPUXWS:    CategoryVector,
+	PPC_INS_XVCVSPDP:      CategoryVector,
+	PPC_INS_XVCVSPSXDS:    CategoryVector,
+	PPC_INS_XVCVSPSXWS:    CategoryVector,
+	PPC_INS_XVCVSPUXDS:    CategoryVector,
+	PPC_INS_XVCVSPUXWS:    CategoryVector,
+	PPC_INS_XVCVSXDDP:     CategoryVector,
+	PPC_INS_XVCVSXDSP:     CategoryVector,
+	PPC_INS_XVCVSXWDP:     CategoryVector,
+	PPC_INS_XVCVSXWSP:     CategoryVector,
+	PPC_INS_XVCVUXDDP:     CategoryVector,
+	PPC_INS_XVCVUXDSP:     CategoryVector,
+	PPC_INS_XVCVUXWDP:     CategoryVector,
+	PPC_INS_XVCVUXWSP:     CategoryVector,
+	PPC_INS_XVDIVDP:       CategoryVector,
+	PPC_INS_XVDIVSP:       CategoryVector,
+	PPC_INS_XVMADDADP:     CategoryVector,
+	PPC_INS_XVMADDASP:     CategoryVector,
+	PPC_INS_XVMADDMDP:     CategoryVector,
+	PPC_INS_XVMADDMSP:     CategoryVector,
+	PPC_INS_XVMAXDP:       CategoryVector,
+	PPC_INS_XVMAXSP:       CategoryVector,
+	PPC_INS_XVMINDP:       CategoryVector,
+	PPC_INS_XVMINSP:       CategoryVector,
+	PPC_INS_XVMSUBADP:     CategoryVector,
+	PPC_INS_XVMSUBASP:     CategoryVector,
+	PPC_INS_XVMSUBMDP:     CategoryVector,
+	PPC_INS_XVMSUBMSP:     CategoryVector,
+	PPC_INS_XVMULDP:       CategoryVector,
+	PPC_INS_XVMULSP:       CategoryVector,
+	PPC_INS_XVNABSDP:      CategoryVector,
+	PPC_INS_XVNABSSP:      CategoryVector,
+	PPC_INS_XVNEGDP:       CategoryVector,
+	PPC_INS_XVNEGSP:       CategoryVector,
+	PPC_INS_XVNMADDADP:    CategoryVector,
+	PPC_INS_XVNMADDASP:    CategoryVector,
+	PPC_INS_XVNMADDMDP:    CategoryVector,
+	PPC_INS_XVNMADDMSP:    CategoryVector,
+	PPC_INS_XVNMSUBADP:    CategoryVector,
+	PPC_INS_XVNMSUBASP:    CategoryVector,
+	PPC_INS_XVNMSUBMDP:    CategoryVector,
+	PPC_INS_XVNMSUBMSP:    CategoryVector,
+	PPC_INS_XVRDPI:        CategoryVector,
+	PPC_INS_XVRDPIC:       CategoryVector,
+	PPC_INS_XVRDPIM:       CategoryVector,
+	PPC_INS_XVRDPIP:       CategoryVector,
+	PPC_INS_XVRDPIZ:       CategoryVector,
+	PPC_INS_XVREDP:        CategoryVector,
+	PPC_INS_XVRESP:        CategoryVector,
+	PPC_INS_XVRSPI:        CategoryVector,
+	PPC_INS_XVRSPIC:       CategoryVector,
+	PPC_INS_XVRSPIM:       CategoryVector,
+	PPC_INS_XVRSPIP:       CategoryVector,
+	PPC_INS_XVRSPIZ:       CategoryVector,
+	PPC_INS_XVRSQRTEDP:    CategoryVector,
+	PPC_INS_XVRSQRTESP:    CategoryVector,
+	PPC_INS_XVSQRTDP:      CategoryVector,
+	PPC_INS_XVSQRTSP:      CategoryVector,
+	PPC_INS_XVSUBDP:       CategoryVector,
+	PPC_INS_XVSUBSP:       CategoryVector,
+	PPC_INS_XVTDIVDP:      CategoryVector,
+	PPC_INS_XVTDIVSP:      CategoryVector,
+	PPC_INS_XVTSQRTDP:     CategoryVector,
+	PPC_INS_XVTSQRTSP:     CategoryVector,
+	PPC_INS_XXLAND:        CategoryVector,
+	PPC_INS_XXLANDC:       CategoryVector,
+	PPC_INS_XXLNOR:        CategoryVector,
+	PPC_INS_XXLOR:         CategoryVector,
+	PPC_INS_XXLXOR:        CategoryVector,
+	PPC_INS_XXMRGHW:       CategoryVector,
+	PPC_INS_XXMRGLW:       CategoryVector,
+	PPC_INS_XXPERMDI:      CategoryVector,
+	PPC_INS_XXSEL:         CategoryVector,
+	PPC_INS_XXSLDWI:       CategoryVector,
+	PPC_INS_XXSPLTW:       CategoryVector,
+	PPC_INS_BCA:           CategoryBranch,
+	PPC_INS_BCLA:          CategoryCall,
+	PPC_INS_SLWI:          CategoryShift,
+	PPC_INS_SRWI:          CategoryShift,
+	PPC_INS_SLDI:          CategoryShift,
+	PPC_INS_BTA:           CategoryBranch,
+	PPC_INS_CRSET:         CategoryLogic,
+	PPC_INS_CRNOT:         CategoryLogic,
+	PPC_INS_CRMOVE:        CategoryLogic,
+	PPC_INS_CRCLR:         CategoryLogic,
+	PPC_INS_MFBR0:         CategorySystem,
+	PPC_INS_MFBR1:         CategorySystem,
+	PPC_INS_MFBR2:         CategorySystem,
+	PPC_INS_MFBR3:         CategorySystem,
+	PPC_INS_MFBR4:         CategorySystem,
+	PPC_INS_MFBR5:         CategorySystem,
+	PPC_INS_MFBR6:         CategorySystem,
+	PPC_INS_MFBR7:         CategorySystem,
+	PPC_INS_MFXER:         CategoryMove,
+	PPC_INS_MFRTCU:        CategorySystem,
+	PPC_INS_MFRTCL:        CategorySystem,
+	PPC_INS_MFDSCR:        CategorySystem,
+	PPC_INS_MFDSISR:       CategorySystem,
+	PPC_INS_MFDAR:         CategorySystem,
+	PPC_INS_MFSRR2:        CategorySystem,
+	PPC_INS_MFSRR3:        CategorySystem,
+	PPC_INS_MFCFAR:        CategorySystem,
+	PPC_INS_MFAMR:         CategorySystem,
+	PPC_INS_MFPID:         CategorySystem,
+	PPC_INS_MFTBLO:        CategorySystem,
+	PPC_INS_MFTBHI:        CategorySystem,
+	PPC_INS_MFDBATU:       CategorySystem,
+	PPC_INS_MFDBATL:       CategorySystem,
+	PPC_INS_MFIBATU:       CategorySystem,
+	PPC_INS_MFIBATL:       CategorySystem,
+	PPC_INS_MFDCCR:        CategorySystem,
+	PPC_INS_MFICCR:        CategorySystem,
+	PPC_INS_MFDEAR:        CategorySystem,
+	PPC_INS_MFESR:         CategorySystem,
+	PPC_INS_MFSPEFSCR:     CategorySystem,
+	PPC_INS_MFTCR:         CategorySystem,
+	PPC_INS_MFASR:         CategorySystem,
+	PPC_INS_MFPVR:         CategorySystem,
+	PPC_INS_MFTBU:         CategorySystem,
+	PPC_INS_MTCR:          CategoryMove,
+	PPC_INS_MTBR0:         CategorySystem,
+	PPC_INS_MTBR1:         CategorySystem,
+	PPC_INS_MTBR2:         CategorySystem,
+	PPC_INS_MTBR3:         CategorySystem,
+	PPC_INS_MTBR4:         CategorySystem,
+	PPC_INS_MTBR5:         CategorySystem,
+	PPC_INS_MTBR6:         CategorySystem,
+	PPC_INS_MTBR7:         CategorySystem,
+	PPC_INS_MTXER:         CategoryMove,
+	PPC_INS_MTDSCR:        CategorySystem,
+	PPC_INS_MTDSISR:       CategorySystem,
+	PPC_INS_MTDAR:         CategorySystem,
+	PPC_INS_MTSRR2:        CategorySystem,
+	PPC_INS_MTSRR3:        CategorySystem,
+	PPC_INS_MTCFAR:        CategorySystem,
+	PPC_INS_MTAMR:         CategorySystem,
+	PPC_INS_MTPID:         CategorySystem,
+	PPC_INS_MTTBL:         CategorySystem,
+	PPC_INS_MTTBU:         CategorySystem,
+	PPC_INS_MTTBLO:        CategorySystem,
+	PPC_INS_MTTBHI:        CategorySystem,
+	PPC_INS_MTDBATU:       CategorySystem,
+	PPC_INS_MTDBATL:       CategorySystem,
+	PPC_INS_MTIBATU:       CategorySystem,
+	PPC_INS_MTIBATL:       CategorySystem,
+	PPC_INS_MTDCCR:        CategorySystem,
+	PPC_INS_MTICCR:        CategorySystem,
+	PPC_INS_MTDEAR:        CategorySystem,
+	PPC_INS_MTESR:         CategorySystem,
+	PPC_INS_MTSPEFSCR:     CategorySystem,
+	PPC_INS_MTTCR:         CategorySystem,
+	PPC_INS_NOT:           CategoryLogic,
+	PPC_INS_MR:            CategoryMove,
+	PPC_INS_ROTLD:         CategoryShift,
+	PPC_INS_ROTLDI:        CategoryShift,
+	PPC_INS_CLRLDI:        CategoryShift,
+	PPC_INS_ROTLWI:        CategoryShift,
+	PPC_INS_CLRLWI:        CategoryShift,
+	PPC_INS_ROTLW:         CategoryShift,
+	PPC_INS_SUB:           CategoryArithmetic,
+	PPC_INS_SUBC:          CategoryArithmetic,
+	PPC_INS_LWSYNC:        CategoryAtomic,
+	PPC_INS_PTESYNC:       CategoryAtomic,
+	PPC_INS_TDLT:          CategorySystem,
+	PPC_INS_TDEQ:          CategorySystem,
+	PPC_INS_TDGT:          CategorySystem,
+	PPC_INS_TDNE:          CategorySystem,
+	PPC_INS_TDLLT:         CategorySystem,
+	PPC_INS_TDLGT:         CategorySystem,
+	PPC_INS_TDU:           CategorySystem,
+	PPC_INS_TDLTI:         CategorySystem,
+	PPC_INS_TDEQI:         CategorySystem,
+	PPC_INS_TDGTI:         CategorySystem,
+	PPC_INS_TDNEI:         CategorySystem,
+	PPC_INS_TDLLTI:        CategorySystem,
+	PPC_INS_TDLGTI:        CategorySystem,
+	PPC_INS_TDUI:          CategorySystem,
+	PPC_INS_TLBREHI:       CategorySystem,
+	PPC_INS_TLBRELO:       CategorySystem,
+	PPC_INS_TLBWEHI:       CategorySystem,
+	PPC_INS_TLBWELO:       CategorySystem,
+	PPC_INS_TWLT:          CategorySystem,
+	PPC_INS_TWEQ:          CategorySystem,
+	PPC_INS_TWGT:          CategorySystem,
+	PPC_INS_TWNE:          CategorySystem,
+	PPC_INS_TWLLT:         CategorySystem,
+	PPC_INS_TWLGT:         CategorySystem,
+	PPC_INS_TWU:           CategorySystem,
+	PPC_INS_TWLTI:         CategorySystem,
+	PPC_INS_TWEQI:         CategorySystem,
+	PPC_INS_TWGTI:         CategorySystem,
+	PPC_INS_TWNEI:         CategorySystem,
+	PPC_INS_TWLLTI:        CategorySystem,
+	PPC_INS_TWLGTI:        CategorySystem,
+	PPC_INS_TWUI:          CategorySystem,
+	PPC_INS_WAITRSV:       CategorySystem,
+	PPC_INS_WAITIMPL:      CategorySystem,
+	PPC_INS_XNOP:          CategoryNop,
+	PPC_INS_XVMOVDP:       CategoryVector,
+	PPC_INS_XVMOVSP:       CategoryVector,
+	PPC_INS_XXSPLTD:       CategoryVector,
+	PPC_INS_XXMRGHD:       CategoryVector,
+	PPC_INS_XXMRGLD:       CategoryVector,
+	PPC_INS_XXSWAPD:       CategoryVector,
+	PPC_INS_BT:            CategoryBranch,
+	PPC_INS_BF:            CategoryBranch,
+	PPC_INS_BDNZT:         CategoryBranch,
+	PPC_INS_BDNZF:         CategoryBranch,
+	PPC_INS_BDZF:          CategoryBranch,
+	PPC_INS_BDZT:          CategoryBranch,
+	PPC_INS_BFA:           CategoryBranch,
+	PPC_INS_BDNZTA:        CategoryBranch,
+	PPC_INS_BDNZFA:        CategoryBranch,
+	PPC_INS_BDZTA:         CategoryBranch,
+	PPC_INS_BDZFA:         CategoryBranch,
+	PPC_INS_BTCTR:         CategoryBranch,
+	PPC_INS_BFCTR:         CategoryBranch,
+	PPC_INS_BTCTRL:        CategoryCall,
+	PPC_INS_BFCTRL:        CategoryCall,
+	PPC_INS_BTL:           CategoryCall,
+	PPC_INS_BFL:           CategoryCall,
+	PPC_INS_BDNZTL:        CategoryCall,
+	PPC_INS_BDNZFL:        CategoryCall,
+	PPC_INS_BDZTL:         CategoryCall,
+	PPC_INS_BDZFL:         CategoryCall,
+	PPC_INS_BTLA:          CategoryCall,
+	PPC_INS_BFLA:          CategoryCall,
+	PPC_INS_BDNZTLA:       CategoryCall,
+	PPC_INS_BDNZFLA:       CategoryCall,
+	PPC_INS_BDZTLA:        CategoryCall,
+	PPC_INS_BDZFLA:        CategoryCall,
+	PPC_INS_BTLR:          CategoryReturn,
+	PPC_INS_BFLR:          CategoryReturn,
+	PPC_INS_BDNZTLR:       CategoryReturn,
+	PPC_INS_BDZTLR:        CategoryReturn,
+	PPC_INS_BDZFLR:        CategoryReturn,
+	PPC_INS_BTLRL:         CategoryCall,
+	PPC_INS_BFLRL:         CategoryCall,
+	PPC_INS_BDNZTLRL:      CategoryCall,
+	PPC_INS_BDNZFLRL:      CategoryCall,
+	PPC_INS_BDZTLRL:       CategoryCall,
+	PPC_INS_BDZFLRL:       CategoryCall,
+}
+
+var sparcCategories = map[uint]Category{
+	SPARC_INS_ADDCC:       CategoryArithmetic,
+	SPARC_INS_ADDX:        CategoryArithmetic,
+	SPARC_INS_ADDXCC:      CategoryArithmetic,
+	SPARC_INS_ADDXC:       CategoryArithmetic,
+	SPARC_INS_ADDXCCC:     CategoryArithmetic,
+	SPARC_INS_ADD:         CategoryArithmetic,
+	SPARC_INS_ALIGNADDR:   CategoryVector,
+	SPARC_INS_ALIGNADDRL:  CategoryVector,
+	SPARC_INS_ANDCC:       CategoryLogic,
+	SPARC_INS_ANDNCC:      CategoryLogic,
+	SPARC_INS_ANDN:        CategoryLogic,
+	SPARC_INS_AND:         CategoryLogic,
+	SPARC_INS_ARRAY16:     CategoryVector,
+	SPARC_INS_ARRAY32:     CategoryVector,
+	SPARC_INS_ARRAY8:      CategoryVector,
+	SPARC_INS_B:           CategoryBranch,
+	SPARC_INS_JMP:         CategoryBranch,
+	SPARC_INS_BMASK:       CategoryVector,
+	SPARC_INS_FB:          CategoryBranch,
+	SPARC_INS_BRGEZ:       CategoryBranch,
+	SPARC_INS_BRGZ:        CategoryBranch,
+	SPARC_INS_BRLEZ:       CategoryBranch,
+	SPARC_INS_BRLZ:        CategoryBranch,
+	SPARC_INS_BRNZ:        CategoryBranch,
+	SPARC_INS_BRZ:         CategoryBranch,
+	SPARC_INS_BSHUFFLE:    CategoryVector,
+	SPARC_INS_CALL:        CategoryCall,
+	SPARC_INS_CASX:        CategoryAtomic,
+	SPARC_INS_CAS:         CategoryAtomic,
+	SPARC_INS_CMASK16:     CategoryVector,
+	SPARC_INS_CMASK32:     CategoryVector,
+	SPARC_INS_CMASK8:      CategoryVector,
+	SPARC_INS_CMP:         CategoryCompare,
+	SPARC_INS_EDGE16:      CategoryVector,
+	SPARC_INS_EDGE16L:     CategoryVector,
+	SPARC_INS_EDGE16LN:    CategoryVector,
+	SPARC_INS_EDGE16N:     CategoryVector,
+	SPARC_INS_EDGE32:      CategoryVector,
+	SPARC_INS_EDGE32L:     CategoryVector,
+	SPARC_INS_EDGE32LN:    CategoryVector,
+	SPARC_INS_EDGE32N:     CategoryVector,
+	SPARC_INS_EDGE8:       CategoryVector,
+	SPARC_INS_EDGE8L:      CategoryVector,
+	SPARC_INS_EDGE8LN:     CategoryVector,
+	SPARC_INS_EDGE8N:      CategoryVector,
+	SPARC_INS_FABSD:       CategoryFloat,
+	SPARC_INS_FABSQ:       CategoryFloat,
+	SPARC_INS_FABSS:       CategoryFloat,
+	SPARC_INS_FADDD:       CategoryFloat,
+	SPARC_INS_FADDQ:       CategoryFloat,
+	SPARC_INS_FADDS:       CategoryFloat,
+	SPARC_INS_FALIGNDATA:  CategoryVector,
+	SPARC_INS_FAND:        CategoryVector,
+	SPARC_INS_FANDNOT1:    CategoryVector,
+	SPARC_INS_FANDNOT1S:   CategoryVector,
+	SPARC_INS_FANDNOT2:    CategoryVector,
+	SPARC_INS_FANDNOT2S:   CategoryVector,
+	SPARC_INS_FANDS:       CategoryVector,
+	SPARC_INS_FCHKSM16:    CategoryVector,
+	SPARC_INS_FCMPD:       CategoryFloat,
+	SPARC_INS_FCMPEQ16:    CategoryVector,
+	SPARC_INS_FCMPEQ32:    CategoryVector,
+	SPARC_INS_FCMPGT16:    CategoryVector,
+	SPARC_INS_FCMPGT32:    CategoryVector,
+	SPARC_INS_FCMPLE16:    CategoryVector,
+	SPARC_INS_FCMPLE32:    CategoryVector,
+	SPARC_INS_FCMPNE16:    CategoryVector,
+	SPARC_INS_FCMPNE32:    CategoryVector,
+	SPARC_INS_FCMPQ:       CategoryFloat,
+	SPARC_INS_FCMPS:       CategoryFloat,
+	SPARC_INS_FDIVD:       CategoryFloat,
+	SPARC_INS_FDIVQ:       CategoryFloat,
+	SPARC_INS_FDIVS:       CategoryFloat,
+	SPARC_INS_FDMULQ:      CategoryFloat,
+	SPARC_INS_FDTOI:       CategoryFloat,
+	SPARC_INS_FDTOQ:       CategoryFloat,
+	SPARC_INS_FDTOS:       CategoryFloat,
+	SPARC_INS_FDTOX:       CategoryFloat,
+	SPARC_INS_FEXPAND:     CategoryVector,
+	SPARC_INS_FHADDD:      CategoryFloat,
+	SPARC_INS_FHADDS:      CategoryFloat,
+	SPARC_INS_FHSUBD:      CategoryFloat,
+	SPARC_INS_FHSUBS:      CategoryFloat,
+	SPARC_INS_FITOD:       CategoryFloat,
+	SPARC_INS_FITOQ:       CategoryFloat,
+	SPARC_INS_FITOS:       CategoryFloat,
+	SPARC_INS_FLCMPD:      CategoryFloat,
+	SPARC_INS_FLCMPS:      CategoryFloat,
+	SPARC_INS_FLUSHW:      CategorySystem,
+	SPARC_INS_FMEAN16:     CategoryVector,
+	SPARC_INS_FMOVD:       CategoryMove,
+	SPARC_INS_FMOVQ:       CategoryMove,
+	SPARC_INS_FMOVRDGEZ:   CategoryMove,
+	SPARC_INS_FMOVRQGEZ:   CategoryMove,
+	SPARC_INS_FMOVRSGEZ:   CategoryMove,
+	SPARC_INS_FMOVRDGZ:    CategoryMove,
+	SPARC_INS_FMOVRQGZ:    CategoryMove,
+	SPARC_INS_FMOVRSGZ:    CategoryMove,
+	SPARC_INS_FMOVRDLEZ:   CategoryMove,
+	SPARC_INS_FMOVRQLEZ:   CategoryMove,
+	SPARC_INS_FMOVRSLEZ:   CategoryMove,
+	SPARC_INS_FMOVRDLZ:    CategoryMove,
+	SPARC_INS_FMOVRQLZ:    CategoryMove,
+	SPARC_INS_FMOVRSLZ:    CategoryMove,
+	SPARC_INS_FMOVRDNZ:    CategoryMove,
+	SPARC_INS_FMOVRQNZ:    CategoryMove,
+	SPARC_INS_FMOVRSNZ:    CategoryMove,
+	SPARC_INS_FMOVRDZ:     CategoryMove,
+	SPARC_INS_FMOVRQZ:     CategoryMove,
+	SPARC_INS_FMOVRSZ:     CategoryMove,
+	SPARC_INS_FMOVS:       CategoryMove,
+	SPARC_INS_FMUL8SUX16:  CategoryVector,
+	SPARC_INS_FMUL8ULX16:  CategoryVector,
+	SPARC_INS_FMUL8X16:    CategoryVector,
+	SPARC_INS_FMUL8X16AL:  CategoryVector,
+	SPARC_INS_FMUL8X16AU:  CategoryVector,
+	SPARC_INS_FMULD:       CategoryFloat,
+	SPARC_INS_FMULD8SUX16: CategoryVector,
+	SPARC_INS_FMULD8ULX16: CategoryVector,
+	SPARC_INS_FMULQ:       CategoryFloat,
+	SPARC_INS_FMULS:       CategoryFloat,
+	SPARC_INS_FNADDD:      CategoryFloat,
+	SPARC_INS_FNADDS:      CategoryFloat,
+	SPARC_INS_FNAND:       CategoryVector,
+	SPARC_INS_FNANDS:      CategoryVector,
+	SPARC_INS_FNEGD:       CategoryFloat,
+	SPARC_INS_FNEGQ:       CategoryFloat,
+	SPARC_INS_FNEGS:       CategoryFloat,
+	SPARC_INS_FNHADDD:     CategoryFloat,
+	SPARC_INS_FNHADDS:     CategoryFloat,
+	SPARC_INS_FNOR:        CategoryVector,
+	SPARC_INS_FNORS:       CategoryVector,
+	SPARC_INS_FNOT1:       CategoryVector,
+	SPARC_INS_FNOT1S:      CategoryVector,
+	SPARC_INS_FNOT2:       CategoryVector,
+	SPARC_INS_FNOT2S:      CategoryVector,
+	SPARC_INS_FONE:        CategoryVector,
+	SPARC_INS_FONES:       CategoryVector,
+	SPARC_INS_FOR:         CategoryVector,
+	SPARC_INS_FORNOT1:     CategoryVector,
+	SPARC_INS_FORNOT1S:    CategoryVector,
+	SPARC_INS_FORNOT2:     CategoryVector,
+	SPARC_INS_FORNOT2S:    CategoryVector,
+	SPARC_INS_FORS:        CategoryVector,
+	SPARC_INS_FPACK16:     CategoryVector,
+	SPARC_INS_FPACK32:     CategoryVector,
+	SPARC_INS_FPACKFIX:    CategoryVector,
+	SPARC_INS_FPADD16:     CategoryVector,
+	SPARC_INS_FPADD16S:    CategoryVector,
+	SPARC_INS_FPADD32:     CategoryVector,
+	SPARC_INS_FPADD32S:    CategoryVector,
+	SPARC_INS_FPADD64:     CategoryVector,
+	SPARC_INS_FPMERGE:     CategoryVector,
+	SPARC_INS_FPSUB16:     CategoryVector,
+	SPARC_INS_FPSUB16S:    CategoryVector,
+	SPARC_INS_FPSUB32:     CategoryVector,
+	SPARC_INS_FPSUB32S:    CategoryVector,
+	SPARC_INS_FQTOD:       CategoryFloat,
+	SPARC_INS_FQTOI:       CategoryFloat,
+	SPARC_INS_FQTOS:       CategoryFloat,
+	SPARC_INS_FQTOX:       CategoryFloat,
+	SPARC_INS_FSLAS16:     CategoryVector,
+	SPARC_INS_FSLAS32:     CategoryVector,
+	SPARC_INS_FSLL16:      CategoryVector,
+	SPARC_INS_FSLL32:      CategoryVector,
+	SPARC_INS_FSMULD:      CategoryFloat,
+	SPARC_INS_FSQRTD:      CategoryFloat,
+	SPARC_INS_FSQRTQ:      CategoryFloat,
+	SPARC_INS_FSQRTS:      CategoryFloat,
+	SPARC_INS_FSRA16:      CategoryVector,
+	SPARC_INS_FSRA32:      CategoryVector,
+	SPARC_INS_FSRC1:       CategoryVector,
+	SPARC_INS_FSRC1S:      CategoryVector,
+	SPARC_INS_FSRC2:       CategoryVector,
+	SPARC_INS_FSRC2S:      CategoryVector,
+	SPARC_INS_FSRL16:      CategoryVector,
+	SPARC_INS_FSRL32:      CategoryVector,
+	SPARC_INS_FSTOD:       CategoryFloat,
+	SPARC_INS_FSTOI:       CategoryFloat,
+	SPARC_INS_FSTOQ:       CategoryFloat,
+	SPARC_INS_FSTOX:       CategoryFloat,
+	SPARC_INS_FSUBD:       CategoryFloat,
+	SPARC_INS_FSUBQ:       CategoryFloat,
+	SPARC_INS_FSUBS:       CategoryFloat,
+	SPARC_INS_FXNOR:       CategoryVector,
+	SPARC_INS_FXNORS:      CategoryVector,
+	SPARC_INS_FXOR:        CategoryVector,
+	SPARC_INS_FXORS:       CategoryVector,
+	SPARC_INS_FXTOD:       CategoryFloat,
+	SPARC_INS_FXTOQ:       CategoryFloat,
+	SPARC_INS_FXTOS:       CategoryFloat,
+	SPARC_INS_FZERO:       CategoryVector,
+	SPARC_INS_FZEROS:      CategoryVector,
+	SPARC_INS_JMPL:        CategoryBranch,
+	SPARC_INS_LDD:         CategoryLoad,
+	SPARC_INS_LD:          CategoryLoad,
+	SPARC_INS_LDQ:         CategoryLoad,
+	SPARC_INS_LDSB:        CategoryLoad,
+	SPARC_INS_LDSH:        CategoryLoad,
+	SPARC_INS_LDSW:        CategoryLoad,
+	SPARC_INS_LDUB:        CategoryLoad,
+	SPARC_INS_LDUH:        CategoryLoad,
+	SPARC_INS_LDX:         CategoryLoad,
+	SPARC_INS_LZCNT:       CategoryLogic,
+	SPARC_INS_MEMBAR:      CategoryAtomic,
+	SPARC_INS_MOVDTOX:     CategoryMove,
+	SPARC_INS_MOV:         CategoryMove,
+	SPARC_INS_MOVRGEZ:     CategoryMove,
+	SPARC_INS_MOVRGZ:      CategoryMove,
+	SPARC_INS_MOVRLEZ:     CategoryMove,
+	SPARC_INS_MOVRLZ:      CategoryMove,
+	SPARC_INS_MOVRNZ:      CategoryMove,
+	SPARC_INS_MOVRZ:       CategoryMove,
+	SPARC_INS_MOVSTOSW:    CategoryMove,
+	SPARC_INS_MOVSTOUW:    CategoryMove,
+	SPARC_INS_MULX:        CategoryArithmetic,
+	SPARC_INS_NOP:         CategoryNop,
+	SPARC_INS_ORCC:        CategoryLogic,
+	SPARC_INS_ORNCC:       CategoryLogic,
+	SPARC_INS_ORN:         CategoryLogic,
+	SPARC_INS_OR:          CategoryLogic,
+	SPARC_INS_PDIST:       CategoryVector,
+	SPARC_INS_PDISTN:      CategoryVector,
+	SPARC_INS_POPC:        CategoryLogic,
+	SPARC_INS_RD:          CategorySystem,
+	SPARC_INS_RESTORE:     CategoryStack,
+	SPARC_INS_RETT:        CategoryReturn,
+	SPARC_INS_SAVE:        CategoryStack,
+	SPARC_INS_SDIVCC:      CategoryArithmetic,
+	SPARC_INS_SDIVX:       CategoryArithmetic,
+	SPARC_INS_SDIV:        CategoryArithmetic,
+	SPARC_INS_SETHI:       CategoryMove,
+	SPARC_INS_SHUTDOWN:    CategorySystem,
+	SPARC_INS_SIAM:        CategorySystem,
+	SPARC_INS_SLLX:        CategoryShift,
+	SPARC_INS_SLL:         CategoryShift,
+	SPARC_INS_SMULCC:      CategoryArithmetic,
+	SPARC_INS_SMUL:        CategoryArithmetic,
+	SPARC_INS_SRAX:        CategoryShift,
+	SPARC_INS_SRA:         CategoryShift,
+	SPARC_INS_SRLX:        CategoryShift,
+	SPARC_INS_SRL:         CategoryShift,
+	SPARC_INS_STBAR:       CategoryAtomic,
+	SPARC_INS_STB:         CategoryStore,
+	SPARC_INS_STD:         CategoryStore,
+	SPARC_INS_ST:          CategoryStore,
+	SPARC_INS_STH:         CategoryStore,
+	SPARC_INS_STQ:         CategoryStore,
+	SPARC_INS_STX:         CategoryStore,
+	SPARC_INS_SUBCC:       CategoryArithmetic,
+	SPARC_INS_SUBX:        CategoryArithmetic,
+	SPARC_INS_SUBXCC:      CategoryArithmetic,
+	SPARC_INS_SUB:         CategoryArithmetic,
+	SPARC_INS_SWAP:        CategoryAtomic,
+	SPARC_INS_TADDCCTV:    CategoryArithmetic,
+	SPARC_INS_TADDCC:      CategoryArithmetic,
+	SPARC_INS_T:           CategorySystem,
+	SPARC_INS_TSUBCCTV:    CategoryArithmetic,
+	SPARC_INS_TSUBCC:      CategoryArithmetic,
+	SPARC_INS_UDIVCC:      CategoryArithmetic,
+	SPARC_INS_UDIVX:       CategoryArithmetic,
+	SPARC_INS_UDIV:        CategoryArithmetic,
+	SPARC_INS_UMULCC:      CategoryArithmetic,
+	SPARC_INS_UMULXHI:     CategoryArithmetic,
+	SPARC_INS_UMUL:        CategoryArithmetic,
+	SPARC_INS_UNIMP:       CategorySystem,
+	SPARC_INS_FCMPED:      CategoryFloat,
+	SPARC_INS_FCMPEQ:      CategoryFloat,
+	SPARC_INS_FCMPES:      CategoryFloat,
+	SPARC_INS_WR:          CategorySystem,
+	SPARC_INS_XMULX:       CategoryArithmetic,
+	SPARC_INS_XMULXHI:     CategoryArithmetic,
+	SPARC_INS_XNORCC:      CategoryLogic,
+	SPARC_INS_XNOR:        CategoryLogic,
+	SPARC_INS_XORCC:       CategoryLogic,
+	SPARC_INS_XOR:         CategoryLogic,
+	SPARC_INS_RET:         CategoryReturn,
+	SPARC_INS_RETL:        CategoryReturn,
+}
+
+var syszCategories = map[uint]Category{
+	SYSZ_INS_A:        CategoryArithmetic,
+	SYSZ_INS_ADB:      CategoryFloat,
+	SYSZ_INS_ADBR:     CategoryFloat,
+	SYSZ_INS_AEB:      CategoryFloat,
+	SYSZ_INS_AEBR:     CategoryFloat,
+	SYSZ_INS_AFI:      CategoryArithmetic,
+	SYSZ_INS_AG:       CategoryArithmetic,
+	SYSZ_INS_AGF:      CategoryArithmetic,
+	SYSZ_INS_AGFI:     CategoryArithmetic,
+	SYSZ_INS_AGFR:     CategoryArithmetic,
+	SYSZ_INS_AGHI:     CategoryArithmetic,
+	SYSZ_INS_AGHIK:    CategoryArithmetic,
+	SYSZ_INS_AGR:      CategoryArithmetic,
+	SYSZ_INS_AGRK:     CategoryArithmetic,
+	SYSZ_INS_AGSI:     CategoryArithmetic,
+	SYSZ_INS_AH:       CategoryArithmetic,
+	SYSZ_INS_AHI:      CategoryArithmetic,
+	SYSZ_INS_AHIK:     CategoryArithmetic,
+	SYSZ_INS_AHY:      CategoryArithmetic,
+	SYSZ_INS_AIH:      CategoryArithmetic,
+	SYSZ_INS_AL:       CategoryArithmetic,
+	SYSZ_INS_ALC:      CategoryArithmetic,
+	SYSZ_INS_ALCG:     CategoryArithmetic,
+	SYSZ_INS_ALCGR:    CategoryArithmetic,
+	SYSZ_INS_ALCR:     CategoryArithmetic,
+	SYSZ_INS_ALFI:     CategoryArithmetic,
+	SYSZ_INS_ALG:      CategoryArithmetic,
+	SYSZ_INS_ALGF:     CategoryArithmetic,
+	SYSZ_INS_ALGFI:    CategoryArithmetic,
+	SYSZ_INS_ALGFR:    CategoryArithmetic,
+	SYSZ_INS_ALGHSIK:  CategoryArithmetic,
+	SYSZ_INS_ALGR:     CategoryArithmetic,
+	SYSZ_INS_ALGRK:    CategoryArithmetic,
+	SYSZ_INS_ALHSIK:   CategoryArithmetic,
+	SYSZ_INS_ALR:      CategoryArithmetic,
+	SYSZ_INS_ALRK:     CategoryArithmetic,
+	SYSZ_INS_ALY:      CategoryArithmetic,
+	SYSZ_INS_AR:       CategoryArithmetic,
+	SYSZ_INS_ARK:      CategoryArithmetic,
+	SYSZ_INS_ASI:      CategoryArithmetic,
+	SYSZ_INS_AXBR:     CategoryFloat,
+	SYSZ_INS_AY:       CategoryArithmetic,
+	SYSZ_INS_BCR:      CategoryBranch,
+	SYSZ_INS_BRC:      CategoryBranch,
+	SYSZ_INS_BRCL:     CategoryBranch,
+	SYSZ_INS_CGIJ:     CategoryBranch,
+	SYSZ_INS_CGRJ:     CategoryBranch,
+	SYSZ_INS_CIJ:      CategoryBranch,
+	SYSZ_INS_CLGIJ:    CategoryBranch,
+	SYSZ_INS_CLGRJ:    CategoryBranch,
+	SYSZ_INS_CLIJ:     CategoryBranch,
+	SYSZ_INS_CLRJ:     CategoryBranch,
+	SYSZ_INS_CRJ:      CategoryBranch,
+	SYSZ_INS_BER:      CategoryBranch,
+	SYSZ_INS_JE:       CategoryBranch,
+	SYSZ_INS_JGE:      CategoryBranch,
+	SYSZ_INS_LOCE:     CategoryLoad,
+	SYSZ_INS_LOCGE:    CategoryLoad,
+	SYSZ_INS_LOCGRE:   CategoryMove,
+	SYSZ_INS_LOCRE:    CategoryMove,
+	SYSZ_INS_STOCE:    CategoryStore,
+	SYSZ_INS_STOCGE:   CategoryStore,
+	SYSZ_INS_BHR:      CategoryBranch,
+	SYSZ_INS_BHER:     CategoryBranch,
+	SYSZ_INS_JHE:      CategoryBranch,
+	SYSZ_INS_JGHE:     CategoryBranch,
+	SYSZ_INS_LOCHE:    CategoryLoad,
+	SYSZ_INS_LOCGHE:   CategoryLoad,
+	SYSZ_INS_LOCGRHE:  CategoryMove,
+	SYSZ_INS_LOCRHE:   CategoryMove,
+	SYSZ_INS_STOCHE:   CategoryStore,
+	SYSZ_INS_STOCGHE:  CategoryStore,
+	SYSZ_INS_JH:       CategoryBranch,
+	SYSZ_INS_JGH:      CategoryBranch,
+	SYSZ_INS_LOCH:     CategoryLoad,
+	SYSZ_INS_LOCGH:    CategoryLoad,
+	SYSZ_INS_LOCGRH:   CategoryMove,
+	SYSZ_INS_LOCRH:    CategoryMove,
+	SYSZ_INS_STOCH:    CategoryStore,
+	SYSZ_INS_STOCGH:   CategoryStore,
+	SYSZ_INS_CGIJNLH:  CategoryBranch,
+	SYSZ_INS_CGRJNLH:  CategoryBranch,
+	SYSZ_INS_CIJNLH:   CategoryBranch,
+	SYSZ_INS_CLGIJNLH: CategoryBranch,
+	SYSZ_INS_CLGRJNLH: CategoryBranch,
+	SYSZ_INS_CLIJNLH:  CategoryBranch,
+	SYSZ_INS_CLRJNLH:  CategoryBranch,
+	SYSZ_INS_CRJNLH:   CategoryBranch,
+	SYSZ_INS_CGIJE:    CategoryBranch,
+	SYSZ_INS_CGRJE:    CategoryBranch,
+	SYSZ_INS_CIJE:     CategoryBranch,
+	SYSZ_INS_CLGIJE:   CategoryBranch,
+	SYSZ_INS_CLGRJE:   CategoryBranch,
+	SYSZ_INS_CLIJE:    CategoryBranch,
+	SYSZ_INS_CLRJE:    CategoryBranch,
+	SYSZ_INS_CRJE:     CategoryBranch,
+	SYSZ_INS_CGIJNLE:  CategoryBranch,
+	SYSZ_INS_CGRJNLE:  CategoryBranch,
+	SYSZ_INS_CIJNLE:   CategoryBranch,
+	SYSZ_INS_CLGIJNLE: CategoryBranch,
+	SYSZ_INS_CLGRJNLE: CategoryBranch,
+	SYSZ_INS_CLIJNLE:  CategoryBranch,
+	SYSZ_INS_CLRJNLE:  CategoryBranch,
+	SYSZ_INS_CRJNLE:   CategoryBranch,
+	SYSZ_INS_CGIJH:    CategoryBranch,
+	SYSZ_INS_CGRJH:    CategoryBranch,
+	SYSZ_INS_CIJH:     CategoryBranch,
+	SYSZ_INS_CLGIJH:   CategoryBranch,
+	SYSZ_INS_CLGRJH:   CategoryBranch,
+	SYSZ_INS_CLIJH:    CategoryBranch,
+	SYSZ_INS_CLRJH:    CategoryBranch,
+	SYSZ_INS_CRJH:     CategoryBranch,
+	SYSZ_INS_CGIJNL:   CategoryBranch,
+	SYSZ_INS_CGRJNL:   CategoryBranch,
+	SYSZ_INS_CIJNL:    CategoryBranch,
+	SYSZ_INS_CLGIJNL:  CategoryBranch,
+	SYSZ_INS_CLGRJNL:  CategoryBranch,
+	SYSZ_INS_CLIJNL:   CategoryBranch,
+	SYSZ_INS_CLRJNL:   CategoryBranch,
+	SYSZ_INS_CRJNL:    CategoryBranch,
+	SYSZ_INS_CGIJHE:   CategoryBranch,
+	SYSZ_INS_CGRJHE:   CategoryBranch,
+	SYSZ_INS_CIJHE:    CategoryBranch,
+	SYSZ_INS_CLGIJHE:  CategoryBranch,
+	SYSZ_INS_CLGRJHE:  CategoryBranch,
+	SYSZ_INS_CLIJHE:   CategoryBranch,
+	SYSZ_INS_CLRJHE:   CategoryBranch,
+	SYSZ_INS_CRJHE:    CategoryBranch,
+	SYSZ_INS_CGIJNHE:  CategoryBranch,
+	SYSZ_INS_CGRJNHE:  CategoryBranch,
+	SYSZ_INS_CIJNHE:   CategoryBranch,
+	SYSZ_INS_CLGIJNHE: CategoryBranch,
+	SYSZ_INS_CLGRJNHE: CategoryBranch,
+	SYSZ_INS_CLIJNHE:  CategoryBranch,
+	SYSZ_INS_CLRJNHE:  CategoryBranch,
+	SYSZ_INS_CRJNHE:   CategoryBranch,
+	SYSZ_INS_CGIJL:    CategoryBranch,
+	SYSZ_INS_CGRJL:    CategoryBranch,
+	SYSZ_INS_CIJL:     CategoryBranch,
+	SYSZ_INS_CLGIJL:   CategoryBranch,
+	SYSZ_INS_CLGRJL:   CategoryBranch,
+	SYSZ_INS_CLIJL:    CategoryBranch,
+	SYSZ_INS_CLRJL:    CategoryBranch,
+	SYSZ_INS_CRJL:     CategoryBranch,
+	SYSZ_INS_CGIJNH:   CategoryBranch,
+	SYSZ_INS_CGRJNH:   CategoryBranch,
+	SYSZ_INS_CIJNH:    CategoryBranch,
+	SYSZ_INS_CLGIJNH:  CategoryBranch,
+	SYSZ_INS_CLGRJNH:  CategoryBranch,
+	SYSZ_INS_CLIJNH:   CategoryBranch,
+	SYSZ_INS_CLRJNH:   CategoryBranch,
+	SYSZ_INS_CRJNH:    CategoryBranch,
+	SYSZ_INS_CGIJLE:   CategoryBranch,
+	SYSZ_INS_CGRJLE:   CategoryBranch,
+	SYSZ_INS_CIJLE:    CategoryBranch,
+	SYSZ_INS_CLGIJLE:  CategoryBranch,
+	SYSZ_INS_CLGRJLE:  CategoryBranch,
+	SYSZ_INS_CLIJLE:   CategoryBranch,
+	SYSZ_INS_CLRJLE:   CategoryBranch,
+	SYSZ_INS_CRJLE:    CategoryBranch,
+	SYSZ_INS_CGIJNE:   CategoryBranch,
+	SYSZ_INS_CGRJNE:   CategoryBranch,
+	SYSZ_INS_CIJNE:    CategoryBranch,
+	SYSZ_INS_CLGIJNE:  CategoryBranch,
+	SYSZ_INS_CLGRJNE:  CategoryBranch,
+	SYSZ_INS_CLIJNE:   CategoryBranch,
+	SYSZ_INS_CLRJNE:   CategoryBranch,
+	SYSZ_INS_CRJNE:    CategoryBranch,
+	SYSZ_INS_CGIJLH:   CategoryBranch,
+	SYSZ_INS_CGRJLH:   CategoryBranch,
+	SYSZ_INS_CIJLH:    CategoryBranch,
+	SYSZ_INS_CLGIJLH:  CategoryBranch,
+	SYSZ_INS_CLGRJLH:  CategoryBranch,
+	SYSZ_INS_CLIJLH:   CategoryBranch,
+	SYSZ_INS_CLRJLH:   CategoryBranch,
+	SYSZ_INS_CRJLH:    CategoryBranch,
+	SYSZ_INS_BLR:      CategoryBranch,
+	SYSZ_INS_BLER:     CategoryBranch,
+	SYSZ_INS_JLE:      CategoryBranch,
+	SYSZ_INS_JGLE:     CategoryBranch,
+	SYSZ_INS_LOCLE:    CategoryLoad,
+	SYSZ_INS_LOCGLE:   CategoryLoad,
+	SYSZ_INS_LOCGRLE:  CategoryMove,
+	SYSZ_INS_LOCRLE:   CategoryMove,
+	SYSZ_INS_STOCLE:   CategoryStore,
+	SYSZ_INS_STOCGLE:  CategoryStore,
+	SYSZ_INS_BLHR:     CategoryBranch,
+	SYSZ_INS_JLH:      CategoryBranch,
+	SYSZ_INS_JGLH:     CategoryBranch,
+	SYSZ_INS_LOCLH:    CategoryLoad,
+	SYSZ_INS_LOCGLH:   CategoryLoad,
+	SYSZ_INS_LOCGRLH:  CategoryMove,
+	SYSZ_INS_LOCRLH:   CategoryMove,
+	SYSZ_INS_STOCLH:   CategoryStore,
+	SYSZ_INS_STOCGLH:  CategoryStore,
+	SYSZ_INS_JL:       CategoryBranch,
+	SYSZ_INS_JGL:      CategoryBranch,
+	SYSZ_INS_LOCL:     CategoryLoad,
+	SYSZ_INS_LOCGL:    CategoryLoad,
+	SYSZ_INS_LOCGRL:   CategoryMove,
+	SYSZ_INS_LOCRL:    CategoryMove,
+	SYSZ_INS_LOC:      CategoryLoad,
+	SYSZ_INS_LOCG:     CategoryLoad,
+	SYSZ_INS_LOCGR:    CategoryMove,
+	SYSZ_INS_LOCR:     CategoryMove,
+	SYSZ_INS_STOCL:    CategoryStore,
+	SYSZ_INS_STOCGL:   CategoryStore,
+	SYSZ_INS_BNER:     CategoryBranch,
+	SYSZ_INS_JNE:      CategoryBranch,
+	SYSZ_INS_JGNE:     CategoryBranch,
+	SYSZ_INS_LOCNE:    CategoryLoad,
+	SYSZ_INS_LOCGNE:   CategoryLoad,
+	SYSZ_INS_LOCGRNE:  CategoryMove,
+	SYSZ_INS_LOCRNE:   CategoryMove,
+	SYSZ_INS_STOCNE:   CategoryStore,
+	SYSZ_INS_STOCGNE:  CategoryStore,
+	SYSZ_INS_BNHR:     CategoryBranch,
+	SYSZ_INS_BNHER:    CategoryBranch,
+	SYSZ_INS_JNHE:     CategoryBranch,
+	SYSZ_INS_JGNHE:    CategoryBranch,
+	SYSZ_INS_LOCNHE:   CategoryLoad,
+	SYSZ_INS_LOCGNHE:  CategoryLoad,
+	SYSZ_INS_LOCGRNHE: CategoryMove,
+	SYSZ_INS_LOCRNHE:  CategoryMove,
+	SYSZ_INS_STOCNHE:  CategoryStore,
+	SYSZ_INS_STOCGNHE: CategoryStore,
+	SYSZ_INS_JNH:      CategoryBranch,
+	SYSZ_INS_JGNH:     CategoryBranch,
+	SYSZ_INS_LOCNH:    CategoryLoad,
+	SYSZ_INS_LOCGNH:   CategoryLoad,
+	SYSZ_INS_LOCGRNH:  CategoryMove,
+	SYSZ_INS_LOCRNH:   CategoryMove,
+	SYSZ_INS_STOCNH:   CategoryStore,
+	SYSZ_INS_STOCGNH:  CategoryStore,
+	SYSZ_INS_BNLR:     CategoryBranch,
+	SYSZ_INS_BNLER:    CategoryBranch,
+	SYSZ_INS_JNLE:     CategoryBranch,
+	SYSZ_INS_JGNLE:    CategoryBranch,
+	SYSZ_INS_LOCNLE:   CategoryLoad,
+	SYSZ_INS_LOCGNLE:  CategoryLoad,
+	SYSZ_INS_LOCGRNLE: CategoryMove,
+	SYSZ_INS_LOCRNLE:  CategoryMove,
+	SYSZ_INS_STOCNLE:  CategoryStore,
+	SYSZ_INS_STOCGNLE: CategoryStore,
+	SYSZ_INS_BNLHR:    CategoryBranch,
+	SYSZ_INS_JNLH:     CategoryBranch,
+	SYSZ_INS_JGNLH:    CategoryBranch,
+	SYSZ_INS_LOCNLH:   CategoryLoad,
+	SYSZ_INS_LOCGNLH:  CategoryLoad,
+	SYSZ_INS_LOCGRNLH: CategoryMove,
+	SYSZ_INS_LOCRNLH:  CategoryMove,
+	SYSZ_INS_STOCNLH:  CategoryStore,
+	SYSZ_INS_STOCGNLH: CategoryStore,
+	SYSZ_INS_JNL:      CategoryBranch,
+	SYSZ_INS_JGNL:     CategoryBranch,
+	SYSZ_INS_LOCNL:    CategoryLoad,
+	SYSZ_INS_LOCGNL:   CategoryLoad,
+	SYSZ_INS_LOCGRNL:  CategoryMove,
+	SYSZ_INS_LOCRNL:   CategoryMove,
+	SYSZ_INS_STOCNL:   CategoryStore,
+	SYSZ_INS_STOCGNL:  CategoryStore,
+	SYSZ_INS_BNOR:     CategoryBranch,
+	SYSZ_INS_JNO:      CategoryBranch,
+	SYSZ_INS_JGNO:     CategoryBranch,
+	SYSZ_INS_LOCNO:    CategoryLoad,
+	SYSZ_INS_LOCGNO:   CategoryLoad,
+	SYSZ_INS_LOCGRNO:  CategoryMove,
+	SYSZ_INS_LOCRNO:   CategoryMove,
+	SYSZ_INS_STOCNO:   CategoryStore,
+	SYSZ_INS_STOCGNO:  CategoryStore,
+	SYSZ_INS_BOR:      CategoryBranch,
+	SYSZ_INS_JO:       CategoryBranch,
+	SYSZ_INS_JGO:      CategoryBranch,
+	SYSZ_INS_LOCO:     CategoryLoad,
+	SYSZ_INS_LOCGO:    CategoryLoad,
+	SYSZ_INS_LOCGRO:   CategoryMove,
+	SYSZ_INS_LOCRO:    CategoryMove,
+	SYSZ_INS_STOCO:    CategoryStore,
+	SYSZ_INS_STOCGO:   CategoryStore,
+	SYSZ_INS_STOC:     CategoryStore,
+	SYSZ_INS_STOCG:    CategoryStore,
+	SYSZ_INS_BASR:     CategoryCall,
+	SYSZ_INS_BR:       CategoryBranch,
+	SYSZ_INS_BRAS:     CategoryCall,
+	SYSZ_INS_BRASL:    CategoryCall,
+	SYSZ_INS_J:        CategoryBranch,
+	SYSZ_INS_JG:       CategoryBranch,
+	SYSZ_INS_BRCT:     CategoryBranch,
+	SYSZ_INS_BRCTG:    CategoryBranch,
+	SYSZ_INS_C:        CategoryCompare,
+	SYSZ_INS_CDB:      CategoryFloat,
+	SYSZ_INS_CDBR:     CategoryFloat,
+	SYSZ_INS_CDFBR:    CategoryFloat,
+	SYSZ_INS_CDGBR:    CategoryFloat,
+	SYSZ_INS_CDLFBR:   CategoryFloat,
+	SYSZ_INS_CDLGBR:   CategoryFloat,
+	SYSZ_INS_CEB:      CategoryFloat,
+	SYSZ_INS_CEBR:     CategoryFloat,
+	SYSZ_INS_CEFBR:    CategoryFloat,
+	SYSZ_INS_CEGBR:    CategoryFloat,
+	SYSZ_INS_CELFBR:   CategoryFloat,
+	SYSZ_INS_CELGBR:   CategoryFloat,
+	SYSZ_INS_CFDBR:    CategoryFloat,
+	SYSZ_INS_CFEBR:    CategoryFloat,
+	SYSZ_INS_CFI:      CategoryCompare,
+	SYSZ_INS_CFXBR:    CategoryFloat,
+	SYSZ_INS_CG:       CategoryCompare,
+	SYSZ_INS_CGDBR:    CategoryFloat,
+	SYSZ_INS_CGEBR:    CategoryFloat,
+	SYSZ_INS_CGF:      CategoryCompare,
+	SYSZ_INS_CGFI:     CategoryCompare,
+	SYSZ_INS_CGFR:     CategoryCompare,
+	SYSZ_INS_CGFRL:    CategoryCompare,
+	SYSZ_INS_CGH:      CategoryCompare,
+	SYSZ_INS_CGHI:     CategoryCompare,
+	SYSZ_INS_CGHRL:    CategoryCompare,
+	SYSZ_INS_CGHSI:    CategoryCompare,
+	SYSZ_INS_CGR:      CategoryCompare,
+	SYSZ_INS_CGRL:     CategoryCompare,
+	SYSZ_INS_CGXBR:    CategoryFloat,
+	SYSZ_INS_CH:       CategoryCompare,
+	SYSZ_INS_CHF:      CategoryCompare,
+	SYSZ_INS_CHHSI:    CategoryCompare,
+	SYSZ_INS_CHI:      CategoryCompare,
+	SYSZ_INS_CHRL:     CategoryCompare,
+	SYSZ_INS_CHSI:     CategoryCompare,
+	SYSZ_INS_CHY:      CategoryCompare,
+	SYSZ_INS_CIH:      CategoryCompare,
+	SYSZ_INS_CL:       CategoryCompare,
+	SYSZ_INS_CLC:      CategoryCompare,
+	SYSZ_INS_CLFDBR:   CategoryFloat,
+	SYSZ_INS_CLFEBR:   CategoryFloat,
+	SYSZ_INS_CLFHSI:   CategoryCompare,
+	SYSZ_INS_CLFI:     CategoryCompare,
+	SYSZ_INS_CLFXBR:   CategoryFloat,
+	SYSZ_INS_CLG:      CategoryCompare,
+	SYSZ_INS_CLGDBR:   CategoryFloat,
+	SYSZ_INS_CLGEBR:   CategoryFloat,
+	SYSZ_INS_CLGF:     CategoryCompare,
+	SYSZ_INS_CLGFI:    CategoryCompare,
+	SYSZ_INS_CLGFR:    CategoryCompare,
+	SYSZ_INS_CLGFRL:   CategoryCompare,
+	SYSZ_INS_CLGHRL:   CategoryCompare,
+	SYSZ_INS_CLGHSI:   CategoryCompare,
+	SYSZ_INS_CLGR:     CategoryCompare,
+	SYSZ_INS_CLGRL:    CategoryCompare,
+	SYSZ_INS_CLGXBR:   CategoryFloat,
+	SYSZ_INS_CLHF:     CategoryCompare,
+	SYSZ_INS_CLHHSI:   CategoryCompare,
+	SYSZ_INS_CLHRL:    CategoryCompare,
+	SYSZ_INS_CLI:      CategoryCompare,
+	SYSZ_INS_CLIH:     CategoryCompare,
+	SYSZ_INS_CLIY:     CategoryCompare,
+	SYSZ_INS_CLR:      CategoryCompare,
+	SYSZ_INS_CLRL:     CategoryCompare,
+	SYSZ_INS_CLST:     CategoryCompare,
+	SYSZ_INS_CLY:      CategoryCompare,
+	SYSZ_INS_CPSDR:    CategoryFloat,
+	SYSZ_INS_CR:       CategoryCompare,
+	SYSZ_INS_CRL:      CategoryCompare,
+	SYSZ_INS_CS:       CategoryAtomic,
+	SYSZ_INS_CSG:      CategoryAtomic,
+	SYSZ_INS_CSY:      CategoryAtomic,
+	SYSZ_INS_CXBR:     CategoryFloat,
+	SYSZ_INS_CXFBR:    CategoryFloat,
+	SYSZ_INS_CXGBR:    CategoryFloat,
+	SYSZ_INS_CXLFBR:   CategoryFloat,
+	SYSZ_INS_CXLGBR:   CategoryFloat,
+	SYSZ_INS_CY:       CategoryCompare,
+	SYSZ_INS_DDB:      CategoryFloat,
+	SYSZ_INS_DDBR:     CategoryFloat,
+	SYSZ_INS_DEB:      CategoryFloat,
+	SYSZ_INS_DEBR:     CategoryFloat,
+	SYSZ_INS_DL:       CategoryArithmetic,
+	SYSZ_INS_DLG:      CategoryArithmetic,
+	SYSZ_INS_DLGR:     CategoryArithmetic,
+	SYSZ_INS_DLR:      CategoryArithmetic,
+	SYSZ_INS_DSG:      CategoryArithmetic,
+	SYSZ_INS_DSGF:     CategoryArithmetic,
+	SYSZ_INS_DSGFR:    CategoryArithmetic,
+	SYSZ_INS_DSGR:     CategoryArithmetic,
+	SYSZ_INS_DXBR:     CategoryFloat,
+	SYSZ_INS_EAR:      CategorySystem,
+	SYSZ_INS_FIDBR:    CategoryFloat,
+	SYSZ_INS_FIDBRA:   CategoryFloat,
+	SYSZ_INS_FIEBR:    CategoryFloat,
+	SYSZ_INS_FIEBRA:   CategoryFloat,
+	SYSZ_INS_FIXBR:    CategoryFloat,
+	SYSZ_INS_FIXBRA:   CategoryFloat,
+	SYSZ_INS_FLOGR:    CategoryLogic,
+	SYSZ_INS_IC:       CategoryLoad,
+	SYSZ_INS_ICY:      CategoryLoad,
+	SYSZ_INS_IIHF:     CategoryMove,
+	SYSZ_INS_IIHH:     CategoryMove,
+	SYSZ_INS_IIHL:     CategoryMove,
+	SYSZ_INS_IILF:     CategoryMove,
+	SYSZ_INS_IILH:     CategoryMove,
+	SYSZ_INS_IILL:     CategoryMove,
+	SYSZ_INS_IPM:      CategoryMove,
+	SYSZ_INS_L:        CategoryLoad,
+	SYSZ_INS_LA:       CategoryArithmetic,
+	SYSZ_INS_LAA:      CategoryAtomic,
+	SYSZ_INS_LAAG:     CategoryAtomic,
+	SYSZ_INS_LAAL:     CategoryAtomic,
+	SYSZ_INS_LAALG:    CategoryAtomic,
+	SYSZ_INS_LAN:      CategoryAtomic,
+	SYSZ_INS_LANG:     CategoryAtomic,
+	SYSZ_INS_LAO:      CategoryAtomic,
+	SYSZ_INS_LAOG:     CategoryAtomic,
+	SYSZ_INS_LARL:     CategoryArithmetic,
+	SYSZ_INS_LAX:      CategoryAtomic,
+	SYSZ_INS_LAXG:     CategoryAtomic,
+	SYSZ_INS_LAY:      CategoryArithmetic,
+	SYSZ_INS_LB:       CategoryLoad,
+	SYSZ_INS_LBH:      CategoryLoad,
+	SYSZ_INS_LBR:      CategoryMove,
+	SYSZ_INS_LCDBR:    CategoryFloat,
+	SYSZ_INS_LCEBR:    CategoryFloat,
+	SYSZ_INS_LCGFR:    CategoryArithmetic,
+	SYSZ_INS_LCGR:     CategoryArithmetic,
+	SYSZ_INS_LCR:      CategoryArithmetic,
+	SYSZ_INS_LCXBR:    CategoryFloat,
+	SYSZ_INS_LD:       CategoryLoad,
+	SYSZ_INS_LDEB:     CategoryFloat,
+	SYSZ_INS_LDEBR:    CategoryFloat,
+	SYSZ_INS_LDGR:     CategoryMove,
+	SYSZ_INS_LDR:      CategoryMove,
+	SYSZ_INS_LDXBR:    CategoryFloat,
+	SYSZ_INS_LDXBRA:   CategoryFloat,
+	SYSZ_INS_LDY:      CategoryLoad,
+	SYSZ_INS_LE:       CategoryLoad,
+	SYSZ_INS_LEDBR:    CategoryFloat,
+	SYSZ_INS_LEDBRA:   CategoryFloat,
+	SYSZ_INS_LER:      CategoryMove,
+	SYSZ_INS_LEXBR:    CategoryFloat,
+	SYSZ_INS_LEXBRA:   CategoryFloat,
+	SYSZ_INS_LEY:      CategoryLoad,
+	SYSZ_INS_LFH:      CategoryLoad,
+	SYSZ_INS_LG:       CategoryLoad,
+	SYSZ_INS_LGB:      CategoryLoad,
+	SYSZ_INS_LGBR:     CategoryMove,
+	SYSZ_INS_LGDR:     CategoryMove,
+	SYSZ_INS_LGF:      CategoryLoad,
+	SYSZ_INS_LGFI:     CategoryMove,
+	SYSZ_INS_LGFR:     CategoryMove,
+	SYSZ_INS_LGFRL:    CategoryLoad,
+	SYSZ_INS_LGH:      CategoryLoad,
+	SYSZ_INS_LGHI:     CategoryMove,
+	SYSZ_INS_LGHR:     CategoryMove,
+	SYSZ_INS_LGHRL:    CategoryLoad,
+	SYSZ_INS_LGR:      CategoryMove,
+	SYSZ_INS_LGRL:     CategoryLoad,
+	SYSZ_INS_LH:       CategoryLoad,
+	SYSZ_INS_LHH:      CategoryLoad,
+	SYSZ_INS_LHI:      CategoryMove,
+	SYSZ_INS_LHR:      CategoryMove,
+	SYSZ_INS_LHRL:     CategoryLoad,
+	SYSZ_INS_LHY:      CategoryLoad,
+	SYSZ_INS_LLC:      CategoryLoad,
+	SYSZ_INS_LLCH:     CategoryLoad,
+	SYSZ_INS_LLCR:     CategoryMove,
+	SYSZ_INS_LLGC:     CategoryLoad,
+	SYSZ_INS_LLGCR:    CategoryMove,
+	SYSZ_INS_LLGF:     CategoryLoad,
+	SYSZ_INS_LLGFR:    CategoryMove,
+	SYSZ_INS_LLGFRL:   CategoryLoad,
+	SYSZ_INS_LLGH:     CategoryLoad,
+	SYSZ_INS_LLGHR:    CategoryMove,
+	SYSZ_INS_LLGHRL:   CategoryLoad,
+	SYSZ_INS_LLH:      CategoryLoad,
+	SYSZ_INS_LLHH:     CategoryLoad,
+	SYSZ_INS_LLHR:     CategoryMove,
+	SYSZ_INS_LLHRL:    CategoryLoad,
+	SYSZ_INS_LLIHF:    CategoryMove,
+	SYSZ_INS_LLIHH:    CategoryMove,
+	SYSZ_INS_LLIHL:    CategoryMove,
+	SYSZ_INS_LLILF:    CategoryMove,
+	SYSZ_INS_LLILH:    CategoryMove,
+	SYSZ_INS_LLILL:    CategoryMove,
+	SYSZ_INS_LMG:      CategoryLoad,
+	SYSZ_INS_LNDBR:    CategoryFloat,
+	SYSZ_INS_LNEBR:    CategoryFloat,
+	SYSZ_INS_LNGFR:    CategoryArithmetic,
+	SYSZ_INS_LNGR:     CategoryArithmetic,
+	SYSZ_INS_LNR:      CategoryArithmetic,
+	SYSZ_INS_LNXBR:    CategoryFloat,
+	SYSZ_INS_LPDBR:    CategoryFloat,
+	SYSZ_INS_LPEBR:    CategoryFloat,
+	SYSZ_INS_LPGFR:    CategoryArithmetic,
+	SYSZ_INS_LPGR:     CategoryArithmetic,
+	SYSZ_INS_LPR:      CategoryArithmetic,
+	SYSZ_INS_LPXBR:    CategoryFloat,
+	SYSZ_INS_LR:       CategoryMove,
+	SYSZ_INS_LRL:      CategoryLoad,
+	SYSZ_INS_LRV:      CategoryLoad,
+	SYSZ_INS_LRVG:     CategoryLoad,
+	SYSZ_INS_LRVGR:    CategoryMove,
+	SYSZ_INS_LRVR:     CategoryMove,
+	SYSZ_INS_LT:       CategoryLoad,
+	SYSZ_INS_LTDBR:    CategoryFloat,
+	SYSZ_INS_LTEBR:    CategoryFloat,
+	SYSZ_INS_LTG:      CategoryLoad,
+	SYSZ_INS_LTGF:     CategoryLoad,
+	SYSZ_INS_LTGFR:    CategoryCompare,
+	SYSZ_INS_LTGR:     CategoryCompare,
+	SYSZ_INS_LTR:      CategoryCompare,
+	SYSZ_INS_LTXBR:    CategoryFloat,
+	SYSZ_INS_LXDB:     CategoryFloat,
+	SYSZ_INS_LXDBR:    CategoryFloat,
+	SYSZ_INS_LXEB:     CategoryFloat,
+	SYSZ_INS_LXEBR:    CategoryFloat,
+	SYSZ_INS_LXR:      CategoryMove,
+	SYSZ_INS_LY:       CategoryLoad,
+	SYSZ_INS_LZDR:     CategoryMove,
+	SYSZ_INS_LZER:     CategoryMove,
+	SYSZ_INS_LZXR:     CategoryMove,
+	SYSZ_INS_MADB:     CategoryFloat,
+	SYSZ_INS_MADBR:    CategoryFloat,
+	SYSZ_INS_MAEB:     CategoryFloat,
+	SYSZ_INS_MAEBR:    CategoryFloat,
+	SYSZ_INS_MDB:      CategoryFloat,
+	SYSZ_INS_MDBR:     CategoryFloat,
+	SYSZ_INS_MDEB:     CategoryFloat,
+	SYSZ_INS_MDEBR:    CategoryFloat,
+	SYSZ_INS_MEEB:     CategoryFloat,
+	SYSZ_INS_MEEBR:    CategoryFloat,
+	SYSZ_INS_MGHI:     CategoryArithmetic,
+	SYSZ_INS_MH:       CategoryArithmetic,
+	SYSZ_INS_MHI:      CategoryArithmetic,
+	SYSZ_INS_MHY:      CategoryArithmetic,
+	SYSZ_INS_MLG:      CategoryArithmetic,
+	SYSZ_INS_MLGR:     CategoryArithmetic,
+	SYSZ_INS_MS:       CategoryArithmetic,
+	SYSZ_INS_MSDB:     CategoryFloat,
+	SYSZ_INS_MSDBR:    CategoryFloat,
+	SYSZ_INS_MSEB:     CategoryFloat,
+	SYSZ_INS_MSEBR:    CategoryFloat,
+	SYSZ_INS_MSFI:     CategoryArithmetic,
+	SYSZ_INS_MSG:      CategoryArithmetic,
+	SYSZ_INS_MSGF:     CategoryArithmetic,
+	SYSZ_INS_MSGFI:    CategoryArithmetic,
+	SYSZ_INS_MSGFR:    CategoryArithmetic,
+	SYSZ_INS_MSGR:     CategoryArithmetic,
+	SYSZ_INS_MSR:      CategoryArithmetic,
+	SYSZ_INS_MSY:      CategoryArithmetic,
+	SYSZ_INS_MVC:      CategoryMove,
+	SYSZ_INS_MVGHI:    CategoryStore,
+	SYSZ_INS_MVHHI:    CategoryStore,
+	SYSZ_INS_MVHI:     CategoryStore,
+	SYSZ_INS_MVI:      CategoryStore,
+	SYSZ_INS_MVIY:     CategoryStore,
+	SYSZ_INS_MVST:     CategoryMove,
+	SYSZ_INS_MXBR:     CategoryFloat,
+	SYSZ_INS_MXDB:     CategoryFloat,
+	SYSZ_INS_MXDBR:    CategoryFloat,
+	SYSZ_INS_N:        CategoryLogic,
+	SYSZ_INS_NC:       CategoryLogic,
+	SYSZ_INS_NG:       CategoryLogic,
+	SYSZ_INS_NGR:      CategoryLogic,
+	SYSZ_INS_NGRK:     CategoryLogic,
+	SYSZ_INS_NI:       CategoryLogic,
+	SYSZ_INS_NIHF:     CategoryLogic,
+	SYSZ_INS_NIHH:     CategoryLogic,
+	SYSZ_INS_NIHL:     CategoryLogic,
+	SYSZ_INS_NILF:     CategoryLogic,
+	SYSZ_INS_NILH:     CategoryLogic,
+	SYSZ_INS_NILL:     CategoryLogic,
+	SYSZ_INS_NIY:      CategoryLogic,
+	SYSZ_INS_NR:       CategoryLogic,
+	SYSZ_INS_NRK:      CategoryLogic,
+	SYSZ_INS_NY:       CategoryLogic,
+	SYSZ_INS_O:        CategoryLogic,
+	SYSZ_INS_OC:       CategoryLogic,
+	SYSZ_INS_OG:       CategoryLogic,
+	SYSZ_INS_OGR:      CategoryLogic,
+	SYSZ_INS_OGRK:     CategoryLogic,
+	SYSZ_INS_OI:       CategoryLogic,
+	SYSZ_INS_OIHF:     CategoryLogic,
+	SYSZ_INS_OIHH:     CategoryLogic,
+	SYSZ_INS_OIHL:     CategoryLogic,
+	SYSZ_INS_OILF:     CategoryLogic,
+	SYSZ_INS_OILH:     CategoryLogic,
+	SYSZ_INS_OILL:     CategoryLogic,
+	SYSZ_INS_OIY:      CategoryLogic,
+	SYSZ_INS_OR:       CategoryLogic,
+	SYSZ_INS_ORK:      CategoryLogic,
+	SYSZ_INS_OY:       CategoryLogic,
+	SYSZ_INS_PFD:      CategorySystem,
+	SYSZ_INS_PFDRL:    CategorySystem,
+	SYSZ_INS_RISBG:    CategoryShift,
+	SYSZ_INS_RISBHG:   CategoryShift,
+	SYSZ_INS_RISBLG:   CategoryShift,
+	SYSZ_INS_RLL:      CategoryShift,
+	SYSZ_INS_RLLG:     CategoryShift,
+	SYSZ_INS_RNSBG:    CategoryShift,
+	SYSZ_INS_ROSBG:    CategoryShift,
+	SYSZ_INS_RXSBG:    CategoryShift,
+	SYSZ_INS_S:        CategoryArithmetic,
+	SYSZ_INS_SDB:      CategoryFloat,
+	SYSZ_INS_SDBR:     CategoryFloat,
+	SYSZ_INS_SEB:      CategoryFloat,
+	SYSZ_INS_SEBR:     CategoryFloat,
+	SYSZ_INS_SG:       CategoryArithmetic,
+	SYSZ_INS_SGF:      CategoryArithmetic,
+	SYSZ_INS_SGFR:     CategoryArithmetic,
+	SYSZ_INS_SGR:      CategoryArithmetic,
+	SYSZ_INS_SGRK:     CategoryArithmetic,
+	SYSZ_INS_SH:       CategoryArithmetic,
+	SYSZ_INS_SHY:      CategoryArithmetic,
+	SYSZ_INS_SL:       CategoryArithmetic,
+	SYSZ_INS_SLB:      CategoryArithmetic,
+	SYSZ_INS_SLBG:     CategoryArithmetic,
+	SYSZ_INS_SLBR:     CategoryArithmetic,
+	SYSZ_INS_SLFI:     CategoryArithmetic,
+	SYSZ_INS_SLG:      CategoryArithmetic,
+	SYSZ_INS_SLBGR:    CategoryArithmetic,
+	SYSZ_INS_SLGF:     CategoryArithmetic,
+	SYSZ_INS_SLGFI:    CategoryArithmetic,
+	SYSZ_INS_SLGFR:    CategoryArithmetic,
+	SYSZ_INS_SLGR:     CategoryArithmetic,
+	SYSZ_INS_SLGRK:    CategoryArithmetic,
+	SYSZ_INS_SLL:      CategoryShift,
+	SYSZ_INS_SLLG:     CategoryShift,
+	SYSZ_INS_SLLK:     CategoryShift,
+	SYSZ_INS_SLR:      CategoryArithmetic,
+	SYSZ_INS_SLRK:     CategoryArithmetic,
+	SYSZ_INS_SLY:      CategoryArithmetic,
+	SYSZ_INS_SQDB:     CategoryFloat,
+	SYSZ_INS_SQDBR:    CategoryFloat,
+	SYSZ_INS_SQEB:     CategoryFloat,
+	SYSZ_INS_SQEBR:    CategoryFloat,
+	SYSZ_INS_SQXBR:    CategoryFloat,
+	SYSZ_INS_SR:       CategoryArithmetic,
+	SYSZ_INS_SRA:      CategoryShift,
+	SYSZ_INS_SRAG:     CategoryShift,
+	SYSZ_INS_SRAK:     CategoryShift,
+	SYSZ_INS_SRK:      CategoryArithmetic,
+	SYSZ_INS_SRL:      CategoryShift,
+	SYSZ_INS_SRLG:     CategoryShift,
+	SYSZ_INS_SRLK:     CategoryShift,
+	SYSZ_INS_SRST:     CategoryCompare,
+	SYSZ_INS_ST:       CategoryStore,
+	SYSZ_INS_STC:      CategoryStore,
+	SYSZ_INS_STCH:     CategoryStore,
+	SYSZ_INS_STCY:     CategoryStore,
+	SYSZ_INS_STD:      CategoryStore,
+	SYSZ_INS_STDY:     CategoryStore,
+	SYSZ_INS_STE:      CategoryStore,
+	SYSZ_INS_STEY:     CategoryStore,
+	SYSZ_INS_STFH:     CategoryStore,
+	SYSZ_INS_STG:      CategoryStore,
+	SYSZ_INS_STGRL:    CategoryStore,
+	SYSZ_INS_STH:      CategoryStore,
+	SYSZ_INS_STHH:     CategoryStore,
+	SYSZ_INS_STHRL:    CategoryStore,
+	SYSZ_INS_STHY:     CategoryStore,
+	SYSZ_INS_STMG:     CategoryStore,
+	SYSZ_INS_STRL:     CategoryStore,
+	SYSZ_INS_STRV:     CategoryStore,
+	SYSZ_INS_STRVG:    CategoryStore,
+	SYSZ_INS_STY:      CategoryStore,
+	SYSZ_INS_SXBR:     CategoryFloat,
+	SYSZ_INS_SY:       CategoryArithmetic,
+	SYSZ_INS_TM:       CategoryCompare,
+	SYSZ_INS_TMHH:     CategoryCompare,
+	SYSZ_INS_TMHL:     CategoryCompare,
+	SYSZ_INS_TMLH:     CategoryCompare,
+	SYSZ_INS_TMLL:     CategoryCompare,
+	SYSZ_INS_TMY:      CategoryCompare,
+	SYSZ_INS_X:        CategoryLogic,
+	SYSZ_INS_XC:       CategoryLogic,
+	SYSZ_INS_XG:       CategoryLogic,
+	SYSZ_INS_XGR:      CategoryLogic,
+	SYSZ_INS_XGRK:     CategoryLogic,
+	SYSZ_INS_XI:       CategoryLogic,
+	SYSZ_INS_XIHF:     CategoryLogic,
+	SYSZ_INS_XILF:     CategoryLogic,
+	SYSZ_INS_XIY:      CategoryLogic,
+	SYSZ_INS_XR:       CategoryLogic,
+	SYSZ_INS_XRK:      CategoryLogic,
+	SYSZ_INS_XY:       CategoryLogic,
+}
+
+var xcoreCategories = map[uint]Category{
+	XCORE_INS_ADD:     CategoryArithmetic,
+	XCORE_INS_ANDNOT:  CategoryLogic,
+	XCORE_INS_AND:     CategoryLogic,
+	XCORE_INS_ASHR:    CategoryShift,
+	XCORE_INS_BAU:     CategoryBranch,
+	XCORE_INS_BITREV:  CategoryLogic,
+	XCORE_INS_BLA:     CategoryCall,
+	XCORE_INS_BLAT:    CategoryCall,
+	XCORE_INS_BL:      CategoryCall,
+	XCORE_INS_BF:      CategoryBranch,
+	XCORE_INS_BT:      CategoryBranch,
+	XCORE_INS_BU:      CategoryBranch,
+	XCORE_INS_BRU:     CategoryBranch,
+	XCORE_INS_BYTEREV: CategoryMove,
+	XCORE_INS_CHKCT:   CategorySystem,
+	XCORE_INS_CLRE:    CategorySystem,
+	XCORE_INS_CLRPT:   CategorySystem,
+	XCORE_INS_CLRSR:   CategorySystem,
+	XCORE_INS_CLZ:     CategoryLogic,
+	XCORE_INS_CRC8:    CategoryArithmetic,
+	XCORE_INS_CRC32:   CategoryArithmetic,
+	XCORE_INS_DCALL:   CategorySystem,
+	XCORE_INS_DENTSP:  CategoryStack,
+	XCORE_INS_DGETREG: CategorySystem,
+	XCORE_INS_DIVS:    CategoryArithmetic,
+	XCORE_INS_DIVU:    CategoryArithmetic,
+	XCORE_INS_DRESTSP: CategoryStack,
+	XCORE_INS_DRET:    CategoryReturn,
+	XCORE_INS_ECALLF:  CategorySystem,
+	XCORE_INS_ECALLT:  CategorySystem,
+	XCORE_INS_EDU:     CategorySystem,
+	XCORE_INS_EEF:     CategorySystem,
+	XCORE_INS_EET:     CategorySystem,
+	XCORE_INS_EEU:     CategorySystem,
+	XCORE_INS_ENDIN:   CategorySystem,
+	XCORE_INS_ENTSP:   CategoryStack,
+	XCORE_INS_EQ:      CategoryCompare,
+	XCORE_INS_EXTDP:   CategoryStack,
+	XCORE_INS_EXTSP:   CategoryStack,
+	XCORE_INS_FREER:   CategorySystem,
+	XCORE_INS_FREET:   CategorySystem,
+	XCORE_INS_GETD:    CategorySystem,
+	XCORE_INS_GET:     CategorySystem,
+	XCORE_INS_GETN:    CategorySystem,
+	XCORE_INS_GETR:    CategorySystem,
+	XCORE_INS_GETSR:   CategorySystem,
+	XCORE_INS_GETST:   CategorySystem,
+	XCORE_INS_GETTS:   CategorySystem,
+	XCORE_INS_INCT:    CategorySystem,
+	XCORE_INS_INIT:    CategorySystem,
+	XCORE_INS_INPW:    CategorySystem,
+	XCORE_INS_INSHR:   CategorySystem,
+	XCORE_INS_INT:     CategorySystem,
+	XCORE_INS_IN:      CategorySystem,
+	XCORE_INS_KCALL:   CategorySystem,
+	XCORE_INS_KENTSP:  CategoryStack,
+	XCORE_INS_KRESTSP: CategoryStack,
+	XCORE_INS_KRET:    CategoryReturn,
+	XCORE_INS_LADD:    CategoryArithmetic,
+	XCORE_INS_LD16S:   CategoryLoad,
+	XCORE_INS_LD8U:    CategoryLoad,
+	XCORE_INS_LDA16:   CategoryArithmetic,
+	XCORE_INS_LDAP:    CategoryArithmetic,
+	XCORE_INS_LDAW:    CategoryArithmetic,
+	XCORE_INS_LDC:     CategoryMove,
+	XCORE_INS_LDW:     CategoryLoad,
+	XCORE_INS_LDIVU:   CategoryArithmetic,
+	XCORE_INS_LMUL:    CategoryArithmetic,
+	XCORE_INS_LSS:     CategoryCompare,
+	XCORE_INS_LSUB:    CategoryArithmetic,
+	XCORE_INS_LSU:     CategoryCompare,
+	XCORE_INS_MACCS:   CategoryArithmetic,
+	XCORE_INS_MACCU:   CategoryArithmetic,
+	XCORE_INS_MJOIN:   CategorySystem,
+	XCORE_INS_MKMSK:   CategoryLogic,
+	XCORE_INS_MSYNC:   CategorySystem,
+	XCORE_INS_MUL:     CategoryArithmetic,
+	XCORE_INS_NEG:     CategoryArithmetic,
+	XCORE_INS_NOT:     CategoryLogic,
+	XCORE_INS_OR:      CategoryLogic,
+	XCORE_INS_OUTCT:   CategorySystem,
+	XCORE_INS_OUTPW:   CategorySystem,
+	XCORE_INS_OUTSHR:  CategorySystem,
+	XCORE_INS_OUTT:    CategorySystem,
+	XCORE_INS_OUT:     CategorySystem,
+	XCORE_INS_PEEK:    CategorySystem,
+	XCORE_INS_REMS:    CategoryArithmetic,
+	XCORE_INS_REMU:    CategoryArithmetic,
+	XCORE_INS_RETSP:   CategoryReturn,
+	XCORE_INS_SETCLK:  CategorySystem,
+	XCORE_INS_SET:     CategorySystem,
+	XCORE_INS_SETC:    CategorySystem,
+	XCORE_INS_SETD:    CategorySystem,
+	XCORE_INS_SETEV:   CategorySystem,
+	XCORE_INS_SETN:    CategorySystem,
+	XCORE_INS_SETPSC:  CategorySystem,
+	XCORE_INS_SETPT:   CategorySystem,
+	XCORE_INS_SETRDY:  CategorySystem,
+	XCORE_INS_SETSR:   CategorySystem,
+	XCORE_INS_SETTW:   CategorySystem,
+	XCORE_INS_SETV:    CategorySystem,
+	XCORE_INS_SEXT:    CategoryMove,
+	XCORE_INS_SHL:     CategoryShift,
+	XCORE_INS_SHR:     CategoryShift,
+	XCORE_INS_SSYNC:   CategorySystem,
+	XCORE_INS_ST16:    CategoryStore,
+	XCORE_INS_ST8:     CategoryStore,
+	XCORE_INS_STW:     CategoryStore,
+	XCORE_INS_SUB:     CategoryArithmetic,
+	XCORE_INS_SYNCR:   CategorySystem,
+	XCORE_INS_TESTCT:  CategorySystem,
+	XCORE_INS_TESTLCL: CategorySystem,
+	XCORE_INS_TESTWCT: CategorySystem,
+	XCORE_INS_TSETMR:  CategorySystem,
+	XCORE_INS_START:   CategorySystem,
+	XCORE_INS_WAITEF:  CategorySystem,
+	XCORE_INS_WAITET:  CategorySystem,
+	XCORE_INS_WAITEU:  CategorySystem,
+	XCORE_INS_XOR:     CategoryLogic,
+	XCORE_INS_ZEXT:    CategoryMove,
+}
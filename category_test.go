@@ -0,0 +1,112 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+func TestInsnCategory(t *testing.T) {
+	tests := []struct {
+		arch int
+		id   uint
+		want Category
+	}{
+		{CS_ARCH_X86, X86_INS_MOV, CategoryMove},
+		{CS_ARCH_X86, X86_INS_LEA, CategoryArithmetic},
+		{CS_ARCH_X86, X86_INS_SHRD, CategoryShift},
+		{CS_ARCH_X86, X86_INS_JNE, CategoryBranch},
+		{CS_ARCH_X86, X86_INS_PUSH, CategoryStack},
+		{CS_ARCH_X86, X86_INS_POPCNT, CategoryLogic},
+		{CS_ARCH_X86, X86_INS_ADDSD, CategoryFloat},
+		{CS_ARCH_X86, X86_INS_VPMAXSD, CategoryVector},
+		{CS_ARCH_X86, X86_INS_AESENC, CategoryCrypto},
+		{CS_ARCH_X86, X86_INS_CMPXCHG, CategoryAtomic},
+		{CS_ARCH_X86, X86_INS_SYSCALL, CategorySystem},
+		{CS_ARCH_X86, X86_INS_INVALID, CategoryUnknown},
+		{CS_ARCH_ARM, ARM_INS_LDREX, CategoryAtomic},
+		{CS_ARCH_ARM, ARM_INS_LDRB, CategoryLoad},
+		{CS_ARCH_ARM, ARM_INS_VADD, CategoryFloat},
+		{CS_ARCH_ARM, ARM_INS_VLD1, CategoryVector},
+		{CS_ARCH_ARM, ARM_INS_UADD8, CategoryVector},
+		{CS_ARCH_ARM, ARM_INS_IT, CategorySystem},
+		{CS_ARCH_ARM64, ARM64_INS_STP, CategoryStore},
+		{CS_ARCH_ARM64, ARM64_INS_CSEL, CategoryMove},
+		{CS_ARCH_ARM64, ARM64_INS_FCMP, CategoryFloat},
+		{CS_ARCH_ARM64, ARM64_INS_FADDP, CategoryVector},
+		{CS_ARCH_ARM64, ARM64_INS_SHA256H, CategoryCrypto},
+		{CS_ARCH_MIPS, MIPS_INS_JAL, CategoryCall},
+		{CS_ARCH_MIPS, MIPS_INS_SLTIU, CategoryCompare},
+		{CS_ARCH_MIPS, MIPS_INS_BNEG, CategoryVector},
+		{CS_ARCH_PPC, PPC_INS_BLR, CategoryReturn},
+		{CS_ARCH_PPC, PPC_INS_BCTRL, CategoryCall},
+		{CS_ARCH_PPC, PPC_INS_RLWINM, CategoryShift},
+		{CS_ARCH_SPARC, SPARC_INS_SAVE, CategoryStack},
+		{CS_ARCH_SPARC, SPARC_INS_FPADD16, CategoryVector},
+		{CS_ARCH_SYSZ, SYSZ_INS_CGIJ, CategoryBranch},
+		{CS_ARCH_SYSZ, SYSZ_INS_LGR, CategoryMove},
+		{CS_ARCH_SYSZ, SYSZ_INS_LAA, CategoryAtomic},
+		{CS_ARCH_XCORE, XCORE_INS_RETSP, CategoryReturn},
+		{CS_ARCH_XCORE, XCORE_INS_LDW, CategoryLoad},
+	}
+
+	for i, test := range tests {
+		if got := InsnCategory(test.arch, test.id); got != test.want {
+			t.Errorf("%2d> arch %d id %d: want %v got %v", i, test.arch, test.id, test.want, got)
+		}
+	}
+}
+
+func TestCategoryTables(t *testing.T) {
+	tables := map[string]map[uint]Category{
+		"x86": x86Categories, "arm": armCategories, "arm64": arm64Categories,
+		"mips": mipsCategories, "ppc": ppcCategories, "sparc": sparcCategories,
+		"sysz": syszCategories, "xcore": xcoreCategories,
+	}
+	for name, table := range tables {
+		for id, c := range table {
+			if c <= CategoryUnknown || c > CategoryNop {
+				t.Errorf("%s: id %d has bad category %d", name, id, c)
+			}
+		}
+	}
+}
+
+func TestCategoryRefine(t *testing.T) {
+	reg := X86Operand{Type: X86_OP_REG, Reg: X86_REG_RAX, Size: 8}
+	mem := X86Operand{Type: X86_OP_MEM, Mem: X86MemoryOperand{Base: X86_REG_RSP, Scale: 1}, Size: 8}
+
+	// mov rax, [rsp]
+	insn := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_MOV, 0x1000, "\x48\x8b\x04\x24")
+	insn.X86 = &X86Instruction{Operands: []X86Operand{reg, mem}}
+	if got := insn.Category(); got != CategoryLoad {
+		t.Errorf("mov rax, [rsp]: want %v got %v", CategoryLoad, got)
+	}
+
+	// mov [rsp], rax
+	insn = bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_MOV, 0x1000, "\x48\x89\x04\x24")
+	insn.X86 = &X86Instruction{Operands: []X86Operand{mem, reg}}
+	if got := insn.Category(); got != CategoryStore {
+		t.Errorf("mov [rsp], rax: want %v got %v", CategoryStore, got)
+	}
+
+	// mov pc, lr
+	insn = bareInsn(CS_ARCH_ARM, CS_MODE_ARM, ARM_INS_MOV, 0x1000, "\x0e\xf0\xa0\xe1")
+	insn.Arm = &ArmInstruction{Operands: []ArmOperand{
+		{Type: ARM_OP_REG, Reg: ARM_REG_PC},
+		{Type: ARM_OP_REG, Reg: ARM_REG_LR},
+	}}
+	if got := insn.Category(); got != CategoryReturn {
+		t.Errorf("mov pc, lr: want %v got %v", CategoryReturn, got)
+	}
+
+	if got := Category(99).String(); got != "unknown" {
+		t.Errorf("Want unknown got %v", got)
+	}
+}
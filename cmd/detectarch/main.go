@@ -0,0 +1,83 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+// Guess the architecture and mode of a code blob with gapstone.DetectArch.
+//
+//	detectarch [-n 5] [-offset N] [-x] file
+//
+// Reads stdin if file is "-". With -x the input is hex text, eg shellcode
+// pasted as "31c050682f2f7368", and whitespace and \x escapes are ignored.
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+var (
+	top    = flag.Int("n", 5, "candidates to show, 0 for all")
+	offset = flag.Int("offset", 0, "skip this many bytes of input")
+	isHex  = flag.Bool("x", false, "input is hex text")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("detectarch: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: detectarch [flags] file\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var buf []byte
+	var err error
+	if name := flag.Arg(0); name == "-" {
+		buf, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		buf, err = ioutil.ReadFile(name)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *isHex {
+		text := strings.Join(strings.Fields(string(buf)), "")
+		text = strings.Replace(strings.Replace(text, `\x`, "", -1), "0x", "", -1)
+		if buf, err = hex.DecodeString(text); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *offset < 0 || *offset > len(buf) {
+		log.Fatalf("offset %d outside %d byte input", *offset, len(buf))
+	}
+
+	cands, err := gapstone.DetectArch(buf[*offset:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *top > 0 && len(cands) > *top {
+		cands = cands[:*top]
+	}
+	fmt.Printf("%-26s %6s %8s %8s %7s %7s %9s\n", "ARCH", "SCORE", "COVERAGE", "INVALID", "TOP10", "RETURNS", "PROLOGUES")
+	for _, c := range cands {
+		fmt.Printf("%-26s %6.3f %7.1f%% %7.1f%% %6.1f%% %7d %9d\n",
+			c.Name, c.Score, 100*c.Coverage, 100*c.Invalid, 100*c.TopTen, c.Returns, c.Prologues)
+	}
+}
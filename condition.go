@@ -0,0 +1,548 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "strings"
+
+// An architecture independent condition. Integer conditions are named for
+// the comparison they test after a compare / subtract, so CondULT is x86 jb
+// (carry set) but ARM blo (carry clear) - the arches disagree about the sense
+// of the borrow. PPC and SysZ keep signedness in the compare instruction, so
+// their conditions map to the signed forms.
+//
+// Compare-and-branch instructions (MIPS beq / bgez, ARM64 cbz, x86 jecxz)
+// test registers instead of flags; their Condition describes the relation
+// between the operands, or the operand and zero.
+type Condition int
+
+const (
+	CondUnknown    Condition = iota // no detail, or not expressible (PPC bdnzt, x86 loope)
+	CondAlways                      // unconditional
+	CondNever                       // SPARC bn, fbn
+	CondEQ                          // equal / zero
+	CondNE                          // not equal / non-zero (for floats: or unordered)
+	CondLT                          // signed less than
+	CondGE                          // signed greater or equal
+	CondLE                          // signed less or equal
+	CondGT                          // signed greater than
+	CondULT                         // unsigned lower
+	CondUGE                         // unsigned higher or same
+	CondULE                         // unsigned lower or same
+	CondUGT                         // unsigned higher
+	CondNeg                         // negative / sign set
+	CondPos                         // positive or zero / sign clear
+	CondOverflow                    // signed overflow (PPC summary overflow, SysZ CC 3)
+	CondNoOverflow                  // no signed overflow
+	CondParity                      // x86 parity even - unordered after ucomis*
+	CondNoParity                    // x86 parity odd
+	CondFUnordered                  // floating point compare unordered (a NaN was involved)
+	CondFOrdered                    // floating point compare ordered
+	CondFLT                         // ordered and less than
+	CondFUGE                        // unordered or greater or equal
+	CondFLE                         // ordered and less or equal
+	CondFUGT                        // unordered or greater than
+	CondFGT                         // ordered and greater than
+	CondFULE                        // unordered or less or equal
+	CondFGE                         // ordered and greater or equal
+	CondFULT                        // unordered or less than
+	CondFLG                         // ordered and not equal
+	CondFUEQ                        // unordered or equal
+)
+
+var conditionNames = [...]string{
+	CondUnknown:    "unknown",
+	CondAlways:     "al",
+	CondNever:      "nv",
+	CondEQ:         "eq",
+	CondNE:         "ne",
+	CondLT:         "lt",
+	CondGE:         "ge",
+	CondLE:         "le",
+	CondGT:         "gt",
+	CondULT:        "ult",
+	CondUGE:        "uge",
+	CondULE:        "ule",
+	CondUGT:        "ugt",
+	CondNeg:        "neg",
+	CondPos:        "pos",
+	CondOverflow:   "ov",
+	CondNoOverflow: "nov",
+	CondParity:     "p",
+	CondNoParity:   "np",
+	CondFUnordered: "funord",
+	CondFOrdered:   "ford",
+	CondFLT:        "flt",
+	CondFUGE:       "fuge",
+	CondFLE:        "fle",
+	CondFUGT:       "fugt",
+	CondFGT:        "fgt",
+	CondFULE:       "fule",
+	CondFGE:        "fge",
+	CondFULT:       "fult",
+	CondFLG:        "flg",
+	CondFUEQ:       "fueq",
+}
+
+func (c Condition) String() string {
+	if c >= 0 && int(c) < len(conditionNames) {
+		return conditionNames[c]
+	}
+	return "unknown"
+}
+
+var conditionInverses = [...]Condition{
+	CondUnknown:    CondUnknown,
+	CondAlways:     CondNever,
+	CondNever:      CondAlways,
+	CondEQ:         CondNE,
+	CondNE:         CondEQ,
+	CondLT:         CondGE,
+	CondGE:         CondLT,
+	CondLE:         CondGT,
+	CondGT:         CondLE,
+	CondULT:        CondUGE,
+	CondUGE:        CondULT,
+	CondULE:        CondUGT,
+	CondUGT:        CondULE,
+	CondNeg:        CondPos,
+	CondPos:        CondNeg,
+	CondOverflow:   CondNoOverflow,
+	CondNoOverflow: CondOverflow,
+	CondParity:     CondNoParity,
+	CondNoParity:   CondParity,
+	CondFUnordered: CondFOrdered,
+	CondFOrdered:   CondFUnordered,
+	CondFLT:        CondFUGE,
+	CondFUGE:       CondFLT,
+	CondFLE:        CondFUGT,
+	CondFUGT:       CondFLE,
+	CondFGT:        CondFULE,
+	CondFULE:       CondFGT,
+	CondFGE:        CondFULT,
+	CondFULT:       CondFGE,
+	CondFLG:        CondFUEQ,
+	CondFUEQ:       CondFLG,
+}
+
+// The condition which holds exactly when c does not - the not taken side of
+// a conditional branch. CondUnknown inverts to itself.
+func (c Condition) Invert() Condition {
+	if c >= 0 && int(c) < len(conditionInverses) {
+		return conditionInverses[c]
+	}
+	return CondUnknown
+}
+
+// Status flags, in x86 / ARM terms. PPC CR fields and the SysZ condition
+// code are modelled as their equivalents.
+type CondFlags uint8
+
+const (
+	FlagZero     CondFlags = 1 << iota // x86 ZF, ARM Z
+	FlagCarry                          // x86 CF, ARM C
+	FlagSign                           // x86 SF, ARM N
+	FlagOverflow                       // x86 OF, ARM V
+	FlagParity                         // x86 PF
+	FlagFloat                          // floating point compare result, including unordered
+)
+
+var condFlagNames = []string{"z", "c", "s", "o", "p", "f"}
+
+func (f CondFlags) String() string {
+	var names []string
+	for i, name := range condFlagNames {
+		if f&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+var conditionFlags = [...]CondFlags{
+	CondEQ:         FlagZero,
+	CondNE:         FlagZero,
+	CondLT:         FlagSign | FlagOverflow,
+	CondGE:         FlagSign | FlagOverflow,
+	CondLE:         FlagZero | FlagSign | FlagOverflow,
+	CondGT:         FlagZero | FlagSign | FlagOverflow,
+	CondULT:        FlagCarry,
+	CondUGE:        FlagCarry,
+	CondULE:        FlagCarry | FlagZero,
+	CondUGT:        FlagCarry | FlagZero,
+	CondNeg:        FlagSign,
+	CondPos:        FlagSign,
+	CondOverflow:   FlagOverflow,
+	CondNoOverflow: FlagOverflow,
+	CondParity:     FlagParity,
+	CondNoParity:   FlagParity,
+	CondFUnordered: FlagFloat,
+	CondFOrdered:   FlagFloat,
+	CondFLT:        FlagFloat,
+	CondFUGE:       FlagFloat,
+	CondFLE:        FlagFloat,
+	CondFUGT:       FlagFloat,
+	CondFGT:        FlagFloat,
+	CondFULE:       FlagFloat,
+	CondFGE:        FlagFloat,
+	CondFULT:       FlagFloat,
+	CondFLG:        FlagFloat,
+	CondFUEQ:       FlagFloat,
+}
+
+// The flags a flag based evaluation of c reads. Always, Never and Unknown
+// read none.
+func (c Condition) Flags() CondFlags {
+	if c >= 0 && int(c) < len(conditionFlags) {
+		return conditionFlags[c]
+	}
+	return 0
+}
+
+// The condition under which this Instruction takes effect - for branches,
+// the condition for the branch to be taken. Unconditional instructions return
+// CondAlways. x86, MIPS and ARM64 cbz / tbz conditions come from the
+// instruction id; the rest need CS_OPT_DETAIL (except A32 ARM, which is
+// decoded from the encoding) and return CondUnknown without it.
+func (insn Instruction) Condition() Condition {
+	c := CondAlways
+	switch insn.archOf() {
+	case CS_ARCH_X86:
+		if cond, ok := x86Conditions[insn.Id]; ok {
+			c = cond
+		}
+	case CS_ARCH_MIPS:
+		if cond, ok := mipsConditions[insn.Id]; ok {
+			c = cond
+		}
+	case CS_ARCH_ARM:
+		c = armInsnCondition(&insn)
+	case CS_ARCH_ARM64:
+		switch insn.Id {
+		case ARM64_INS_CBZ, ARM64_INS_TBZ:
+			return CondEQ
+		case ARM64_INS_CBNZ, ARM64_INS_TBNZ:
+			return CondNE
+		}
+		if insn.Arm64 == nil {
+			return CondUnknown
+		}
+		c = Arm64Condition(insn.Arm64.CC)
+	case CS_ARCH_PPC:
+		if insn.PPC == nil {
+			return CondUnknown
+		}
+		if ppcCounterBranches[insn.Id] {
+			return CondUnknown
+		}
+		c = PPCCondition(insn.PPC.BC)
+	case CS_ARCH_SPARC:
+		if insn.Sparc == nil {
+			return CondUnknown
+		}
+		c = SparcCondition(insn.Sparc.CC)
+	case CS_ARCH_SYSZ:
+		if insn.SysZ == nil {
+			return CondUnknown
+		}
+		c = SysZCondition(insn.SysZ.CC)
+	case CS_ARCH_XCORE:
+		switch insn.Id {
+		case XCORE_INS_BT:
+			c = CondNE
+		case XCORE_INS_BF:
+			c = CondEQ
+		}
+	default:
+		return CondUnknown
+	}
+
+	// Conditional branches the tables can't express, like PPC bc with raw
+	// BO / BI operands
+	if c == CondAlways && insn.Flow().Conditional {
+		return CondUnknown
+	}
+	return c
+}
+
+// X86
+
+var x86Conditions = map[uint]Condition{
+	X86_INS_JA: CondUGT, X86_INS_JAE: CondUGE, X86_INS_JB: CondULT,
+	X86_INS_JBE: CondULE, X86_INS_JE: CondEQ, X86_INS_JNE: CondNE,
+	X86_INS_JG: CondGT, X86_INS_JGE: CondGE, X86_INS_JL: CondLT,
+	X86_INS_JLE: CondLE, X86_INS_JO: CondOverflow, X86_INS_JNO: CondNoOverflow,
+	X86_INS_JP: CondParity, X86_INS_JNP: CondNoParity, X86_INS_JS: CondNeg,
+	X86_INS_JNS: CondPos,
+
+	X86_INS_SETA: CondUGT, X86_INS_SETAE: CondUGE, X86_INS_SETB: CondULT,
+	X86_INS_SETBE: CondULE, X86_INS_SETE: CondEQ, X86_INS_SETNE: CondNE,
+	X86_INS_SETG: CondGT, X86_INS_SETGE: CondGE, X86_INS_SETL: CondLT,
+	X86_INS_SETLE: CondLE, X86_INS_SETO: CondOverflow, X86_INS_SETNO: CondNoOverflow,
+	X86_INS_SETP: CondParity, X86_INS_SETNP: CondNoParity, X86_INS_SETS: CondNeg,
+	X86_INS_SETNS: CondPos,
+
+	X86_INS_CMOVA: CondUGT, X86_INS_CMOVAE: CondUGE, X86_INS_CMOVB: CondULT,
+	X86_INS_CMOVBE: CondULE, X86_INS_CMOVE: CondEQ, X86_INS_CMOVNE: CondNE,
+	X86_INS_CMOVG: CondGT, X86_INS_CMOVGE: CondGE, X86_INS_CMOVL: CondLT,
+	X86_INS_CMOVLE: CondLE, X86_INS_CMOVO: CondOverflow, X86_INS_CMOVNO: CondNoOverflow,
+	X86_INS_CMOVP: CondParity, X86_INS_CMOVNP: CondNoParity, X86_INS_CMOVS: CondNeg,
+	X86_INS_CMOVNS: CondPos,
+
+	X86_INS_FCMOVB: CondULT, X86_INS_FCMOVNB: CondUGE, X86_INS_FCMOVBE: CondULE,
+	X86_INS_FCMOVNBE: CondUGT, X86_INS_FCMOVE: CondEQ, X86_INS_FCMOVNE: CondNE,
+	X86_INS_FCMOVU: CondParity, X86_INS_FCMOVNU: CondNoParity,
+
+	// register tests
+	X86_INS_JCXZ: CondEQ, X86_INS_JECXZ: CondEQ, X86_INS_JRCXZ: CondEQ,
+
+	// decrement and branch
+	X86_INS_LOOP: CondUnknown, X86_INS_LOOPE: CondUnknown, X86_INS_LOOPNE: CondUnknown,
+}
+
+// ARM
+
+var armConditions = map[uint]Condition{
+	ARM_CC_INVALID: CondAlways,
+	ARM_CC_EQ:      CondEQ,
+	ARM_CC_NE:      CondNE,
+	ARM_CC_HS:      CondUGE,
+	ARM_CC_LO:      CondULT,
+	ARM_CC_MI:      CondNeg,
+	ARM_CC_PL:      CondPos,
+	ARM_CC_VS:      CondOverflow,
+	ARM_CC_VC:      CondNoOverflow,
+	ARM_CC_HI:      CondUGT,
+	ARM_CC_LS:      CondULE,
+	ARM_CC_GE:      CondGE,
+	ARM_CC_LT:      CondLT,
+	ARM_CC_GT:      CondGT,
+	ARM_CC_LE:      CondLE,
+	ARM_CC_AL:      CondAlways,
+}
+
+// A32 condition field encodings, 0x0 (eq) to 0xe (al)
+var armCondEncodings = [...]Condition{
+	CondEQ, CondNE, CondUGE, CondULT, CondNeg, CondPos, CondOverflow,
+	CondNoOverflow, CondUGT, CondULE, CondGE, CondLT, CondGT, CondLE, CondAlways,
+}
+
+// Map an ArmInstruction.CC (ARM_CC_*) to a Condition
+func ArmCondition(cc uint) Condition {
+	if c, ok := armConditions[cc]; ok {
+		return c
+	}
+	return CondUnknown
+}
+
+// Without detail the condition can only be read from A32 encodings, and
+// cbz / cbnz are register tests.
+func armInsnCondition(insn *Instruction) Condition {
+	switch insn.Id {
+	case ARM_INS_CBZ:
+		return CondEQ
+	case ARM_INS_CBNZ:
+		return CondNE
+	}
+	if insn.Arm != nil {
+		return ArmCondition(insn.Arm.CC)
+	}
+	if insn.mode&CS_MODE_THUMB != 0 || len(insn.Bytes) != 4 {
+		return CondUnknown
+	}
+	cond := insn.Bytes[3] >> 4
+	if insn.mode&CS_MODE_BIG_ENDIAN != 0 {
+		cond = insn.Bytes[0] >> 4
+	}
+	if int(cond) < len(armCondEncodings) {
+		return armCondEncodings[cond]
+	}
+	// 0xf - unconditional instruction space
+	return CondAlways
+}
+
+// ARM64
+
+var arm64Conditions = map[uint]Condition{
+	ARM64_CC_INVALID: CondAlways,
+	ARM64_CC_EQ:      CondEQ,
+	ARM64_CC_NE:      CondNE,
+	ARM64_CC_HS:      CondUGE,
+	ARM64_CC_LO:      CondULT,
+	ARM64_CC_MI:      CondNeg,
+	ARM64_CC_PL:      CondPos,
+	ARM64_CC_VS:      CondOverflow,
+	ARM64_CC_VC:      CondNoOverflow,
+	ARM64_CC_HI:      CondUGT,
+	ARM64_CC_LS:      CondULE,
+	ARM64_CC_GE:      CondGE,
+	ARM64_CC_LT:      CondLT,
+	ARM64_CC_GT:      CondGT,
+	ARM64_CC_LE:      CondLE,
+	ARM64_CC_AL:      CondAlways,
+	ARM64_CC_NV:      CondAlways, // nv executes as al in AArch64
+}
+
+// Map an Arm64Instruction.CC (ARM64_CC_*) to a Condition
+func Arm64Condition(cc uint) Condition {
+	if c, ok := arm64Conditions[cc]; ok {
+		return c
+	}
+	return CondUnknown
+}
+
+// MIPS
+
+var mipsConditions = map[uint]Condition{
+	MIPS_INS_BEQ: CondEQ, MIPS_INS_BEQL: CondEQ, MIPS_INS_BEQC: CondEQ,
+	MIPS_INS_BEQZ: CondEQ, MIPS_INS_BEQZC: CondEQ, MIPS_INS_BEQZALC: CondEQ,
+	MIPS_INS_BTEQZ: CondEQ, MIPS_INS_BZ: CondEQ,
+	MIPS_INS_BNE: CondNE, MIPS_INS_BNEL: CondNE, MIPS_INS_BNEC: CondNE,
+	MIPS_INS_BNEZ: CondNE, MIPS_INS_BNEZC: CondNE, MIPS_INS_BNEZALC: CondNE,
+	MIPS_INS_BTNEZ: CondNE, MIPS_INS_BNZ: CondNE,
+
+	MIPS_INS_BGEZ: CondGE, MIPS_INS_BGEZL: CondGE, MIPS_INS_BGEZAL: CondGE,
+	MIPS_INS_BGEZALL: CondGE, MIPS_INS_BGEZALS: CondGE, MIPS_INS_BGEZC: CondGE,
+	MIPS_INS_BGEZALC: CondGE, MIPS_INS_BGEC: CondGE, MIPS_INS_BPOSGE32: CondGE,
+	MIPS_INS_BGTZ: CondGT, MIPS_INS_BGTZL: CondGT, MIPS_INS_BGTZC: CondGT,
+	MIPS_INS_BGTZALC: CondGT,
+	MIPS_INS_BLEZ:    CondLE, MIPS_INS_BLEZL: CondLE, MIPS_INS_BLEZC: CondLE,
+	MIPS_INS_BLEZALC: CondLE,
+	MIPS_INS_BLTZ:    CondLT, MIPS_INS_BLTZL: CondLT, MIPS_INS_BLTZAL: CondLT,
+	MIPS_INS_BLTZALL: CondLT, MIPS_INS_BLTZALS: CondLT, MIPS_INS_BLTZC: CondLT,
+	MIPS_INS_BLTZALC: CondLT, MIPS_INS_BLTC: CondLT,
+	MIPS_INS_BGEUC: CondUGE, MIPS_INS_BLTUC: CondULT,
+	MIPS_INS_BOVC: CondOverflow, MIPS_INS_BNVC: CondNoOverflow,
+
+	// coprocessor condition bits: true is non-zero
+	MIPS_INS_BC0T: CondNE, MIPS_INS_BC0TL: CondNE, MIPS_INS_BC1T: CondNE,
+	MIPS_INS_BC1TL: CondNE, MIPS_INS_BC2T: CondNE, MIPS_INS_BC2TL: CondNE,
+	MIPS_INS_BC3T: CondNE, MIPS_INS_BC3TL: CondNE, MIPS_INS_BC1NEZ: CondNE,
+	MIPS_INS_BC2NEZ: CondNE, MIPS_INS_MOVT: CondNE,
+	MIPS_INS_BC0F: CondEQ, MIPS_INS_BC0FL: CondEQ, MIPS_INS_BC1F: CondEQ,
+	MIPS_INS_BC1FL: CondEQ, MIPS_INS_BC2F: CondEQ, MIPS_INS_BC2FL: CondEQ,
+	MIPS_INS_BC3F: CondEQ, MIPS_INS_BC3FL: CondEQ, MIPS_INS_BC1EQZ: CondEQ,
+	MIPS_INS_BC2EQZ: CondEQ, MIPS_INS_MOVF: CondEQ,
+
+	// conditional moves and selects test a register against zero
+	MIPS_INS_MOVZ: CondEQ, MIPS_INS_SELEQZ: CondEQ,
+	MIPS_INS_MOVN: CondNE, MIPS_INS_SELNEZ: CondNE,
+}
+
+// PPC
+
+var ppcConditions = map[int]Condition{
+	PPC_BC_INVALID: CondAlways,
+	PPC_BC_LT:      CondLT,
+	PPC_BC_LE:      CondLE,
+	PPC_BC_EQ:      CondEQ,
+	PPC_BC_GE:      CondGE,
+	PPC_BC_GT:      CondGT,
+	PPC_BC_NE:      CondNE,
+	PPC_BC_UN:      CondFUnordered,
+	PPC_BC_NU:      CondFOrdered,
+	PPC_BC_SO:      CondOverflow,
+	PPC_BC_NS:      CondNoOverflow,
+}
+
+// Branches which decrement and test CTR
+var ppcCounterBranches = newInsnSet(
+	PPC_INS_BDNZ, PPC_INS_BDNZA, PPC_INS_BDZ, PPC_INS_BDZA, PPC_INS_BDNZT,
+	PPC_INS_BDNZF, PPC_INS_BDZT, PPC_INS_BDZF, PPC_INS_BDNZTA,
+	PPC_INS_BDNZFA, PPC_INS_BDZTA, PPC_INS_BDZFA, PPC_INS_BDNZL,
+	PPC_INS_BDNZLA, PPC_INS_BDZL, PPC_INS_BDZLA, PPC_INS_BDNZTL,
+	PPC_INS_BDNZFL, PPC_INS_BDZTL, PPC_INS_BDZFL, PPC_INS_BDNZTLA,
+	PPC_INS_BDNZFLA, PPC_INS_BDZTLA, PPC_INS_BDZFLA,
+)
+
+// Map a PPCInstruction.BC (PPC_BC_*) to a Condition
+func PPCCondition(bc int) Condition {
+	if c, ok := ppcConditions[bc]; ok {
+		return c
+	}
+	return CondUnknown
+}
+
+// SPARC
+
+var sparcConditions = map[uint]Condition{
+	SPARC_CC_INVALID: CondAlways,
+	SPARC_CC_ICC_A:   CondAlways,
+	SPARC_CC_ICC_N:   CondNever,
+	SPARC_CC_ICC_NE:  CondNE,
+	SPARC_CC_ICC_E:   CondEQ,
+	SPARC_CC_ICC_G:   CondGT,
+	SPARC_CC_ICC_LE:  CondLE,
+	SPARC_CC_ICC_GE:  CondGE,
+	SPARC_CC_ICC_L:   CondLT,
+	SPARC_CC_ICC_GU:  CondUGT,
+	SPARC_CC_ICC_LEU: CondULE,
+	SPARC_CC_ICC_CC:  CondUGE,
+	SPARC_CC_ICC_CS:  CondULT,
+	SPARC_CC_ICC_POS: CondPos,
+	SPARC_CC_ICC_NEG: CondNeg,
+	SPARC_CC_ICC_VC:  CondNoOverflow,
+	SPARC_CC_ICC_VS:  CondOverflow,
+	SPARC_CC_FCC_A:   CondAlways,
+	SPARC_CC_FCC_N:   CondNever,
+	SPARC_CC_FCC_U:   CondFUnordered,
+	SPARC_CC_FCC_G:   CondFGT,
+	SPARC_CC_FCC_UG:  CondFUGT,
+	SPARC_CC_FCC_L:   CondFLT,
+	SPARC_CC_FCC_UL:  CondFULT,
+	SPARC_CC_FCC_LG:  CondFLG,
+	SPARC_CC_FCC_NE:  CondNE,
+	SPARC_CC_FCC_E:   CondEQ,
+	SPARC_CC_FCC_UE:  CondFUEQ,
+	SPARC_CC_FCC_GE:  CondFGE,
+	SPARC_CC_FCC_UGE: CondFUGE,
+	SPARC_CC_FCC_LE:  CondFLE,
+	SPARC_CC_FCC_ULE: CondFULE,
+	SPARC_CC_FCC_O:   CondFOrdered,
+}
+
+// Map a SparcInstruction.CC (SPARC_CC_*) to a Condition
+func SparcCondition(cc uint) Condition {
+	if c, ok := sparcConditions[cc]; ok {
+		return c
+	}
+	return CondUnknown
+}
+
+// SYSZ
+
+// SystemZ masks select condition code values 0 (equal), 1 (low), 2 (high)
+// and 3 (overflow, or unordered after a floating point compare). The masks
+// which include 3 alongside a relation map to the unordered-or forms.
+var syszConditions = map[uint]Condition{
+	SYSZ_CC_INVALID: CondAlways,
+	SYSZ_CC_O:       CondOverflow,
+	SYSZ_CC_NO:      CondNoOverflow,
+	SYSZ_CC_E:       CondEQ,
+	SYSZ_CC_NE:      CondNE,
+	SYSZ_CC_L:       CondLT,
+	SYSZ_CC_H:       CondGT,
+	SYSZ_CC_LE:      CondLE,
+	SYSZ_CC_HE:      CondGE,
+	SYSZ_CC_LH:      CondFLG,
+	SYSZ_CC_NLH:     CondFUEQ,
+	SYSZ_CC_NLE:     CondFUGT,
+	SYSZ_CC_NHE:     CondFULT,
+	SYSZ_CC_NL:      CondFUGE,
+	SYSZ_CC_NH:      CondFULE,
+}
+
+// Map a SysZInstruction.CC (SYSZ_CC_*) to a Condition
+func SysZCondition(cc uint) Condition {
+	if c, ok := syszConditions[cc]; ok {
+		return c
+	}
+	return CondUnknown
+}
@@ -0,0 +1,87 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+func TestConditionInvert(t *testing.T) {
+	for c := CondUnknown; c <= CondFUEQ; c++ {
+		if c.Invert().Invert() != c {
+			t.Errorf("%v: Invert is not an involution, got %v", c, c.Invert().Invert())
+		}
+		if c != CondUnknown && c.Invert() == c {
+			t.Errorf("%v inverts to itself", c)
+		}
+		if c.Flags() != c.Invert().Flags() {
+			t.Errorf("%v and %v should read the same flags", c, c.Invert())
+		}
+	}
+	if CondULE.String() != "ule" || CondULE.Invert() != CondUGT {
+		t.Errorf("Want ule inverting to ugt, got %v -> %v", CondULE, CondULE.Invert())
+	}
+	if f := CondGT.Flags(); f != FlagZero|FlagSign|FlagOverflow || f.String() != "z|s|o" {
+		t.Errorf("Want gt to read z|s|o, got %v", f)
+	}
+}
+
+func TestCondition(t *testing.T) {
+
+	// Without detail
+	tests := []struct {
+		comment string
+		insn    Instruction
+		want    Condition
+	}{
+		{"jbe", bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_JBE, 0x1000, "\x76\x10"), CondULE},
+		{"cmovl", bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_CMOVL, 0x1000, "\x0f\x4c\xc3"), CondLT},
+		{"mov", bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_MOV, 0x1000, "\x89\xd8"), CondAlways},
+		{"addne r0, r0, #1", bareInsn(CS_ARCH_ARM, CS_MODE_ARM, ARM_INS_ADD, 0x1000, "\x01\x00\x80\x12"), CondNE},
+		{"cbz x0", bareInsn(CS_ARCH_ARM64, CS_MODE_ARM, ARM64_INS_CBZ, 0x1000, "\x00\x02\x00\xb4"), CondEQ},
+		{"tbnz w0, #3", bareInsn(CS_ARCH_ARM64, CS_MODE_ARM, ARM64_INS_TBNZ, 0x1000, "\xa0\xff\x1f\x37"), CondNE},
+		{"b.hi", bareInsn(CS_ARCH_ARM64, CS_MODE_ARM, ARM64_INS_B, 0x1000, "\x08\x02\x00\x54"), CondUnknown},
+		{"bltz", bareInsn(CS_ARCH_MIPS, CS_MODE_32, MIPS_INS_BLTZ, 0x1000, "\x10\x00\x80\x04"), CondLT},
+	}
+	for i, ct := range tests {
+		if got := ct.insn.Condition(); got != ct.want {
+			t.Errorf("%2d> %s: want %v got %v", i, ct.comment, ct.want, got)
+		}
+	}
+
+	if ArmCondition(ARM_CC_LO) != CondULT || SparcCondition(SPARC_CC_ICC_CS) != CondULT {
+		t.Errorf("ARM lo and SPARC cs should both be unsigned lower")
+	}
+}
+
+var conditionTests = []struct {
+	arch    int
+	mode    uint
+	code    string
+	comment string
+	want    Condition
+}{
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x01\x00\x80\x12", "addne r0, r0, #1", CondNE},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x08\x02\x00\x54", "b.hi 0x1040", CondUGT},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x00\x02\x00\xb4", "cbz x0, 0x1040", CondEQ},
+	{CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, "\x1a\x80\x00\x10", "bgeu 0x1040", CondUGE},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xa7\x54\x00\x20", "jnhe 0x1040", CondFULT},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x42\x00\x00\x40", "bdnz 0x1040", CondUnknown},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x41\x80\x00\x40", "blt 0x1040", CondLT},
+}
+
+func TestConditionEngine(t *testing.T) {
+
+	for i, ct := range conditionTests {
+		insn := disasmLast(t, ct.arch, ct.mode, ct.code)
+		if got := insn.Condition(); got != ct.want {
+			t.Errorf("%2d> %s: want %v got %v", i, ct.comment, ct.want, got)
+		}
+	}
+}
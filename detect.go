@@ -0,0 +1,217 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+var ErrNoCode = errors.New("gapstone: no code to detect")
+
+// One arch / mode guess from DetectArch
+type ArchCandidate struct {
+	Arch  int
+	Mode  uint
+	Name  string  // eg "MIPS32 (big endian)"
+	Score float64 // 0 to 1, higher is more likely
+
+	Coverage  float64 // fraction of bytes decoded as instructions
+	Invalid   float64 // fraction of decode steps that hit undecodable bytes
+	TopTen    float64 // fraction of instructions using the ten commonest IDs
+	Returns   int
+	Prologues int // stack allocations after a return, jump or undecodable bytes
+}
+
+// The combinations DetectArch tries. Those the installed capstone can't open
+// are skipped.
+var detectTargets = []struct {
+	arch int
+	mode uint
+	name string
+}{
+	{CS_ARCH_X86, CS_MODE_16, "x86-16"},
+	{CS_ARCH_X86, CS_MODE_32, "x86-32"},
+	{CS_ARCH_X86, CS_MODE_64, "x86-64"},
+	{CS_ARCH_ARM, CS_MODE_ARM, "ARM"},
+	{CS_ARCH_ARM, CS_MODE_ARM + CS_MODE_BIG_ENDIAN, "ARM (big endian)"},
+	{CS_ARCH_ARM, CS_MODE_THUMB, "Thumb"},
+	{CS_ARCH_ARM, CS_MODE_THUMB + CS_MODE_BIG_ENDIAN, "Thumb (big endian)"},
+	{CS_ARCH_ARM, CS_MODE_THUMB + CS_MODE_MCLASS, "Thumb (Cortex-M)"},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "ARM64"},
+	{CS_ARCH_ARM64, CS_MODE_BIG_ENDIAN, "ARM64 (big endian)"},
+	{CS_ARCH_MIPS, CS_MODE_MIPS32, "MIPS32 (little endian)"},
+	{CS_ARCH_MIPS, CS_MODE_MIPS32 + CS_MODE_BIG_ENDIAN, "MIPS32 (big endian)"},
+	{CS_ARCH_MIPS, CS_MODE_MIPS64, "MIPS64 (little endian)"},
+	{CS_ARCH_MIPS, CS_MODE_MIPS64 + CS_MODE_BIG_ENDIAN, "MIPS64 (big endian)"},
+	{CS_ARCH_MIPS, CS_MODE_MIPS32 + CS_MODE_MICRO, "microMIPS (little endian)"},
+	{CS_ARCH_MIPS, CS_MODE_MIPS32 + CS_MODE_MICRO + CS_MODE_BIG_ENDIAN, "microMIPS (big endian)"},
+	{CS_ARCH_PPC, CS_MODE_32 + CS_MODE_BIG_ENDIAN, "PPC32"},
+	{CS_ARCH_PPC, CS_MODE_64 + CS_MODE_BIG_ENDIAN, "PPC64"},
+	{CS_ARCH_PPC, CS_MODE_64, "PPC64 (little endian)"},
+	{CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, "SPARC"},
+	{CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN + CS_MODE_V9, "SPARC V9"},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "SystemZ"},
+	{CS_ARCH_XCORE, CS_MODE_BIG_ENDIAN, "XCore"},
+}
+
+// Only the start of large inputs is examined
+const detectMaxBytes = 64 << 10
+
+// Score weights. Coverage alone can't tell x86 from noise, since almost any
+// byte sequence decodes, so the shape of the instruction mix counts for as
+// much.
+const (
+	detectCoverageWeight  = 0.4
+	detectTopTenWeight    = 0.35
+	detectStructureWeight = 0.25
+)
+
+// Guess the arch and mode of a blob of code, like shellcode or a raw
+// firmware image, by disassembling it (the first 64KiB at most) with every
+// combination in detectTargets concurrently. Each is scored on
+//
+//   - decode coverage and the rate of undecodable bytes
+//   - the share of the ten most frequent instruction IDs - real code reuses
+//     a few instructions heavily, decoded noise doesn't
+//   - returns and function prologues per instruction
+//
+// Returns every candidate, best first. Scores are heuristic: short inputs
+// often can't separate related modes like Thumb and Cortex-M.
+func DetectArch(buf []byte) ([]ArchCandidate, error) {
+	if len(buf) == 0 {
+		return nil, ErrNoCode
+	}
+	if len(buf) > detectMaxBytes {
+		buf = buf[:detectMaxBytes]
+	}
+
+	results := make([]*ArchCandidate, len(detectTargets))
+	var wg sync.WaitGroup
+	for i := range detectTargets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			t := detectTargets[i]
+			results[i] = detectOne(t.arch, t.mode, buf)
+			if results[i] != nil {
+				results[i].Name = t.name
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var cands []ArchCandidate
+	for _, c := range results {
+		if c != nil {
+			cands = append(cands, *c)
+		}
+	}
+	if len(cands) == 0 {
+		return nil, ErrArch
+	}
+	sort.Stable(byScore(cands))
+	return cands, nil
+}
+
+type byScore []ArchCandidate
+
+func (c byScore) Len() int           { return len(c) }
+func (c byScore) Less(i, j int) bool { return c[i].Score > c[j].Score }
+func (c byScore) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+// Disassemble buf with one arch / mode, or return nil if capstone can't
+func detectOne(arch int, mode uint, buf []byte) *ArchCandidate {
+	engine, err := New(arch, mode)
+	if err != nil {
+		return nil
+	}
+	defer engine.Close()
+	if engine.SetOption(CS_OPT_DETAIL, CS_OPT_ON) != nil {
+		return nil
+	}
+	engine.SkipDataStart(nil)
+	insns, err := engine.Disasm(buf, 0, 0)
+	if err != nil {
+		return nil
+	}
+	c := scoreInsns(insns, len(buf))
+	c.Arch, c.Mode = arch, mode
+	return &c
+}
+
+// Score a SKIPDATA disassembly of n bytes. Data runs have ID 0.
+func scoreInsns(insns []Instruction, n int) ArchCandidate {
+	var c ArchCandidate
+	var valid, invalid, decoded int
+	freq := map[uint]int{}
+	boundary := true
+	for i := range insns {
+		insn := &insns[i]
+		if insn.Id == 0 {
+			invalid++
+			boundary = true
+			continue
+		}
+		valid++
+		decoded += int(insn.Size)
+		freq[insn.Id]++
+
+		if boundary {
+			if se, err := insn.StackDelta(); err == nil && se.Delta < 0 &&
+				(se.Kind == StackKnown || se.Kind == StackFrameSetup) {
+				c.Prologues++
+			}
+		}
+		switch insn.Flow().Kind {
+		case FlowReturn:
+			c.Returns++
+			boundary = true
+		case FlowJump, FlowIndirect:
+			boundary = true
+		default:
+			boundary = false
+		}
+	}
+	if valid == 0 {
+		c.Invalid = 1
+		return c
+	}
+
+	counts := make([]int, 0, len(freq))
+	for _, k := range freq {
+		counts = append(counts, k)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(counts)))
+	top := 0
+	for i := 0; i < len(counts) && i < 10; i++ {
+		top += counts[i]
+	}
+
+	c.Coverage = float64(decoded) / float64(n)
+	c.Invalid = float64(invalid) / float64(valid+invalid)
+	c.TopTen = float64(top) / float64(valid)
+	// About one return or prologue per 50 instructions is typical compiled
+	// code
+	structure := float64(c.Returns+c.Prologues) * 50 / float64(valid)
+	if structure > 1 {
+		structure = 1
+	}
+	// With very few instructions every ID is in the top ten
+	if valid < 20 {
+		c.TopTen *= float64(valid) / 20
+	}
+	c.Score = detectCoverageWeight*c.Coverage*(1-c.Invalid) +
+		detectTopTenWeight*c.TopTen +
+		detectStructureWeight*structure
+	return c
+}
@@ -0,0 +1,91 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+// A one byte x86 instruction with just enough detail for the scoring
+// heuristics
+func x86ScoreInsn(id uint, ops ...X86Operand) Instruction {
+	insn := bareInsn(CS_ARCH_X86, CS_MODE_64, id, 0x1000, "\x90")
+	insn.X86 = &X86Instruction{Operands: ops}
+	return insn
+}
+
+func TestScoreInsns(t *testing.T) {
+	reg := func(r uint) X86Operand { return X86Operand{Type: X86_OP_REG, Reg: r, Size: 8} }
+	push := x86ScoreInsn(X86_INS_PUSH, reg(X86_REG_RBP))
+	mov := x86ScoreInsn(X86_INS_MOV, reg(X86_REG_RBP), reg(X86_REG_RSP))
+	ret := x86ScoreInsn(X86_INS_RET)
+	data := bareInsn(CS_ARCH_X86, CS_MODE_64, 0, 0x1000, "\xff")
+
+	// Every instruction is one byte, so the seven decoded ones cover 7 of the
+	// 8 bytes, and 1 of the 8 instructions is data
+	c := scoreInsns([]Instruction{push, mov, mov, ret, data, push, mov, ret}, 8)
+	want := ArchCandidate{
+		Coverage:  7.0 / 8,
+		Invalid:   1.0 / 8,
+		TopTen:    7.0 / 20,
+		Returns:   2,
+		Prologues: 2,
+	}
+	want.Score = detectCoverageWeight*want.Coverage*(1-want.Invalid) +
+		detectTopTenWeight*want.TopTen + detectStructureWeight
+	if math.Abs(c.Score-want.Score) > 1e-9 {
+		t.Errorf("score: want %v got %v", want.Score, c.Score)
+	}
+	c.Score = want.Score
+	if c != want {
+		t.Errorf("want %+v got %+v", want, c)
+	}
+
+	if c := scoreInsns([]Instruction{data, data}, 2); c.Score != 0 || c.Invalid != 1 {
+		t.Errorf("all data: want score 0 got %+v", c)
+	}
+}
+
+func TestDetectArchEngine(t *testing.T) {
+
+	// A MIPS32 big endian function, several times over
+	fn := []byte{
+		0x27, 0xbd, 0xff, 0xe0, // addiu $sp, $sp, -0x20
+		0xaf, 0xbf, 0x00, 0x1c, // sw $ra, 0x1c($sp)
+		0xaf, 0xb0, 0x00, 0x18, // sw $s0, 0x18($sp)
+		0x00, 0x80, 0x80, 0x21, // move $s0, $a0
+		0x8e, 0x02, 0x00, 0x00, // lw $v0, ($s0)
+		0x24, 0x42, 0x00, 0x01, // addiu $v0, $v0, 1
+		0xae, 0x02, 0x00, 0x00, // sw $v0, ($s0)
+		0x8f, 0xbf, 0x00, 0x1c, // lw $ra, 0x1c($sp)
+		0x8f, 0xb0, 0x00, 0x18, // lw $s0, 0x18($sp)
+		0x03, 0xe0, 0x00, 0x08, // jr $ra
+		0x27, 0xbd, 0x00, 0x20, // addiu $sp, $sp, 0x20
+	}
+	cands, err := DetectArch(bytes.Repeat(fn, 8))
+	if err != nil || len(cands) == 0 {
+		t.Fatalf("DetectArch: %v", err)
+	}
+	if c := cands[0]; c.Arch != CS_ARCH_MIPS || c.Mode&CS_MODE_BIG_ENDIAN == 0 {
+		t.Errorf("want big endian MIPS got %+v", c)
+	}
+	for i := 1; i < len(cands); i++ {
+		if cands[i].Score > cands[i-1].Score {
+			t.Errorf("%2d> %s: not sorted", i, cands[i].Name)
+		}
+	}
+
+	if _, err := DetectArch(nil); err != ErrNoCode {
+		t.Errorf("empty: want %v got %v", ErrNoCode, err)
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "errors"
+
+var (
+	ErrNotMemory = errors.New("gapstone: operand is not a memory operand")
+	ErrRegValue  = errors.New("gapstone: register value unavailable")
+)
+
+// Supplies register values to EffectiveAddress. ok is false if the value is
+// not known. For x86 segment registers return the segment base (eg the FS
+// base from the TEB / arch_prctl), not the selector.
+type RegisterValues func(reg uint) (val uint64, ok bool)
+
+// A simple RegisterValues backed by a map. Use the Lookup method value, eg
+// insn.EffectiveAddress(1, regs.Lookup)
+type RegisterMap map[uint]uint64
+
+func (m RegisterMap) Lookup(reg uint) (uint64, bool) {
+	v, ok := m[reg]
+	return v, ok
+}
+
+// Compute the address accessed by the memory operand at idx (an index into
+// the arch specific Operands slice), given the register values before the
+// instruction executes. Register values are truncated to the register width,
+// and zero registers (MIPS $zero, ARM64 xzr, SPARC %g0) read as zero without
+// consulting regs.
+//
+//	X86:   segment:[base + index*scale + disp], wrapped to the address size.
+//	       RIP / EIP relative operands use the next instruction address.
+//	       Only segment overrides are applied, except in 16-bit mode where
+//	       the default DS / SS segment is always used.
+//	ARM:   base +/- shifted index + disp. A pc base reads as the
+//	       instruction address + 8, or Align(address + 4, 4) in Thumb.
+//	ARM64: base + extended, shifted index + disp.
+//	Others: base (+ index) + disp. PPC r0 as a base reads as zero.
+//
+// For pre-indexed (writeback) forms this is the updated address. For
+// post-indexed forms it is the base register value - the update is carried
+// by a separate operand. ARM64 ldr literal and the SysZ *rl instructions have
+// no memory operand at all: Capstone gives the address as an immediate, which
+// References reports.
+//
+// Returns ErrDetail without detail, ErrNotMemory if the operand doesn't exist
+// or isn't a memory operand, ErrRegValue if regs can't supply a register,
+// ErrMode for x86 when the mode is unknown and ErrArch for XCore, whose
+// operands don't record index scaling.
+func (insn Instruction) EffectiveAddress(idx int, regs RegisterValues) (uint64, error) {
+	arch, ok := detailArch(&insn)
+	if !ok {
+		return 0, ErrDetail
+	}
+	r := &eaRegs{arch: arch, values: regs}
+
+	var ea uint64
+	switch arch {
+	case CS_ARCH_X86:
+		if idx < 0 || idx >= len(insn.X86.Operands) || insn.X86.Operands[idx].Type != X86_OP_MEM {
+			return 0, ErrNotMemory
+		}
+		// The address size can't be guessed for an Instruction which wasn't
+		// disassembled by an Engine
+		if insn.mode&(CS_MODE_16|CS_MODE_32|CS_MODE_64) == 0 {
+			return 0, ErrMode
+		}
+		ea = insn.x86EffectiveAddress(insn.X86.Operands[idx].Mem, r)
+	case CS_ARCH_ARM:
+		if idx < 0 || idx >= len(insn.Arm.Operands) || insn.Arm.Operands[idx].Type != ARM_OP_MEM {
+			return 0, ErrNotMemory
+		}
+		ea = insn.armEffectiveAddress(insn.Arm.Operands[idx], r)
+	case CS_ARCH_ARM64:
+		if idx < 0 || idx >= len(insn.Arm64.Operands) || insn.Arm64.Operands[idx].Type != ARM64_OP_MEM {
+			return 0, ErrNotMemory
+		}
+		ea = arm64EffectiveAddress(insn.Arm64.Operands[idx], r)
+	case CS_ARCH_MIPS:
+		if idx < 0 || idx >= len(insn.Mips.Operands) || insn.Mips.Operands[idx].Type != MIPS_OP_MEM {
+			return 0, ErrNotMemory
+		}
+		mem := insn.Mips.Operands[idx].Mem
+		ea = r.get(mem.Base) + uint64(mem.Disp)
+		if insn.mode&CS_MODE_64 == 0 {
+			ea &= 0xffffffff
+		}
+	case CS_ARCH_PPC:
+		if idx < 0 || idx >= len(insn.PPC.Operands) || insn.PPC.Operands[idx].Type != PPC_OP_MEM {
+			return 0, ErrNotMemory
+		}
+		mem := insn.PPC.Operands[idx].Mem
+		// (rA|0) - r0 as a base register means a literal zero
+		if mem.Base != PPC_REG_R0 {
+			ea = r.get(mem.Base)
+		}
+		ea += uint64(int64(mem.Disp))
+		if insn.mode&CS_MODE_64 == 0 {
+			ea &= 0xffffffff
+		}
+	case CS_ARCH_SPARC:
+		if idx < 0 || idx >= len(insn.Sparc.Operands) || insn.Sparc.Operands[idx].Type != SPARC_OP_MEM {
+			return 0, ErrNotMemory
+		}
+		mem := insn.Sparc.Operands[idx].Mem
+		ea = r.get(uint(mem.Base)) + r.get(uint(mem.Index)) + uint64(int64(mem.Disp))
+		if insn.mode&CS_MODE_V9 == 0 {
+			ea &= 0xffffffff
+		}
+	case CS_ARCH_SYSZ:
+		if idx < 0 || idx >= len(insn.SysZ.Operands) || insn.SysZ.Operands[idx].Type != SYSZ_OP_MEM {
+			return 0, ErrNotMemory
+		}
+		mem := insn.SysZ.Operands[idx].Mem
+		ea = r.get(uint(mem.Base)) + r.get(uint(mem.Index)) + uint64(mem.Disp)
+	default:
+		return 0, ErrArch
+	}
+
+	if r.err != nil {
+		return 0, r.err
+	}
+	return ea, nil
+}
+
+// Register reads for one address computation. The first failure is kept.
+type eaRegs struct {
+	arch   int
+	values RegisterValues
+	err    error
+}
+
+func (r *eaRegs) get(reg uint) uint64 {
+	// every *_REG_INVALID is 0
+	if reg == 0 {
+		return 0
+	}
+	info, known := RegInfo(r.arch, reg)
+	if known && info.Role == RegRoleZero {
+		return 0
+	}
+	v, ok := r.values(reg)
+	if !ok {
+		if r.err == nil {
+			r.err = ErrRegValue
+		}
+		return 0
+	}
+	// x86 segment registers supply a base, which is wider than the selector
+	if known && info.Width < 64 && info.Class != RegClassSegment {
+		v &= 1<<info.Width - 1
+	}
+	return v
+}
+
+// The width in bits of a register, or 0 if unknown
+func (r *eaRegs) width(reg uint) uint {
+	info, _ := RegInfo(r.arch, reg)
+	return info.Width
+}
+
+// X86
+
+func (insn *Instruction) x86EffectiveAddress(mem X86MemoryOperand, r *eaRegs) uint64 {
+	next := uint64(insn.Address + insn.Size)
+
+	// The address size comes from the registers used, or the mode for an
+	// absolute address.
+	var base uint64
+	var size uint
+	switch mem.Base {
+	case X86_REG_RIP:
+		base, size = next, 64
+	case X86_REG_EIP:
+		base, size = next&0xffffffff, 32
+	default:
+		base = r.get(mem.Base)
+		if size = r.width(mem.Base); size == 0 {
+			size = r.width(mem.Index)
+		}
+	}
+	if size == 0 {
+		switch {
+		case insn.mode&CS_MODE_16 != 0:
+			size = 16
+		case insn.mode&CS_MODE_32 != 0:
+			size = 32
+		default:
+			size = 64
+		}
+	}
+
+	ea := base + r.get(mem.Index)*uint64(mem.Scale) + uint64(mem.Disp)
+	if size < 64 {
+		ea &= 1<<size - 1
+	}
+
+	seg := mem.Segment
+	if seg == X86_REG_INVALID && insn.mode&CS_MODE_16 != 0 {
+		seg = X86_REG_DS
+		switch mem.Base {
+		case X86_REG_BP, X86_REG_EBP, X86_REG_SP, X86_REG_ESP:
+			seg = X86_REG_SS
+		}
+	}
+	switch seg {
+	case X86_REG_INVALID:
+	case X86_REG_FS, X86_REG_GS:
+		ea += r.get(seg)
+	default:
+		// long mode ignores the other segment bases
+		if insn.mode&CS_MODE_64 == 0 {
+			ea += r.get(seg)
+		}
+	}
+	if insn.mode&CS_MODE_64 == 0 {
+		ea &= 0xffffffff
+	}
+	return ea
+}
+
+// ARM
+
+// The value of pc as an operand
+func (insn *Instruction) armPC() uint64 {
+	addr := uint64(insn.Address)
+	if insn.mode&CS_MODE_THUMB != 0 || insn.Size == 2 {
+		return (addr + 4) &^ 3
+	}
+	return addr + 8
+}
+
+func (insn *Instruction) armEffectiveAddress(op ArmOperand, r *eaRegs) uint64 {
+	mem := op.Mem
+	var ea uint64
+	if mem.Base == ARM_REG_PC {
+		ea = insn.armPC()
+	} else {
+		ea = r.get(mem.Base)
+	}
+
+	if mem.Index != ARM_REG_INVALID {
+		index := uint32(r.get(mem.Index))
+		amount := op.Shift.Value & 31
+		switch op.Shift.Type {
+		case ARM_SFT_LSL:
+			index <<= amount
+		case ARM_SFT_LSR:
+			index >>= amount
+		case ARM_SFT_ASR:
+			index = uint32(int32(index) >> amount)
+		case ARM_SFT_ROR:
+			index = index>>amount | index<<(32-amount)
+		case ARM_SFT_RRX:
+			carry := uint32(r.get(ARM_REG_CPSR)>>29) & 1
+			index = carry<<31 | index>>1
+		}
+		if op.Subtracted || mem.Scale < 0 {
+			ea -= uint64(index)
+		} else {
+			ea += uint64(index)
+		}
+	}
+
+	ea += uint64(int64(mem.Disp))
+	return ea & 0xffffffff
+}
+
+// ARM64
+
+func arm64EffectiveAddress(op Arm64Operand, r *eaRegs) uint64 {
+	mem := op.Mem
+	ea := r.get(mem.Base)
+
+	if mem.Index != ARM64_REG_INVALID {
+		index := r.get(mem.Index)
+		switch op.Ext {
+		case ARM64_EXT_UXTB:
+			index = uint64(uint8(index))
+		case ARM64_EXT_UXTH:
+			index = uint64(uint16(index))
+		case ARM64_EXT_UXTW:
+			index = uint64(uint32(index))
+		case ARM64_EXT_SXTB:
+			index = uint64(int8(index))
+		case ARM64_EXT_SXTH:
+			index = uint64(int16(index))
+		case ARM64_EXT_SXTW:
+			index = uint64(int32(index))
+		}
+		if op.Shift.Type == ARM64_SFT_LSL {
+			index <<= op.Shift.Value & 63
+		}
+		ea += index
+	}
+
+	return ea + uint64(int64(mem.Disp))
+}
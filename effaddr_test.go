@@ -0,0 +1,117 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+type effAddrTest struct {
+	arch    int
+	mode    uint
+	code    string
+	comment string
+	idx     int
+	regs    RegisterMap
+	want    uint64
+}
+
+// Disassemble code at address with detail on, and return the last
+// instruction
+func disasmLast(t *testing.T, arch int, mode uint, code string) Instruction {
+	engine, err := New(arch, mode)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer engine.Close()
+	engine.SetOption(CS_OPT_DETAIL, CS_OPT_ON)
+
+	insns, err := engine.Disasm([]byte(code), address, 0)
+	if err != nil || len(insns) == 0 {
+		t.Fatalf("Disassembly error: %v", err)
+	}
+	return insns[len(insns)-1]
+}
+
+var effAddrTests = []effAddrTest{
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x8b\x05\x00\x01\x00\x00",
+		"mov rax, qword ptr [rip + 0x100]", 1, RegisterMap{}, 0x1107},
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x8d\x44\xcb\xf8",
+		"lea rax, [rbx + rcx*8 - 8]", 1, RegisterMap{X86_REG_RBX: 0x10000, X86_REG_RCX: 2}, 0x10008},
+	{CS_ARCH_X86, CS_MODE_32, "\x64\xa1\x30\x00\x00\x00",
+		"mov eax, dword ptr fs:[0x30]", 1, RegisterMap{X86_REG_FS: 0x7ffdf000}, 0x7ffdf030},
+	{CS_ARCH_X86, CS_MODE_16, "\x8b\x40\x10",
+		"mov ax, word ptr [bx + si + 0x10]", 1,
+		RegisterMap{X86_REG_BX: 0xfff0, X86_REG_SI: 0x20, X86_REG_DS: 0x10000}, 0x10020},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x04\xf0\x9f\xe5",
+		"ldr pc, [pc, #4]", 1, RegisterMap{}, 0x100c},
+	// pc is aligned down: the ldr is at 0x1002
+	{CS_ARCH_ARM, CS_MODE_THUMB, "\x00\xbf\x02\x48",
+		"nop; ldr r0, [pc, #8]", 1, RegisterMap{}, 0x100c},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x02\x01\x11\xe7",
+		"ldr r0, [r1, -r2, lsl #2]", 1, RegisterMap{ARM_REG_R1: 0x2000, ARM_REG_R2: 4}, 0x1ff0},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x20\xd8\x62\xb8",
+		"ldr w0, [x1, w2, sxtw #2]", 1,
+		RegisterMap{ARM64_REG_X1: 0x1000, ARM64_REG_W2: 0xdeadbeefffffffff}, 0xffc},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x20\x8c\x40\xf8",
+		"ldr x0, [x1, #8]!", 1, RegisterMap{ARM64_REG_X1: 0x8000}, 0x8008},
+	{CS_ARCH_MIPS, CS_MODE_32, "\xfc\xff\x08\x8c",
+		"lw $t0, -4($zero)", 1, RegisterMap{}, 0xfffffffc},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x80\x60\x00\x08",
+		"lwz r3, 8(0)", 1, RegisterMap{}, 8},
+	{CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, "\xd4\x02\x00\x09",
+		"ld [%o0+%o1], %o2", 0, RegisterMap{SPARC_REG_O0: 0x4000, SPARC_REG_O1: 0x10}, 0x4010},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\x58\x12\x30\x10",
+		"l %r1, 16(%r2,%r3)", 1, RegisterMap{SYSZ_REG_2: 0x100, SYSZ_REG_3: 0x2000}, 0x2110},
+}
+
+func TestEffectiveAddress(t *testing.T) {
+
+	for i, et := range effAddrTests {
+		insn := disasmLast(t, et.arch, et.mode, et.code)
+		ea, err := insn.EffectiveAddress(et.idx, et.regs.Lookup)
+		if err != nil {
+			t.Errorf("%2d> %s: unexpected error %v", i, et.comment, err)
+			continue
+		}
+		if ea != et.want {
+			t.Errorf("%2d> %s: want %#x got %#x", i, et.comment, et.want, ea)
+		}
+	}
+
+	// ldr x1, 0x1100 and strl %r1, 0x2000: Capstone reports the literal
+	// address as an immediate
+	literals := []effAddrTest{
+		{arch: CS_ARCH_ARM64, mode: CS_MODE_ARM, code: "\x01\x08\x00\x58", idx: 1},
+		{arch: CS_ARCH_SYSZ, mode: CS_MODE_BIG_ENDIAN, code: "\xc4\x1f\x00\x00\x08\x00", idx: 1},
+	}
+	for _, et := range literals {
+		insn := disasmLast(t, et.arch, et.mode, et.code)
+		if _, err := insn.EffectiveAddress(et.idx, RegisterMap{}.Lookup); err != ErrNotMemory {
+			t.Errorf("%s %s: want ErrNotMemory, got %v", insn.Mnemonic, insn.OpStr, err)
+		}
+	}
+
+	lea := disasmLast(t, CS_ARCH_X86, CS_MODE_64, "\x48\x8d\x44\xcb\xf8")
+	if _, err := lea.EffectiveAddress(0, RegisterMap{}.Lookup); err != ErrNotMemory {
+		t.Errorf("Want ErrNotMemory for a register operand, got %v", err)
+	}
+	if _, err := lea.EffectiveAddress(1, RegisterMap{X86_REG_RBX: 0}.Lookup); err != ErrRegValue {
+		t.Errorf("Want ErrRegValue for a missing register, got %v", err)
+	}
+	unstamped := lea
+	unstamped.mode = 0
+	if _, err := unstamped.EffectiveAddress(1, RegisterMap{X86_REG_RBX: 0, X86_REG_RCX: 0}.Lookup); err != ErrMode {
+		t.Errorf("Want ErrMode without a mode, got %v", err)
+	}
+	bare := bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_NOP, 0x1000, "\x90")
+	if _, err := bare.EffectiveAddress(0, RegisterMap{}.Lookup); err != ErrDetail {
+		t.Errorf("Want ErrDetail without detail, got %v", err)
+	}
+}
@@ -0,0 +1,497 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+// Package elf opens ELF binaries with debug/elf and disassembles their
+// sections, symbols and executable segments with an Engine configured from
+// the ELF header. DWARF line tables can be used to annotate the disassembly
+// with source positions, like objdump -S.
+package elf
+
+import (
+	"bytes"
+	delf "debug/elf"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+var (
+	ErrMachine   = errors.New("gapstone/elf: unsupported machine")
+	ErrNoSection = errors.New("gapstone/elf: no such section")
+	ErrNoSymbol  = errors.New("gapstone/elf: no such symbol")
+	ErrNoData    = errors.New("gapstone/elf: no file data at address")
+	ErrNoWriter  = errors.New("gapstone/elf: SourceWriter has no writer")
+)
+
+// An ELF file with an Engine set up for its machine. The Engine has
+// CS_OPT_DETAIL turned on.
+type File struct {
+	*delf.File
+	Engine gapstone.Engine
+	Arch   int
+	Mode   uint
+	closer io.Closer
+}
+
+// An executable PT_LOAD segment
+type Segment struct {
+	Addr  uint64 // Virtual address
+	Data  []byte // File contents, the zero filled tail is not included
+	Flags delf.ProgFlag
+}
+
+// Open the named file and create an Engine for it. Call Close when done.
+func Open(name string) (*File, error) {
+	fh, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := NewFile(fh)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+	f.closer = fh
+	return f, nil
+}
+
+// Parse an ELF file from r and create an Engine for it. Call Close when done.
+func NewFile(r io.ReaderAt) (*File, error) {
+	ef, err := delf.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+	flags, err := readFlags(r, ef)
+	if err != nil {
+		return nil, err
+	}
+	arch, mode, err := Target(ef, flags)
+	if err != nil {
+		return nil, err
+	}
+	engine, err := gapstone.New(arch, mode)
+	if err != nil {
+		return nil, err
+	}
+	if err := engine.SetOption(gapstone.CS_OPT_DETAIL, gapstone.CS_OPT_ON); err != nil {
+		engine.Close()
+		return nil, err
+	}
+	return &File{File: ef, Engine: engine, Arch: arch, Mode: mode}, nil
+}
+
+// Close the Engine, and the underlying file if it was opened with Open.
+func (f *File) Close() error {
+	err := f.Engine.Close()
+	if f.closer != nil {
+		if cerr := f.closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// debug/elf doesn't keep e_flags, so read it from the header
+func readFlags(r io.ReaderAt, f *delf.File) (uint32, error) {
+	off := int64(36)
+	if f.Class == delf.ELFCLASS64 {
+		off = 48
+	}
+	var b [4]byte
+	if _, err := r.ReadAt(b[:], off); err != nil {
+		return 0, err
+	}
+	return f.ByteOrder.Uint32(b[:]), nil
+}
+
+// e_flags bits
+const (
+	efARMBE8        = 0x00800000
+	efMIPSArch      = 0xf0000000
+	efMIPSMicroMIPS = 0x02000000
+)
+
+// EF_MIPS_ARCH values
+const (
+	mipsArch3    = 0x20000000
+	mipsArch4    = 0x30000000
+	mipsArch5    = 0x40000000
+	mipsArch64   = 0x60000000
+	mipsArch64R2 = 0x80000000
+	mipsArch32R6 = 0x90000000
+	mipsArch64R6 = 0xa0000000
+)
+
+// Choose the CS_ARCH_* and CS_MODE_* for an ELF file from its machine, class,
+// data encoding and e_flags, which debug/elf does not expose.
+//
+// ARM files are Thumb when the entry point has bit 0 set, and Cortex-M
+// (CS_MODE_MCLASS) when .ARM.attributes names an M profile CPU. ARM code in
+// BE8 images is little endian. For MIPS, the EF_MIPS_ARCH flags select
+// MIPS64 and MIPS32R6, and EF_MIPS_MICROMIPS selects CS_MODE_MICRO.
+func Target(f *delf.File, flags uint32) (arch int, mode uint, err error) {
+	bigEndian := f.Data == delf.ELFDATA2MSB
+
+	switch f.Machine {
+	case delf.EM_386:
+		arch, mode = gapstone.CS_ARCH_X86, gapstone.CS_MODE_32
+	case delf.EM_X86_64:
+		// x32 is ELFCLASS32, but still 64 bit code
+		arch, mode = gapstone.CS_ARCH_X86, gapstone.CS_MODE_64
+	case delf.EM_ARM:
+		arch, mode = gapstone.CS_ARCH_ARM, gapstone.CS_MODE_ARM
+		if f.Entry&1 != 0 {
+			mode |= gapstone.CS_MODE_THUMB
+		}
+		switch armProfile(f) {
+		case 'M':
+			mode |= gapstone.CS_MODE_THUMB | gapstone.CS_MODE_MCLASS
+		case '8':
+			mode |= gapstone.CS_MODE_V8
+		}
+		if flags&efARMBE8 != 0 {
+			bigEndian = false
+		}
+	case delf.EM_AARCH64:
+		arch, mode = gapstone.CS_ARCH_ARM64, gapstone.CS_MODE_ARM
+	case delf.EM_MIPS, delf.EM_MIPS_RS3_LE:
+		arch, mode = gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_MIPS32
+		switch flags & efMIPSArch {
+		case mipsArch3, mipsArch4, mipsArch5, mipsArch64, mipsArch64R2:
+			mode = gapstone.CS_MODE_MIPS64
+		case mipsArch32R6:
+			mode = gapstone.CS_MODE_MIPS32R6
+		case mipsArch64R6:
+			mode = gapstone.CS_MODE_MIPS64 | gapstone.CS_MODE_MIPS32R6
+		}
+		if f.Class == delf.ELFCLASS64 {
+			mode = mode&^gapstone.CS_MODE_MIPS32 | gapstone.CS_MODE_MIPS64
+		}
+		if flags&efMIPSMicroMIPS != 0 {
+			mode |= gapstone.CS_MODE_MICRO
+		}
+	case delf.EM_PPC:
+		arch, mode = gapstone.CS_ARCH_PPC, gapstone.CS_MODE_32
+	case delf.EM_PPC64:
+		arch, mode = gapstone.CS_ARCH_PPC, gapstone.CS_MODE_64
+	case delf.EM_SPARC, delf.EM_SPARC32PLUS:
+		arch, mode = gapstone.CS_ARCH_SPARC, 0
+		if f.Machine == delf.EM_SPARC32PLUS {
+			mode = gapstone.CS_MODE_V9
+		}
+	case delf.EM_SPARCV9:
+		arch, mode = gapstone.CS_ARCH_SPARC, gapstone.CS_MODE_V9
+	case delf.EM_S390:
+		arch, mode = gapstone.CS_ARCH_SYSZ, 0
+	case delf.EM_XCORE:
+		arch, mode = gapstone.CS_ARCH_XCORE, 0
+	default:
+		return 0, 0, ErrMachine
+	}
+
+	if bigEndian {
+		mode |= gapstone.CS_MODE_BIG_ENDIAN
+	}
+	return arch, mode, nil
+}
+
+// Tag_CPU_arch and Tag_CPU_arch_profile in the aeabi attributes
+const (
+	tagFile           = 1
+	tagCPUArch        = 6
+	tagCPUArchProfile = 7
+	tagCompatibility  = 32
+)
+
+// Returns 'M' for Cortex-M (v6-M, v7-M, v8-M), '8' for ARMv8 A32, or 0 if
+// unknown, from the .ARM.attributes section.
+func armProfile(f *delf.File) byte {
+	s := f.Section(".ARM.attributes")
+	if s == nil || s.Type == delf.SHT_NOBITS {
+		return 0
+	}
+	data, err := s.Data()
+	if err != nil {
+		return 0
+	}
+	return parseARMAttributes(data, f.ByteOrder)
+}
+
+func parseARMAttributes(data []byte, order binary.ByteOrder) byte {
+	if len(data) < 1 || data[0] != 'A' {
+		return 0
+	}
+	data = data[1:]
+	var cpuArch uint64
+	var profile byte
+	for len(data) >= 4 {
+		n := int(order.Uint32(data))
+		if n < 4 || n > len(data) {
+			break
+		}
+		sub := data[4:n]
+		data = data[n:]
+		vendor, sub := cstring(sub)
+		if vendor != "aeabi" {
+			continue
+		}
+		for len(sub) >= 5 {
+			kind, size := sub[0], int(order.Uint32(sub[1:]))
+			if size < 5 || size > len(sub) {
+				break
+			}
+			attrs := sub[5:size]
+			sub = sub[size:]
+			if kind != tagFile {
+				continue
+			}
+			for len(attrs) > 0 {
+				var tag, val uint64
+				tag, attrs = uleb128(attrs)
+				switch {
+				case tag == tagCompatibility:
+					_, attrs = uleb128(attrs)
+					_, attrs = cstring(attrs)
+				case tag == 4 || tag == 5 || (tag > tagCompatibility && tag%2 == 1):
+					// NTBS values
+					_, attrs = cstring(attrs)
+				default:
+					val, attrs = uleb128(attrs)
+				}
+				switch tag {
+				case tagCPUArch:
+					cpuArch = val
+				case tagCPUArchProfile:
+					profile = byte(val)
+				}
+			}
+		}
+	}
+
+	switch {
+	case profile == 'M':
+		return 'M'
+	case cpuArch == 11, cpuArch == 12, cpuArch == 13, cpuArch == 16, cpuArch == 17:
+		// v6-M, v6S-M, v7E-M, v8-M.baseline, v8-M.mainline
+		return 'M'
+	case cpuArch == 14:
+		return '8'
+	}
+	return 0
+}
+
+func cstring(b []byte) (string, []byte) {
+	i := bytes.IndexByte(b, 0)
+	if i < 0 {
+		return string(b), nil
+	}
+	return string(b[:i]), b[i+1:]
+}
+
+func uleb128(b []byte) (uint64, []byte) {
+	var v uint64
+	var shift uint
+	for i, c := range b {
+		v |= uint64(c&0x7f) << shift
+		shift += 7
+		if c&0x80 == 0 {
+			return v, b[i+1:]
+		}
+	}
+	return v, nil
+}
+
+// Disassemble a section by name, eg ".text", at its virtual address. ARM
+// sections are split at the $a, $t and $d mapping symbols, disassembling
+// each run in ARM or Thumb mode and skipping literal pools.
+func (f *File) DisasmSection(name string) ([]gapstone.Instruction, error) {
+	for i, s := range f.Sections {
+		if s.Name != name {
+			continue
+		}
+		if s.Type == delf.SHT_NOBITS {
+			return nil, ErrNoData
+		}
+		data, err := s.Data()
+		if err != nil {
+			return nil, err
+		}
+		return f.disasm(data, s.Addr, delf.SectionIndex(i), false)
+	}
+	return nil, ErrNoSection
+}
+
+// Disassemble a function by symbol name, eg "main". The symbol table is
+// searched before the dynamic symbol table, and the symbol must have a size.
+// ARM symbols with bit 0 set are disassembled as Thumb.
+func (f *File) DisasmSymbol(name string) ([]gapstone.Instruction, error) {
+	sym, ok := f.lookup(name)
+	if !ok {
+		return nil, ErrNoSymbol
+	}
+	if sym.Size == 0 || sym.Section == delf.SHN_UNDEF || int(sym.Section) >= len(f.Sections) {
+		return nil, ErrNoData
+	}
+	s := f.Sections[sym.Section]
+	if s.Type == delf.SHT_NOBITS {
+		return nil, ErrNoData
+	}
+
+	addr, thumb := sym.Value, false
+	if f.Arch == gapstone.CS_ARCH_ARM && delf.ST_TYPE(sym.Info) == delf.STT_FUNC {
+		addr, thumb = addr&^1, addr&1 != 0
+	}
+	if addr < s.Addr || addr-s.Addr+sym.Size > s.Size {
+		return nil, ErrNoData
+	}
+	data := make([]byte, sym.Size)
+	if _, err := s.ReadAt(data, int64(addr-s.Addr)); err != nil {
+		return nil, err
+	}
+	return f.disasm(data, addr, sym.Section, thumb)
+}
+
+func (f *File) lookup(name string) (delf.Symbol, bool) {
+	for _, table := range []func() ([]delf.Symbol, error){f.Symbols, f.DynamicSymbols} {
+		syms, _ := table()
+		for _, sym := range syms {
+			if sym.Name == name && sym.Section != delf.SHN_UNDEF {
+				return sym, true
+			}
+		}
+	}
+	return delf.Symbol{}, false
+}
+
+// The executable PT_LOAD segments, in program header order
+func (f *File) ExecSegments() ([]Segment, error) {
+	var segs []Segment
+	for _, p := range f.Progs {
+		if p.Type != delf.PT_LOAD || p.Flags&delf.PF_X == 0 || p.Filesz == 0 {
+			continue
+		}
+		data, err := ioutil.ReadAll(p.Open())
+		if err != nil {
+			return nil, err
+		}
+		segs = append(segs, Segment{Addr: p.Vaddr, Data: data, Flags: p.Flags})
+	}
+	return segs, nil
+}
+
+// Disassemble a segment from ExecSegments at its virtual address. ARM
+// segments are split at mapping symbols like DisasmSection.
+func (f *File) DisasmSegment(s Segment) ([]gapstone.Instruction, error) {
+	return f.disasm(s.Data, s.Addr, delf.SHN_UNDEF, false)
+}
+
+// A run of code or data starting at addr, from an ARM mapping symbol
+type mapping struct {
+	addr uint64
+	mode uint
+	data bool
+}
+
+// The ARM mapping symbols in section idx, sorted by address. SHN_UNDEF
+// matches every section, which only makes sense when addresses are unique.
+func (f *File) mappings(idx delf.SectionIndex) []mapping {
+	syms, _ := f.Symbols()
+	arm := f.Mode &^ gapstone.CS_MODE_THUMB
+	var maps []mapping
+	for _, sym := range syms {
+		if idx != delf.SHN_UNDEF && sym.Section != idx {
+			continue
+		}
+		if sym.Section == delf.SHN_UNDEF || len(sym.Name) < 2 || sym.Name[0] != '$' {
+			continue
+		}
+		if len(sym.Name) > 2 && sym.Name[2] != '.' {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(sym.Name, "$a"):
+			maps = append(maps, mapping{addr: sym.Value, mode: arm})
+		case strings.HasPrefix(sym.Name, "$t"):
+			maps = append(maps, mapping{addr: sym.Value, mode: arm | gapstone.CS_MODE_THUMB})
+		case strings.HasPrefix(sym.Name, "$d"):
+			maps = append(maps, mapping{addr: sym.Value, data: true})
+		}
+	}
+	sort.Stable(byAddr(maps))
+	return maps
+}
+
+type byAddr []mapping
+
+func (m byAddr) Len() int           { return len(m) }
+func (m byAddr) Less(i, j int) bool { return m[i].addr < m[j].addr }
+func (m byAddr) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+
+func (f *File) disasm(data []byte, addr uint64, idx delf.SectionIndex, thumb bool) ([]gapstone.Instruction, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if f.Arch != gapstone.CS_ARCH_ARM {
+		insns, err := f.Engine.Disasm(data, addr, 0)
+		if err != nil && err != gapstone.ErrOK {
+			return insns, err
+		}
+		return insns, nil
+	}
+
+	end := addr + uint64(len(data))
+	cur := mapping{addr: addr, mode: f.Mode}
+	var runs []mapping
+	for _, m := range f.mappings(idx) {
+		if m.addr <= addr {
+			cur.mode, cur.data = m.mode, m.data
+			continue
+		}
+		if m.addr >= end {
+			break
+		}
+		runs = append(runs, cur)
+		cur = m
+	}
+	runs = append(runs, cur)
+	if thumb {
+		runs[0].mode |= gapstone.CS_MODE_THUMB
+		runs[0].data = false
+	}
+
+	defer f.Engine.SetOption(gapstone.CS_OPT_MODE, f.Mode)
+	var insns []gapstone.Instruction
+	for i, r := range runs {
+		next := end
+		if i+1 < len(runs) {
+			next = runs[i+1].addr
+		}
+		if r.data || next <= r.addr {
+			continue
+		}
+		if r.mode != f.Engine.Mode() {
+			if err := f.Engine.SetOption(gapstone.CS_OPT_MODE, r.mode); err != nil {
+				return insns, err
+			}
+		}
+		out, err := f.Engine.Disasm(data[r.addr-addr:next-addr], r.addr, 0)
+		if err != nil && err != gapstone.ErrOK {
+			return insns, err
+		}
+		insns = append(insns, out...)
+	}
+	return insns, nil
+}
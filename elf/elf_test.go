@@ -0,0 +1,296 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package elf
+
+import (
+	delf "debug/elf"
+	"encoding/binary"
+	"testing"
+
+	"github.com/bnagy/gapstone"
+)
+
+func TestTarget(t *testing.T) {
+	type target struct {
+		f     *delf.File
+		flags uint32
+	}
+	header := func(m delf.Machine, class delf.Class, data delf.Data, flags uint32, entry uint64) target {
+		return target{&delf.File{FileHeader: delf.FileHeader{
+			Machine: m, Class: class, Data: data, Entry: entry,
+		}}, flags}
+	}
+	const (
+		c32, c64 = delf.ELFCLASS32, delf.ELFCLASS64
+		le, be   = delf.ELFDATA2LSB, delf.ELFDATA2MSB
+	)
+
+	tests := []struct {
+		h    target
+		arch int
+		mode uint
+	}{
+		{header(delf.EM_386, c32, le, 0, 0), gapstone.CS_ARCH_X86, gapstone.CS_MODE_32},
+		{header(delf.EM_X86_64, c64, le, 0, 0), gapstone.CS_ARCH_X86, gapstone.CS_MODE_64},
+		{header(delf.EM_ARM, c32, le, 0, 0x8000), gapstone.CS_ARCH_ARM, gapstone.CS_MODE_ARM},
+		{header(delf.EM_ARM, c32, le, 0, 0x8001), gapstone.CS_ARCH_ARM, gapstone.CS_MODE_THUMB},
+		{header(delf.EM_ARM, c32, be, 0, 0x8000), gapstone.CS_ARCH_ARM, gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_ARM, c32, be, efARMBE8, 0x8000), gapstone.CS_ARCH_ARM, gapstone.CS_MODE_ARM},
+		{header(delf.EM_AARCH64, c64, le, 0, 0), gapstone.CS_ARCH_ARM64, gapstone.CS_MODE_ARM},
+		{header(delf.EM_MIPS, c32, be, 0x50001007, 0), gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_MIPS32 | gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_MIPS, c32, le, 0x70001007, 0), gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_MIPS32},
+		{header(delf.EM_MIPS, c32, le, mipsArch32R6, 0), gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_MIPS32R6},
+		{header(delf.EM_MIPS, c32, be, mipsArch32R6|efMIPSMicroMIPS, 0), gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_MIPS32R6 | gapstone.CS_MODE_MICRO | gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_MIPS, c32, le, mipsArch3, 0), gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_MIPS64},
+		{header(delf.EM_MIPS, c64, be, mipsArch64R2, 0), gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_MIPS64 | gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_MIPS, c64, le, mipsArch64R6, 0), gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_MIPS64 | gapstone.CS_MODE_MIPS32R6},
+		{header(delf.EM_PPC, c32, be, 0, 0), gapstone.CS_ARCH_PPC, gapstone.CS_MODE_32 | gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_PPC64, c64, be, 0, 0), gapstone.CS_ARCH_PPC, gapstone.CS_MODE_64 | gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_PPC64, c64, le, 0, 0), gapstone.CS_ARCH_PPC, gapstone.CS_MODE_64},
+		{header(delf.EM_SPARC, c32, be, 0, 0), gapstone.CS_ARCH_SPARC, gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_SPARCV9, c64, be, 0, 0), gapstone.CS_ARCH_SPARC, gapstone.CS_MODE_V9 | gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_S390, c64, be, 0, 0), gapstone.CS_ARCH_SYSZ, gapstone.CS_MODE_BIG_ENDIAN},
+		{header(delf.EM_XCORE, c32, le, 0, 0), gapstone.CS_ARCH_XCORE, 0},
+	}
+	for i, test := range tests {
+		arch, mode, err := Target(test.h.f, test.h.flags)
+		if err != nil || arch != test.arch || mode != test.mode {
+			t.Errorf("%2d> %v: want %v/%#x got %v/%#x (%v)", i, test.h.f.Machine, test.arch, test.mode, arch, mode, err)
+		}
+	}
+	if _, _, err := Target(header(delf.EM_68K, c32, be, 0, 0).f, 0); err != ErrMachine {
+		t.Errorf("EM_68K: want %v got %v", ErrMachine, err)
+	}
+}
+
+func TestParseARMAttributes(t *testing.T) {
+	// Tag_CPU_name "Cortex-M4", Tag_CPU_arch v7E-M, Tag_CPU_arch_profile 'M'
+	attrs := append([]byte{5}, "Cortex-M4\x00"...)
+	attrs = append(attrs, 6, 13, 7, 'M', 8, 0)
+	blob := func(attrs []byte) []byte {
+		file := append([]byte{tagFile, 0, 0, 0, 0}, attrs...)
+		binary.LittleEndian.PutUint32(file[1:], uint32(len(file)))
+		sub := append([]byte{0, 0, 0, 0}, "aeabi\x00"...)
+		sub = append(sub, file...)
+		binary.LittleEndian.PutUint32(sub, uint32(len(sub)))
+		return append([]byte{'A'}, sub...)
+	}
+
+	tests := []struct {
+		attrs []byte
+		want  byte
+	}{
+		{attrs, 'M'},
+		{[]byte{6, 11}, 'M'},
+		{[]byte{6, 14, 7, 'A'}, '8'},
+		{[]byte{6, 10, 7, 'A'}, 0},
+		{[]byte{67, '2', '.', '0', 0, 7, 'M'}, 'M'},
+	}
+	for i, test := range tests {
+		if got := parseARMAttributes(blob(test.attrs), binary.LittleEndian); got != test.want {
+			t.Errorf("%2d> want %q got %q", i, test.want, got)
+		}
+	}
+	if got := parseARMAttributes([]byte("junk"), binary.LittleEndian); got != 0 {
+		t.Errorf("junk: want 0 got %q", got)
+	}
+}
+
+func TestElfEngine(t *testing.T) {
+
+	// testdata/hello.c, built as in its header
+	f, err := Open("testdata/hello-x86_64")
+	if err != nil {
+		t.Fatalf("Failed to open %v", err)
+	}
+	defer f.Close()
+
+	if f.Arch != gapstone.CS_ARCH_X86 || f.Mode != gapstone.CS_MODE_64 {
+		t.Fatalf("want x86-64 got %v/%#x", f.Arch, f.Mode)
+	}
+
+	// add: lea eax, [rdi + rsi]; ret
+	insns, err := f.DisasmSymbol("add")
+	if err != nil || len(insns) != 2 {
+		t.Fatalf("add: want 2 instructions got %d (%v)", len(insns), err)
+	}
+	want := []struct {
+		addr  uint
+		bytes string
+	}{
+		{0x401126, "\x8d\x04\x37"},
+		{0x401129, "\xc3"},
+	}
+	for i, insn := range insns {
+		if insn.Address != want[i].addr || string(insn.Bytes) != want[i].bytes {
+			t.Errorf("%2d> add: want %#x % x got %#x % x", i, want[i].addr, want[i].bytes, insn.Address, insn.Bytes)
+		}
+	}
+
+	segs, err := f.ExecSegments()
+	if err != nil || len(segs) == 0 {
+		t.Fatalf("no executable segments: %v", err)
+	}
+	found := false
+	for _, s := range segs {
+		if s.Addr > 0x401126 || s.Addr+uint64(len(s.Data)) < 0x40112a {
+			continue
+		}
+		found = true
+		insns, err := f.DisasmSegment(Segment{Addr: 0x401126, Data: s.Data[0x401126-s.Addr:][:4]})
+		if err != nil || len(insns) != 2 || insns[1].Address != 0x401129 {
+			t.Errorf("add in segment: got %d instructions (%v)", len(insns), err)
+		}
+	}
+	if !found {
+		t.Errorf("add is in no executable segment")
+	}
+
+	if _, err := f.DisasmSection(".nope"); err != ErrNoSection {
+		t.Errorf(".nope: want %v got %v", ErrNoSection, err)
+	}
+	if _, err := f.DisasmSymbol("nope"); err != ErrNoSymbol {
+		t.Errorf("nope: want %v got %v", ErrNoSymbol, err)
+	}
+}
+
+type armInsn struct {
+	addr     uint
+	size     uint
+	mnemonic string
+}
+
+func checkARMInsns(t *testing.T, what string, insns []gapstone.Instruction, want []armInsn) {
+	if len(insns) != len(want) {
+		t.Errorf("%s: want %d instructions got %d", what, len(want), len(insns))
+		return
+	}
+	for i, insn := range insns {
+		if insn.Address != want[i].addr || insn.Size != want[i].size || insn.Mnemonic != want[i].mnemonic {
+			t.Errorf("%2d> %s: want %#x %s (%d bytes) got %#x %s (%d bytes)", i, what,
+				want[i].addr, want[i].mnemonic, want[i].size, insn.Address, insn.Mnemonic, insn.Size)
+		}
+	}
+}
+
+func TestElfARMEngine(t *testing.T) {
+
+	// testdata/thumb.s, built as in its header. Mapping symbols mark ARM code
+	// at 0, the literal word at 8 and Thumb code at 0xc. Instruction sizes
+	// show the mode: 4 bytes for ARM, 2 for these Thumb instructions.
+	f, err := Open("testdata/thumb-arm.o")
+	if err != nil {
+		t.Fatalf("Failed to open %v", err)
+	}
+	defer f.Close()
+
+	if f.Arch != gapstone.CS_ARCH_ARM || f.Mode != gapstone.CS_MODE_ARM {
+		t.Fatalf("want ARM got %v/%#x", f.Arch, f.Mode)
+	}
+
+	arm := []armInsn{{0, 4, "ldr"}, {4, 4, "bx"}}
+	thumb := []armInsn{{0xc, 2, "movs"}, {0xe, 2, "bx"}}
+
+	insns, err := f.DisasmSymbol("arm_func")
+	if err != nil {
+		t.Fatalf("arm_func: %v", err)
+	}
+	checkARMInsns(t, "arm_func", insns, arm)
+
+	insns, err = f.DisasmSymbol("thumb_func")
+	if err != nil {
+		t.Fatalf("thumb_func: %v", err)
+	}
+	checkARMInsns(t, "thumb_func", insns, thumb)
+
+	insns, err = f.DisasmSection(".text")
+	if err != nil {
+		t.Fatalf(".text: %v", err)
+	}
+	checkARMInsns(t, ".text", insns, append(arm, thumb...))
+
+	if f.Engine.Mode() != gapstone.CS_MODE_ARM {
+		t.Errorf("engine mode not restored: got %#x", f.Engine.Mode())
+	}
+}
+
+func TestPltAddr(t *testing.T) {
+	tests := []struct {
+		m         delf.Machine
+		plt, sec  uint64
+		i         int
+		want      uint64
+		supported bool
+	}{
+		{delf.EM_X86_64, 0x1020, 0, 0, 0x1030, true},
+		{delf.EM_X86_64, 0x1020, 0, 2, 0x1050, true},
+		{delf.EM_X86_64, 0x1020, 0x1060, 2, 0x1080, true},
+		{delf.EM_386, 0x8048300, 0, 1, 0x8048320, true},
+		{delf.EM_ARM, 0x102c8, 0, 1, 0x102e8, true},
+		{delf.EM_AARCH64, 0x400, 0, 1, 0x430, true},
+		{delf.EM_MIPS, 0x400, 0, 1, 0, false},
+	}
+	for i, test := range tests {
+		got, ok := pltAddr(test.m, test.plt, test.sec, test.i)
+		if got != test.want || ok != test.supported {
+			t.Errorf("%2d> %v: want %#x (%v) got %#x (%v)", i, test.m, test.want, test.supported, got, ok)
+		}
+	}
+}
+
+func TestSymbolTable(t *testing.T) {
+	lookup := []struct {
+		file string
+		addr uint64
+		name string
+		off  uint64
+	}{
+		// testdata/hello.c
+		{"hello-x86_64", 0x401030, "puts@plt", 0},
+		{"hello-x86_64", 0x401036, "puts@plt", 6},
+		{"hello-x86_64", 0x401127, "add", 1},
+		{"hello-x86_64", 0x40401c, "counter", 0},
+		// testdata/thumb.s, where thumb_func's symbol is 0xd. The mapping
+		// symbols at 0, 8 and 0xc mustn't shadow the functions.
+		{"thumb-arm.o", 0, "arm_func", 0},
+		{"thumb-arm.o", 8, "arm_func", 8},
+		{"thumb-arm.o", 0xc, "thumb_func", 0},
+		{"thumb-arm.o", 0xe, "thumb_func", 2},
+	}
+	tables := map[string]*gapstone.SymbolMap{}
+	for _, file := range []string{"hello-x86_64", "thumb-arm.o"} {
+		ef, err := delf.Open("testdata/" + file)
+		if err != nil {
+			t.Fatalf("Failed to open %v", err)
+		}
+		defer ef.Close()
+		tables[file] = symbolTable(ef)
+	}
+
+	for i, test := range lookup {
+		name, off, ok := tables[test.file].Lookup(test.addr)
+		if !ok || name != test.name || off != test.off {
+			t.Errorf("%2d> %s %#x: want %s+%#x got %s+%#x (%v)", i, test.file, test.addr, test.name, test.off, name, off, ok)
+		}
+	}
+
+	if addr, ok := tables["thumb-arm.o"].Address("thumb_func"); !ok || addr != 0xc {
+		t.Errorf("thumb_func: want 0xc got %#x (%v)", addr, ok)
+	}
+	for _, sym := range tables["thumb-arm.o"].Symbols() {
+		if isMapping(sym.Name) {
+			t.Errorf("mapping symbol %s at %#x", sym.Name, sym.Addr)
+		}
+	}
+	if _, ok := tables["hello-x86_64"].Address("puts"); ok {
+		t.Errorf("undefined puts is in the table")
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package elf
+
+import (
+	"debug/dwarf"
+	"sort"
+)
+
+// A source position from the DWARF line table, with the inlining that led
+// there
+type Position struct {
+	File   string
+	Line   int
+	Column int
+	// The function as compiled, eg main
+	Function string
+	// The inlined calls covering the address, outermost first. The last one
+	// is the innermost function, which File and Line belong to.
+	Inlined []Inline
+}
+
+// A call inlined into its caller. File and Line are the call site, in the
+// caller.
+type Inline struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// The innermost function, after inlining
+func (p Position) Innermost() string {
+	if n := len(p.Inlined); n > 0 {
+		return p.Inlined[n-1].Function
+	}
+	return p.Function
+}
+
+// Maps addresses to source positions, from the DWARF line tables and the
+// subprogram and inlined subroutine entries
+type LineTable struct {
+	lines []lineRange // sorted by lo
+	funcs []funcScope // sorted by lo
+}
+
+// One row of a line table, covering [lo, hi)
+type lineRange struct {
+	lo, hi       uint64
+	file         string
+	line, column int
+}
+
+// A range of a subprogram, with the inlined calls inside it
+type funcScope struct {
+	lo, hi  uint64
+	name    string
+	inlines []inlineScope
+}
+
+type inlineScope struct {
+	lo, hi uint64
+	depth  int // DIE nesting, deeper is further in
+	call   Inline
+}
+
+// Read the line table from the DWARF sections. The error is from
+// debug/elf when there is no DWARF.
+func (f *File) LineTable() (*LineTable, error) {
+	d, err := f.DWARF()
+	if err != nil {
+		return nil, err
+	}
+	return readLineTable(d)
+}
+
+func readLineTable(d *dwarf.Data) (*LineTable, error) {
+	t := &LineTable{}
+	r := d.Reader()
+	for {
+		cu, err := r.Next()
+		if err != nil {
+			return nil, err
+		}
+		if cu == nil {
+			break
+		}
+		if cu.Tag != dwarf.TagCompileUnit {
+			r.SkipChildren()
+			continue
+		}
+		var files []*dwarf.LineFile
+		if lr, err := d.LineReader(cu); err == nil && lr != nil {
+			files = lr.Files()
+			t.readLines(lr)
+		}
+		if !cu.Children {
+			continue
+		}
+		if err := t.readFuncs(d, r, files); err != nil {
+			return nil, err
+		}
+	}
+	sort.Stable(linesByAddr(t.lines))
+	sort.Stable(funcsByAddr(t.funcs))
+	return t, nil
+}
+
+// Turn the rows of each sequence into address ranges
+func (t *LineTable) readLines(lr *dwarf.LineReader) {
+	var prev dwarf.LineEntry
+	have := false
+	for {
+		var le dwarf.LineEntry
+		if err := lr.Next(&le); err != nil {
+			// io.EOF, or a damaged table which is used as far as it goes
+			return
+		}
+		if have && le.Address > prev.Address {
+			lrng := lineRange{lo: prev.Address, hi: le.Address, line: prev.Line, column: prev.Column}
+			if prev.File != nil {
+				lrng.file = prev.File.Name
+			}
+			t.lines = append(t.lines, lrng)
+		}
+		prev, have = le, !le.EndSequence
+	}
+}
+
+// Read the subprograms of one compile unit, and the inlined subroutines
+// nested in them, leaving r after the unit's children
+func (t *LineTable) readFuncs(d *dwarf.Data, r *dwarf.Reader, files []*dwarf.LineFile) error {
+	// Depth below the compile unit, and the subprogram being read, if any
+	depth, subDepth := 1, 0
+	var sub []int // indexes into t.funcs for the current subprogram's ranges
+	for depth > 0 {
+		e, err := r.Next()
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			return nil
+		}
+		if e.Tag == 0 {
+			depth--
+			if depth < subDepth {
+				sub, subDepth = nil, 0
+			}
+			continue
+		}
+
+		switch {
+		case e.Tag == dwarf.TagSubprogram && subDepth == 0:
+			ranges, _ := d.Ranges(e)
+			if len(ranges) > 0 {
+				sub = nil
+				if e.Children {
+					subDepth = depth + 1
+				}
+				name := dieName(d, e)
+				for _, rg := range ranges {
+					if rg[1] <= rg[0] {
+						continue
+					}
+					sub = append(sub, len(t.funcs))
+					t.funcs = append(t.funcs, funcScope{lo: rg[0], hi: rg[1], name: name})
+				}
+			}
+		case e.Tag == dwarf.TagInlinedSubroutine && subDepth > 0:
+			call := Inline{Function: dieName(d, e)}
+			if idx, ok := e.Val(dwarf.AttrCallFile).(int64); ok && idx >= 0 && int(idx) < len(files) && files[idx] != nil {
+				call.File = files[idx].Name
+			}
+			if line, ok := e.Val(dwarf.AttrCallLine).(int64); ok {
+				call.Line = int(line)
+			}
+			ranges, _ := d.Ranges(e)
+			for _, rg := range ranges {
+				if rg[1] <= rg[0] {
+					continue
+				}
+				in := inlineScope{lo: rg[0], hi: rg[1], depth: depth, call: call}
+				// Attach to whichever range of the subprogram holds it
+				for _, i := range sub {
+					if rg[0] >= t.funcs[i].lo && rg[0] < t.funcs[i].hi {
+						t.funcs[i].inlines = append(t.funcs[i].inlines, in)
+						break
+					}
+				}
+			}
+		}
+		if e.Children {
+			depth++
+		}
+	}
+	return nil
+}
+
+// The name of a DIE, following DW_AT_abstract_origin and DW_AT_specification
+// as inlined and out of line C++ functions need
+func dieName(d *dwarf.Data, e *dwarf.Entry) string {
+	for hops := 0; e != nil && hops < 4; hops++ {
+		if name, ok := e.Val(dwarf.AttrName).(string); ok {
+			return name
+		}
+		off, ok := e.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset)
+		if !ok {
+			if off, ok = e.Val(dwarf.AttrSpecification).(dwarf.Offset); !ok {
+				return ""
+			}
+		}
+		r := d.Reader()
+		r.Seek(off)
+		e, _ = r.Next()
+	}
+	return ""
+}
+
+// The source position of addr. ok is false if the line table doesn't cover
+// it.
+func (t *LineTable) Lookup(addr uint64) (pos Position, ok bool) {
+	i := sort.Search(len(t.lines), func(i int) bool { return t.lines[i].lo > addr }) - 1
+	if i < 0 || addr >= t.lines[i].hi {
+		return pos, false
+	}
+	l := t.lines[i]
+	pos = Position{File: l.file, Line: l.line, Column: l.column}
+
+	j := sort.Search(len(t.funcs), func(j int) bool { return t.funcs[j].lo > addr }) - 1
+	if j < 0 || addr >= t.funcs[j].hi {
+		return pos, true
+	}
+	fn := t.funcs[j]
+	pos.Function = fn.name
+	// Inlines covering the same address always nest, so DIE depth orders them
+	var chain []inlineScope
+	for _, in := range fn.inlines {
+		if addr >= in.lo && addr < in.hi {
+			chain = append(chain, in)
+		}
+	}
+	sort.Stable(inlinesByDepth(chain))
+	for _, in := range chain {
+		pos.Inlined = append(pos.Inlined, in.call)
+	}
+	return pos, true
+}
+
+type linesByAddr []lineRange
+
+func (l linesByAddr) Len() int           { return len(l) }
+func (l linesByAddr) Less(i, j int) bool { return l[i].lo < l[j].lo }
+func (l linesByAddr) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+type funcsByAddr []funcScope
+
+func (f funcsByAddr) Len() int           { return len(f) }
+func (f funcsByAddr) Less(i, j int) bool { return f[i].lo < f[j].lo }
+func (f funcsByAddr) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+type inlinesByDepth []inlineScope
+
+func (s inlinesByDepth) Len() int           { return len(s) }
+func (s inlinesByDepth) Less(i, j int) bool { return s[i].depth < s[j].depth }
+func (s inlinesByDepth) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,201 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package elf
+
+import (
+	"bytes"
+	delf "debug/elf"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/bnagy/gapstone"
+)
+
+const testSource = `int counter;
+static inline int triple(int x) {
+	return x * 3;
+}
+static inline int helper(int x) {
+	return triple(x) + 1;
+}
+int main(void) {
+	return helper(counter);
+}
+`
+
+// The tables gcc -O2 -g emits for testSource: triple inlined into helper
+// inlined into main
+func testLineTable(file string) *LineTable {
+	helper := Inline{Function: "helper", File: file, Line: 9}
+	triple := Inline{Function: "triple", File: file, Line: 6}
+	return &LineTable{
+		lines: []lineRange{
+			{0x1040, 0x1046, file, 3, 11},
+			{0x1046, 0x104a, file, 6, 19},
+			{0x104a, 0x104b, file, 10, 1},
+		},
+		funcs: []funcScope{
+			{0x1040, 0x104b, "main", []inlineScope{
+				{0x1040, 0x104a, 2, helper},
+				{0x1040, 0x1046, 3, triple},
+			}},
+		},
+	}
+}
+
+func TestLineTableLookup(t *testing.T) {
+	lt := testLineTable("m.c")
+	helper := Inline{Function: "helper", File: "m.c", Line: 9}
+	triple := Inline{Function: "triple", File: "m.c", Line: 6}
+	tests := []struct {
+		addr uint64
+		pos  Position
+		ok   bool
+	}{
+		{0x1040, Position{"m.c", 3, 11, "main", []Inline{helper, triple}}, true},
+		{0x1048, Position{"m.c", 6, 19, "main", []Inline{helper}}, true},
+		{0x104a, Position{"m.c", 10, 1, "main", nil}, true},
+		{0x104b, Position{}, false},
+		{0x103f, Position{}, false},
+	}
+	for i, test := range tests {
+		pos, ok := lt.Lookup(test.addr)
+		if ok != test.ok || !reflect.DeepEqual(pos, test.pos) {
+			t.Errorf("%2d> %#x: want %+v (%v) got %+v (%v)", i, test.addr, test.pos, test.ok, pos, ok)
+		}
+	}
+	if pos, _ := lt.Lookup(0x1040); pos.Innermost() != "triple" {
+		t.Errorf("innermost: want triple got %s", pos.Innermost())
+	}
+}
+
+func TestSourceWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gapstone")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "m.c"), []byte(testSource), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	insn := func(addr uint, code []byte, mnemonic, ops string) gapstone.Instruction {
+		return gapstone.Instruction{InstructionHeader: gapstone.InstructionHeader{
+			Address: addr, Size: uint(len(code)), Bytes: code, Mnemonic: mnemonic, OpStr: ops,
+		}}
+	}
+	insns := []gapstone.Instruction{
+		insn(0x1040, []byte{0x8b, 0x05, 0xce, 0x2f, 0x00, 0x00}, "mov", "eax, dword ptr [rip + 0x2fce]"),
+		insn(0x1046, []byte{0x8d, 0x44, 0x40, 0x01}, "lea", "eax, [rax + rax*2 + 1]"),
+		insn(0x104a, []byte{0xc3}, "ret", ""),
+		insn(0x104b, []byte{0x90}, "nop", ""),
+	}
+
+	var buf bytes.Buffer
+	// The recorded path is from the build machine
+	sw := NewSourceWriter(&buf, testLineTable("/build/m.c"))
+	sw.Source, sw.SourceDirs = true, []string{dir}
+	if err := sw.Write(insns); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	want := `
+triple():
+ inlined into helper at /build/m.c:6
+ inlined into main at /build/m.c:9
+/build/m.c:3
+	return x * 3;
+    1040:	8b 05 ce 2f 00 00    	mov	eax, dword ptr [rip + 0x2fce]
+
+helper():
+ inlined into main at /build/m.c:9
+/build/m.c:6
+	return triple(x) + 1;
+    1046:	8d 44 40 01          	lea	eax, [rax + rax*2 + 1]
+
+main():
+/build/m.c:10
+}
+    104a:	c3                   	ret
+    104b:	90                   	nop
+`
+	if got := buf.String(); got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+
+	// Padding at line 0, then code outside any function
+	buf.Reset()
+	sw = NewSourceWriter(&buf, &LineTable{lines: []lineRange{
+		{0x2000, 0x2002, "/build/pad.s", 0, 0},
+		{0x2002, 0x2003, "/build/pad.s", 4, 0},
+	}})
+	pad := []gapstone.Instruction{
+		insn(0x2000, []byte{0x90}, "nop", ""),
+		insn(0x2001, []byte{0x90}, "nop", ""),
+		insn(0x2002, []byte{0xc3}, "ret", ""),
+	}
+	if err := sw.Write(pad); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	want = `    2000:	90                   	nop
+    2001:	90                   	nop
+/build/pad.s:4
+    2002:	c3                   	ret
+`
+	if got := buf.String(); got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+
+	if err := new(SourceWriter).Write(pad); err != ErrNoWriter {
+		t.Errorf("zero value: want %v got %v", ErrNoWriter, err)
+	}
+	if err := NewSourceWriter(nil, nil).Write(pad); err != ErrNoWriter {
+		t.Errorf("nil writer: want %v got %v", ErrNoWriter, err)
+	}
+}
+
+func TestLineTableDWARF(t *testing.T) {
+	// testdata/hello.c, built as in its header
+	ef, err := delf.Open("testdata/hello-x86_64")
+	if err != nil {
+		t.Fatalf("Failed to open %v", err)
+	}
+	defer ef.Close()
+	d, err := ef.DWARF()
+	if err != nil {
+		t.Fatalf("DWARF: %v", err)
+	}
+	lt, err := readLineTable(d)
+	if err != nil {
+		t.Fatalf("readLineTable: %v", err)
+	}
+
+	tests := []struct {
+		addr     uint64
+		function string
+		line     int
+	}{
+		{0x401129, "add", 11}, // ret
+		{0x40113d, "main", 15},
+		{0x401143, "main", 16},
+	}
+	for i, test := range tests {
+		pos, ok := lt.Lookup(test.addr)
+		if !ok || pos.Function != test.function || pos.Line != test.line || filepath.Base(pos.File) != "hello.c" {
+			t.Errorf("%2d> %#x: want %s at hello.c:%d got %+v (%v)", i, test.addr, test.function, test.line, pos, ok)
+		}
+	}
+	if pos, ok := lt.Lookup(0x401000); ok {
+		t.Errorf("_init: want no position got %+v", pos)
+	}
+}
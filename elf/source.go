@@ -0,0 +1,154 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package elf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+// Writes disassembly annotated from a LineTable, like objdump -S -l. A header
+// is written when the innermost function changes, naming the functions it was
+// inlined into, then file:line whenever the position changes. Addresses
+// without a line, or at line 0, are written bare. Create one with
+// NewSourceWriter, as the zero value has nowhere to write.
+//
+//	triple():
+//	 inlined into helper at /src/m.c:6
+//	 inlined into main at /src/m.c:9
+//	/src/m.c:3
+//	  return x * 3;
+//	    1040:	8b 05 ce 2f 00 00    	mov	eax, dword ptr [rip + 0x2fce]
+type SourceWriter struct {
+	Lines *LineTable
+	// Write the text of each source line after its file:line
+	Source bool
+	// Directories to search for sources which aren't at their recorded path,
+	// eg a checkout on another machine. The recorded path is tried under
+	// each directory, then just its base name.
+	SourceDirs []string
+
+	w     io.Writer
+	last  Position
+	have  bool
+	files map[string][]string
+}
+
+// Create a SourceWriter which writes to w
+func NewSourceWriter(w io.Writer, lines *LineTable) *SourceWriter {
+	return &SourceWriter{Lines: lines, w: w}
+}
+
+// Write instructions, with their source positions. Positions carry over
+// between calls, so a function can be written in pieces.
+func (s *SourceWriter) Write(insns []gapstone.Instruction) error {
+	if s.w == nil {
+		return ErrNoWriter
+	}
+	for _, insn := range insns {
+		if pos, ok := s.lookup(uint64(insn.Address)); ok {
+			if err := s.position(pos); err != nil {
+				return err
+			}
+		}
+		line := fmt.Sprintf("%8x:\t%-21s\t%s\t%s", insn.Address, hexBytes(insn.Bytes), insn.Mnemonic, insn.OpStr)
+		if _, err := io.WriteString(s.w, strings.TrimRight(line, "\t")+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The position of addr, if it has a source line. Line 0 marks code with no
+// line, like padding or compiler generated code.
+func (s *SourceWriter) lookup(addr uint64) (Position, bool) {
+	if s.Lines == nil {
+		return Position{}, false
+	}
+	pos, ok := s.Lines.Lookup(addr)
+	return pos, ok && pos.Line != 0
+}
+
+func (s *SourceWriter) position(pos Position) error {
+	var b bytes.Buffer
+	if !s.have || pos.Innermost() != s.last.Innermost() || pos.Function != s.last.Function {
+		if fn := pos.Innermost(); fn != "" {
+			fmt.Fprintf(&b, "\n%s():\n", fn)
+		}
+		// Innermost call first, like a backtrace
+		for i := len(pos.Inlined) - 1; i >= 0; i-- {
+			caller := pos.Function
+			if i > 0 {
+				caller = pos.Inlined[i-1].Function
+			}
+			fmt.Fprintf(&b, " inlined into %s at %s:%d\n", caller, pos.Inlined[i].File, pos.Inlined[i].Line)
+		}
+		s.have = false
+	}
+	if !s.have || pos.File != s.last.File || pos.Line != s.last.Line {
+		fmt.Fprintf(&b, "%s:%d\n", pos.File, pos.Line)
+		if s.Source {
+			if text, ok := s.sourceLine(pos.File, pos.Line); ok {
+				fmt.Fprintf(&b, "%s\n", text)
+			}
+		}
+	}
+	s.last, s.have = pos, true
+	_, err := b.WriteTo(s.w)
+	return err
+}
+
+// Line n of a source file, read once and cached. Missing files are cached
+// too, as nil.
+func (s *SourceWriter) sourceLine(name string, n int) (string, bool) {
+	lines, ok := s.files[name]
+	if !ok {
+		lines = s.readSource(name)
+		if s.files == nil {
+			s.files = make(map[string][]string)
+		}
+		s.files[name] = lines
+	}
+	if n < 1 || n > len(lines) {
+		return "", false
+	}
+	return lines[n-1], true
+}
+
+func (s *SourceWriter) readSource(name string) []string {
+	paths := []string{name}
+	for _, dir := range s.SourceDirs {
+		paths = append(paths, filepath.Join(dir, name), filepath.Join(dir, filepath.Base(name)))
+	}
+	for _, p := range paths {
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			continue
+		}
+		lines := strings.Split(string(data), "\n")
+		for i := range lines {
+			lines[i] = strings.TrimRight(lines[i], "\r")
+		}
+		return lines
+	}
+	return nil
+}
+
+// "8d 04 40"
+func hexBytes(b []byte) string {
+	return strings.TrimSpace(fmt.Sprintf("% x", b))
+}
@@ -0,0 +1,134 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package elf
+
+import (
+	delf "debug/elf"
+
+	"github.com/bnagy/gapstone"
+)
+
+// A SymbolTable for Instruction.SymbolicOpStr, from the defined function,
+// object and untyped symbols in the symbol and dynamic symbol tables, plus
+// "name@plt" for each PLT entry. ARM mapping symbols are left out and the
+// Thumb bit is cleared.
+func (f *File) SymbolTable() *gapstone.SymbolMap {
+	return symbolTable(f.File)
+}
+
+func symbolTable(ef *delf.File) *gapstone.SymbolMap {
+	var out []gapstone.Symbol
+	for _, table := range []func() ([]delf.Symbol, error){ef.Symbols, ef.DynamicSymbols} {
+		syms, _ := table()
+		for _, sym := range syms {
+			if sym.Section == delf.SHN_UNDEF || sym.Section == delf.SHN_ABS || isMapping(sym.Name) {
+				continue
+			}
+			addr := sym.Value
+			switch delf.ST_TYPE(sym.Info) {
+			case delf.STT_FUNC:
+				if ef.Machine == delf.EM_ARM {
+					addr &^= 1
+				}
+			case delf.STT_OBJECT, delf.STT_NOTYPE:
+			default:
+				continue
+			}
+			out = append(out, gapstone.Symbol{Name: sym.Name, Addr: addr, Size: sym.Size})
+		}
+	}
+	return gapstone.NewSymbolMap(append(out, pltSymbols(ef)...))
+}
+
+// ARM and AArch64 mapping symbols, $a $t $d $x and $d.foo etc
+func isMapping(name string) bool {
+	if len(name) < 2 || name[0] != '$' {
+		return false
+	}
+	switch name[1] {
+	case 'a', 't', 'd', 'x':
+		return len(name) == 2 || name[2] == '.'
+	}
+	return false
+}
+
+// Name the PLT entries from the JUMP_SLOT relocations, which are in PLT
+// order. Only the common layouts from GNU ld are known.
+func pltSymbols(ef *delf.File) []gapstone.Symbol {
+	plt, sec := ef.Section(".plt"), ef.Section(".plt.sec")
+	if plt == nil {
+		return nil
+	}
+	rel, size := ef.Section(".rela.plt"), 24
+	if ef.Class == delf.ELFCLASS32 {
+		size = 12
+	}
+	if rel == nil {
+		rel, size = ef.Section(".rel.plt"), 16
+		if ef.Class == delf.ELFCLASS32 {
+			size = 8
+		}
+	}
+	if rel == nil {
+		return nil
+	}
+	data, err := rel.Data()
+	if err != nil {
+		return nil
+	}
+	dyn, err := ef.DynamicSymbols()
+	if err != nil {
+		return nil
+	}
+
+	var syms []gapstone.Symbol
+	for i := 0; (i+1)*size <= len(data); i++ {
+		var idx uint64
+		entry := data[i*size:]
+		if ef.Class == delf.ELFCLASS32 {
+			idx = uint64(ef.ByteOrder.Uint32(entry[4:]) >> 8)
+		} else {
+			idx = ef.ByteOrder.Uint64(entry[8:]) >> 32
+		}
+		if idx == 0 || int(idx) > len(dyn) {
+			continue
+		}
+		var secAddr uint64
+		if sec != nil {
+			secAddr = sec.Addr
+		}
+		addr, ok := pltAddr(ef.Machine, plt.Addr, secAddr, i)
+		if !ok {
+			return nil
+		}
+		// DynamicSymbols drops the null symbol at index 0
+		syms = append(syms, gapstone.Symbol{Name: dyn[idx-1].Name + "@plt", Addr: addr})
+	}
+	return syms
+}
+
+// The address of PLT entry i. sec is the .plt.sec address used with IBT, or
+// 0.
+func pltAddr(m delf.Machine, plt, sec uint64, i int) (uint64, bool) {
+	n := uint64(i)
+	switch m {
+	case delf.EM_386, delf.EM_X86_64:
+		if sec != 0 {
+			return sec + 16*n, true
+		}
+		return plt + 16*(n+1), true
+	case delf.EM_ARM:
+		return plt + 20 + 12*n, true
+	case delf.EM_AARCH64:
+		return plt + 32 + 16*n, true
+	}
+	return 0, false
+}
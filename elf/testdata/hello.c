@@ -0,0 +1,18 @@
+// gcc -O1 -g -fno-asynchronous-unwind-tables -fno-pie -no-pie -Wl,--build-id=none \
+//     -fdebug-prefix-map=$PWD=. -o hello-x86_64 hello.c
+
+#include <stdio.h>
+
+int counter;
+
+__attribute__((noinline)) int add(int a, int b)
+{
+	return a + b;
+}
+
+int main(void)
+{
+	counter = add(1, 2);
+	puts("hello");
+	return 0;
+}
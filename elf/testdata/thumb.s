@@ -0,0 +1,22 @@
+@ llvm-mc -triple=armv7-linux-gnueabi -filetype=obj -o thumb-arm.o thumb.s
+
+	.syntax unified
+	.text
+
+	.arm
+	.globl	arm_func
+	.type	arm_func, %function
+arm_func:
+	ldr	r0, 1f
+	bx	lr
+1:	.word	0x12345678
+	.size	arm_func, . - arm_func
+
+	.thumb
+	.globl	thumb_func
+	.type	thumb_func, %function
+	.thumb_func
+thumb_func:
+	movs	r0, #1
+	bx	lr
+	.size	thumb_func, . - thumb_func
@@ -0,0 +1,726 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var ErrEncoding = errors.New("gapstone: instruction bytes don't match a known encoding")
+
+// What an EncodingField holds
+type EncodingKind int
+
+const (
+	EncPrefix   EncodingKind = iota // x86 legacy prefixes
+	EncRex                          // x86 REX
+	EncVex                          // x86 VEX, XOP or EVEX prefix
+	EncOpcode                       // x86 opcode bytes
+	EncModRM                        // x86 ModRM
+	EncSIB                          // x86 SIB
+	EncDisp                         // memory displacement or offset
+	EncImm                          // immediate operand
+	EncRelative                     // PC-relative branch or literal offset
+	EncAbsolute                     // absolute target (MIPS j, PPC ba, x86 far pointers)
+)
+
+var encodingKindNames = [...]string{
+	EncPrefix:   "prefix",
+	EncRex:      "rex",
+	EncVex:      "vex",
+	EncOpcode:   "opcode",
+	EncModRM:    "modrm",
+	EncSIB:      "sib",
+	EncDisp:     "disp",
+	EncImm:      "imm",
+	EncRelative: "relative",
+	EncAbsolute: "absolute",
+}
+
+func (k EncodingKind) String() string {
+	if k >= 0 && int(k) < len(encodingKindNames) {
+		return encodingKindNames[k]
+	}
+	return "unknown"
+}
+
+// One field of an instruction encoding. The field lives in Bytes[Offset :
+// Offset+Size], read as an integer with the instruction's byte order, as bits
+// Bit .. Bit+Bits-1 counting from the least significant bit. x86 fields are
+// always whole bytes.
+type EncodingField struct {
+	Kind   EncodingKind
+	Offset int
+	Size   int
+	Bit    uint
+	Bits   uint
+	Shift  uint // the operand value is the field value << Shift
+	Signed bool
+}
+
+// Where the parts of this Instruction live in its Bytes, for patching,
+// signature masking and relocation.
+//
+// x86 is described completely - prefixes, opcode, ModRM, SIB, displacement
+// and immediates. EVEX disp8 values are additionally scaled by the operand
+// size (disp8*N), which isn't reflected in Shift.
+//
+// For the fixed width ISAs only the displacement, immediate and branch offset
+// fields of the common formats are listed, and operands split across the
+// encoding (ARM movw, ARM64 adr, SysZ long displacements) are listed most
+// significant part first, each shifted to its position in the value. ARM ldr
+// / str offsets are magnitudes, with the sign in the U bit. Thumb-2 32-bit,
+// microMIPS and XCore encodings return no fields.
+//
+// Returns ErrEncoding if x86 bytes are truncated or a fixed width instruction
+// has an unexpected size.
+func (insn Instruction) Encoding() ([]EncodingField, error) {
+	switch insn.archOf() {
+	case CS_ARCH_X86:
+		return x86Encoding(&insn)
+	case CS_ARCH_ARM:
+		if insn.mode&CS_MODE_THUMB != 0 {
+			return insn.wordFields(2, thumbFields)
+		}
+		return insn.wordFields(4, armFields)
+	case CS_ARCH_ARM64:
+		return insn.wordFields(4, arm64Fields)
+	case CS_ARCH_MIPS:
+		if insn.mode&CS_MODE_MICRO != 0 {
+			return nil, nil
+		}
+		return insn.wordFields(4, func(w uint32) []EncodingField {
+			return mipsFields(w, insn.mode&CS_MODE_MIPS32R6 != 0)
+		})
+	case CS_ARCH_PPC:
+		return insn.wordFields(4, ppcFields)
+	case CS_ARCH_SPARC:
+		return insn.wordFields(4, sparcFields)
+	case CS_ARCH_SYSZ:
+		return syszEncoding(&insn)
+	case CS_ARCH_XCORE:
+		return nil, nil
+	}
+	return nil, ErrArch
+}
+
+// A field of a fixed width instruction word
+func wordField(kind EncodingKind, bit, bits, shift uint, signed bool) EncodingField {
+	return EncodingField{Kind: kind, Bit: bit, Bits: bits, Shift: shift, Signed: signed}
+}
+
+// Read the instruction word and fill in the location of its fields. A size
+// mismatch means a wider encoding (Thumb-2) with no field tables.
+func (insn *Instruction) wordFields(size int, fields func(uint32) []EncodingField) ([]EncodingField, error) {
+	if len(insn.Bytes) != size {
+		if size == 2 && len(insn.Bytes) == 4 {
+			return nil, nil
+		}
+		return nil, ErrEncoding
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if insn.mode&CS_MODE_BIG_ENDIAN != 0 {
+		order = binary.BigEndian
+	}
+	var w uint32
+	if size == 2 {
+		w = uint32(order.Uint16(insn.Bytes))
+	} else {
+		w = order.Uint32(insn.Bytes)
+	}
+	fs := fields(w)
+	for i := range fs {
+		fs[i].Size = size
+	}
+	return fs, nil
+}
+
+// X86
+
+// One byte opcodes with a ModRM byte, as a bitmap indexed by opcode
+var x86ModRM1 = [8]uint32{
+	0x0f0f0f0f, // 00-1f alu r/m forms
+	0x0f0f0f0f, // 20-3f
+	0x00000000, // 40-5f
+	0x00000a0c, // 60-7f bound arpl imul
+	0x0000ffff, // 80-9f groups 1, test, xchg, mov, lea, pop r/m
+	0x00000000, // a0-bf
+	0xff0f00f3, // c0-df shift groups, les lds, mov imm, x87
+	0xc0c00000, // e0-ff groups 3, 4, 5
+}
+
+// Two byte (0f xx) opcodes without a ModRM byte
+var x86NoModRM2 = [8]uint32{
+	0x00005ff0, // 00-1f syscall clts sysret invd wbinvd ud2 femms
+	0x00ff0000, // 20-3f wrmsr .. getsec
+	0x00000000, // 40-5f
+	0x00800000, // 60-7f emms
+	0x0000ffff, // 80-9f jcc rel
+	0x00000707, // a0-bf push / pop fs gs, cpuid, rsm
+	0x0000ff00, // c0-df bswap
+	0x00000000, // e0-ff
+}
+
+func bitmapHas(m [8]uint32, b byte) bool {
+	return m[b>>5]&(1<<(b&31)) != 0
+}
+
+func byteField(kind EncodingKind, offset, size int, signed bool) EncodingField {
+	return EncodingField{Kind: kind, Offset: offset, Size: size, Bits: uint(size) * 8, Signed: signed}
+}
+
+func x86Encoding(insn *Instruction) ([]EncodingField, error) {
+	b := insn.Bytes
+	var fs []EncodingField
+	i := 0
+
+	addrSize := 4
+	switch {
+	case insn.mode&CS_MODE_64 != 0:
+		addrSize = 8
+	case insn.mode&CS_MODE_16 != 0:
+		addrSize = 2
+	}
+	for i < len(b) && x86LegacyPrefix(b[i]) {
+		if b[i] == X86_PREFIX_ADDRSIZE {
+			switch addrSize {
+			case 8:
+				addrSize = 4
+			case 4:
+				addrSize = 2
+			case 2:
+				addrSize = 4
+			}
+		}
+		i++
+	}
+	if i > 0 {
+		fs = append(fs, byteField(EncPrefix, 0, i, false))
+	}
+	if insn.mode&CS_MODE_64 != 0 && i < len(b) && b[i]&0xf0 == 0x40 {
+		fs = append(fs, byteField(EncRex, i, 1, false))
+		i++
+	}
+
+	// opcode, and whether a ModRM follows
+	start := i
+	modrm := true
+	opcode := byte(0)
+	v, err := insn.X86Vex()
+	switch {
+	case err != nil && err != ErrX86VexMismatch:
+		return nil, ErrEncoding
+	case v.Kind != X86VexNone:
+		size := map[X86VexKind]int{X86Vex2: 2, X86Vex3: 3, X86Xop: 3, X86Evex: 4}[v.Kind]
+		fs = append(fs, byteField(EncVex, i, size, false))
+		i += size
+		start = i
+		if i < len(b) {
+			opcode = b[i]
+			modrm = !(v.MMMMM == 1 && opcode == 0x77) // vzeroupper / vzeroall
+		}
+		i++
+	case i < len(b) && b[i] == 0x0f:
+		i++
+		if i < len(b) {
+			opcode = b[i]
+			switch opcode {
+			case 0x38, 0x3a:
+				i++
+			default:
+				modrm = !bitmapHas(x86NoModRM2, opcode)
+			}
+		}
+		i++
+	case i < len(b):
+		opcode = b[i]
+		modrm = bitmapHas(x86ModRM1, opcode)
+		i++
+	}
+	if i > len(b) {
+		return nil, ErrEncoding
+	}
+	twoByte := i-start == 2 && b[start] == 0x0f
+	fs = append(fs, byteField(EncOpcode, start, i-start, false))
+
+	if modrm {
+		if i >= len(b) {
+			return nil, ErrEncoding
+		}
+		fs = append(fs, byteField(EncModRM, i, 1, false))
+		mod, rm := b[i]>>6, b[i]&7
+		i++
+		disp := 0
+		switch {
+		case mod == 3:
+		case addrSize == 2:
+			switch {
+			case mod == 0 && rm == 6, mod == 2:
+				disp = 2
+			case mod == 1:
+				disp = 1
+			}
+		default:
+			if rm == 4 {
+				if i >= len(b) {
+					return nil, ErrEncoding
+				}
+				fs = append(fs, byteField(EncSIB, i, 1, false))
+				if mod == 0 && b[i]&7 == 5 {
+					disp = 4
+				}
+				i++
+			}
+			switch {
+			case mod == 0 && rm == 5, mod == 2:
+				disp = 4
+			case mod == 1:
+				disp = 1
+			}
+		}
+		if disp > 0 {
+			fs = append(fs, byteField(EncDisp, i, disp, true))
+			i += disp
+		}
+	}
+
+	// everything left is immediates
+	rest := len(b) - i
+	if rest < 0 {
+		return nil, ErrEncoding
+	}
+	if rest == 0 {
+		return fs, nil
+	}
+	switch {
+	case v.Kind == X86VexNone && b[start] != 0x0f:
+		switch op := b[start]; {
+		case op >= 0xa0 && op <= 0xa3:
+			// mov moffs
+			fs = append(fs, byteField(EncDisp, i, rest, false))
+		case op >= 0x70 && op <= 0x7f, op >= 0xe0 && op <= 0xe3, op == 0xe8, op == 0xe9, op == 0xeb:
+			fs = append(fs, byteField(EncRelative, i, rest, true))
+		case op == 0x9a, op == 0xea:
+			// far pointer: offset then selector
+			fs = append(fs, byteField(EncAbsolute, i, rest-2, false))
+			fs = append(fs, byteField(EncImm, i+rest-2, 2, false))
+		case op == 0xc8:
+			// enter imm16, imm8
+			fs = append(fs, byteField(EncImm, i, 2, false))
+			fs = append(fs, byteField(EncImm, i+2, rest-2, false))
+		default:
+			fs = append(fs, byteField(EncImm, i, rest, false))
+		}
+	case twoByte && opcode >= 0x80 && opcode <= 0x8f:
+		fs = append(fs, byteField(EncRelative, i, rest, true))
+	default:
+		fs = append(fs, byteField(EncImm, i, rest, false))
+	}
+	return fs, nil
+}
+
+// ARM
+
+func armFields(w uint32) []EncodingField {
+	cond := w >> 28
+	switch {
+	case w>>25&7 == 5:
+		// b, bl, blx imm
+		return []EncodingField{wordField(EncRelative, 0, 24, 2, true)}
+	case cond == 0xf:
+		return nil
+	case w>>24&0xf == 0xf:
+		// svc
+		return []EncodingField{wordField(EncImm, 0, 24, 0, false)}
+	case w>>20&0xfb == 0x30:
+		// movw, movt imm4:imm12
+		return []EncodingField{
+			wordField(EncImm, 16, 4, 12, false),
+			wordField(EncImm, 0, 12, 0, false),
+		}
+	case w>>25&7 == 1:
+		// data processing, rotated imm12
+		return []EncodingField{wordField(EncImm, 0, 12, 0, false)}
+	case w>>25&7 == 2:
+		// ldr / str imm12
+		return []EncodingField{wordField(EncDisp, 0, 12, 0, false)}
+	case w>>25&7 == 0 && w&0x90 == 0x90 && w&0x60 != 0 && w&(1<<22) != 0:
+		// ldrh / strh / ldrd ... imm4H:imm4L
+		return []EncodingField{
+			wordField(EncDisp, 8, 4, 4, false),
+			wordField(EncDisp, 0, 4, 0, false),
+		}
+	case w>>20&0xff == 0x12 && w>>4&0xf == 7:
+		// bkpt imm12:imm4
+		return []EncodingField{
+			wordField(EncImm, 8, 12, 4, false),
+			wordField(EncImm, 0, 4, 0, false),
+		}
+	case w>>25&7 == 6:
+		// vldr / vstr / ldc / stc imm8
+		return []EncodingField{wordField(EncDisp, 0, 8, 2, false)}
+	}
+	return nil
+}
+
+func thumbFields(w uint32) []EncodingField {
+	switch {
+	case w>>12 == 0xd:
+		switch w >> 8 & 0xf {
+		case 0xe, 0xf:
+			// udf, svc
+			return []EncodingField{wordField(EncImm, 0, 8, 0, false)}
+		}
+		return []EncodingField{wordField(EncRelative, 0, 8, 1, true)}
+	case w>>11 == 0x1c:
+		return []EncodingField{wordField(EncRelative, 0, 11, 1, true)}
+	case w>>12 == 0xb && w&0x500 == 0x100:
+		// cbz / cbnz i:imm5
+		return []EncodingField{
+			wordField(EncRelative, 9, 1, 6, false),
+			wordField(EncRelative, 3, 5, 1, false),
+		}
+	case w>>8 == 0xbe:
+		// bkpt
+		return []EncodingField{wordField(EncImm, 0, 8, 0, false)}
+	case w>>8 == 0xb0:
+		// add / sub sp, #imm7
+		return []EncodingField{wordField(EncImm, 0, 7, 2, false)}
+	case w>>11 == 9:
+		// ldr literal
+		return []EncodingField{wordField(EncRelative, 0, 8, 2, false)}
+	case w>>13 == 1:
+		// movs / cmp / adds / subs imm8
+		return []EncodingField{wordField(EncImm, 0, 8, 0, false)}
+	case w>>13 == 0 && w>>11 != 3:
+		// shift by imm5
+		return []EncodingField{wordField(EncImm, 6, 5, 0, false)}
+	case w>>13 == 3:
+		// ldr / str (word at 011x0, byte at 011x1) imm5
+		shift := uint(2)
+		if w&(1<<12) != 0 {
+			shift = 0
+		}
+		return []EncodingField{wordField(EncDisp, 6, 5, shift, false)}
+	case w>>12 == 8:
+		// ldrh / strh imm5
+		return []EncodingField{wordField(EncDisp, 6, 5, 1, false)}
+	case w>>12 == 9:
+		// sp relative ldr / str
+		return []EncodingField{wordField(EncDisp, 0, 8, 2, false)}
+	case w>>12 == 0xa:
+		// adr, add rd, sp, #imm8
+		kind := EncImm
+		if w&(1<<11) == 0 {
+			kind = EncRelative
+		}
+		return []EncodingField{wordField(kind, 0, 8, 2, false)}
+	}
+	return nil
+}
+
+// ARM64
+
+func arm64Fields(w uint32) []EncodingField {
+	switch {
+	case w>>26&0x1f == 5:
+		// b, bl
+		return []EncodingField{wordField(EncRelative, 0, 26, 2, true)}
+	case w>>24 == 0x54, w>>25&0x3f == 0x1a:
+		// b.cond, cbz, cbnz
+		return []EncodingField{wordField(EncRelative, 5, 19, 2, true)}
+	case w>>25&0x3f == 0x1b:
+		// tbz, tbnz b5:b40, imm14
+		return []EncodingField{
+			wordField(EncImm, 31, 1, 5, false),
+			wordField(EncImm, 19, 5, 0, false),
+			wordField(EncRelative, 5, 14, 2, true),
+		}
+	case w>>24&0x1f == 0x10:
+		// adr, adrp immhi:immlo
+		shift := uint(0)
+		if w>>31 != 0 {
+			shift = 12
+		}
+		return []EncodingField{
+			wordField(EncRelative, 5, 19, shift+2, true),
+			wordField(EncRelative, 29, 2, shift, false),
+		}
+	case w>>24 == 0xd4:
+		// svc, hvc, smc, brk, hlt
+		return []EncodingField{wordField(EncImm, 5, 16, 0, false)}
+	case w>>27&7 == 3 && w>>24&3 == 0:
+		// ldr literal
+		return []EncodingField{wordField(EncRelative, 5, 19, 2, true)}
+	case w>>23&0x3f == 0x22:
+		// add / sub imm12, optionally lsl #12
+		return []EncodingField{wordField(EncImm, 10, 12, uint(w>>22&1)*12, false)}
+	case w>>23&0x3f == 0x24:
+		// logical immediate - an encoded bitmask
+		return []EncodingField{wordField(EncImm, 10, 13, 0, false)}
+	case w>>23&0x3f == 0x25:
+		// movn, movz, movk imm16, lsl #hw*16
+		return []EncodingField{wordField(EncImm, 5, 16, uint(w>>21&3)*16, false)}
+	case w>>27&7 == 7 && w>>24&3 == 1:
+		// ldr / str unsigned offset, scaled by the access size
+		shift := uint(w >> 30)
+		if w&(1<<26) != 0 && w&(1<<23) != 0 {
+			shift = 4
+		}
+		return []EncodingField{wordField(EncDisp, 10, 12, shift, false)}
+	case w>>27&7 == 7 && w>>24&3 == 0 && w&(1<<21) == 0:
+		// unscaled, pre and post indexed imm9
+		return []EncodingField{wordField(EncDisp, 12, 9, 0, true)}
+	case w>>27&7 == 5 && w>>23&7 >= 1 && w>>23&7 <= 3:
+		// ldp / stp imm7, scaled
+		shift := uint(2 + w>>31)
+		if w&(1<<26) != 0 {
+			shift = uint(2 + w>>30)
+		}
+		return []EncodingField{wordField(EncDisp, 15, 7, shift, true)}
+	}
+	return nil
+}
+
+// MIPS
+
+func mipsFields(w uint32, r6 bool) []EncodingField {
+	op := w >> 26
+	switch {
+	case op == 2, op == 3:
+		// j, jal - within the current 256MB region
+		return []EncodingField{wordField(EncAbsolute, 0, 26, 2, false)}
+	case r6 && (op == 0x32 || op == 0x3a):
+		// bc, balc
+		return []EncodingField{wordField(EncRelative, 0, 26, 2, true)}
+	case r6 && (op == 0x36 || op == 0x3e):
+		// beqzc, bnezc (jic, jialc when rs is 0 are not relative)
+		if w>>21&0x1f == 0 {
+			return []EncodingField{wordField(EncImm, 0, 16, 0, true)}
+		}
+		return []EncodingField{wordField(EncRelative, 0, 21, 2, true)}
+	case r6 && (op == 8 || op == 0x18):
+		// bovc, beqzalc, beqc and bnvc, bnezalc, bnec replace addi / daddi
+		return []EncodingField{wordField(EncRelative, 0, 16, 2, true)}
+	case r6 && op == 0x3b:
+		// PC-relative addresses and loads, selected by rt
+		switch rt := w >> 16 & 0x1f; {
+		case rt>>3 < 3:
+			// addiupc, lwpc, lwupc
+			return []EncodingField{wordField(EncRelative, 0, 19, 2, true)}
+		case rt>>2 == 6:
+			// ldpc
+			return []EncodingField{wordField(EncRelative, 0, 18, 3, true)}
+		}
+		// auipc, aluipc
+		return []EncodingField{wordField(EncRelative, 0, 16, 16, true)}
+	case op == 1, op >= 4 && op <= 7, op >= 0x14 && op <= 0x17:
+		// regimm, beq .. bgtz and the likely forms
+		return []EncodingField{wordField(EncRelative, 0, 16, 2, true)}
+	case op >= 8 && op <= 0xb, op == 0x18, op == 0x19:
+		// addi .. sltiu, daddi, daddiu
+		return []EncodingField{wordField(EncImm, 0, 16, 0, true)}
+	case op >= 0xc && op <= 0xf:
+		// andi, ori, xori, lui
+		return []EncodingField{wordField(EncImm, 0, 16, 0, false)}
+	case op >= 0x20:
+		return []EncodingField{wordField(EncDisp, 0, 16, 0, true)}
+	}
+	return nil
+}
+
+// PPC
+
+func ppcFields(w uint32) []EncodingField {
+	op := w >> 26
+	kind := EncRelative
+	if w&2 != 0 {
+		kind = EncAbsolute // AA
+	}
+	switch {
+	case op == 18:
+		return []EncodingField{wordField(kind, 2, 24, 2, true)}
+	case op == 16:
+		return []EncodingField{wordField(kind, 2, 14, 2, true)}
+	case op == 58, op == 62:
+		// DS form ld / std
+		return []EncodingField{wordField(EncDisp, 2, 14, 2, true)}
+	case op >= 32 && op <= 55:
+		return []EncodingField{wordField(EncDisp, 0, 16, 0, true)}
+	case op == 2, op == 3, op == 7, op == 8, op == 11, op >= 12 && op <= 15:
+		// tdi twi mulli subfic cmpi addic addic. addi addis
+		return []EncodingField{wordField(EncImm, 0, 16, 0, true)}
+	case op == 10, op >= 24 && op <= 29:
+		// cmpli ori oris xori xoris andi. andis.
+		return []EncodingField{wordField(EncImm, 0, 16, 0, false)}
+	}
+	return nil
+}
+
+// SPARC
+
+func sparcFields(w uint32) []EncodingField {
+	switch w >> 30 {
+	case 1:
+		// call
+		return []EncodingField{wordField(EncRelative, 0, 30, 2, true)}
+	case 0:
+		switch w >> 22 & 7 {
+		case 2, 6, 7:
+			// bicc, fbfcc, cbccc disp22
+			return []EncodingField{wordField(EncRelative, 0, 22, 2, true)}
+		case 1, 5:
+			// bpcc, fbpfcc disp19
+			return []EncodingField{wordField(EncRelative, 0, 19, 2, true)}
+		case 3:
+			// bpr d16hi:d16lo
+			return []EncodingField{
+				wordField(EncRelative, 20, 2, 16, true),
+				wordField(EncRelative, 0, 14, 2, false),
+			}
+		case 4:
+			// sethi imm22 << 10
+			return []EncodingField{wordField(EncImm, 0, 22, 10, false)}
+		}
+	default:
+		if w&(1<<13) == 0 {
+			return nil
+		}
+		kind := EncImm
+		if w>>30 == 3 {
+			kind = EncDisp
+		}
+		return []EncodingField{wordField(kind, 0, 13, 0, true)}
+	}
+	return nil
+}
+
+// SYSZ
+
+// A field of a SystemZ instruction, within the big endian integer at
+// Bytes[offset:offset+size]
+func syszField(kind EncodingKind, offset, size int, bits, shift uint, signed bool) EncodingField {
+	return EncodingField{Kind: kind, Offset: offset, Size: size, Bits: bits, Shift: shift, Signed: signed}
+}
+
+// Relative long: larl, brcl, brasl, and the c4 / c6 loads, stores and
+// compares
+func syszRelativeLong(op, op2 byte) bool {
+	switch op {
+	case 0xc0:
+		return op2 == 0 || op2 == 4 || op2 == 5
+	case 0xc4, 0xc6:
+		return true
+	}
+	return false
+}
+
+func syszEncoding(insn *Instruction) ([]EncodingField, error) {
+	b := insn.Bytes
+	if len(b) == 0 {
+		return nil, ErrEncoding
+	}
+	// the top two bits of the first byte give the length
+	size := [4]int{2, 4, 4, 6}[b[0]>>6]
+	if len(b) != size {
+		return nil, ErrEncoding
+	}
+	op := b[0]
+	switch size {
+	case 2:
+		return nil, nil
+	case 4:
+		op2 := b[1] & 0xf
+		switch {
+		case op == 0xa7:
+			// ri: brc, bras, brct, brctg are relative
+			if op2 >= 4 && op2 <= 7 {
+				return []EncodingField{syszField(EncRelative, 2, 2, 16, 1, true)}, nil
+			}
+			return []EncodingField{syszField(EncImm, 2, 2, 16, 0, op2 >= 8)}, nil
+		case op == 0xa5:
+			return []EncodingField{syszField(EncImm, 2, 2, 16, 0, false)}, nil
+		case op == 0x84, op == 0x85:
+			// rsi: brxh, brxle
+			return []EncodingField{syszField(EncRelative, 2, 2, 16, 1, true)}, nil
+		case op == 0x93:
+			// s: ts has no immediate
+			return []EncodingField{syszField(EncDisp, 2, 2, 12, 0, false)}, nil
+		case op >= 0x91 && op <= 0x97:
+			// si: i2 and d1
+			return []EncodingField{
+				syszField(EncImm, 1, 1, 8, 0, false),
+				syszField(EncDisp, 2, 2, 12, 0, false),
+			}, nil
+		case op >= 0x40 && op <= 0x9f:
+			// rx, rs
+			return []EncodingField{syszField(EncDisp, 2, 2, 12, 0, false)}, nil
+		}
+	case 6:
+		op2 := b[1] & 0xf
+		switch {
+		case op == 0xc0, op == 0xc2, op == 0xc4, op == 0xc6:
+			// ril
+			if syszRelativeLong(op, op2) {
+				return []EncodingField{syszField(EncRelative, 2, 4, 32, 1, true)}, nil
+			}
+			return []EncodingField{syszField(EncImm, 2, 4, 32, 0, false)}, nil
+		case op == 0xcc:
+			// ril: brcth, and the high word aih, alsih, cih, clih
+			if op2 == 6 {
+				return []EncodingField{syszField(EncRelative, 2, 4, 32, 1, true)}, nil
+			}
+			return []EncodingField{syszField(EncImm, 2, 4, 32, 0, op2 != 0xf)}, nil
+		case op == 0xc8:
+			// ssf: mvcos, ectg, csst, lpd, lpdg
+			return []EncodingField{
+				syszField(EncDisp, 2, 2, 12, 0, false),
+				syszField(EncDisp, 4, 2, 12, 0, false),
+			}, nil
+		case op == 0xed:
+			// rxy: ley, ldy, stey, stdy. The rest are rxe, rxf and rsl,
+			// where byte 4 holds a register or mask, not dh.
+			switch b[5] {
+			case 0x64, 0x65, 0x66, 0x67:
+				return []EncodingField{
+					syszField(EncDisp, 4, 1, 8, 12, true),
+					syszField(EncDisp, 2, 2, 12, 0, false),
+				}, nil
+			}
+			return []EncodingField{syszField(EncDisp, 2, 2, 12, 0, false)}, nil
+		case op == 0xe3, op == 0xeb:
+			// rxy, rsy: dh:dl
+			return []EncodingField{
+				syszField(EncDisp, 4, 1, 8, 12, true),
+				syszField(EncDisp, 2, 2, 12, 0, false),
+			}, nil
+		case op == 0xec:
+			// compare and branch relative
+			switch b[5] {
+			case 0x64, 0x65, 0x76, 0x77:
+				return []EncodingField{syszField(EncRelative, 2, 2, 16, 1, true)}, nil
+			case 0x7c, 0x7d, 0x7e, 0x7f:
+				return []EncodingField{
+					syszField(EncRelative, 2, 2, 16, 1, true),
+					syszField(EncImm, 4, 1, 8, 0, b[5] == 0x7c || b[5] == 0x7e),
+				}, nil
+			}
+		case op >= 0xd0 && op <= 0xdf:
+			// ss: d1, d2
+			return []EncodingField{
+				syszField(EncDisp, 2, 2, 12, 0, false),
+				syszField(EncDisp, 4, 2, 12, 0, false),
+			}, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,265 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEncodingX86(t *testing.T) {
+	tests := []struct {
+		comment string
+		mode    uint
+		code    string
+		want    []EncodingField
+	}{
+		{"lock add dword ptr fs:[rax + rbx*4 + 0x10], 0x12345678", CS_MODE_64,
+			"\xf0\x64\x81\x44\x98\x10\x78\x56\x34\x12",
+			[]EncodingField{
+				{Kind: EncPrefix, Offset: 0, Size: 2, Bits: 16},
+				{Kind: EncOpcode, Offset: 2, Size: 1, Bits: 8},
+				{Kind: EncModRM, Offset: 3, Size: 1, Bits: 8},
+				{Kind: EncSIB, Offset: 4, Size: 1, Bits: 8},
+				{Kind: EncDisp, Offset: 5, Size: 1, Bits: 8, Signed: true},
+				{Kind: EncImm, Offset: 6, Size: 4, Bits: 32},
+			}},
+		{"mov rax, qword ptr [rip + 0x1000]", CS_MODE_64, "\x48\x8b\x05\x00\x10\x00\x00",
+			[]EncodingField{
+				{Kind: EncRex, Offset: 0, Size: 1, Bits: 8},
+				{Kind: EncOpcode, Offset: 1, Size: 1, Bits: 8},
+				{Kind: EncModRM, Offset: 2, Size: 1, Bits: 8},
+				{Kind: EncDisp, Offset: 3, Size: 4, Bits: 32, Signed: true},
+			}},
+		{"call 0x1005", CS_MODE_32, "\xe8\x00\x00\x00\x00",
+			[]EncodingField{
+				{Kind: EncOpcode, Offset: 0, Size: 1, Bits: 8},
+				{Kind: EncRelative, Offset: 1, Size: 4, Bits: 32, Signed: true},
+			}},
+		{"je 0x1006", CS_MODE_32, "\x0f\x84\x00\x00\x00\x00",
+			[]EncodingField{
+				{Kind: EncOpcode, Offset: 0, Size: 2, Bits: 16},
+				{Kind: EncRelative, Offset: 2, Size: 4, Bits: 32, Signed: true},
+			}},
+		{"mov ax, word ptr [bp + si + 4]", CS_MODE_16, "\x8b\x42\x04",
+			[]EncodingField{
+				{Kind: EncOpcode, Offset: 0, Size: 1, Bits: 8},
+				{Kind: EncModRM, Offset: 1, Size: 1, Bits: 8},
+				{Kind: EncDisp, Offset: 2, Size: 1, Bits: 8, Signed: true},
+			}},
+		{"mov eax, dword ptr [0x1234]", CS_MODE_32, "\xa1\x34\x12\x00\x00",
+			[]EncodingField{
+				{Kind: EncOpcode, Offset: 0, Size: 1, Bits: 8},
+				{Kind: EncDisp, Offset: 1, Size: 4, Bits: 32},
+			}},
+		{"enter 0x10, 1", CS_MODE_32, "\xc8\x10\x00\x01",
+			[]EncodingField{
+				{Kind: EncOpcode, Offset: 0, Size: 1, Bits: 8},
+				{Kind: EncImm, Offset: 1, Size: 2, Bits: 16},
+				{Kind: EncImm, Offset: 3, Size: 1, Bits: 8},
+			}},
+		{"pshufd xmm0, xmm1, 0x1b", CS_MODE_64, "\x66\x0f\x70\xc1\x1b",
+			[]EncodingField{
+				{Kind: EncPrefix, Offset: 0, Size: 1, Bits: 8},
+				{Kind: EncOpcode, Offset: 1, Size: 2, Bits: 16},
+				{Kind: EncModRM, Offset: 3, Size: 1, Bits: 8},
+				{Kind: EncImm, Offset: 4, Size: 1, Bits: 8},
+			}},
+		{"vpermq ymm0, ymm1, 0x1b", CS_MODE_64, "\xc4\xe3\xfd\x00\xc1\x1b",
+			[]EncodingField{
+				{Kind: EncVex, Offset: 0, Size: 3, Bits: 24},
+				{Kind: EncOpcode, Offset: 3, Size: 1, Bits: 8},
+				{Kind: EncModRM, Offset: 4, Size: 1, Bits: 8},
+				{Kind: EncImm, Offset: 5, Size: 1, Bits: 8},
+			}},
+		{"vzeroupper", CS_MODE_64, "\xc5\xf8\x77",
+			[]EncodingField{
+				{Kind: EncVex, Offset: 0, Size: 2, Bits: 16},
+				{Kind: EncOpcode, Offset: 2, Size: 1, Bits: 8},
+			}},
+		{"syscall", CS_MODE_64, "\x0f\x05",
+			[]EncodingField{
+				{Kind: EncOpcode, Offset: 0, Size: 2, Bits: 16},
+			}},
+	}
+
+	for i, test := range tests {
+		insn := bareInsn(CS_ARCH_X86, test.mode, 0, 0x1000, test.code)
+		got, err := insn.Encoding()
+		if err != nil {
+			t.Errorf("%2d> %s: %v", i, test.comment, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%2d> %s: want %+v got %+v", i, test.comment, test.want, got)
+		}
+	}
+
+	// modrm promised, bytes missing
+	insn := bareInsn(CS_ARCH_X86, CS_MODE_64, 0, 0x1000, "\x8b")
+	if _, err := insn.Encoding(); err != ErrEncoding {
+		t.Errorf("Truncated: want %v got %v", ErrEncoding, err)
+	}
+}
+
+func TestEncodingFixed(t *testing.T) {
+	tests := []struct {
+		comment string
+		arch    int
+		mode    uint
+		code    string
+		want    []EncodingField
+	}{
+		{"bl #0x1000", CS_ARCH_ARM, CS_MODE_ARM, "\xfe\x03\x00\xeb",
+			[]EncodingField{{Kind: EncRelative, Size: 4, Bits: 24, Shift: 2, Signed: true}}},
+		{"ldr r0, [r1, #4]", CS_ARCH_ARM, CS_MODE_ARM, "\x04\x00\x91\xe5",
+			[]EncodingField{{Kind: EncDisp, Size: 4, Bits: 12}}},
+		{"movw r0, #0x1234", CS_ARCH_ARM, CS_MODE_ARM, "\x34\x02\x01\xe3",
+			[]EncodingField{
+				{Kind: EncImm, Size: 4, Bit: 16, Bits: 4, Shift: 12},
+				{Kind: EncImm, Size: 4, Bits: 12},
+			}},
+		{"beq #0x1008", CS_ARCH_ARM, CS_MODE_THUMB, "\x02\xd0",
+			[]EncodingField{{Kind: EncRelative, Size: 2, Bits: 8, Shift: 1, Signed: true}}},
+		{"ldr r0, [pc, #8]", CS_ARCH_ARM, CS_MODE_THUMB, "\x02\x48",
+			[]EncodingField{{Kind: EncRelative, Size: 2, Bits: 8, Shift: 2}}},
+		{"cbz r0, #0x1046", CS_ARCH_ARM, CS_MODE_THUMB, "\x08\xb3",
+			[]EncodingField{
+				{Kind: EncRelative, Size: 2, Bit: 9, Bits: 1, Shift: 6},
+				{Kind: EncRelative, Size: 2, Bit: 3, Bits: 5, Shift: 1},
+			}},
+		{"bl #0x2000", CS_ARCH_ARM, CS_MODE_THUMB, "\x01\xf0\xfe\xff", nil},
+		{"b.ne #0x1010", CS_ARCH_ARM64, CS_MODE_ARM, "\x81\x00\x00\x54",
+			[]EncodingField{{Kind: EncRelative, Size: 4, Bit: 5, Bits: 19, Shift: 2, Signed: true}}},
+		{"adrp x0, #0x2000", CS_ARCH_ARM64, CS_MODE_ARM, "\x00\x00\x00\xb0",
+			[]EncodingField{
+				{Kind: EncRelative, Size: 4, Bit: 5, Bits: 19, Shift: 14, Signed: true},
+				{Kind: EncRelative, Size: 4, Bit: 29, Bits: 2, Shift: 12},
+			}},
+		{"tbz x0, #33, #0x1008", CS_ARCH_ARM64, CS_MODE_ARM, "\x40\x00\x08\xb6",
+			[]EncodingField{
+				{Kind: EncImm, Size: 4, Bit: 31, Bits: 1, Shift: 5},
+				{Kind: EncImm, Size: 4, Bit: 19, Bits: 5},
+				{Kind: EncRelative, Size: 4, Bit: 5, Bits: 14, Shift: 2, Signed: true},
+			}},
+		{"ldr x0, [x1, #8]", CS_ARCH_ARM64, CS_MODE_ARM, "\x20\x04\x40\xf9",
+			[]EncodingField{{Kind: EncDisp, Size: 4, Bit: 10, Bits: 12, Shift: 3}}},
+		{"movk x0, #0x1234, lsl #16", CS_ARCH_ARM64, CS_MODE_ARM, "\x80\x46\xa2\xf2",
+			[]EncodingField{{Kind: EncImm, Size: 4, Bit: 5, Bits: 16, Shift: 16}}},
+		{"jal 0x400000", CS_ARCH_MIPS, CS_MODE_MIPS32 | CS_MODE_BIG_ENDIAN, "\x0c\x10\x00\x00",
+			[]EncodingField{{Kind: EncAbsolute, Size: 4, Bits: 26, Shift: 2}}},
+		{"lw $a0, 0x10($sp)", CS_ARCH_MIPS, CS_MODE_MIPS32, "\x10\x00\xa4\x8f",
+			[]EncodingField{{Kind: EncDisp, Size: 4, Bits: 16, Signed: true}}},
+		{"bl 0x1100", CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x48\x00\x01\x01",
+			[]EncodingField{{Kind: EncRelative, Size: 4, Bit: 2, Bits: 24, Shift: 2, Signed: true}}},
+		{"ld r3, 8(r1)", CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\xe8\x61\x00\x08",
+			[]EncodingField{{Kind: EncDisp, Size: 4, Bit: 2, Bits: 14, Shift: 2, Signed: true}}},
+		{"call 0x1100", CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, "\x40\x00\x00\x40",
+			[]EncodingField{{Kind: EncRelative, Size: 4, Bits: 30, Shift: 2, Signed: true}}},
+		{"sethi 0x48d, %g1", CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, "\x03\x00\x04\x8d",
+			[]EncodingField{{Kind: EncImm, Size: 4, Bits: 22, Shift: 10}}},
+		{"brasl %r14, 0x1100", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xc0\xe5\x00\x00\x00\x80",
+			[]EncodingField{{Kind: EncRelative, Offset: 2, Size: 4, Bits: 32, Shift: 1, Signed: true}}},
+		{"ts 8(%r1)", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\x93\x00\x10\x08",
+			[]EncodingField{{Kind: EncDisp, Offset: 2, Size: 2, Bits: 12}}},
+		{"mvcos 16(%r1), 32(%r2), %r3", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xc8\x30\x10\x10\x20\x20",
+			[]EncodingField{
+				{Kind: EncDisp, Offset: 2, Size: 2, Bits: 12},
+				{Kind: EncDisp, Offset: 4, Size: 2, Bits: 12},
+			}},
+		{"lg %r1, 0x1000(%r15)", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xe3\x10\xf0\x00\x01\x04",
+			[]EncodingField{
+				{Kind: EncDisp, Offset: 4, Size: 1, Bits: 8, Shift: 12, Signed: true},
+				{Kind: EncDisp, Offset: 2, Size: 2, Bits: 12},
+			}},
+	}
+
+	for i, test := range tests {
+		insn := bareInsn(test.arch, test.mode, 0, 0x1000, test.code)
+		got, err := insn.Encoding()
+		if err != nil {
+			t.Errorf("%2d> %s: %v", i, test.comment, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%2d> %s: want %+v got %+v", i, test.comment, test.want, got)
+		}
+	}
+}
+
+// The value of the fields of a kind, read from the instruction bytes. Split
+// fields are summed.
+func encodedValue(insn Instruction, fields []EncodingField, kind EncodingKind) int64 {
+	var v int64
+	for _, f := range fields {
+		if f.Kind != kind {
+			continue
+		}
+		var raw uint64
+		for i := 0; i < f.Size; i++ {
+			if insn.mode&CS_MODE_BIG_ENDIAN != 0 {
+				raw = raw<<8 | uint64(insn.Bytes[f.Offset+i])
+			} else {
+				raw |= uint64(insn.Bytes[f.Offset+i]) << (8 * uint(i))
+			}
+		}
+		raw = raw >> f.Bit & (1<<f.Bits - 1)
+		x := int64(raw)
+		if f.Signed && raw>>(f.Bits-1) != 0 {
+			x -= 1 << f.Bits
+		}
+		v += x << f.Shift
+	}
+	return v
+}
+
+func TestEncodingValues(t *testing.T) {
+	r6 := uint(CS_MODE_MIPS32R6 | CS_MODE_BIG_ENDIAN)
+	tests := []struct {
+		comment string
+		arch    int
+		mode    uint
+		code    string
+		kind    EncodingKind
+		want    int64
+	}{
+		// R6 compact branches count from the next instruction
+		{"beqc $a0, $a1, 0x1024", CS_ARCH_MIPS, r6, "\x20\x85\x00\x08", EncRelative, 0x20},
+		{"bovc $a1, $a0, 0x1020", CS_ARCH_MIPS, r6, "\x20\xa4\x00\x07", EncRelative, 0x1c},
+		{"beqzalc $a1, 0x101c", CS_ARCH_MIPS, r6, "\x20\x05\x00\x06", EncRelative, 0x18},
+		{"bnec $a0, $a1, 0xff4", CS_ARCH_MIPS, r6, "\x60\x85\xff\xfc", EncRelative, -0x10},
+		{"bnvc $a1, $a0, 0xff0", CS_ARCH_MIPS, r6, "\x60\xa4\xff\xfb", EncRelative, -0x14},
+		{"bnezalc $a1, 0xfec", CS_ARCH_MIPS, r6, "\x60\x05\xff\xfa", EncRelative, -0x18},
+		{"addiupc $a0, 0x100", CS_ARCH_MIPS, r6, "\xec\x80\x00\x40", EncRelative, 0x100},
+		{"auipc $a0, 0x12", CS_ARCH_MIPS, r6, "\xec\x9e\x00\x12", EncRelative, 0x120000},
+		{"lwpc $v0, 0x40", CS_ARCH_MIPS, r6, "\xec\x48\x00\x10", EncRelative, 0x40},
+		{"addi $a0, $a1, -1", CS_ARCH_MIPS, CS_MODE_MIPS32 | CS_MODE_BIG_ENDIAN, "\x20\xa4\xff\xff", EncImm, -1},
+		{"brxh %r1, %r3, 0x1000", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\x84\x13\x00\x00", EncRelative, 0},
+		{"brxle %r1, %r3, 0x101a", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\x85\x13\x00\x0d", EncRelative, 0x1a},
+		{"ts 8(%r1)", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\x93\x00\x10\x08", EncImm, 0},
+		{"brcth %r1, 0xfee", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xcc\x16\xff\xff\xff\xf7", EncRelative, -0x12},
+		{"aih %r1, -5", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xcc\x18\xff\xff\xff\xfb", EncImm, -5},
+		{"madb %f1, %f2, 8(%r1)", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xed\x20\x10\x08\x10\x1e", EncDisp, 8},
+		{"ley %f0, -8(%r1)", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xed\x00\x1f\xf8\xff\x64", EncDisp, -8},
+	}
+
+	for i, test := range tests {
+		insn := bareInsn(test.arch, test.mode, 0, 0x1000, test.code)
+		fields, err := insn.Encoding()
+		if err != nil {
+			t.Errorf("%2d> %s: %v", i, test.comment, err)
+			continue
+		}
+		if got := encodedValue(insn, fields, test.kind); got != test.want {
+			t.Errorf("%2d> %s: want %v %#x got %#x (%+v)", i, test.comment, test.kind, test.want, got, fields)
+		}
+	}
+}
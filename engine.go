@@ -58,6 +58,8 @@ var (
 // - No regs_read, regs_written or groups
 // - No response to reg_name or insn_name
 // - No mnemonic or op_str
+// - No reverse name lookups, since the tables are built from the names above:
+//   InsnID and GroupID always fail, and RegID only resolves its aliases
 // If you want to see any operands in diet mode, then you need CS_DETAIL.
 var dietMode = bool(C.cs_support(CS_SUPPORT_DIET))
 
@@ -69,6 +71,13 @@ type Engine struct {
 	arch     int
 	mode     uint
 	skipdata *C.cs_opt_skipdata
+	options  []EngineOption
+}
+
+// An option type / value pair, as passed to SetOption
+type EngineOption struct {
+	Type  uint // CS_OPT_*
+	Value uint
 }
 
 // Information that exists for every Instruction, regardless of arch.
@@ -104,6 +113,54 @@ type Instruction struct {
 	SysZ  *SysZInstruction
 	Sparc *SparcInstruction
 	Xcore *XcoreInstruction
+	// Recorded at disassembly time so that analysis helpers like Flow() work
+	// without an Engine, and without detail.
+	arch    int
+	mode    uint
+	stamped bool
+}
+
+// Record the Engine arch and mode on freshly decomposed Instructions
+func (e *Engine) stamp(insns []Instruction) []Instruction {
+	for i := range insns {
+		insns[i].arch = e.arch
+		insns[i].mode = e.mode
+		insns[i].stamped = true
+	}
+	return insns
+}
+
+// Returns the arch whose detail struct is filled in, if any.
+func detailArch(insn *Instruction) (int, bool) {
+	switch {
+	case insn.Arm != nil:
+		return CS_ARCH_ARM, true
+	case insn.Arm64 != nil:
+		return CS_ARCH_ARM64, true
+	case insn.Mips != nil:
+		return CS_ARCH_MIPS, true
+	case insn.X86 != nil:
+		return CS_ARCH_X86, true
+	case insn.PPC != nil:
+		return CS_ARCH_PPC, true
+	case insn.SysZ != nil:
+		return CS_ARCH_SYSZ, true
+	case insn.Sparc != nil:
+		return CS_ARCH_SPARC, true
+	case insn.Xcore != nil:
+		return CS_ARCH_XCORE, true
+	}
+	return CS_ARCH_MAX, false
+}
+
+// The CS_ARCH_* this Instruction was disassembled for, or CS_ARCH_MAX if that
+// can't be determined.
+func (insn *Instruction) archOf() int {
+	if insn.stamped {
+		return insn.arch
+	}
+	arch, _ := detailArch(insn)
+	return arch
 }
 
 // Called by the arch specific decomposers
@@ -152,9 +209,31 @@ func (e *Engine) Close() error {
 // Accessor for the Engine architecture CS_ARCH_*
 func (e *Engine) Arch() int { return e.arch }
 
-// Accessor for the Engine mode CS_MODE_*
+// Accessor for the Engine mode CS_MODE_*. This is the current mode, so it
+// follows SetOption(CS_OPT_MODE, ...) rather than staying at the mode passed
+// to New. The elf and macho loaders rely on this when they switch between ARM
+// and Thumb.
 func (e *Engine) Mode() uint { return e.mode }
 
+// Options that have been successfully set on this Engine, in the order they
+// were first set. Setting an option again replaces the earlier value.
+func (e *Engine) Options() []EngineOption {
+	return append([]EngineOption(nil), e.options...)
+}
+
+func (e *Engine) recordOption(ty, value uint) {
+	if ty == CS_OPT_MODE {
+		e.mode = value
+	}
+	for i := range e.options {
+		if e.options[i].Type == ty {
+			e.options[i].Value = value
+			return
+		}
+	}
+	e.options = append(e.options, EngineOption{ty, value})
+}
+
 // Check if a particular arch is supported by this engine.
 // To verify if this engine supports everything, use CS_ARCH_ALL
 func (e *Engine) Support(arch int) bool { return bool(C.cs_support(C.int(arch))) }
@@ -212,6 +291,7 @@ func (e *Engine) SetOption(ty, value uint) error {
 	)
 
 	if Errno(res) == ErrOK {
+		e.recordOption(ty, value)
 		return nil
 	}
 	return Errno(res)
@@ -247,21 +327,21 @@ func (e *Engine) Disasm(input []byte, address, count uint64) ([]Instruction, err
 
 		switch e.arch {
 		case CS_ARCH_ARM:
-			return decomposeArm(insns), nil
+			return e.stamp(decomposeArm(insns)), nil
 		case CS_ARCH_ARM64:
-			return decomposeArm64(insns), nil
+			return e.stamp(decomposeArm64(insns)), nil
 		case CS_ARCH_MIPS:
-			return decomposeMips(insns), nil
+			return e.stamp(decomposeMips(insns)), nil
 		case CS_ARCH_X86:
-			return decomposeX86(insns), nil
+			return e.stamp(decomposeX86(insns)), nil
 		case CS_ARCH_PPC:
-			return decomposePPC(insns), nil
+			return e.stamp(decomposePPC(insns)), nil
 		case CS_ARCH_SYSZ:
-			return decomposeSysZ(insns), nil
+			return e.stamp(decomposeSysZ(insns)), nil
 		case CS_ARCH_SPARC:
-			return decomposeSparc(insns), nil
+			return e.stamp(decomposeSparc(insns)), nil
 		case CS_ARCH_XCORE:
-			return decomposeXcore(insns), nil
+			return e.stamp(decomposeXcore(insns)), nil
 		default:
 			return []Instruction{}, ErrArch
 		}
@@ -317,12 +397,14 @@ func (e *Engine) SkipDataStart(config *SkipDataConfig) {
 
 	// If there's no config, just turn on skipdata with the default behaviour
 	C.cs_option(e.handle, CS_OPT_SKIPDATA, CS_OPT_ON)
+	e.recordOption(CS_OPT_SKIPDATA, CS_OPT_ON)
 }
 
 // Disable CS_OPT_SKIPDATA. Removes any registered callbacks and frees
 // resources.
 func (e *Engine) SkipDataStop() {
 	C.cs_option(e.handle, CS_OPT_SKIPDATA, CS_OPT_OFF)
+	e.recordOption(CS_OPT_SKIPDATA, CS_OPT_OFF)
 	if e.skipdata == nil {
 		return
 	}
@@ -335,7 +417,7 @@ func New(arch int, mode uint) (Engine, error) {
 	var handle C.csh
 	res := C.cs_open(C.cs_arch(arch), C.cs_mode(mode), &handle)
 	if Errno(res) == ErrOK {
-		return Engine{handle, arch, mode, nil}, nil
+		return Engine{handle, arch, mode, nil, nil}, nil
 	}
-	return Engine{0, CS_ARCH_MAX, 0, nil}, Errno(res)
+	return Engine{0, CS_ARCH_MAX, 0, nil, nil}, Errno(res)
 }
@@ -58,21 +58,21 @@ func (e *Engine) DisasmIter(input []byte, address uint64) <-chan Instruction {
 
 			switch e.arch {
 			case CS_ARCH_ARM:
-				out <- decomposeArm(insns)[0]
+				out <- e.stamp(decomposeArm(insns))[0]
 			case CS_ARCH_ARM64:
-				out <- decomposeArm64(insns)[0]
+				out <- e.stamp(decomposeArm64(insns))[0]
 			case CS_ARCH_MIPS:
-				out <- decomposeMips(insns)[0]
+				out <- e.stamp(decomposeMips(insns))[0]
 			case CS_ARCH_X86:
-				out <- decomposeX86(insns)[0]
+				out <- e.stamp(decomposeX86(insns))[0]
 			case CS_ARCH_PPC:
-				out <- decomposePPC(insns)[0]
+				out <- e.stamp(decomposePPC(insns))[0]
 			case CS_ARCH_SYSZ:
-				out <- decomposeSysZ(insns)[0]
+				out <- e.stamp(decomposeSysZ(insns))[0]
 			case CS_ARCH_SPARC:
-				out <- decomposeSparc(insns)[0]
+				out <- e.stamp(decomposeSparc(insns))[0]
 			case CS_ARCH_XCORE:
-				out <- decomposeXcore(insns)[0]
+				out <- e.stamp(decomposeXcore(insns))[0]
 			default:
 				return
 			}
@@ -0,0 +1,360 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package firmware
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/bnagy/gapstone"
+)
+
+// Options for FindBase. A nil *BaseOptions uses the defaults.
+type BaseOptions struct {
+	Align     uint64 // candidate bases are multiples of Align, default 0x1000
+	MinString int    // shortest NUL terminated ASCII string counted, default 4
+	Max       int    // most candidates returned, default 10
+}
+
+// A possible load address for a raw image
+type BaseCandidate struct {
+	Base       uint64
+	Strings    int     // pointers landing on the start of a string
+	Functions  int     // pointers landing on a function start
+	Confidence float64 // fraction of the collected pointers explained, 0 to 1
+}
+
+// Pointers below this are too likely to be small constants
+const minPointer = 0x100
+
+// Guess where a raw image loads, by collecting absolute pointers from the
+// code and finding the bases which make the most of them land on strings and
+// function starts.
+//
+// The data is disassembled from offset 0 with the Engine as configured by
+// the caller (arch, mode and endianness), with CS_OPT_DETAIL turned on and
+// CS_OPT_SKIPDATA used to step over data. Both are put back as they were on
+// return; if SKIPDATA was already on, the caller's SkipDataConfig is used.
+// Pointers come from:
+//
+//	ARM, ARM64: the words loaded by ldr literal
+//	MIPS:      lui + addiu / ori, and lui + memory offset pairs
+//	PPC:       lis + addi / ori, and lis + memory offset pairs
+//
+// Function starts are instructions that move the stack pointer down (push
+// {..., lr}, addiu $sp, $sp, -N, stwu r1, -N(r1) ...) after a return, jump or
+// data. In Thumb mode pointers with bit 0 set match function starts only.
+//
+// Returns candidates sorted by the number of pointers explained, best first,
+// and ErrArch for other arches.
+func FindBase(engine *gapstone.Engine, data []byte, opts *BaseOptions) ([]BaseCandidate, error) {
+	switch engine.Arch() {
+	case gapstone.CS_ARCH_ARM, gapstone.CS_ARCH_ARM64, gapstone.CS_ARCH_MIPS, gapstone.CS_ARCH_PPC:
+	default:
+		return nil, gapstone.ErrArch
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	if optionValue(engine, gapstone.CS_OPT_DETAIL) != gapstone.CS_OPT_ON {
+		if err := engine.SetOption(gapstone.CS_OPT_DETAIL, gapstone.CS_OPT_ON); err != nil {
+			return nil, err
+		}
+		defer engine.SetOption(gapstone.CS_OPT_DETAIL, gapstone.CS_OPT_OFF)
+	}
+	if optionValue(engine, gapstone.CS_OPT_SKIPDATA) != gapstone.CS_OPT_ON {
+		engine.SkipDataStart(nil)
+		defer engine.SkipDataStop()
+	}
+	insns, err := engine.Disasm(data, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if engine.Mode()&gapstone.CS_MODE_BIG_ENDIAN != 0 {
+		order = binary.BigEndian
+	}
+	thumb := engine.Arch() == gapstone.CS_ARCH_ARM && engine.Mode()&gapstone.CS_MODE_THUMB != 0
+	return rankBases(codePointers(insns, data, order), stringStarts(data, opts.minString()),
+		functionStarts(insns), thumb, opts), nil
+}
+
+// The value of an option set on the engine, or CS_OPT_OFF if it never was
+func optionValue(engine *gapstone.Engine, ty uint) uint {
+	for _, opt := range engine.Options() {
+		if opt.Type == ty {
+			return opt.Value
+		}
+	}
+	return gapstone.CS_OPT_OFF
+}
+
+func (o *BaseOptions) align() uint64 {
+	if o == nil || o.Align == 0 {
+		return 0x1000
+	}
+	return o.Align
+}
+
+func (o *BaseOptions) minString() int {
+	if o == nil || o.MinString == 0 {
+		return 4
+	}
+	return o.MinString
+}
+
+func (o *BaseOptions) max() int {
+	if o == nil || o.Max == 0 {
+		return 10
+	}
+	return o.Max
+}
+
+// Absolute pointers from the code, deduplicated. insns must have been
+// disassembled at address 0, so addresses are offsets into data.
+func codePointers(insns []gapstone.Instruction, data []byte, order binary.ByteOrder) []uint64 {
+	seen := map[uint64]bool{}
+	add := func(p uint64) {
+		if p >= minPointer {
+			seen[p] = true
+		}
+	}
+
+	// The hi half from the last lui / lis into each register
+	hi := map[uint]uint64{}
+	for _, insn := range insns {
+		switch {
+		case insn.Arm != nil || insn.Arm64 != nil:
+			for _, ref := range insn.References() {
+				if ref.Kind != gapstone.RefData || ref.Access != gapstone.AccessRead {
+					continue
+				}
+				if ref.Address+4 > uint64(len(data)) {
+					continue
+				}
+				size := 4
+				if insn.Arm64 != nil && len(insn.Arm64.Operands) > 0 {
+					if info, ok := gapstone.RegInfo(gapstone.CS_ARCH_ARM64, insn.Arm64.Operands[0].Reg); ok && info.Width == 64 {
+						size = 8
+					}
+				}
+				if size == 8 && ref.Address+8 <= uint64(len(data)) {
+					add(order.Uint64(data[ref.Address:]))
+				} else {
+					add(uint64(order.Uint32(data[ref.Address:])))
+				}
+			}
+		case insn.Mips != nil:
+			if mipsPairs(insn, hi, add) {
+				continue
+			}
+		case insn.PPC != nil:
+			if ppcPairs(insn, hi, add) {
+				continue
+			}
+		}
+		// Anything else written over a hi half ends the pair
+		if _, written, err := insn.RegsAccess(); err == nil {
+			for _, reg := range written {
+				delete(hi, reg)
+			}
+		}
+	}
+
+	ptrs := make([]uint64, 0, len(seen))
+	for p := range seen {
+		ptrs = append(ptrs, p)
+	}
+	sort.Sort(uint64s(ptrs))
+	return ptrs
+}
+
+// Records a hi half, returning true, or adds the pointer formed with one
+func mipsPairs(insn gapstone.Instruction, hi map[uint]uint64, add func(uint64)) bool {
+	ops := insn.Mips.Operands
+	switch {
+	case insn.Id == gapstone.MIPS_INS_LUI && len(ops) == 2 && ops[1].Type == gapstone.MIPS_OP_IMM:
+		hi[ops[0].Reg] = uint64(ops[1].Imm&0xffff) << 16
+		return true
+	case (insn.Id == gapstone.MIPS_INS_ADDIU || insn.Id == gapstone.MIPS_INS_ADDI || insn.Id == gapstone.MIPS_INS_DADDIU) &&
+		len(ops) == 3 && ops[2].Type == gapstone.MIPS_OP_IMM:
+		if h, ok := hi[ops[1].Reg]; ok {
+			add((h + uint64(int64(int16(ops[2].Imm)))) & 0xffffffff)
+		}
+	case insn.Id == gapstone.MIPS_INS_ORI && len(ops) == 3 && ops[2].Type == gapstone.MIPS_OP_IMM:
+		if h, ok := hi[ops[1].Reg]; ok {
+			add(h | uint64(ops[2].Imm&0xffff))
+		}
+	default:
+		for _, op := range ops {
+			if op.Type != gapstone.MIPS_OP_MEM {
+				continue
+			}
+			if h, ok := hi[op.Mem.Base]; ok {
+				add((h + uint64(op.Mem.Disp)) & 0xffffffff)
+			}
+		}
+	}
+	return false
+}
+
+// Records a hi half, returning true, or adds the pointer formed with one
+func ppcPairs(insn gapstone.Instruction, hi map[uint]uint64, add func(uint64)) bool {
+	ops := insn.PPC.Operands
+	switch {
+	case insn.Id == gapstone.PPC_INS_LIS && len(ops) == 2 && ops[1].Type == gapstone.PPC_OP_IMM:
+		hi[ops[0].Reg] = uint64(ops[1].Imm&0xffff) << 16
+		return true
+	case insn.Id == gapstone.PPC_INS_ADDI && len(ops) == 3 && ops[2].Type == gapstone.PPC_OP_IMM:
+		if h, ok := hi[ops[1].Reg]; ok {
+			add((h + uint64(int64(int16(ops[2].Imm)))) & 0xffffffff)
+		}
+	case insn.Id == gapstone.PPC_INS_ORI && len(ops) == 3 && ops[2].Type == gapstone.PPC_OP_IMM:
+		if h, ok := hi[ops[1].Reg]; ok {
+			add(h | uint64(ops[2].Imm&0xffff))
+		}
+	default:
+		for _, op := range ops {
+			if op.Type != gapstone.PPC_OP_MEM {
+				continue
+			}
+			if h, ok := hi[op.Mem.Base]; ok {
+				add((h + uint64(op.Mem.Disp)) & 0xffffffff)
+			}
+		}
+	}
+	return false
+}
+
+// The offsets of NUL terminated printable ASCII strings at least min long
+func stringStarts(data []byte, min int) []uint64 {
+	var starts []uint64
+	start := -1
+	for i, c := range data {
+		switch {
+		case c >= 0x20 && c < 0x7f, c == '\t', c == '\n', c == '\r':
+			if start < 0 {
+				start = i
+			}
+		case c == 0 && start >= 0 && i-start >= min:
+			starts = append(starts, uint64(start))
+			start = -1
+		default:
+			start = -1
+		}
+	}
+	return starts
+}
+
+// The offsets of instructions that move the stack pointer down, following a
+// return, jump, data or the start of the image
+func functionStarts(insns []gapstone.Instruction) []uint64 {
+	var starts []uint64
+	boundary := true
+	for _, insn := range insns {
+		if insn.Id == 0 {
+			// SKIPDATA
+			boundary = true
+			continue
+		}
+		if boundary {
+			if se, err := insn.StackDelta(); err == nil && se.Delta < 0 &&
+				(se.Kind == gapstone.StackKnown || se.Kind == gapstone.StackFrameSetup) {
+				starts = append(starts, uint64(insn.Address))
+			}
+		}
+		switch insn.Flow().Kind {
+		case gapstone.FlowReturn, gapstone.FlowJump, gapstone.FlowIndirect:
+			boundary = true
+		default:
+			boundary = false
+		}
+	}
+	return starts
+}
+
+// Vote for base = pointer - target, for every aligned combination
+func rankBases(ptrs, strs, funcs []uint64, thumb bool, opts *BaseOptions) []BaseCandidate {
+	align := opts.align()
+	// Only a target congruent to a pointer modulo the alignment can give an
+	// aligned base, so bucket the targets rather than pairing every one
+	bucket := func(ts []uint64) map[uint64][]uint64 {
+		m := map[uint64][]uint64{}
+		for _, t := range ts {
+			m[t%align] = append(m[t%align], t)
+		}
+		return m
+	}
+	strsBy, funcsBy := bucket(strs), bucket(funcs)
+
+	votes := map[uint64]*BaseCandidate{}
+	vote := func(p, t uint64, fn bool) {
+		if p < t {
+			return
+		}
+		c := votes[p-t]
+		if c == nil {
+			c = &BaseCandidate{Base: p - t}
+			votes[p-t] = c
+		}
+		if fn {
+			c.Functions++
+		} else {
+			c.Strings++
+		}
+	}
+	for _, p := range ptrs {
+		if !thumb || p&1 == 0 {
+			for _, t := range strsBy[p%align] {
+				vote(p, t, false)
+			}
+		}
+		if thumb {
+			if p&1 == 0 {
+				continue
+			}
+			p &^= 1
+		}
+		for _, t := range funcsBy[p%align] {
+			vote(p, t, true)
+		}
+	}
+
+	cands := make([]BaseCandidate, 0, len(votes))
+	for _, c := range votes {
+		c.Confidence = float64(c.Strings+c.Functions) / float64(len(ptrs))
+		cands = append(cands, *c)
+	}
+	sort.Sort(byVotes(cands))
+	if len(cands) > opts.max() {
+		cands = cands[:opts.max()]
+	}
+	return cands
+}
+
+type byVotes []BaseCandidate
+
+func (c byVotes) Len() int      { return len(c) }
+func (c byVotes) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c byVotes) Less(i, j int) bool {
+	vi, vj := c[i].Strings+c[i].Functions, c[j].Strings+c[j].Functions
+	if vi != vj {
+		return vi > vj
+	}
+	return c[i].Base < c[j].Base
+}
+
+type uint64s []uint64
+
+func (a uint64s) Len() int           { return len(a) }
+func (a uint64s) Less(i, j int) bool { return a[i] < a[j] }
+func (a uint64s) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
@@ -0,0 +1,144 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package firmware
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/bnagy/gapstone"
+)
+
+func TestStringStarts(t *testing.T) {
+	data := []byte("\x00abc\x00hello\x00\x01world!\x00tail")
+	if got := stringStarts(data, 4); !reflect.DeepEqual(got, []uint64{5, 12}) {
+		t.Errorf("want [5 12] got %v", got)
+	}
+	if got := stringStarts(data, 3); !reflect.DeepEqual(got, []uint64{1, 5, 12}) {
+		t.Errorf("want [1 5 12] got %v", got)
+	}
+}
+
+func TestCodePointersEngine(t *testing.T) {
+
+	tests := []struct {
+		comment string
+		arch    int
+		mode    uint
+		code    []byte
+		want    []uint64
+	}{
+		{"mips", gapstone.CS_ARCH_MIPS, gapstone.CS_MODE_32 | gapstone.CS_MODE_BIG_ENDIAN, []byte{
+			0x3c, 0x02, 0x80, 0x01, // lui $v0, 0x8001
+			0x24, 0x44, 0xff, 0xf0, // addiu $a0, $v0, -0x10
+			0x8c, 0x45, 0x00, 0x20, // lw $a1, 0x20($v0)
+			0x00, 0x85, 0x10, 0x21, // addu $v0, $a0, $a1   ; v0 is overwritten,
+			0x34, 0x46, 0x50, 0x00, // ori $a2, $v0, 0x5000 ; so this isn't a pointer
+			0x3c, 0x08, 0x90, 0x00, // lui $t0, 0x9000
+			0x35, 0x08, 0x80, 0x04, // ori $t0, $t0, 0x8004
+		}, []uint64{0x8000fff0, 0x80010020, 0x90008004}},
+		{"ppc", gapstone.CS_ARCH_PPC, gapstone.CS_MODE_BIG_ENDIAN, []byte{
+			0x3d, 0x20, 0x12, 0x34, // lis r9, 0x1234
+			0x61, 0x23, 0x56, 0x78, // ori r3, r9, 0x5678
+			0x80, 0x89, 0xff, 0xfc, // lwz r4, -4(r9)
+			0x38, 0xa9, 0x80, 0x00, // addi r5, r9, -0x8000
+		}, []uint64{0x12338000, 0x1233fffc, 0x12345678}},
+	}
+
+	for i, ct := range tests {
+		engine, err := gapstone.New(ct.arch, ct.mode)
+		if err != nil {
+			t.Fatalf("Failed to initialize engine %v", err)
+		}
+		if err := engine.SetOption(gapstone.CS_OPT_DETAIL, gapstone.CS_OPT_ON); err != nil {
+			t.Fatalf("Failed to set detail %v", err)
+		}
+		insns, err := engine.Disasm(ct.code, 0, 0)
+		engine.Close()
+		if err != nil {
+			t.Fatalf("%2d> %s: disassembly failed: %v", i, ct.comment, err)
+		}
+		if got := codePointers(insns, ct.code, binary.BigEndian); !reflect.DeepEqual(got, ct.want) {
+			t.Errorf("%2d> %s: want %#x got %#x", i, ct.comment, ct.want, got)
+		}
+	}
+}
+
+func TestRankBases(t *testing.T) {
+	strs := []uint64{0x100, 0x200}
+	funcs := []uint64{0x300}
+	ptrs := []uint64{0x80000100, 0x80000200, 0x80000300, 0x80001200}
+
+	got := rankBases(ptrs, strs, funcs, false, nil)
+	want := []BaseCandidate{
+		{Base: 0x80000000, Strings: 2, Functions: 1, Confidence: 0.75},
+		{Base: 0x80001000, Strings: 1, Confidence: 0.25},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v got %+v", want, got)
+	}
+
+	// Thumb function pointers have bit 0 set, and never point at strings
+	got = rankBases([]uint64{0x80000100, 0x80000301, 0x80000300}, strs, funcs, true, &BaseOptions{Max: 1})
+	want = []BaseCandidate{{Base: 0x80000000, Strings: 1, Functions: 1, Confidence: 2.0 / 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("thumb: want %+v got %+v", want, got)
+	}
+
+	// Alignment
+	got = rankBases([]uint64{0x80000140}, []uint64{0x40}, nil, false, &BaseOptions{Align: 0x100})
+	if len(got) != 1 || got[0].Base != 0x80000100 {
+		t.Errorf("align 0x100: got %+v", got)
+	}
+	if got = rankBases([]uint64{0x80000140}, []uint64{0x40}, nil, false, nil); len(got) != 0 {
+		t.Errorf("align 0x1000: got %+v", got)
+	}
+}
+
+func TestFindBaseEngine(t *testing.T) {
+
+	engine, err := gapstone.New(gapstone.CS_ARCH_ARM, gapstone.CS_MODE_ARM)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer engine.Close()
+
+	// Loaded at 0x10000:
+	//   0x00: push {r4, lr}
+	//   0x04: ldr r0, [pc, #4]    ; -> 0x10018 "hello"
+	//   0x08: ldr r1, [pc, #4]    ; -> 0x10000 (this function)
+	//   0x0c: pop {r4, pc}
+	//   0x10: .word 0x10018
+	//   0x14: .word 0x10000
+	//   0x18: "hello\0"
+	data := []byte{
+		0x10, 0x40, 0x2d, 0xe9,
+		0x04, 0x00, 0x9f, 0xe5,
+		0x04, 0x10, 0x9f, 0xe5,
+		0x10, 0x80, 0xbd, 0xe8,
+		0x18, 0x00, 0x01, 0x00,
+		0x00, 0x00, 0x01, 0x00,
+		'h', 'e', 'l', 'l', 'o', 0,
+	}
+	cands, err := FindBase(&engine, data, nil)
+	if err != nil || len(cands) == 0 {
+		t.Fatalf("FindBase: %v", err)
+	}
+	if c := cands[0]; c.Base != 0x10000 || c.Strings != 1 || c.Functions != 1 {
+		t.Errorf("want base 0x10000 with a string and a function got %+v", c)
+	}
+	for _, opt := range engine.Options() {
+		if opt.Value != gapstone.CS_OPT_OFF {
+			t.Errorf("option %d left at %d", opt.Type, opt.Value)
+		}
+	}
+}
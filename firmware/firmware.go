@@ -0,0 +1,283 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+// Package firmware loads Intel HEX, Motorola S-record and raw binary
+// firmware images into a sparse address space, parses Cortex-M vector tables
+// to find entry points, and guesses the load address of raw images.
+// Firmware carries no machine type, so the caller creates the Engine, eg
+// CS_ARCH_ARM with CS_MODE_THUMB + CS_MODE_MCLASS for Cortex-M.
+package firmware
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+var (
+	ErrFormat   = errors.New("gapstone/firmware: malformed record")
+	ErrChecksum = errors.New("gapstone/firmware: bad checksum")
+	ErrNoData   = errors.New("gapstone/firmware: no data at address")
+)
+
+// A malformed line in a HEX or S-record file. Err is ErrFormat or
+// ErrChecksum.
+type RecordError struct {
+	Line int
+	Err  error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("%v on line %d", e.Err, e.Line)
+}
+
+// Contiguous bytes at an address
+type Segment struct {
+	Addr uint64
+	Data []byte
+}
+
+// A sparse address space. Segments are sorted by address and never overlap
+// or touch - adjacent records are merged.
+type Image struct {
+	Segments []Segment
+	// The start address from a HEX type 03 / 05 record or an S7 / S8 / S9
+	// record, if HasStart
+	Start    uint64
+	HasStart bool
+}
+
+// Build Segments from records in file order. Where records overlap, the later
+// one wins.
+func (img *Image) build(recs []Segment) {
+	sorted := make([]Segment, 0, len(recs))
+	for _, r := range recs {
+		if len(r.Data) > 0 {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Stable(byAddr(sorted))
+
+	// Merged extents first, then the data in file order
+	var segs []Segment
+	for _, r := range sorted {
+		n, end := len(segs), r.Addr+uint64(len(r.Data))
+		if n == 0 || r.Addr > segs[n-1].Addr+uint64(len(segs[n-1].Data)) {
+			segs = append(segs, Segment{r.Addr, make([]byte, len(r.Data))})
+			continue
+		}
+		if last := &segs[n-1]; end > last.Addr+uint64(len(last.Data)) {
+			last.Data = append(last.Data, make([]byte, end-last.Addr-uint64(len(last.Data)))...)
+		}
+	}
+	img.Segments = segs
+	for _, r := range recs {
+		copy(img.At(r.Addr), r.Data)
+	}
+}
+
+type byAddr []Segment
+
+func (s byAddr) Len() int           { return len(s) }
+func (s byAddr) Less(i, j int) bool { return s[i].Addr < s[j].Addr }
+func (s byAddr) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// A raw binary dump loaded at base. data is not copied.
+func Raw(data []byte, base uint64) *Image {
+	return &Image{Segments: []Segment{{base, data}}}
+}
+
+// Load a firmware file, choosing the parser from the extension: .hex, .ihex
+// and .ihx are Intel HEX, .srec, .s19, .s28, .s37 and .mot are S-records.
+// Anything else is a raw binary loaded at base, which is ignored for the
+// other formats.
+func Load(name string, base uint64) (*Image, error) {
+	fh, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".hex", ".ihex", ".ihx":
+		return ParseHex(fh)
+	case ".srec", ".s19", ".s28", ".s37", ".mot":
+		return ParseSRec(fh)
+	}
+	data, err := ioutil.ReadAll(fh)
+	if err != nil {
+		return nil, err
+	}
+	return Raw(data, base), nil
+}
+
+// Decode the hex digits of a record
+func recordBytes(s string) ([]byte, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) < 1 {
+		return nil, ErrFormat
+	}
+	return b, nil
+}
+
+// Parse an Intel HEX file. Extended segment (02) and extended linear (04)
+// address records are applied to the data records that follow.
+func ParseHex(r io.Reader) (*Image, error) {
+	img := &Image{}
+	var recs []Segment
+	var upper uint64
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" {
+			continue
+		}
+		if s[0] != ':' {
+			return nil, &RecordError{line, ErrFormat}
+		}
+		b, err := recordBytes(s[1:])
+		if err != nil || len(b) < 5 || int(b[0]) != len(b)-5 {
+			return nil, &RecordError{line, ErrFormat}
+		}
+		var sum byte
+		for _, c := range b {
+			sum += c
+		}
+		if sum != 0 {
+			return nil, &RecordError{line, ErrChecksum}
+		}
+
+		addr := uint64(b[1])<<8 | uint64(b[2])
+		data := b[4 : len(b)-1]
+		switch b[3] {
+		case 0x00:
+			recs = append(recs, Segment{upper + addr, data})
+		case 0x01:
+			img.build(recs)
+			return img, nil
+		case 0x02:
+			if len(data) != 2 {
+				return nil, &RecordError{line, ErrFormat}
+			}
+			upper = (uint64(data[0])<<8 | uint64(data[1])) << 4
+		case 0x03:
+			if len(data) != 4 {
+				return nil, &RecordError{line, ErrFormat}
+			}
+			cs, ip := uint64(data[0])<<8|uint64(data[1]), uint64(data[2])<<8|uint64(data[3])
+			img.Start, img.HasStart = cs<<4+ip, true
+		case 0x04:
+			if len(data) != 2 {
+				return nil, &RecordError{line, ErrFormat}
+			}
+			upper = (uint64(data[0])<<8 | uint64(data[1])) << 16
+		case 0x05:
+			if len(data) != 4 {
+				return nil, &RecordError{line, ErrFormat}
+			}
+			img.Start, img.HasStart = beUint(data), true
+		default:
+			return nil, &RecordError{line, ErrFormat}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	// A missing EOF record is tolerated
+	img.build(recs)
+	return img, nil
+}
+
+func beUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+// Address bytes for S0-S9
+var srecAddrLen = [10]int{2, 2, 3, 4, 0, 2, 3, 4, 3, 2}
+
+// Parse a Motorola S-record file. S0 headers and S5 / S6 counts are
+// ignored.
+func ParseSRec(r io.Reader) (*Image, error) {
+	img := &Image{}
+	var recs []Segment
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" {
+			continue
+		}
+		if len(s) < 4 || s[0] != 'S' || s[1] < '0' || s[1] > '9' || s[1] == '4' {
+			return nil, &RecordError{line, ErrFormat}
+		}
+		typ := int(s[1] - '0')
+		b, err := recordBytes(s[2:])
+		alen := srecAddrLen[typ]
+		if err != nil || int(b[0]) != len(b)-1 || len(b) < 2+alen {
+			return nil, &RecordError{line, ErrFormat}
+		}
+		var sum byte
+		for _, c := range b[:len(b)-1] {
+			sum += c
+		}
+		if ^sum != b[len(b)-1] {
+			return nil, &RecordError{line, ErrChecksum}
+		}
+
+		addr := beUint(b[1 : 1+alen])
+		data := b[1+alen : len(b)-1]
+		switch {
+		case typ >= 1 && typ <= 3:
+			recs = append(recs, Segment{addr, data})
+		case typ >= 7:
+			img.Start, img.HasStart = addr, true
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	img.build(recs)
+	return img, nil
+}
+
+// The bytes from addr to the end of its segment, or nil
+func (img *Image) At(addr uint64) []byte {
+	i := sort.Search(len(img.Segments), func(i int) bool {
+		s := img.Segments[i]
+		return s.Addr+uint64(len(s.Data)) > addr
+	})
+	if i < len(img.Segments) && img.Segments[i].Addr <= addr {
+		s := img.Segments[i]
+		return s.Data[addr-s.Addr:]
+	}
+	return nil
+}
+
+// Disassemble up to count instructions at addr with an Engine set up by the
+// caller, 0 for the rest of the segment.
+func (img *Image) Disasm(engine *gapstone.Engine, addr, count uint64) ([]gapstone.Instruction, error) {
+	data := img.At(addr)
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	return engine.Disasm(data, addr, count)
+}
@@ -0,0 +1,128 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package firmware
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// A Cortex-M image at 0x08000000: the vector table, two bx lr handlers, and
+// an overlapping pair of records at 0x08000100
+var testHex = `:020000040800F2
+:10000000001000201100000813000008000000008C
+:04001000704770477E
+:0401000001020304F1
+:01010200AA52
+:0400000508000011DE
+:00000001FF
+`
+
+func TestParseHex(t *testing.T) {
+	img, err := ParseHex(strings.NewReader(testHex))
+	if err != nil {
+		t.Fatalf("ParseHex: %v", err)
+	}
+	want := []Segment{
+		{0x08000000, []byte{
+			0x00, 0x10, 0x00, 0x20, 0x11, 0x00, 0x00, 0x08, 0x13, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00,
+			0x70, 0x47, 0x70, 0x47,
+		}},
+		{0x08000100, []byte{0x01, 0x02, 0xaa, 0x04}},
+	}
+	if !reflect.DeepEqual(img.Segments, want) {
+		t.Errorf("segments: want %x got %x", want, img.Segments)
+	}
+	if !img.HasStart || img.Start != 0x08000011 {
+		t.Errorf("start: want 0x8000011 got %#x (%v)", img.Start, img.HasStart)
+	}
+
+	// Extended segment addresses are paragraphs
+	img, err = ParseHex(strings.NewReader(":020000021000EC\n:0401000001020304F1\n"))
+	if err != nil || len(img.Segments) != 1 || img.Segments[0].Addr != 0x10100 {
+		t.Errorf("segment address: want 0x10100 got %+v (%v)", img.Segments, err)
+	}
+
+	bad := []struct {
+		text string
+		line int
+		err  error
+	}{
+		{":00000001FF\n0401000001020304F1", 0, nil}, // nothing after EOF is read
+		{"\n:0401000001020304F2", 2, ErrChecksum},
+		{":0501000001020304F1", 1, ErrFormat},
+		{":0401000001020304F", 1, ErrFormat},
+		{":00000006FA", 1, ErrFormat},
+	}
+	for i, test := range bad {
+		_, err := ParseHex(strings.NewReader(test.text))
+		if test.err == nil {
+			if err != nil {
+				t.Errorf("%2d> want no error got %v", i, err)
+			}
+			continue
+		}
+		if re, ok := err.(*RecordError); !ok || re.Line != test.line || re.Err != test.err {
+			t.Errorf("%2d> want line %d %v got %v", i, test.line, test.err, err)
+		}
+	}
+}
+
+func TestParseSRec(t *testing.T) {
+	text := strings.Join([]string{
+		"S0060000686472BB",
+		"S107100001020304DE",
+		"S2080200000708090AD3",
+		"S307080000040506E1",
+		"S5030003F9",
+		"S70508000000F2",
+	}, "\n")
+	img, err := ParseSRec(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("ParseSRec: %v", err)
+	}
+	want := []Segment{
+		{0x1000, []byte{1, 2, 3, 4}},
+		{0x20000, []byte{7, 8, 9, 10}},
+		{0x08000004, []byte{5, 6}},
+	}
+	if !reflect.DeepEqual(img.Segments, want) {
+		t.Errorf("segments: want %x got %x", want, img.Segments)
+	}
+	if !img.HasStart || img.Start != 0x08000000 {
+		t.Errorf("start: want 0x8000000 got %#x (%v)", img.Start, img.HasStart)
+	}
+
+	for i, text := range []string{"S107100001020304DF", "S4030003F9", "X107100001020304DE", "S1071000010203DE"} {
+		if _, err := ParseSRec(strings.NewReader(text)); err == nil {
+			t.Errorf("%2d> %s: want error", i, text)
+		}
+	}
+}
+
+func TestImageAt(t *testing.T) {
+	img := Raw([]byte{1, 2, 3, 4}, 0x8000)
+	tests := []struct {
+		addr uint64
+		want []byte
+	}{
+		{0x7fff, nil},
+		{0x8000, []byte{1, 2, 3, 4}},
+		{0x8003, []byte{4}},
+		{0x8004, nil},
+	}
+	for i, test := range tests {
+		if got := img.At(test.addr); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%2d> %#x: want %v got %v", i, test.addr, test.want, got)
+		}
+	}
+}
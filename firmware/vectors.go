@@ -0,0 +1,98 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package firmware
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+)
+
+var ErrVectorTable = errors.New("gapstone/firmware: no Cortex-M vector table at address")
+
+// One handler from a Cortex-M vector table
+type Vector struct {
+	Index int    // 1 for Reset, 16 and up for external interrupts
+	Name  string // "Reset", "HardFault", "IRQ0" ...
+	Addr  uint64 // handler address with the Thumb bit stripped
+}
+
+// A Cortex-M vector table. Unused (zero) entries are left out of Vectors.
+type VectorTable struct {
+	Base      uint64
+	InitialSP uint32
+	Reset     uint64 // with the Thumb bit stripped
+	Vectors   []Vector
+}
+
+var cortexMVectorNames = [16]string{
+	"InitialSP", "Reset", "NMI", "HardFault", "MemManage", "BusFault",
+	"UsageFault", "Reserved", "Reserved", "Reserved", "Reserved", "SVCall",
+	"DebugMon", "Reserved", "PendSV", "SysTick",
+}
+
+// At most 16 system exceptions and 496 external interrupts
+const maxVectors = 16 + 496
+
+// Parse the little endian Cortex-M vector table at base - usually the lowest
+// address in the image, or wherever VTOR points. The reset vector must be a
+// Thumb address (bit 0 set) inside the image. The table ends at the first
+// entry that is neither zero nor a Thumb address inside the image, or at the
+// end of the data.
+func ParseVectorTable(img *Image, base uint64) (*VectorTable, error) {
+	data := img.At(base)
+	if len(data) < 8 {
+		return nil, ErrVectorTable
+	}
+	reset := binary.LittleEndian.Uint32(data[4:])
+	if !img.isThumbCode(reset) {
+		return nil, ErrVectorTable
+	}
+
+	vt := &VectorTable{
+		Base:      base,
+		InitialSP: binary.LittleEndian.Uint32(data),
+		Reset:     uint64(reset &^ 1),
+	}
+	for i := 1; i < maxVectors && 4*i+4 <= len(data); i++ {
+		v := binary.LittleEndian.Uint32(data[4*i:])
+		if v == 0 {
+			continue
+		}
+		if !img.isThumbCode(v) {
+			break
+		}
+		name := "IRQ" + strconv.Itoa(i-16)
+		if i < 16 {
+			name = cortexMVectorNames[i]
+		}
+		vt.Vectors = append(vt.Vectors, Vector{Index: i, Name: name, Addr: uint64(v &^ 1)})
+	}
+	return vt, nil
+}
+
+func (img *Image) isThumbCode(v uint32) bool {
+	return v&1 != 0 && len(img.At(uint64(v&^1))) >= 2
+}
+
+// The distinct handler addresses, Reset first, to seed disassembly. Shared
+// default handlers appear once.
+func (vt *VectorTable) Entries() []uint64 {
+	seen := map[uint64]bool{}
+	var entries []uint64
+	for _, v := range vt.Vectors {
+		if !seen[v.Addr] {
+			seen[v.Addr] = true
+			entries = append(entries, v.Addr)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,88 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package firmware
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bnagy/gapstone"
+)
+
+func TestParseVectorTable(t *testing.T) {
+	img, err := ParseHex(strings.NewReader(testHex))
+	if err != nil {
+		t.Fatalf("ParseHex: %v", err)
+	}
+	vt, err := ParseVectorTable(img, 0x08000000)
+	if err != nil {
+		t.Fatalf("ParseVectorTable: %v", err)
+	}
+	want := &VectorTable{
+		Base:      0x08000000,
+		InitialSP: 0x20001000,
+		Reset:     0x08000010,
+		Vectors: []Vector{
+			{1, "Reset", 0x08000010},
+			{2, "NMI", 0x08000012},
+		},
+	}
+	if !reflect.DeepEqual(vt, want) {
+		t.Errorf("want %+v got %+v", want, vt)
+	}
+	if got := vt.Entries(); !reflect.DeepEqual(got, []uint64{0x08000010, 0x08000012}) {
+		t.Errorf("entries: got %#x", got)
+	}
+
+	// Reset must be a Thumb address inside the image
+	for _, reset := range []byte{0x10, 0x41} {
+		data := []byte{0x00, 0x10, 0x00, 0x20, reset, 0x00, 0x00, 0x00, 0x70, 0x47}
+		if _, err := ParseVectorTable(Raw(data, 0), 0); err != ErrVectorTable {
+			t.Errorf("reset %#x: want %v got %v", reset, ErrVectorTable, err)
+		}
+	}
+
+	// Shared handlers, and an IRQ
+	data := make([]byte, 17*4+2)
+	for i := 1; i < 17; i++ {
+		data[4*i] = 17*4 + 1
+	}
+	vt, err = ParseVectorTable(Raw(data, 0), 0)
+	if err != nil || len(vt.Vectors) != 16 || vt.Vectors[15].Name != "IRQ0" || len(vt.Entries()) != 1 {
+		t.Errorf("shared handlers: got %+v (%v)", vt, err)
+	}
+}
+
+func TestVectorTableEngine(t *testing.T) {
+
+	engine, err := gapstone.New(gapstone.CS_ARCH_ARM, gapstone.CS_MODE_THUMB+gapstone.CS_MODE_MCLASS)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer engine.Close()
+
+	img, err := ParseHex(strings.NewReader(testHex))
+	if err != nil {
+		t.Fatalf("ParseHex: %v", err)
+	}
+	vt, err := ParseVectorTable(img, img.Segments[0].Addr)
+	if err != nil {
+		t.Fatalf("ParseVectorTable: %v", err)
+	}
+	insns, err := img.Disasm(&engine, vt.Reset, 1)
+	if err != nil || len(insns) != 1 || insns[0].Mnemonic != "bx" || uint64(insns[0].Address) != vt.Reset {
+		t.Errorf("reset handler: want bx lr at %#x got %v (%v)", vt.Reset, insns, err)
+	}
+	if _, err := img.Disasm(&engine, 0, 1); err != ErrNoData {
+		t.Errorf("unmapped: want %v got %v", ErrNoData, err)
+	}
+}
@@ -0,0 +1,916 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "encoding/binary"
+
+// How an instruction affects control flow. Unlike the CS_GRP_* groups, this
+// is worked out from the instruction id (and the operands when detail is on),
+// so it also works with CS_OPT_DETAIL off and in diet mode.
+type FlowKind int
+
+const (
+	FlowFallthrough FlowKind = iota // execution continues with the next instruction
+	FlowCondBranch                  // direct branch taken only if a condition holds
+	FlowJump                        // unconditional direct jump
+	FlowCall                        // subroutine call, direct or indirect
+	FlowReturn                      // subroutine or exception return
+	FlowIndirect                    // jump through a register, memory or jump table
+	FlowTrap                        // breakpoint, undefined instruction or other trap
+	FlowSyscall                     // supervisor call
+)
+
+var flowKindNames = [...]string{
+	FlowFallthrough: "fallthrough",
+	FlowCondBranch:  "conditional branch",
+	FlowJump:        "jump",
+	FlowCall:        "call",
+	FlowReturn:      "return",
+	FlowIndirect:    "indirect",
+	FlowTrap:        "trap",
+	FlowSyscall:     "syscall",
+}
+
+func (k FlowKind) String() string {
+	if k >= 0 && int(k) < len(flowKindNames) {
+		return flowKindNames[k]
+	}
+	return "unknown"
+}
+
+// Control flow classification for a single instruction, as returned by
+// Instruction.Flow()
+type Flow struct {
+	Kind        FlowKind
+	Conditional bool   // Kind only happens if a condition holds (bne, bxeq lr, jal on MIPS bgezal...)
+	Target      uint64 // static destination address, only valid if HasTarget
+	HasTarget   bool
+}
+
+// Branches in delay slot ISAs (MIPS, SPARC) are classified by their own
+// effect; the delay slot instruction is not considered.
+//
+// Targets for direct branches come from the immediate operands, so without
+// CS_OPT_DETAIL they are only recovered for x86 and XCore (from the raw
+// bytes) and kinds which depend on the operands (ARM `pop {pc}`, MIPS
+// `jr $ra`) fall back to their register-free meaning.
+func (insn Instruction) Flow() Flow {
+	var f Flow
+	switch insn.archOf() {
+	case CS_ARCH_X86:
+		f = x86Flow(&insn)
+	case CS_ARCH_ARM:
+		f = armFlow(&insn)
+	case CS_ARCH_ARM64:
+		f = arm64Flow(&insn)
+	case CS_ARCH_MIPS:
+		f = mipsFlow(&insn)
+	case CS_ARCH_PPC:
+		f = ppcFlow(&insn)
+	case CS_ARCH_SPARC:
+		f = sparcFlow(&insn)
+	case CS_ARCH_SYSZ:
+		f = syszFlow(&insn)
+	case CS_ARCH_XCORE:
+		f = xcoreFlow(&insn)
+	}
+	if f.Kind == FlowFallthrough {
+		f.Kind = groupFlow(insn.Groups)
+	}
+	return f
+}
+
+// Fallback for anything the tables don't know about.
+func groupFlow(groups []uint) FlowKind {
+	for _, g := range groups {
+		switch g {
+		case CS_GRP_CALL:
+			return FlowCall
+		case CS_GRP_RET, CS_GRP_IRET:
+			return FlowReturn
+		case CS_GRP_INT:
+			return FlowTrap
+		case CS_GRP_JUMP:
+			return FlowJump
+		}
+	}
+	return FlowFallthrough
+}
+
+// The address of the instruction following insn
+func (insn *Instruction) next() uint64 {
+	return uint64(insn.Address) + uint64(insn.Size)
+}
+
+// A set of instruction ids
+type insnSet map[uint]bool
+
+func newInsnSet(ids ...uint) insnSet {
+	s := make(insnSet, len(ids))
+	for _, id := range ids {
+		s[id] = true
+	}
+	return s
+}
+
+// X86
+
+var x86CondJumps = newInsnSet(
+	X86_INS_JAE, X86_INS_JA, X86_INS_JBE, X86_INS_JB, X86_INS_JCXZ,
+	X86_INS_JECXZ, X86_INS_JE, X86_INS_JGE, X86_INS_JG, X86_INS_JLE,
+	X86_INS_JL, X86_INS_JNE, X86_INS_JNO, X86_INS_JNP, X86_INS_JNS,
+	X86_INS_JO, X86_INS_JP, X86_INS_JRCXZ, X86_INS_JS, X86_INS_LOOP,
+	X86_INS_LOOPE, X86_INS_LOOPNE,
+)
+
+func x86Flow(insn *Instruction) Flow {
+	switch {
+	case x86CondJumps[insn.Id]:
+		f := Flow{Kind: FlowCondBranch, Conditional: true}
+		f.Target, f.HasTarget = x86Target(insn)
+		return f
+	}
+
+	switch insn.Id {
+	case X86_INS_JMP:
+		if t, ok := x86Target(insn); ok {
+			return Flow{Kind: FlowJump, Target: t, HasTarget: true}
+		}
+		if insn.X86 == nil && !x86IndirectForm(insn) {
+			return Flow{Kind: FlowJump}
+		}
+		return Flow{Kind: FlowIndirect}
+	case X86_INS_LJMP:
+		return Flow{Kind: FlowJump}
+	case X86_INS_CALL:
+		f := Flow{Kind: FlowCall}
+		f.Target, f.HasTarget = x86Target(insn)
+		return f
+	case X86_INS_LCALL:
+		return Flow{Kind: FlowCall}
+	case X86_INS_RET, X86_INS_RETF, X86_INS_RETFQ, X86_INS_IRET,
+		X86_INS_IRETD, X86_INS_IRETQ, X86_INS_SYSRET, X86_INS_SYSEXIT:
+		return Flow{Kind: FlowReturn}
+	case X86_INS_SYSCALL, X86_INS_SYSENTER:
+		return Flow{Kind: FlowSyscall}
+	case X86_INS_INT:
+		// int 0x80 (Linux) and int 0x2e (Windows) are system calls
+		if len(insn.Bytes) > 0 {
+			switch insn.Bytes[len(insn.Bytes)-1] {
+			case 0x80, 0x2e:
+				return Flow{Kind: FlowSyscall}
+			}
+		}
+		return Flow{Kind: FlowTrap}
+	case X86_INS_INT1, X86_INS_INT3, X86_INS_UD2, X86_INS_UD2B:
+		return Flow{Kind: FlowTrap}
+	case X86_INS_INTO:
+		return Flow{Kind: FlowTrap, Conditional: true}
+	}
+	return Flow{}
+}
+
+// Target of a direct x86 branch. Capstone reports these as absolute
+// immediates; without detail the relative displacement is decoded from the
+// instruction bytes.
+func x86Target(insn *Instruction) (uint64, bool) {
+	if insn.X86 != nil {
+		if len(insn.X86.Operands) == 1 && insn.X86.Operands[0].Type == X86_OP_IMM {
+			return uint64(insn.X86.Operands[0].Imm), true
+		}
+		return 0, false
+	}
+
+	b := insn.Bytes
+	mode64 := insn.mode&CS_MODE_64 != 0
+	opsize16 := insn.mode&CS_MODE_16 != 0
+	i := 0
+prefixes:
+	for ; i < len(b); i++ {
+		switch b[i] {
+		case 0x66:
+			if !mode64 {
+				opsize16 = !opsize16
+			}
+		case 0x26, 0x2e, 0x36, 0x3e, 0x64, 0x65, 0x67, 0xf0, 0xf2, 0xf3:
+		default:
+			break prefixes
+		}
+	}
+	if mode64 && i < len(b) && b[i]&0xf0 == 0x40 {
+		i++ // REX
+	}
+	if i >= len(b) {
+		return 0, false
+	}
+
+	var rel int64
+	op := b[i]
+	imm := b[i+1:]
+	switch {
+	case op == 0xeb, op >= 0x70 && op <= 0x7f, op >= 0xe0 && op <= 0xe3:
+		if len(imm) < 1 {
+			return 0, false
+		}
+		rel = int64(int8(imm[0]))
+	case op == 0xe8, op == 0xe9, op == 0x0f && len(imm) > 0 && imm[0]&0xf0 == 0x80:
+		if op == 0x0f {
+			imm = imm[1:]
+		}
+		if opsize16 {
+			if len(imm) < 2 {
+				return 0, false
+			}
+			rel = int64(int16(binary.LittleEndian.Uint16(imm)))
+		} else {
+			if len(imm) < 4 {
+				return 0, false
+			}
+			rel = int64(int32(binary.LittleEndian.Uint32(imm)))
+		}
+	default:
+		return 0, false
+	}
+
+	target := insn.next() + uint64(rel)
+	switch {
+	case opsize16:
+		target &= 0xffff
+	case !mode64:
+		target &= 0xffffffff
+	}
+	return target, true
+}
+
+// FF /2 .. FF /5 are the indirect call and jmp forms
+func x86IndirectForm(insn *Instruction) bool {
+	for i, b := range insn.Bytes {
+		if b == 0xff && i+1 < len(insn.Bytes) {
+			reg := (insn.Bytes[i+1] >> 3) & 7
+			return reg >= 2 && reg <= 5
+		}
+	}
+	return false
+}
+
+// ARM
+
+func armFlow(insn *Instruction) Flow {
+	arm := insn.Arm
+	f := Flow{Conditional: armConditional(insn)}
+
+	switch insn.Id {
+	case ARM_INS_B:
+		f.Kind = FlowJump
+		if f.Conditional {
+			f.Kind = FlowCondBranch
+		}
+		f.Target, f.HasTarget = armImm(arm, 0)
+	case ARM_INS_CBZ, ARM_INS_CBNZ:
+		f.Kind = FlowCondBranch
+		f.Conditional = true
+		f.Target, f.HasTarget = armImm(arm, 1)
+	case ARM_INS_BL, ARM_INS_BLX:
+		f.Kind = FlowCall
+		f.Target, f.HasTarget = armImm(arm, 0)
+	case ARM_INS_BX, ARM_INS_BXJ:
+		f.Kind = FlowIndirect
+		if armReg(arm, 0) == ARM_REG_LR {
+			f.Kind = FlowReturn
+		}
+	case ARM_INS_TBB, ARM_INS_TBH:
+		f.Kind = FlowIndirect
+	case ARM_INS_SVC:
+		f.Kind = FlowSyscall
+	case ARM_INS_BKPT, ARM_INS_UDF:
+		f.Kind = FlowTrap
+	case ARM_INS_RFEDA, ARM_INS_RFEDB, ARM_INS_RFEIA, ARM_INS_RFEIB:
+		f.Kind = FlowReturn
+	case ARM_INS_POP:
+		// pop {..., pc}
+		if armListHasPC(arm, 0) {
+			f.Kind = FlowReturn
+		}
+	case ARM_INS_LDM, ARM_INS_LDMDA, ARM_INS_LDMDB, ARM_INS_LDMIB:
+		// ldm sp!, {..., pc} is a return, any other base is a computed jump
+		if armListHasPC(arm, 1) {
+			f.Kind = FlowIndirect
+			if armReg(arm, 0) == ARM_REG_SP {
+				f.Kind = FlowReturn
+			}
+		}
+	case ARM_INS_LDR:
+		// ldr pc, [sp], #4 pops the return address
+		if armReg(arm, 0) == ARM_REG_PC {
+			f.Kind = FlowIndirect
+			if len(arm.Operands) > 1 && arm.Operands[1].Type == ARM_OP_MEM &&
+				arm.Operands[1].Mem.Base == ARM_REG_SP {
+				f.Kind = FlowReturn
+			}
+		}
+	case ARM_INS_MOV:
+		// mov pc, lr
+		if armReg(arm, 0) == ARM_REG_PC {
+			f.Kind = FlowIndirect
+			if armReg(arm, 1) == ARM_REG_LR {
+				f.Kind = FlowReturn
+			}
+		}
+	case ARM_INS_SUBS:
+		// subs pc, lr, #imm is an exception return
+		if armReg(arm, 0) == ARM_REG_PC {
+			f.Kind = FlowReturn
+		}
+	case ARM_INS_ADD, ARM_INS_SUB, ARM_INS_ADDW, ARM_INS_ORR, ARM_INS_MOVS:
+		if armReg(arm, 0) == ARM_REG_PC {
+			f.Kind = FlowIndirect
+		}
+	}
+
+	if f.Kind == FlowFallthrough {
+		f.Conditional = false
+	}
+	return f
+}
+
+// Whether an ARM instruction has a condition other than AL. Without detail
+// this can only be read from the encoding of A32 instructions.
+func armConditional(insn *Instruction) bool {
+	if insn.Arm != nil {
+		return insn.Arm.CC != ARM_CC_AL && insn.Arm.CC != ARM_CC_INVALID
+	}
+	if insn.mode&CS_MODE_THUMB != 0 || len(insn.Bytes) != 4 {
+		return false
+	}
+	cond := insn.Bytes[3] >> 4
+	if insn.mode&CS_MODE_BIG_ENDIAN != 0 {
+		cond = insn.Bytes[0] >> 4
+	}
+	return cond < 0xe
+}
+
+func armImm(arm *ArmInstruction, idx int) (uint64, bool) {
+	if arm == nil || idx >= len(arm.Operands) || arm.Operands[idx].Type != ARM_OP_IMM {
+		return 0, false
+	}
+	return uint64(uint32(arm.Operands[idx].Imm)), true
+}
+
+func armReg(arm *ArmInstruction, idx int) uint {
+	if arm == nil || idx >= len(arm.Operands) || arm.Operands[idx].Type != ARM_OP_REG {
+		return ARM_REG_INVALID
+	}
+	return arm.Operands[idx].Reg
+}
+
+// Does the register list starting at operand idx contain the PC?
+func armListHasPC(arm *ArmInstruction, idx int) bool {
+	if arm == nil {
+		return false
+	}
+	for i := idx; i < len(arm.Operands); i++ {
+		if arm.Operands[i].Type == ARM_OP_REG && arm.Operands[i].Reg == ARM_REG_PC {
+			return true
+		}
+	}
+	return false
+}
+
+// ARM64
+
+func arm64Flow(insn *Instruction) Flow {
+	arm64 := insn.Arm64
+	switch insn.Id {
+	case ARM64_INS_B:
+		f := Flow{Kind: FlowJump}
+		if arm64Conditional(insn) {
+			f = Flow{Kind: FlowCondBranch, Conditional: true}
+		}
+		f.Target, f.HasTarget = arm64LastImm(arm64)
+		return f
+	case ARM64_INS_CBZ, ARM64_INS_CBNZ, ARM64_INS_TBZ, ARM64_INS_TBNZ:
+		f := Flow{Kind: FlowCondBranch, Conditional: true}
+		f.Target, f.HasTarget = arm64LastImm(arm64)
+		return f
+	case ARM64_INS_BL:
+		f := Flow{Kind: FlowCall}
+		f.Target, f.HasTarget = arm64LastImm(arm64)
+		return f
+	case ARM64_INS_BLR:
+		return Flow{Kind: FlowCall}
+	case ARM64_INS_BR:
+		return Flow{Kind: FlowIndirect}
+	case ARM64_INS_RET, ARM64_INS_ERET, ARM64_INS_DRPS:
+		return Flow{Kind: FlowReturn}
+	case ARM64_INS_SVC, ARM64_INS_HVC, ARM64_INS_SMC:
+		return Flow{Kind: FlowSyscall}
+	case ARM64_INS_BRK, ARM64_INS_HLT:
+		return Flow{Kind: FlowTrap}
+	}
+	return Flow{}
+}
+
+// Is a B conditional? Without detail, b.cond is 0x54 in the top byte of the
+// word, with the condition in the low nibble.
+func arm64Conditional(insn *Instruction) bool {
+	if insn.Arm64 != nil {
+		cc := insn.Arm64.CC
+		return cc != ARM64_CC_INVALID && cc != ARM64_CC_AL && cc != ARM64_CC_NV
+	}
+	if len(insn.Bytes) != 4 {
+		return false
+	}
+	op, cond := insn.Bytes[3], insn.Bytes[0]&0x1f
+	if insn.mode&CS_MODE_BIG_ENDIAN != 0 {
+		op, cond = insn.Bytes[0], insn.Bytes[3]&0x1f
+	}
+	return op == 0x54 && cond < 0xe
+}
+
+func arm64LastImm(arm64 *Arm64Instruction) (uint64, bool) {
+	if arm64 == nil || len(arm64.Operands) == 0 {
+		return 0, false
+	}
+	op := arm64.Operands[len(arm64.Operands)-1]
+	if op.Type != ARM64_OP_IMM {
+		return 0, false
+	}
+	return uint64(op.Imm), true
+}
+
+// MIPS
+
+var mipsCondBranches = newInsnSet(
+	MIPS_INS_BEQ, MIPS_INS_BEQL, MIPS_INS_BNE, MIPS_INS_BNEL, MIPS_INS_BGEZ,
+	MIPS_INS_BGEZL, MIPS_INS_BGTZ, MIPS_INS_BGTZL, MIPS_INS_BLEZ,
+	MIPS_INS_BLEZL, MIPS_INS_BLTZ, MIPS_INS_BLTZL, MIPS_INS_BEQZ,
+	MIPS_INS_BNEZ, MIPS_INS_BEQC, MIPS_INS_BNEC, MIPS_INS_BGEC,
+	MIPS_INS_BGEUC, MIPS_INS_BLTC, MIPS_INS_BLTUC, MIPS_INS_BEQZC,
+	MIPS_INS_BNEZC, MIPS_INS_BGEZC, MIPS_INS_BGTZC, MIPS_INS_BLEZC,
+	MIPS_INS_BLTZC, MIPS_INS_BNVC, MIPS_INS_BOVC, MIPS_INS_BC0F,
+	MIPS_INS_BC0FL, MIPS_INS_BC0T, MIPS_INS_BC0TL, MIPS_INS_BC1EQZ,
+	MIPS_INS_BC1F, MIPS_INS_BC1FL, MIPS_INS_BC1NEZ, MIPS_INS_BC1T,
+	MIPS_INS_BC1TL, MIPS_INS_BC2EQZ, MIPS_INS_BC2F, MIPS_INS_BC2FL,
+	MIPS_INS_BC2NEZ, MIPS_INS_BC2T, MIPS_INS_BC2TL, MIPS_INS_BC3F,
+	MIPS_INS_BC3FL, MIPS_INS_BC3T, MIPS_INS_BC3TL, MIPS_INS_BZ,
+	MIPS_INS_BNZ, MIPS_INS_BTEQZ, MIPS_INS_BTNEZ, MIPS_INS_BPOSGE32,
+)
+
+var mipsCondCalls = newInsnSet(
+	MIPS_INS_BGEZAL, MIPS_INS_BGEZALL, MIPS_INS_BGEZALS, MIPS_INS_BLTZAL,
+	MIPS_INS_BLTZALL, MIPS_INS_BLTZALS, MIPS_INS_BEQZALC, MIPS_INS_BNEZALC,
+	MIPS_INS_BGEZALC, MIPS_INS_BGTZALC, MIPS_INS_BLEZALC, MIPS_INS_BLTZALC,
+)
+
+var mipsTraps = newInsnSet(
+	MIPS_INS_TEQ, MIPS_INS_TEQI, MIPS_INS_TGE, MIPS_INS_TGEI, MIPS_INS_TGEIU,
+	MIPS_INS_TGEU, MIPS_INS_TLT, MIPS_INS_TLTI, MIPS_INS_TLTIU, MIPS_INS_TLTU,
+	MIPS_INS_TNE, MIPS_INS_TNEI,
+)
+
+func mipsFlow(insn *Instruction) Flow {
+	switch {
+	case mipsCondBranches[insn.Id]:
+		f := Flow{Kind: FlowCondBranch, Conditional: true}
+		f.Target, f.HasTarget = mipsRelTarget(insn)
+		return f
+	case mipsCondCalls[insn.Id]:
+		f := Flow{Kind: FlowCall, Conditional: true}
+		f.Target, f.HasTarget = mipsRelTarget(insn)
+		return f
+	case mipsTraps[insn.Id]:
+		return Flow{Kind: FlowTrap, Conditional: true}
+	}
+
+	switch insn.Id {
+	case MIPS_INS_J:
+		f := Flow{Kind: FlowJump}
+		f.Target, f.HasTarget = mipsAbsTarget(insn)
+		return f
+	case MIPS_INS_JAL, MIPS_INS_JALS, MIPS_INS_JALX:
+		f := Flow{Kind: FlowCall}
+		f.Target, f.HasTarget = mipsAbsTarget(insn)
+		return f
+	case MIPS_INS_B, MIPS_INS_BC:
+		f := Flow{Kind: FlowJump}
+		f.Target, f.HasTarget = mipsRelTarget(insn)
+		return f
+	case MIPS_INS_BAL, MIPS_INS_BALC:
+		f := Flow{Kind: FlowCall}
+		f.Target, f.HasTarget = mipsRelTarget(insn)
+		return f
+	case MIPS_INS_JR, MIPS_INS_JR_HB, MIPS_INS_JRC:
+		if insn.Mips != nil && len(insn.Mips.Operands) > 0 &&
+			insn.Mips.Operands[0].Type == MIPS_OP_REG && insn.Mips.Operands[0].Reg == MIPS_REG_RA {
+			return Flow{Kind: FlowReturn}
+		}
+		return Flow{Kind: FlowIndirect}
+	case MIPS_INS_JRADDIUSP, MIPS_INS_ERET, MIPS_INS_DERET:
+		return Flow{Kind: FlowReturn}
+	case MIPS_INS_JIC:
+		return Flow{Kind: FlowIndirect}
+	case MIPS_INS_JALR, MIPS_INS_JALRS, MIPS_INS_JALR_HB, MIPS_INS_JALRC, MIPS_INS_JIALC:
+		return Flow{Kind: FlowCall}
+	case MIPS_INS_SYSCALL:
+		return Flow{Kind: FlowSyscall}
+	case MIPS_INS_BREAK, MIPS_INS_SDBBP:
+		return Flow{Kind: FlowTrap}
+	}
+	return Flow{}
+}
+
+func mipsLastImm(insn *Instruction) (int64, bool) {
+	if insn.Mips == nil || len(insn.Mips.Operands) == 0 {
+		return 0, false
+	}
+	op := insn.Mips.Operands[len(insn.Mips.Operands)-1]
+	if op.Type != MIPS_OP_IMM {
+		return 0, false
+	}
+	return op.Imm, true
+}
+
+// j / jal targets are reported as absolute addresses
+func mipsAbsTarget(insn *Instruction) (uint64, bool) {
+	imm, ok := mipsLastImm(insn)
+	return uint64(imm), ok
+}
+
+// Capstone 3 reports PC-relative MIPS branch operands as an offset from the
+// branch itself (the encoded offset plus the delay slot).
+func mipsRelTarget(insn *Instruction) (uint64, bool) {
+	imm, ok := mipsLastImm(insn)
+	if !ok {
+		return 0, false
+	}
+	target := uint64(insn.Address) + uint64(imm)
+	if insn.mode&CS_MODE_64 == 0 {
+		target &= 0xffffffff
+	}
+	return target, true
+}
+
+// PPC
+
+var ppcCondBranches = newInsnSet(
+	PPC_INS_BC, PPC_INS_BCA, PPC_INS_BT, PPC_INS_BF, PPC_INS_BTA, PPC_INS_BFA,
+	PPC_INS_BDNZ, PPC_INS_BDNZA, PPC_INS_BDZ, PPC_INS_BDZA, PPC_INS_BDNZT,
+	PPC_INS_BDNZF, PPC_INS_BDZT, PPC_INS_BDZF, PPC_INS_BDNZTA,
+	PPC_INS_BDNZFA, PPC_INS_BDZTA, PPC_INS_BDZFA,
+)
+
+var ppcCondCalls = newInsnSet(
+	PPC_INS_BCL, PPC_INS_BCLA, PPC_INS_BTL, PPC_INS_BFL, PPC_INS_BTLA,
+	PPC_INS_BFLA, PPC_INS_BDNZL, PPC_INS_BDNZLA, PPC_INS_BDZL, PPC_INS_BDZLA,
+	PPC_INS_BDNZTL, PPC_INS_BDNZFL, PPC_INS_BDZTL, PPC_INS_BDZFL,
+	PPC_INS_BDNZTLA, PPC_INS_BDNZFLA, PPC_INS_BDZTLA, PPC_INS_BDZFLA,
+)
+
+var ppcCondReturns = newInsnSet(
+	PPC_INS_BCLR, PPC_INS_BTLR, PPC_INS_BFLR, PPC_INS_BDNZLR, PPC_INS_BDZLR,
+	PPC_INS_BDNZTLR, PPC_INS_BDZTLR, PPC_INS_BDZFLR,
+)
+
+// Branch to LR and link: a call through the link register
+var ppcLRCalls = newInsnSet(
+	PPC_INS_BLRL, PPC_INS_BCLRL, PPC_INS_BTLRL, PPC_INS_BFLRL,
+	PPC_INS_BDNZLRL, PPC_INS_BDZLRL, PPC_INS_BDNZTLRL, PPC_INS_BDNZFLRL,
+	PPC_INS_BDZTLRL, PPC_INS_BDZFLRL,
+)
+
+var ppcTraps = newInsnSet(
+	PPC_INS_TD, PPC_INS_TDI, PPC_INS_TW, PPC_INS_TWI, PPC_INS_TDLT,
+	PPC_INS_TDEQ, PPC_INS_TDGT, PPC_INS_TDNE, PPC_INS_TDLLT, PPC_INS_TDLGT,
+	PPC_INS_TDU, PPC_INS_TDLTI, PPC_INS_TDEQI, PPC_INS_TDGTI, PPC_INS_TDNEI,
+	PPC_INS_TDLLTI, PPC_INS_TDLGTI, PPC_INS_TDUI, PPC_INS_TWLT, PPC_INS_TWEQ,
+	PPC_INS_TWGT, PPC_INS_TWNE, PPC_INS_TWLLT, PPC_INS_TWLGT, PPC_INS_TWU,
+	PPC_INS_TWLTI, PPC_INS_TWEQI, PPC_INS_TWGTI, PPC_INS_TWNEI,
+	PPC_INS_TWLLTI, PPC_INS_TWLGTI, PPC_INS_TWUI,
+)
+
+func ppcFlow(insn *Instruction) Flow {
+	// Capstone also reports simplified mnemonics like beqlr with BC set
+	cond := insn.PPC != nil && insn.PPC.BC != PPC_BC_INVALID
+
+	switch {
+	case ppcCondBranches[insn.Id]:
+		f := Flow{Kind: FlowCondBranch, Conditional: true}
+		f.Target, f.HasTarget = ppcLastImm(insn)
+		return f
+	case ppcCondCalls[insn.Id]:
+		f := Flow{Kind: FlowCall, Conditional: true}
+		f.Target, f.HasTarget = ppcLastImm(insn)
+		return f
+	case ppcCondReturns[insn.Id]:
+		return Flow{Kind: FlowReturn, Conditional: true}
+	case ppcLRCalls[insn.Id]:
+		return Flow{Kind: FlowCall, Conditional: cond || insn.Id != PPC_INS_BLRL}
+	case ppcTraps[insn.Id]:
+		return Flow{Kind: FlowTrap, Conditional: insn.Id != PPC_INS_TWU && insn.Id != PPC_INS_TDU}
+	}
+
+	switch insn.Id {
+	case PPC_INS_B, PPC_INS_BA:
+		f := Flow{Kind: FlowJump}
+		if cond {
+			f = Flow{Kind: FlowCondBranch, Conditional: true}
+		}
+		f.Target, f.HasTarget = ppcLastImm(insn)
+		return f
+	case PPC_INS_BL, PPC_INS_BLA:
+		f := Flow{Kind: FlowCall, Conditional: cond}
+		f.Target, f.HasTarget = ppcLastImm(insn)
+		return f
+	case PPC_INS_BLR:
+		return Flow{Kind: FlowReturn, Conditional: cond}
+	case PPC_INS_BCTR:
+		return Flow{Kind: FlowIndirect, Conditional: cond}
+	case PPC_INS_BCCTR, PPC_INS_BTCTR, PPC_INS_BFCTR:
+		return Flow{Kind: FlowIndirect, Conditional: true}
+	case PPC_INS_BCTRL:
+		return Flow{Kind: FlowCall, Conditional: cond}
+	case PPC_INS_BCCTRL, PPC_INS_BTCTRL, PPC_INS_BFCTRL:
+		return Flow{Kind: FlowCall, Conditional: true}
+	case PPC_INS_RFI, PPC_INS_RFID, PPC_INS_RFCI, PPC_INS_RFDI, PPC_INS_RFMCI:
+		return Flow{Kind: FlowReturn}
+	case PPC_INS_SC:
+		return Flow{Kind: FlowSyscall}
+	case PPC_INS_TRAP:
+		return Flow{Kind: FlowTrap}
+	}
+	return Flow{}
+}
+
+// PPC branch targets are reported as absolute addresses
+func ppcLastImm(insn *Instruction) (uint64, bool) {
+	if insn.PPC == nil || len(insn.PPC.Operands) == 0 {
+		return 0, false
+	}
+	op := insn.PPC.Operands[len(insn.PPC.Operands)-1]
+	if op.Type != PPC_OP_IMM {
+		return 0, false
+	}
+	target := uint64(uint32(op.Imm))
+	if insn.mode&CS_MODE_64 != 0 {
+		target = uint64(int64(op.Imm))
+	}
+	return target, true
+}
+
+// SPARC
+
+var sparcRegBranches = newInsnSet(
+	SPARC_INS_BRGEZ, SPARC_INS_BRGZ, SPARC_INS_BRLEZ, SPARC_INS_BRLZ,
+	SPARC_INS_BRNZ, SPARC_INS_BRZ,
+)
+
+func sparcFlow(insn *Instruction) Flow {
+	sparc := insn.Sparc
+
+	switch {
+	case sparcRegBranches[insn.Id]:
+		f := Flow{Kind: FlowCondBranch, Conditional: true}
+		f.Target, f.HasTarget = sparcLastImm(insn)
+		return f
+	}
+
+	switch insn.Id {
+	case SPARC_INS_B, SPARC_INS_FB:
+		f := Flow{Kind: FlowJump}
+		if sparc != nil {
+			switch sparc.CC {
+			case SPARC_CC_ICC_N, SPARC_CC_FCC_N:
+				// branch never
+				return Flow{}
+			case SPARC_CC_ICC_A, SPARC_CC_FCC_A, SPARC_CC_INVALID:
+			default:
+				f = Flow{Kind: FlowCondBranch, Conditional: true}
+			}
+		}
+		f.Target, f.HasTarget = sparcLastImm(insn)
+		return f
+	case SPARC_INS_CALL:
+		f := Flow{Kind: FlowCall}
+		f.Target, f.HasTarget = sparcLastImm(insn)
+		return f
+	case SPARC_INS_RET, SPARC_INS_RETL, SPARC_INS_RETT:
+		return Flow{Kind: FlowReturn}
+	case SPARC_INS_JMP:
+		return Flow{Kind: FlowIndirect}
+	case SPARC_INS_JMPL:
+		// jmpl addr, %o7 links (call), jmpl %i7+8, %g0 is a hand written ret
+		if sparc != nil && len(sparc.Operands) > 0 {
+			last := sparc.Operands[len(sparc.Operands)-1]
+			if last.Type == SPARC_OP_REG && last.Reg == SPARC_REG_O7 {
+				return Flow{Kind: FlowCall}
+			}
+			if first := sparc.Operands[0]; first.Type == SPARC_OP_MEM &&
+				(first.Mem.Base == SPARC_REG_I7 || first.Mem.Base == SPARC_REG_O7) {
+				return Flow{Kind: FlowReturn}
+			}
+		}
+		return Flow{Kind: FlowIndirect}
+	case SPARC_INS_T:
+		f := Flow{Kind: FlowTrap}
+		if sparc != nil && sparc.CC != SPARC_CC_ICC_A && sparc.CC != SPARC_CC_INVALID {
+			f.Conditional = true
+		}
+		// ta 0x10 / 0x6d (Linux) and ta 8 (Solaris) are system calls
+		if imm, ok := sparcLastImm(insn); ok && !f.Conditional {
+			switch imm {
+			case 0x10, 0x6d, 0x8:
+				f.Kind = FlowSyscall
+			}
+		}
+		return f
+	}
+	return Flow{}
+}
+
+func sparcLastImm(insn *Instruction) (uint64, bool) {
+	if insn.Sparc == nil || len(insn.Sparc.Operands) == 0 {
+		return 0, false
+	}
+	op := insn.Sparc.Operands[len(insn.Sparc.Operands)-1]
+	if op.Type != SPARC_OP_IMM {
+		return 0, false
+	}
+	if insn.mode&CS_MODE_V9 != 0 {
+		return uint64(int64(op.Imm)), true
+	}
+	return uint64(uint32(op.Imm)), true
+}
+
+// SystemZ
+
+var syszCondJumps = newInsnSet(
+	SYSZ_INS_BRC, SYSZ_INS_BRCL, SYSZ_INS_BRCT, SYSZ_INS_BRCTG,
+	SYSZ_INS_JE, SYSZ_INS_JGE, SYSZ_INS_JHE, SYSZ_INS_JGHE, SYSZ_INS_JH,
+	SYSZ_INS_JGH, SYSZ_INS_JLE, SYSZ_INS_JGLE, SYSZ_INS_JLH, SYSZ_INS_JGLH,
+	SYSZ_INS_JL, SYSZ_INS_JGL, SYSZ_INS_JNE, SYSZ_INS_JGNE, SYSZ_INS_JNHE,
+	SYSZ_INS_JGNHE, SYSZ_INS_JNH, SYSZ_INS_JGNH, SYSZ_INS_JNLE,
+	SYSZ_INS_JGNLE, SYSZ_INS_JNLH, SYSZ_INS_JGNLH, SYSZ_INS_JNL,
+	SYSZ_INS_JGNL, SYSZ_INS_JNO, SYSZ_INS_JGNO, SYSZ_INS_JO, SYSZ_INS_JGO,
+	// compare and branch
+	SYSZ_INS_CGIJ, SYSZ_INS_CGRJ, SYSZ_INS_CIJ, SYSZ_INS_CLGIJ,
+	SYSZ_INS_CLGRJ, SYSZ_INS_CLIJ, SYSZ_INS_CLRJ, SYSZ_INS_CRJ,
+	SYSZ_INS_CGIJNLH, SYSZ_INS_CGRJNLH, SYSZ_INS_CIJNLH, SYSZ_INS_CLGIJNLH,
+	SYSZ_INS_CLGRJNLH, SYSZ_INS_CLIJNLH, SYSZ_INS_CLRJNLH, SYSZ_INS_CRJNLH,
+	SYSZ_INS_CGIJE, SYSZ_INS_CGRJE, SYSZ_INS_CIJE, SYSZ_INS_CLGIJE,
+	SYSZ_INS_CLGRJE, SYSZ_INS_CLIJE, SYSZ_INS_CLRJE, SYSZ_INS_CRJE,
+	SYSZ_INS_CGIJNLE, SYSZ_INS_CGRJNLE, SYSZ_INS_CIJNLE, SYSZ_INS_CLGIJNLE,
+	SYSZ_INS_CLGRJNLE, SYSZ_INS_CLIJNLE, SYSZ_INS_CLRJNLE, SYSZ_INS_CRJNLE,
+	SYSZ_INS_CGIJH, SYSZ_INS_CGRJH, SYSZ_INS_CIJH, SYSZ_INS_CLGIJH,
+	SYSZ_INS_CLGRJH, SYSZ_INS_CLIJH, SYSZ_INS_CLRJH, SYSZ_INS_CRJH,
+	SYSZ_INS_CGIJNL, SYSZ_INS_CGRJNL, SYSZ_INS_CIJNL, SYSZ_INS_CLGIJNL,
+	SYSZ_INS_CLGRJNL, SYSZ_INS_CLIJNL, SYSZ_INS_CLRJNL, SYSZ_INS_CRJNL,
+	SYSZ_INS_CGIJHE, SYSZ_INS_CGRJHE, SYSZ_INS_CIJHE, SYSZ_INS_CLGIJHE,
+	SYSZ_INS_CLGRJHE, SYSZ_INS_CLIJHE, SYSZ_INS_CLRJHE, SYSZ_INS_CRJHE,
+	SYSZ_INS_CGIJNHE, SYSZ_INS_CGRJNHE, SYSZ_INS_CIJNHE, SYSZ_INS_CLGIJNHE,
+	SYSZ_INS_CLGRJNHE, SYSZ_INS_CLIJNHE, SYSZ_INS_CLRJNHE, SYSZ_INS_CRJNHE,
+	SYSZ_INS_CGIJL, SYSZ_INS_CGRJL, SYSZ_INS_CIJL, SYSZ_INS_CLGIJL,
+	SYSZ_INS_CLGRJL, SYSZ_INS_CLIJL, SYSZ_INS_CLRJL, SYSZ_INS_CRJL,
+	SYSZ_INS_CGIJNH, SYSZ_INS_CGRJNH, SYSZ_INS_CIJNH, SYSZ_INS_CLGIJNH,
+	SYSZ_INS_CLGRJNH, SYSZ_INS_CLIJNH, SYSZ_INS_CLRJNH, SYSZ_INS_CRJNH,
+	SYSZ_INS_CGIJLE, SYSZ_INS_CGRJLE, SYSZ_INS_CIJLE, SYSZ_INS_CLGIJLE,
+	SYSZ_INS_CLGRJLE, SYSZ_INS_CLIJLE, SYSZ_INS_CLRJLE, SYSZ_INS_CRJLE,
+	SYSZ_INS_CGIJNE, SYSZ_INS_CGRJNE, SYSZ_INS_CIJNE, SYSZ_INS_CLGIJNE,
+	SYSZ_INS_CLGRJNE, SYSZ_INS_CLIJNE, SYSZ_INS_CLRJNE, SYSZ_INS_CRJNE,
+	SYSZ_INS_CGIJLH, SYSZ_INS_CGRJLH, SYSZ_INS_CIJLH, SYSZ_INS_CLGIJLH,
+	SYSZ_INS_CLGRJLH, SYSZ_INS_CLIJLH, SYSZ_INS_CLRJLH, SYSZ_INS_CRJLH,
+)
+
+// Conditional branch to register
+var syszCondRegJumps = newInsnSet(
+	SYSZ_INS_BCR, SYSZ_INS_BER, SYSZ_INS_BHR, SYSZ_INS_BHER, SYSZ_INS_BLR,
+	SYSZ_INS_BLER, SYSZ_INS_BLHR, SYSZ_INS_BNER, SYSZ_INS_BNHR,
+	SYSZ_INS_BNHER, SYSZ_INS_BNLR, SYSZ_INS_BNLER, SYSZ_INS_BNLHR,
+	SYSZ_INS_BNOR, SYSZ_INS_BOR,
+)
+
+func syszFlow(insn *Instruction) Flow {
+	switch {
+	case syszCondJumps[insn.Id]:
+		f := Flow{Kind: FlowCondBranch, Conditional: true}
+		f.Target, f.HasTarget = syszLastImm(insn)
+		return f
+	case syszCondRegJumps[insn.Id]:
+		f := Flow{Kind: FlowIndirect, Conditional: true}
+		if syszLastReg(insn) == SYSZ_REG_14 {
+			f.Kind = FlowReturn
+		}
+		return f
+	}
+
+	switch insn.Id {
+	case SYSZ_INS_J, SYSZ_INS_JG:
+		f := Flow{Kind: FlowJump}
+		f.Target, f.HasTarget = syszLastImm(insn)
+		return f
+	case SYSZ_INS_BRAS, SYSZ_INS_BRASL:
+		f := Flow{Kind: FlowCall}
+		f.Target, f.HasTarget = syszLastImm(insn)
+		return f
+	case SYSZ_INS_BASR:
+		// basr %rX, 0 only loads the address of the next instruction
+		if insn.SysZ != nil && syszLastReg(insn) == SYSZ_REG_0 {
+			return Flow{}
+		}
+		return Flow{Kind: FlowCall}
+	case SYSZ_INS_BR:
+		if syszLastReg(insn) == SYSZ_REG_14 {
+			return Flow{Kind: FlowReturn}
+		}
+		return Flow{Kind: FlowIndirect}
+	}
+	return Flow{}
+}
+
+// SystemZ relative branch targets are reported as absolute addresses
+func syszLastImm(insn *Instruction) (uint64, bool) {
+	if insn.SysZ == nil || len(insn.SysZ.Operands) == 0 {
+		return 0, false
+	}
+	op := insn.SysZ.Operands[len(insn.SysZ.Operands)-1]
+	if op.Type != SYSZ_OP_IMM {
+		return 0, false
+	}
+	return uint64(op.Imm), true
+}
+
+func syszLastReg(insn *Instruction) uint {
+	if insn.SysZ == nil || len(insn.SysZ.Operands) == 0 {
+		return SYSZ_REG_INVALID
+	}
+	op := insn.SysZ.Operands[len(insn.SysZ.Operands)-1]
+	if op.Type != SYSZ_OP_REG {
+		return SYSZ_REG_INVALID
+	}
+	return op.Reg
+}
+
+// XCore
+
+// XCore relative branches share an instruction id between their forward and
+// backward encodings, so targets are read from the raw bytes.
+func xcoreFlow(insn *Instruction) Flow {
+	switch insn.Id {
+	case XCORE_INS_BU:
+		f := Flow{Kind: FlowJump}
+		f.Target, f.HasTarget = xcoreTarget(insn)
+		return f
+	case XCORE_INS_BT, XCORE_INS_BF:
+		f := Flow{Kind: FlowCondBranch, Conditional: true}
+		f.Target, f.HasTarget = xcoreTarget(insn)
+		return f
+	case XCORE_INS_BL:
+		f := Flow{Kind: FlowCall}
+		f.Target, f.HasTarget = xcoreTarget(insn)
+		return f
+	case XCORE_INS_BLA, XCORE_INS_BLAT:
+		return Flow{Kind: FlowCall}
+	case XCORE_INS_BAU, XCORE_INS_BRU:
+		return Flow{Kind: FlowIndirect}
+	case XCORE_INS_RETSP, XCORE_INS_KRET, XCORE_INS_DRET:
+		return Flow{Kind: FlowReturn}
+	case XCORE_INS_KCALL:
+		return Flow{Kind: FlowSyscall}
+	case XCORE_INS_ECALLT, XCORE_INS_ECALLF:
+		return Flow{Kind: FlowTrap, Conditional: true}
+	}
+	return Flow{}
+}
+
+// The branch is the last (little endian) 16 bit word: bl has a 10 bit
+// offset, bu / bt / bf have 6 bits, and bit 10 of the opcode is set for the
+// backward forms (BRBU, BLRB...). The 32 bit forms carry the high bits of
+// the offset in a 10 bit prefix word. Offsets count 16 bit words from the
+// next instruction.
+func xcoreTarget(insn *Instruction) (uint64, bool) {
+	b := insn.Bytes
+	if len(b) != 2 && len(b) != 4 {
+		return 0, false
+	}
+	op := binary.LittleEndian.Uint16(b[len(b)-2:])
+	bits := uint(6)
+	if op>>11 == 0x1a {
+		bits = 10
+	}
+	off := uint32(op) & (1<<bits - 1)
+	if len(b) == 4 {
+		off |= uint32(binary.LittleEndian.Uint16(b)&0x3ff) << bits
+	}
+	next := uint32(insn.next())
+	if op&0x400 != 0 {
+		return uint64(next - 2*off), true
+	}
+	return uint64(next + 2*off), true
+}
@@ -0,0 +1,259 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+// Build a bare Instruction as Disasm would with CS_OPT_DETAIL off
+func bareInsn(arch int, mode uint, id uint, addr uint, code string) Instruction {
+	return Instruction{
+		InstructionHeader: InstructionHeader{
+			Id:      id,
+			Address: addr,
+			Size:    uint(len(code)),
+			Bytes:   []byte(code),
+		},
+		arch:    arch,
+		mode:    mode,
+		stamped: true,
+	}
+}
+
+type flowTest struct {
+	insn Instruction
+	want Flow
+}
+
+var flowTests = []flowTest{
+	// x86 without detail: targets come from the raw bytes
+	{bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_CALL, 0x1000, "\xe8\xfb\x0f\x00\x00"),
+		Flow{Kind: FlowCall, Target: 0x2000, HasTarget: true}},
+	{bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_JNE, 0x1000, "\x75\xfe"),
+		Flow{Kind: FlowCondBranch, Conditional: true, Target: 0x1000, HasTarget: true}},
+	{bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_JE, 0x1000, "\x0f\x84\x00\x01\x00\x00"),
+		Flow{Kind: FlowCondBranch, Conditional: true, Target: 0x1106, HasTarget: true}},
+	{bareInsn(CS_ARCH_X86, CS_MODE_16, X86_INS_JMP, 0xfff0, "\xe9\x20\x00"),
+		Flow{Kind: FlowJump, Target: 0x0013, HasTarget: true}},
+	{bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_JMP, 0x1000, "\xff\xe0"),
+		Flow{Kind: FlowIndirect}},
+	{bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_RET, 0x1000, "\xc3"),
+		Flow{Kind: FlowReturn}},
+	{bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_INT, 0x1000, "\xcd\x80"),
+		Flow{Kind: FlowSyscall}},
+	{bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_INT3, 0x1000, "\xcc"),
+		Flow{Kind: FlowTrap}},
+	{bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_MOV, 0x1000, "\x89\xd8"),
+		Flow{}},
+	// A32 condition codes are read from the encoding without detail
+	{bareInsn(CS_ARCH_ARM, CS_MODE_ARM, ARM_INS_B, 0x1000, "\xfe\xff\xff\x1a"),
+		Flow{Kind: FlowCondBranch, Conditional: true}},
+	{bareInsn(CS_ARCH_ARM, CS_MODE_ARM, ARM_INS_B, 0x1000, "\xfe\xff\xff\xea"),
+		Flow{Kind: FlowJump}},
+	// b.ne and b
+	{bareInsn(CS_ARCH_ARM64, CS_MODE_ARM, ARM64_INS_B, 0x1000, "\x01\x00\x00\x54"),
+		Flow{Kind: FlowCondBranch, Conditional: true}},
+	{bareInsn(CS_ARCH_ARM64, CS_MODE_ARM, ARM64_INS_B, 0x1000, "\x00\x00\x00\x14"),
+		Flow{Kind: FlowJump}},
+	{bareInsn(CS_ARCH_ARM64, CS_MODE_ARM, ARM64_INS_RET, 0x1000, "\xc0\x03\x5f\xd6"),
+		Flow{Kind: FlowReturn}},
+	{bareInsn(CS_ARCH_MIPS, CS_MODE_32, MIPS_INS_SYSCALL, 0x1000, "\x0c\x00\x00\x00"),
+		Flow{Kind: FlowSyscall}},
+	{bareInsn(CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, PPC_INS_BLR, 0x1000, "\x4e\x80\x00\x20"),
+		Flow{Kind: FlowReturn}},
+	{bareInsn(CS_ARCH_XCORE, CS_MODE_BIG_ENDIAN, XCORE_INS_RETSP, 0x1000, "\xc0\x77"),
+		Flow{Kind: FlowReturn}},
+	// XCore targets come from the bytes: bu 4, bt r0, -4, bl -5 and bu 132
+	{bareInsn(CS_ARCH_XCORE, CS_MODE_BIG_ENDIAN, XCORE_INS_BU, 0x1000, "\x04\x73"),
+		Flow{Kind: FlowJump, Target: 0x100a, HasTarget: true}},
+	{bareInsn(CS_ARCH_XCORE, CS_MODE_BIG_ENDIAN, XCORE_INS_BT, 0x1000, "\x04\x74"),
+		Flow{Kind: FlowCondBranch, Conditional: true, Target: 0xffa, HasTarget: true}},
+	{bareInsn(CS_ARCH_XCORE, CS_MODE_BIG_ENDIAN, XCORE_INS_BL, 0x1000, "\x05\xd4"),
+		Flow{Kind: FlowCall, Target: 0xff8, HasTarget: true}},
+	{bareInsn(CS_ARCH_XCORE, CS_MODE_BIG_ENDIAN, XCORE_INS_BU, 0x1000, "\x02\xf0\x04\x73"),
+		Flow{Kind: FlowJump, Target: 0x110c, HasTarget: true}},
+}
+
+func TestFlow(t *testing.T) {
+	for i, ft := range flowTests {
+		if got := ft.insn.Flow(); got != ft.want {
+			t.Errorf("%2d> id %v: want %+v, got %+v", i, ft.insn.Id, ft.want, got)
+		}
+	}
+}
+
+func TestFlowEngine(t *testing.T) {
+
+	engine, err := New(CS_ARCH_X86, CS_MODE_64)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer engine.Close()
+
+	// call 0x1010; jmp rax; ret
+	code := "\xe8\x0b\x00\x00\x00\xff\xe0\xc3"
+	want := []Flow{
+		{Kind: FlowCall, Target: 0x1010, HasTarget: true},
+		{Kind: FlowIndirect},
+		{Kind: FlowReturn},
+	}
+
+	for _, detail := range []uint{CS_OPT_OFF, CS_OPT_ON} {
+		engine.SetOption(CS_OPT_DETAIL, detail)
+		insns, err := engine.Disasm([]byte(code), address, 0)
+		if err != nil {
+			t.Fatalf("Disassembly error: %v", err)
+		}
+		if len(insns) != len(want) {
+			t.Fatalf("Want %v instructions, got %v", len(want), len(insns))
+		}
+		for i, insn := range insns {
+			if got := insn.Flow(); got != want[i] {
+				t.Errorf("detail %v: %s %s: want %+v, got %+v", detail, insn.Mnemonic, insn.OpStr, want[i], got)
+			}
+		}
+	}
+}
+
+type flowPlatform struct {
+	platform
+	want []Flow
+}
+
+// Branch targets as Capstone reports them for each arch. All code is at
+// address 0x1000.
+var flowPlatforms = []flowPlatform{
+	{platform{CS_ARCH_ARM, CS_MODE_ARM, nil,
+		"\x3e\x00\x00\xeb\xfd\xff\xff\x1a\x10\x80\xbd\xe8\x04\xf0\x9f\xe5\x1e\xff\x2f\x01",
+		"bl 0x1100; bne 0x1000; pop {r4, pc}; ldr pc, [pc, #4]; bxeq lr"},
+		[]Flow{
+			{Kind: FlowCall, Target: 0x1100, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1000, HasTarget: true},
+			{Kind: FlowReturn},
+			{Kind: FlowIndirect},
+			{Kind: FlowReturn, Conditional: true},
+		}},
+	{platform{CS_ARCH_ARM, CS_MODE_THUMB, nil,
+		"\x10\xb1\x00\xf0\x7d\xf8\x70\x47\x10\xbd",
+		"cbz r0, 0x1008; bl 0x1100; bx lr; pop {r4, pc}"},
+		[]Flow{
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1008, HasTarget: true},
+			{Kind: FlowCall, Target: 0x1100, HasTarget: true},
+			{Kind: FlowReturn},
+			{Kind: FlowReturn},
+		}},
+	{platform{CS_ARCH_ARM64, CS_MODE_ARM, nil,
+		"\x40\x00\x00\x94\xe1\xff\xff\x54\x40\x00\x00\xb4\xa0\xff\x1f\x37\x00\x02\x1f\xd6\x00\x01\x3f\xd6\xc0\x03\x5f\xd6",
+		"bl 0x1100; b.ne 0x1000; cbz x0, 0x1010; tbnz w0, #3, 0x1000; br x16; blr x8; ret"},
+		[]Flow{
+			{Kind: FlowCall, Target: 0x1100, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1000, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1010, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1000, HasTarget: true},
+			{Kind: FlowIndirect},
+			{Kind: FlowCall},
+			{Kind: FlowReturn},
+		}},
+	// Every branch has a nop in its delay slot. The relative targets are
+	// counted from the delay slot.
+	{platform{CS_ARCH_MIPS, CS_MODE_32 | CS_MODE_BIG_ENDIAN, nil,
+		"\x10\x22\x00\x05\x00\x00\x00\x00\x04\x91\xff\xfd\x00\x00\x00\x00" +
+			"\x10\x00\x00\x01\x00\x00\x00\x00\x0c\x10\x00\x97\x00\x00\x00\x00" +
+			"\x14\x60\xff\xf7\x00\x00\x00\x00\x03\xe0\x00\x08\x00\x00\x00\x00" +
+			"\x03\x20\xf8\x09\x00\x00\x00\x00",
+		"beq $at, $v0, 0x1018; bgezal $a0, 0x1000; b 0x1018; jal 0x40025c; bnez $v1, 0x1000; jr $ra; jalr $t9"},
+		[]Flow{
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1018, HasTarget: true}, {},
+			{Kind: FlowCall, Conditional: true, Target: 0x1000, HasTarget: true}, {},
+			{Kind: FlowJump, Target: 0x1018, HasTarget: true}, {},
+			{Kind: FlowCall, Target: 0x40025c, HasTarget: true}, {},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1000, HasTarget: true}, {},
+			{Kind: FlowReturn}, {},
+			{Kind: FlowCall}, {},
+		}},
+	// bc with AA and LK set (bdnzla+, beqa, beql) and without
+	{platform{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, nil,
+		"\x43\x20\x0c\x07\x41\x82\x00\x15\x41\x82\x01\x02\x40\x82\x00\x0c" +
+			"\x42\x00\xff\xf4\x48\x00\x00\xf1\x4d\x82\x00\x20\x4e\x80\x00\x20\x4e\x80\x04\x21",
+		"bdnzla+ 0xc04; beql 0x1018; beqa 0x100; bne 0x1018; bdnz 0x1004; bl 0x1104; beqlr; blr; bctrl"},
+		[]Flow{
+			{Kind: FlowCall, Conditional: true, Target: 0xc04, HasTarget: true},
+			{Kind: FlowCall, Conditional: true, Target: 0x1018, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x100, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1018, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1004, HasTarget: true},
+			{Kind: FlowCall, Target: 0x1104, HasTarget: true},
+			{Kind: FlowReturn, Conditional: true},
+			{Kind: FlowReturn},
+			{Kind: FlowCall},
+		}},
+	{platform{CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, nil,
+		"\x40\x00\x00\x10\x01\x00\x00\x00\x12\xbf\xff\xfe\x01\x00\x00\x00" +
+			"\x10\x80\x00\x04\x01\x00\x00\x00\x81\xc3\xe0\x08\x01\x00\x00\x00" +
+			"\x81\xc7\xe0\x08\x81\xe8\x00\x00\x81\xc0\x40\x02\x01\x00\x00\x00",
+		"call 0x1040; bne 0x1000; ba 0x1020; retl; ret; restore; jmp %g1+%g2"},
+		[]Flow{
+			{Kind: FlowCall, Target: 0x1040, HasTarget: true}, {},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1000, HasTarget: true}, {},
+			{Kind: FlowJump, Target: 0x1020, HasTarget: true}, {},
+			{Kind: FlowReturn}, {},
+			{Kind: FlowReturn}, {},
+			{Kind: FlowIndirect}, {},
+		}},
+	{platform{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, nil,
+		"\xc0\xe5\x00\x00\x00\x0f\xa7\x74\xff\xfd\xa7\xf4\x00\x03\x07\xfe\xec\x18\x00\x00\xc1\x7f\x0d\xe1",
+		"brasl %r14, 0x101e; jne 0x1000; j 0x1010; br %r14; clije %r1, 0xc1, 0x1010; basr %r14, %r1"},
+		[]Flow{
+			{Kind: FlowCall, Target: 0x101e, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1000, HasTarget: true},
+			{Kind: FlowJump, Target: 0x1010, HasTarget: true},
+			{Kind: FlowReturn},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x1010, HasTarget: true},
+			{Kind: FlowCall},
+		}},
+	{platform{CS_ARCH_XCORE, CS_MODE_BIG_ENDIAN, nil,
+		"\x04\x73\x04\x74\x44\x78\x05\xd0\x05\xd4\x02\xf0\x04\x73\xc0\x77",
+		"bu 4; bt r0, -4; bf r1, 4; bl 5; bl -5; bu 132; retsp 0"},
+		[]Flow{
+			{Kind: FlowJump, Target: 0x100a, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0xffc, HasTarget: true},
+			{Kind: FlowCondBranch, Conditional: true, Target: 0x100e, HasTarget: true},
+			{Kind: FlowCall, Target: 0x1012, HasTarget: true},
+			{Kind: FlowCall, Target: 0x1000, HasTarget: true},
+			{Kind: FlowJump, Target: 0x1116, HasTarget: true},
+			{Kind: FlowReturn},
+		}},
+}
+
+func TestFlowPlatforms(t *testing.T) {
+
+	for _, fp := range flowPlatforms {
+
+		engine, err := New(fp.arch, fp.mode)
+		if err != nil {
+			t.Fatalf("Failed to initialize engine %v", err)
+		}
+		engine.SetOption(CS_OPT_DETAIL, CS_OPT_ON)
+
+		insns, err := engine.Disasm([]byte(fp.code), address, 0)
+		engine.Close()
+		if err != nil {
+			t.Fatalf("%s: disassembly error: %v", fp.comment, err)
+		}
+		if len(insns) != len(fp.want) {
+			t.Fatalf("%s: want %v instructions, got %v", fp.comment, len(fp.want), len(insns))
+		}
+		for i, insn := range insns {
+			if got := insn.Flow(); got != fp.want[i] {
+				t.Errorf("%s: %s %s: want %+v, got %+v", fp.comment, insn.Mnemonic, insn.OpStr, fp.want[i], got)
+			}
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// +build ignore
+
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+// Generates category_tables.go from the instruction IDs in the
+// *_constants.go files. Rules are tried in order and the first match wins.
+// Every instruction ID must be matched by some rule, so when genconst picks
+// up new instructions this will fail and list the ones that need a rule.
+//
+//	go run gencategory.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type rule struct {
+	cat string
+	re  string
+}
+
+type arch struct {
+	file   string
+	prefix string
+	table  string
+	rules  []rule
+}
+
+var arches = []arch{
+	{"x86_constants.go", "X86", "x86Categories", []rule{
+		{"Nop", `NOP|FNOP|PAUSE|DATA16`},
+		{"Call", `L?CALL`},
+		{"Return", `RETF?Q?|IRET[DQ]?|SYSRET|SYSEXIT`},
+		{"Branch", `L?JMP|J[A-Z]+|LOOP(N?E)?`},
+		{"Stack", `PUSH(A[LW]|F[DQ]?)?|POP(A[LW]|F[DQ]?)?|ENTER|LEAVE`},
+		{"Crypto", `V?AES[A-Z]+|SHA(1|256)[A-Z0-9]+|V?PCLMULQDQ|XCRYPT[A-Z]+|XSHA(1|256)|MONTMUL|XSTORE`},
+		{"Atomic", `CMPXCHG(8B|16B)?|XADD|XCHG|XBEGIN|XEND|XABORT|XTEST|XACQUIRE|XRELEASE|[LMS]FENCE`},
+		{"System", `CL[CDI]|ST[CDI]|CLAC|STAC|CMC|CLTS|HLT|IN|OUT|INS[BWD]|OUTS[BWD]|INT[13O]?|UD2B?|` +
+			`INVD|INVEPT|INVLPGA?|INVPCID|INVVPID|WBINVD|CLFLUSH|PREFETCH(NTA|T[012]|W)?|` +
+			`[LS][GIL]DT|[LS]MSW|LTR|STR|LAR|LSL|ARPL|VERR|VERW|CPUID|RDMSR|WRMSR|RDPMC|RDTSCP?|` +
+			`RDRAND|RDSEED|(RD|WR)[FG]SBASE|SWAPGS|SYSCALL|SYSENTER|RSM|GETSEC|ENCL[SU]|MONITOR|MWAIT|` +
+			`VM(CALL|CLEAR|FUNC|LAUNCH|LOAD|MCALL|PTRLD|PTRST|READ|RESUME|RUN|SAVE|WRITE|XOFF|XON)|` +
+			`CLGI|STGI|SKINIT|X[GS]ETBV|XSAVE(OPT)?(64)?|XRSTOR(64)?|FXSAVE(64)?|FXRSTOR(64)?`},
+		{"Compare", `CMP|TEST|BT|CMPS[BWDQ]|SCAS[BWDQ]|SET[A-Z]+|BOUND`},
+		{"Load", `LODS[BWDQ]|XLATB|L[DEFGS]S|V?LDDQU|V?MOVNTDQA`},
+		{"Store", `STOS[BWDQ]|V?MOVNT(DQ|I|PD|PS|Q|SD|SS)|MASKMOVQ|V?MASKMOVDQU`},
+		{"Move", `MOV|MOVABS|MOVBE|MOVS[XBWQ]|MOVSXD|MOVZX|CMOV[A-Z]+|CBW|CWDE?|CDQE?|CQO|BSWAP|` +
+			`LAHF|SAHF|SALC|V?MOV(APD|APS|D|DQA|DQA32|DQA64|DQU|DQU8|DQU16|DQU32|DQU64|Q|SD|SS|UPD|UPS|HPD|HPS|LPD|LPS)|` +
+			`MOVDQ2Q|MOVQ2DQ`},
+		{"Shift", `SH[LR]D?|SA[LR]|RO[LR]|RC[LR]|S(HL|HR|AR)X|RORX`},
+		{"Logic", `AND|ANDN|OR|XOR|NOT|BT[CRS]|BS[FR]|LZCNT|TZCNT|POPCNT|BEXTR|BL[CS][A-Z]*|BZHI|PDEP|PEXT|T1MSKC|TZMSK`},
+		{"Arithmetic", `ADD|ADC|ADCX|ADOX|SUB|SBB|INC|DEC|NEG|I?MUL|I?DIV|MULX|AA[ADMS]|DA[AS]|LEA|CRC32`},
+		{"Vector", `FEMMS|EMMS|V?P[A-Z0-9]+|K[A-Z]+|VBROADCAST[A-Z0-9]+`},
+		{"Float", `F[A-Z0-9]+|WAIT|V?LDMXCSR|V?STMXCSR|V?[A-Z0-9]*S[SD]|V?CVTT?S[SD]2U?SI`},
+		{"Vector", `V[A-Z0-9]+|[A-Z0-9]+P[SD]|CVTT?P[SDI]2[A-Z]+|EXTRQ|INSERTQ|MOVS[HL]DUP|MOVDDUP|MPSADBW`},
+	}},
+	{"arm_constants.go", "ARM", "armCategories", []rule{
+		{"Nop", `NOP|HINT|YIELD`},
+		{"Call", `BLX?`},
+		{"Return", `RFE(DA|DB|IA|IB)`},
+		{"Branch", `B|BXJ?|CBN?Z|TB[BH]`},
+		{"Stack", `PUSH|POP|VPUSH|VPOP`},
+		{"Crypto", `AES[A-Z]+|SHA(1|256)[A-Z0-9]+`},
+		{"Atomic", `LDREX[BDH]?|STREX[BDH]?|LDA(EX)?[BDH]?|STL(EX)?[BDH]?|CLREX|SWPB?|DMB|DSB`},
+		{"System", `SVC|SMC|BKPT|UDF|HLT|DBG|DCPS[123]|CPS|MRS|MSR|SETEND|SEVL?|WF[EI]|ISB|` +
+			`MCRR?2?|MRRC2?|MRC2?|CDP2?|VMRS|VMSR|SRS(DA|DB|IA|IB)|TRAP|PLDW?|PLI|IT`},
+		{"Load", `LDR(B|BT|D|H|HT|SB|SBT|SH|SHT|T)?|LDM(DA|DB|IB)?|LDC2?L?|VLDR|VLDM(DB|IA)|FLDM(DB|IA)X`},
+		{"Store", `STR(B|BT|D|H|HT|T)?|STM(DA|DB|IB)?|STC2?L?|VSTR|VSTM(DB|IA)|FSTM(DB|IA)X`},
+		{"Compare", `CMP|CMN|TST|TEQ`},
+		{"Vector", `[QSU]?[HQ]?(ADD|SUB)(8|16)|[QSU][HQ]?(ASX|SAX)|[SU]SAT16|[SU]XTA?B16|SEL|PKH(BT|TB)|USADA?8`},
+		{"Move", `MOVS?|MOVT|MOVW|[SU]XT[BH]|REV|REV16|REVSH|VMOV`},
+		{"Shift", `ASRS?|LSL|LSRS?|ROR|RRX`},
+		{"Logic", `AND|ORR|ORN|EOR|BIC|MVN|BFC|BFI|CLZ|RBIT|[SU]BFX`},
+		{"Arithmetic", `ADC|ADDW?|ADR|SUBS?|SUBW|RS[BC]|SBC|MUL|ML[AS]|[SU]DIV|QD?(ADD|SUB)|[SU]SAT|` +
+			`SM(UL|LA|LAL|LS|LSL|MUL|MLA|MLS|UAD|USD)[A-Z]*|UMAAL|UMLAL|UMULL|CRC32C?[BHW]|[SU]XTA[BH]`},
+		{"Float", `V(ADD|SUB|MUL|DIV|SQRT|ABS|NEG|NMUL|NMLA|NMLS|MLA|MLS|FMA|FMS|FNMA|FNMS|CMPE?|` +
+			`CVT[ABMNPRT]?|RINT[AMNPRXZ]|SEL(EQ|GE|GT|VS)|MAXNM|MINNM)`},
+		{"Vector", `V[A-Z0-9]+`},
+	}},
+	{"arm64_constants.go", "ARM64", "arm64Categories", []rule{
+		{"Nop", `NOP|HINT|YIELD`},
+		{"Call", `BLR?`},
+		{"Return", `RET|ERET|DRPS`},
+		{"Branch", `BR?|CBN?Z|TBN?Z`},
+		{"Crypto", `AES[A-Z]+|SHA(1|256)[A-Z0-9]+`},
+		{"Atomic", `LDA?X(R[BH]?|P)|STL?X(R[BH]?|P)|LDAR[BH]?|STLR[BH]?|CLREX|DMB|DSB`},
+		{"System", `SVC|HVC|SMC|BRK|HLT|DCPS[123]|MRS|MSR|SYSL?|AT|DC|IC|TLBI|ISB|SEVL?|WF[EI]|PRFU?M`},
+		{"Load", `LD(R|UR|TR)S?[BHW]?|LDN?P|LDPSW`},
+		{"Store", `ST(R|UR|TR)[BH]?|STN?P`},
+		{"Compare", `CMP|CMN|TST|CC(MN|MP)|CSETM?`},
+		{"Move", `MOV[KNZ]?|FMOV|CSEL|CSINC|CSINV|CSNEG|CINC|CINV|CNEG|[SU]XT[BHW]|REV(16|32)?`},
+		{"Shift", `ASR|LSL|LSR|ROR|EXTR`},
+		{"Logic", `AND|ORR|ORN|EOR|EON|BIC|MVN|CLS|CLZ|RBIT|BFI|BFM|BFXIL|[SU]BFIZ|[SU]BFM|[SU]BFX`},
+		{"Arithmetic", `ADC|ADD|ADRP?|SUB|SBC|NEG|NGC|MUL|MADD|MSUB|MNEG|[SU]DIV|` +
+			`[SU](MADDL|MSUBL|MNEGL|MULH|MULL)|CRC32C?[BHWX]`},
+		{"Vector", `F(ADD|MAX|MAXNM|MIN|MINNM)[PV]|FCVT(L|N|XN)2?`},
+		{"Float", `F[A-Z0-9]+|[SU]CVTF`},
+		{"Vector", `ABS|ML[AS]|REV64|ADDHN2?|ADDP|ADDV|BIF|BIT|BSL|CM(EQ|GE|GT|HI|HS|LE|LT|TST)|CNT|DUP|EXT|INS|` +
+			`LD[1-4]R?|ST[1-4]|MOVI|MVNI|NOT|PMULL?2?|R(ADD|SUB)HN2?|RSHRN2?|SUBHN2?|SHLL?2?|SHRN2?|` +
+			`SLI|SRI|XTN2?|TB[LX]|TRN[12]|UZP[12]|ZIP[12]|[SU]MOV|[SU]Q[A-Z0-9]+|SUQADD|USQADD|` +
+			`URECPE|URSQRTE|[SU]MULL2|[SU]MADDL2|[SU](ABAL?|ABDL?|ADALP|ADDLP?|ADDLV|ADDW|HADD|HSUB|` +
+			`MAXP?|MAXV|MINP?|MINV|MLAL|MLSL|RHADD|RSHL|RSHR|RSRA|SHL|SHLL|SHR|SRA|SUBL|SUBW)2?`},
+	}},
+	{"mips_constants.go", "MIPS", "mipsCategories", []rule{
+		{"Nop", `NOP|SSNOP|PAUSE`},
+		{"Call", `JAL[A-Z_]*|JIALC|BALC?|B[A-Z]*ZAL[CLS]?`},
+		{"Return", `ERET|DERET|JRADDIUSP`},
+		{"Vector", `BNEGI?|BCLRI?|BSETI?|BSELI?|BINS[LR]I?|BMN?ZI?`},
+		{"Branch", `J|JR|JR_HB|JRC|JIC|BC?|B(EQ|NE|GE|GT|LE|LT)[A-Z]*|BC[0-3](F|T|EQZ|NEZ)L?|` +
+			`BN?Z|BTEQZ|BTNEZ|BOVC|BNVC|BPOSGE32`},
+		{"System", `BREAK|SDBBP|SYSCALL|T(EQ|GE|LT|NE)I?U?|TLB(P|R|WI|WR)|CACHE|PREF|DI|EI|EHB|WAIT|` +
+			`RDHWR|D?MFC[02]|D?MTC[02]`},
+		{"Atomic", `LLD?|SCD?|SYNC`},
+		{"Load", `L(B|BU|H|HU|W|WU|D)|L[WD][LR]|L(D|W|WU)PC|L(BU|H|W)X|L[DW]C[1-3]|L[DUW]XC1`},
+		{"Store", `S[BHWD]|S[WD][LR]|S[DW]C[1-3]|S[DUW]XC1|ST`},
+		{"Move", `MOVE?|MOV[FNTZ]|LI|LUI|MF(HI|LO|HC1)|MT(HI|LO|HC1)|D?MFC1|D?MTC1|SE[BH]|WSBH|DSBH|DSHD|` +
+			`SEL(EQ|NE)Z|RDDSP|WRDSP`},
+		{"Shift", `D?(SLL|SRL|SRA|ROTR)(V|32)?|D?ALIGN`},
+		{"Logic", `ANDI?|ORI?|XORI?|NORI?|NOT|D?CL[OZ]|D?EXT[MU]?|EXTS(32)?|D?INS[MU]?|CINS(32)?|` +
+			`D?BITSWAP|BITREV|D?POP`},
+		{"Compare", `SLTI?U?|SEQI?|SNEI?|CMPI`},
+		{"Arithmetic", `D?ADDI?U?|D?SUBU?|NEGU?|D?MULTU?|D?MUHU?|D?MULU?|MUL|D?DIVU?|D?MODU?|MADDU?|MSUBU?|` +
+			`D?LSA|D?AUI|DAHI|DATI|AUIPC|ADDIUPC|ALUIPC|BADDU|V3MULU|VMULU|VMM0|MT[MP][0-2]`},
+		{"Float", `ABS|CEIL|CVT|FLOOR|ROUND|TRUNC|SQRT|RINT|CLASS|MAXA?|MINA?|MADDF|MSUBF|NMADD|NMSUB|` +
+			`C|CMP|SEL|CFC1|CTC1`},
+		{"Vector", `[A-Z0-9]+_[A-Z_]+|F[A-Z0-9]+|ADDQH?|ADDSC|ADDUH|ADDVI?|ADDWC|APPEND|BALIGN|CEQI?|` +
+			`CMPG?D?U|CFCMSA|CTCMSA|DPAU?|DPAX|DPSU?|DPSX|EXTPD?P?V?|EXTRV?|FILL|ILV(EV|L|OD|R)|INSERT|` +
+			`INSVE?|LDI|MADDV|MODSUB|MSUBV|MTHLIP|MULSA|MULV|NLOC|NLZC|PACKRL|PCKEV|PCKOD|PCNT|PICK|` +
+			`PRECEQU?|PRECEU|PRECRQ?|PREPEND|RADDU|REPLV?|SHF|SHILOV?|SHLLV?|SHRAV?|SHRLV?|SLDI?|SLLI|` +
+			`SPLATI?|SRAI|SRARI?|SRLI|SRLRI?|SUBQH?|SUBUH|SUBVI?|VSHF`},
+	}},
+	{"ppc_constants.go", "PPC", "ppcCategories", []rule{
+		{"Nop", `NOP|XNOP`},
+		{"Vector", `BRINC`},
+		{"Return", `B[A-Z]*LR|RF(I|ID|CI|DI|MCI)`},
+		{"Call", `B[A-Z]*(LRL|CTRL|LA?)`},
+		{"Branch", `B[A-Z]*`},
+		{"Atomic", `LWARX|LDARX|STWCX|STDCX|SYNC|LWSYNC|PTESYNC|MSYNC|EIEIO|MBAR`},
+		{"Move", `MR|LI|LIS|MF(LR|CTR|CR|OCRF|XER)|MT(LR|CTR|CR|CRF|OCRF|XER)|MCRF|EXTS[BHW]|ISEL|FMR`},
+		{"Float", `MFFS|MTFS(B0|B1|F)`},
+		{"Vector", `MFVSCR|MTVSCR`},
+		{"System", `SC|T[DW][A-Z]*|TRAP|M[FT][A-Z0-9]+|TLB[A-Z]+|SLB[A-Z]+|DCB[A-Z]*|DCCCI|ICBI|ICCCI|ISYNC|` +
+			`WAIT[A-Z]*|WRTEEI?|DSS(ALL)?|DST(ST)?T?`},
+		{"Load", `L(BZ|HA|HZ|WA|WZ|D|FD|FS)U?X?|L[HWD]BRX|LMW|LSWI|LFIW[AZ]X|LXSDX`},
+		{"Store", `ST(B|H|W|D|FD|FS)U?X?|ST[HWD]BRX|STMW|STSWI|STFIWX|STXSDX`},
+		{"Compare", `CMPL?[DW]I?`},
+		{"Shift", `S[LR][DW]I?|SRA[DW]I?|ROTL[DW]I?|RLD[A-Z]+|RLW[A-Z]+|CLRL[DW]I`},
+		{"Logic", `ANDI?S?|ANDC|ORI?S?|ORC|XORI?S?|NAND|NOR|NOT|EQV|CNTLZ[DW]|POPCNT[DW]|CR[A-Z]+`},
+		{"Arithmetic", `ADD[A-Z]*|SUB[A-Z]*|NEG|MUL[A-Z]+|DIV[A-Z]+|LA`},
+		{"Float", `F[A-Z]+|XS[A-Z]+`},
+		{"Vector", `EV[A-Z]+|V[A-Z0-9]+|XV[A-Z0-9]+|XX[A-Z0-9]+|LV[A-Z]+|STV[A-Z]+|LXV[A-Z0-9]+|STXV[A-Z0-9]+`},
+	}},
+	{"sparc_constants.go", "SPARC", "sparcCategories", []rule{
+		{"Nop", `NOP`},
+		{"Call", `CALL`},
+		{"Return", `RET|RETL|RETT`},
+		{"Branch", `B|FB|BR(GEZ|GZ|LEZ|LZ|NZ|Z)|JMPL?`},
+		{"Stack", `SAVE|RESTORE`},
+		{"Atomic", `CASX?|SWAP|MEMBAR|STBAR`},
+		{"System", `T|RD|WR|FLUSHW|SHUTDOWN|SIAM|UNIMP`},
+		{"Load", `LD(SB|SH|SW|UB|UH|X|D|Q)?`},
+		{"Store", `ST[BHDXQ]?`},
+		{"Compare", `CMP`},
+		{"Move", `MOV|SETHI|MOVR[A-Z]+|MOVDTOX|MOVSTO[SU]W|FMOV[A-Z]+`},
+		{"Shift", `S(LL|RA|RL)X?`},
+		{"Logic", `ANDN?(CC)?|ORN?(CC)?|XN?OR(CC)?|LZCNT|POPC`},
+		{"Arithmetic", `(ADD|SUB)X?C*|T(ADD|SUB)CC(TV)?|[SU](MUL|DIV)(CC)?|[SU]?(MUL|DIV)X|UMULXHI|XMULX(HI)?`},
+		{"Float", `F(ABS|ADD|SUB|MUL|DIV|SQRT|NEG|CMPE?|N?H?ADD|HSUB|LCMP)[DQS]|F[DIQSX]TO[DIQSX]|FSMULD|FDMULQ`},
+		{"Vector", `ALIGNADDRL?|ARRAY(8|16|32)|BMASK|BSHUFFLE|CMASK(8|16|32)|EDGE[0-9A-Z]+|PDISTN?|F[A-Z0-9]+`},
+	}},
+	{"sysz_constants.go", "SYSZ", "syszCategories", []rule{
+		{"Call", `BRAS|BRASL|BASR`},
+		{"Branch", `B[A-Z]*R|BRC|BRCL|BRCTG?|J[A-Z]*|CL?G?[IR]J[A-Z]*`},
+		{"Atomic", `CSG?|CSY|LAAL?G?|LA[NOX]G?`},
+		{"Arithmetic", `LA|LAY|LARL`},
+		{"Float", `[A-Z]*[DEX]BRA?|[A-Z]*[DE]B|C[DEX]L?[FG]BR|CPSDR`},
+		{"Compare", `C[A-Z]*|TM[A-Z]*|LTG?F?R|SRST`},
+		{"Logic", `[NOX][A-Z]*|FLOGR`},
+		{"Shift", `SL[LA]G?K?|SR[AL]G?K?|RLLG?|R[INOX]SBG|RISB[HL]G`},
+		{"Store", `ST|STY|STG|STH|STHY|STC|STCY|STD|STDY|STE|STEY|STMG|STRVG?|STG?RL|STHRL|ST[FHC]H|` +
+			`STOC[A-Z]*|MVIY?|MV[GH]?HI`},
+		{"Move", `MVC|MVST`},
+		{"Arithmetic", `A[A-Z]*|S|SG|SGF|SGFR|SGR|SGRK|SH|SHY|SLB?G?R?|SLFI|SLGF|SLGFI|SLGFR|SLGRK|SLRK|SLY|SR|SRK|SY|` +
+			`M[A-Z]*|D|DL|DLG|DLGR|DLR|DSG[A-Z]*|L[CPN]G?F?R`},
+		{"Move", `IPM|L[A-Z]*R|LOCG?R[A-Z]*|L[G]?HI|LGFI|LLI[HL][FHL]|II[HL][FHL]`},
+		{"System", `EAR|PFD|PFDRL`},
+		{"Load", `L[A-Z]*|ICY?`},
+	}},
+	{"xcore_constants.go", "XCORE", "xcoreCategories", []rule{
+		{"Call", `BLA?T?`},
+		{"Return", `RETSP|KRET|DRET`},
+		{"Branch", `BAU|BF|BT|BU|BRU`},
+		{"Stack", `ENTSP|EXTSP|EXTDP|KENTSP|KRESTSP|DENTSP|DRESTSP`},
+		{"Load", `LD16S|LD8U|LDW`},
+		{"Store", `ST16|ST8|STW`},
+		{"Move", `LDC|SEXT|ZEXT|BYTEREV`},
+		{"Compare", `EQ|LSS|LSU`},
+		{"Logic", `AND|ANDNOT|OR|XOR|NOT|CLZ|BITREV|MKMSK`},
+		{"Shift", `SHL|SHR|ASHR`},
+		{"Arithmetic", `ADD|SUB|MUL|NEG|DIV[SU]|REM[SU]|LADD|LSUB|LMUL|LDIVU|MACC[SU]|LDA16|LDAP|LDAW|CRC32|CRC8`},
+		{"System", `[A-Z0-9]+`},
+	}},
+}
+
+var idRe = regexp.MustCompile(`(?m)^\s*([A-Z0-9]+_INS_([A-Z0-9_]+))\s*=`)
+
+func main() {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, `/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+
+    THIS FILE WAS AUTO-GENERATED -- DO NOT EDIT!
+	Command: go run gencategory.go
+	%s
+
+*/
+
+package gapstone
+`, time.Now().Format(time.RFC3339))
+
+	failed := false
+	for _, a := range arches {
+		src, err := ioutil.ReadFile(a.file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		res := make([]*regexp.Regexp, len(a.rules))
+		for i, r := range a.rules {
+			res[i] = regexp.MustCompile(`^(?:` + r.re + `)$`)
+		}
+
+		fmt.Fprintf(&out, "\nvar %s = map[uint]Category{\n", a.table)
+		var missing []string
+	ids:
+		for _, m := range idRe.FindAllStringSubmatch(string(src), -1) {
+			if m[2] == "INVALID" || m[2] == "ENDING" {
+				continue
+			}
+			for i, re := range res {
+				if re.MatchString(m[2]) {
+					fmt.Fprintf(&out, "\t%s: Category%s,\n", m[1], a.rules[i].cat)
+					continue ids
+				}
+			}
+			missing = append(missing, m[2])
+		}
+		out.WriteString("}\n")
+
+		if len(missing) > 0 {
+			failed = true
+			log.Printf("%s: no rule for %s", a.prefix, strings.Join(missing, " "))
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("category_tables.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,475 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+// Package macho opens Mach-O and universal (fat) binaries with debug/macho
+// and disassembles their sections with an Engine configured from the CPU
+// type. Symbol stubs and lazy / non-lazy symbol pointers are resolved to the
+// names of the symbols they import.
+package macho
+
+import (
+	"bytes"
+	dmacho "debug/macho"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+var (
+	ErrMachine   = errors.New("gapstone/macho: unsupported cpu type")
+	ErrNoSlice   = errors.New("gapstone/macho: no slice for cpu type")
+	ErrNoSection = errors.New("gapstone/macho: no such section")
+	ErrNoSymbol  = errors.New("gapstone/macho: no such symbol")
+	ErrNoData    = errors.New("gapstone/macho: no file data at address")
+)
+
+// A Mach-O file, or one slice of a universal binary, with an Engine set up
+// for its CPU. The Engine has CS_OPT_DETAIL turned on.
+type File struct {
+	*dmacho.File
+	Engine gapstone.Engine
+	Arch   int
+	Mode   uint
+	// Symbol stubs and symbol pointers, by virtual address, as the name of
+	// the imported symbol, eg "_printf"
+	Imports map[uint64]string
+	closer  io.Closer
+}
+
+// A section mapped at its virtual address
+type Segment struct {
+	Name  string // "__TEXT,__text"
+	Addr  uint64
+	Data  []byte
+	Flags uint32
+}
+
+// Open the named file and create an Engine for it. For a universal binary cpu
+// picks the slice, and 0 picks the first one. For a thin file cpu must be 0
+// or match the file. Call Close when done.
+func Open(name string, cpu dmacho.Cpu) (*File, error) {
+	fh, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := NewFile(fh, cpu)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+	f.closer = fh
+	return f, nil
+}
+
+// Parse a Mach-O or universal binary from r and create an Engine for it. cpu
+// selects a slice as for Open. Call Close when done.
+func NewFile(r io.ReaderAt, cpu dmacho.Cpu) (*File, error) {
+	mf, err := slice(r, cpu)
+	if err != nil {
+		return nil, err
+	}
+	f, err := load(mf)
+	if err != nil {
+		return nil, err
+	}
+	f.Engine, err = gapstone.New(f.Arch, f.Mode)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Engine.SetOption(gapstone.CS_OPT_DETAIL, gapstone.CS_OPT_ON); err != nil {
+		f.Engine.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// The CPU types in r, one per slice for a universal binary
+func Slices(r io.ReaderAt) ([]dmacho.Cpu, error) {
+	ff, err := dmacho.NewFatFile(r)
+	if err == dmacho.ErrNotFat {
+		mf, err := dmacho.NewFile(r)
+		if err != nil {
+			return nil, err
+		}
+		return []dmacho.Cpu{mf.Cpu}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cpus []dmacho.Cpu
+	for _, a := range ff.Arches {
+		cpus = append(cpus, a.Cpu)
+	}
+	return cpus, nil
+}
+
+func slice(r io.ReaderAt, cpu dmacho.Cpu) (*dmacho.File, error) {
+	ff, err := dmacho.NewFatFile(r)
+	if err == dmacho.ErrNotFat {
+		mf, err := dmacho.NewFile(r)
+		if err != nil {
+			return nil, err
+		}
+		if cpu != 0 && mf.Cpu != cpu {
+			return nil, ErrNoSlice
+		}
+		return mf, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range ff.Arches {
+		if cpu == 0 || a.Cpu == cpu {
+			return a.File, nil
+		}
+	}
+	return nil, ErrNoSlice
+}
+
+// Everything except the Engine
+func load(mf *dmacho.File) (*File, error) {
+	arch, mode, err := Target(mf.Cpu, mf.SubCpu)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{File: mf, Arch: arch, Mode: mode, Imports: make(map[uint64]string)}
+	f.readIndirect()
+	return f, nil
+}
+
+// Mach-O cpu types and subtypes not in debug/macho
+const (
+	cpuArm64_32    = 0x0200000c
+	subCpuArmV6M   = 14
+	subCpuArmV7M   = 15
+	subCpuArmV7EM  = 16
+	subCpuArmV8M   = 17
+	subCpuTypeMask = 0x00ffffff
+)
+
+// Choose the CS_ARCH_* and CS_MODE_* for a Mach-O cpu type and subtype. ARM
+// slices start in ARM mode, except for the Cortex-M subtypes, and switch to
+// Thumb for N_ARM_THUMB_DEF symbols.
+func Target(cpu dmacho.Cpu, subCpu uint32) (arch int, mode uint, err error) {
+	switch cpu {
+	case dmacho.Cpu386:
+		return gapstone.CS_ARCH_X86, gapstone.CS_MODE_32, nil
+	case dmacho.CpuAmd64:
+		return gapstone.CS_ARCH_X86, gapstone.CS_MODE_64, nil
+	case dmacho.CpuArm:
+		switch subCpu & subCpuTypeMask {
+		case subCpuArmV6M, subCpuArmV7M, subCpuArmV7EM, subCpuArmV8M:
+			return gapstone.CS_ARCH_ARM, gapstone.CS_MODE_THUMB | gapstone.CS_MODE_MCLASS, nil
+		}
+		return gapstone.CS_ARCH_ARM, gapstone.CS_MODE_ARM, nil
+	case dmacho.CpuArm64, cpuArm64_32:
+		return gapstone.CS_ARCH_ARM64, gapstone.CS_MODE_ARM, nil
+	case dmacho.CpuPpc:
+		return gapstone.CS_ARCH_PPC, gapstone.CS_MODE_32 | gapstone.CS_MODE_BIG_ENDIAN, nil
+	case dmacho.CpuPpc64:
+		return gapstone.CS_ARCH_PPC, gapstone.CS_MODE_64 | gapstone.CS_MODE_BIG_ENDIAN, nil
+	}
+	return 0, 0, ErrMachine
+}
+
+// Section types and attributes
+const (
+	sectionType             = 0x000000ff
+	sZerofill               = 0x1
+	sNonLazySymbolPointers  = 0x6
+	sLazySymbolPointers     = 0x7
+	sSymbolStubs            = 0x8
+	sThreadLocalVariablePtr = 0x14
+	sAttrPureInstructions   = 0x80000000
+	sAttrSomeInstructions   = 0x00000400
+
+	indirectSymbolLocal = 0x80000000
+	indirectSymbolAbs   = 0x40000000
+
+	nStab        = 0xe0
+	nType        = 0x0e
+	nSect        = 0x0e
+	nArmThumbDef = 0x0008
+)
+
+// debug/macho drops reserved1 and reserved2, which hold the indirect symbol
+// index and stub size, so they are read again from the segment commands, in
+// the same order as f.Sections.
+func (f *File) sectionReserved() [][2]uint32 {
+	var res [][2]uint32
+	for _, l := range f.Loads {
+		seg, ok := l.(*dmacho.Segment)
+		if !ok {
+			continue
+		}
+		raw := seg.Raw()
+		if f.Magic == dmacho.Magic64 && len(raw) >= 72 {
+			r := bytes.NewReader(raw[72:])
+			for i := uint32(0); i < seg.Nsect; i++ {
+				var s dmacho.Section64
+				if binary.Read(r, f.ByteOrder, &s) != nil {
+					return res
+				}
+				res = append(res, [2]uint32{s.Reserve1, s.Reserve2})
+			}
+		} else if len(raw) >= 56 {
+			r := bytes.NewReader(raw[56:])
+			for i := uint32(0); i < seg.Nsect; i++ {
+				var s dmacho.Section32
+				if binary.Read(r, f.ByteOrder, &s) != nil {
+					return res
+				}
+				res = append(res, [2]uint32{s.Reserve1, s.Reserve2})
+			}
+		}
+	}
+	return res
+}
+
+// Name the entries of every stub and symbol pointer section from the
+// indirect symbol table
+func (f *File) readIndirect() {
+	if f.Symtab == nil || f.Dysymtab == nil {
+		return
+	}
+	ptr := uint64(4)
+	if f.Magic == dmacho.Magic64 {
+		ptr = 8
+	}
+	reserved := f.sectionReserved()
+	for i, s := range f.Sections {
+		if i >= len(reserved) {
+			break
+		}
+		var size uint64
+		switch s.Flags & sectionType {
+		case sSymbolStubs:
+			size = uint64(reserved[i][1])
+		case sNonLazySymbolPointers, sLazySymbolPointers, sThreadLocalVariablePtr:
+			size = ptr
+		}
+		if size == 0 {
+			continue
+		}
+		first := uint64(reserved[i][0])
+		for j := uint64(0); j < s.Size/size; j++ {
+			if first+j >= uint64(len(f.Dysymtab.IndirectSyms)) {
+				break
+			}
+			idx := f.Dysymtab.IndirectSyms[first+j]
+			if idx&(indirectSymbolLocal|indirectSymbolAbs) != 0 || int(idx) >= len(f.Symtab.Syms) {
+				continue
+			}
+			f.Imports[s.Addr+j*size] = f.Symtab.Syms[idx].Name
+		}
+	}
+}
+
+// The virtual address of the LC_MAIN entry point, or 0
+func (f *File) Entry() uint64 {
+	const lcMain = 0x80000028
+	text := f.File.Segment("__TEXT")
+	if text == nil {
+		return 0
+	}
+	for _, l := range f.Loads {
+		raw := l.Raw()
+		if len(raw) >= 16 && f.ByteOrder.Uint32(raw) == lcMain {
+			return text.Addr + f.ByteOrder.Uint64(raw[8:])
+		}
+	}
+	return 0
+}
+
+// The symbol stub or symbol pointer referenced by an instruction, eg _printf
+// for call 0x100000f6a. Requires CS_OPT_DETAIL for anything but direct
+// branches.
+func (f *File) Import(insn gapstone.Instruction) (string, bool) {
+	for _, ref := range insn.References() {
+		if name, ok := f.Imports[ref.Address]; ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Find a section by "segment,section" or just the section name
+func (f *File) section(name string) (*dmacho.Section, int) {
+	seg := ""
+	if i := strings.IndexByte(name, ','); i >= 0 {
+		seg, name = name[:i], name[i+1:]
+	}
+	for i, s := range f.Sections {
+		if s.Name == name && (seg == "" || s.Seg == seg) {
+			return s, i
+		}
+	}
+	return nil, -1
+}
+
+func (f *File) segment(s *dmacho.Section) (Segment, error) {
+	if s.Flags&sectionType == sZerofill {
+		return Segment{}, ErrNoData
+	}
+	data, err := s.Data()
+	if err != nil {
+		return Segment{}, err
+	}
+	return Segment{Name: s.Seg + "," + s.Name, Addr: s.Addr, Data: data, Flags: s.Flags}, nil
+}
+
+// The sections holding instructions, in load command order
+func (f *File) ExecSegments() ([]Segment, error) {
+	var segs []Segment
+	for _, s := range f.Sections {
+		if s.Flags&(sAttrPureInstructions|sAttrSomeInstructions) == 0 || s.Flags&sectionType == sZerofill {
+			continue
+		}
+		seg, err := f.segment(s)
+		if err != nil {
+			return nil, err
+		}
+		segs = append(segs, seg)
+	}
+	return segs, nil
+}
+
+// Disassemble a segment from ExecSegments at its virtual address. ARM code
+// is split at symbols, switching to Thumb for N_ARM_THUMB_DEF symbols.
+func (f *File) DisasmSegment(s Segment) ([]gapstone.Instruction, error) {
+	return f.disasm(s.Data, s.Addr, false)
+}
+
+// Disassemble a section by name at its virtual address, eg "__TEXT,__text"
+// or "__stubs".
+func (f *File) DisasmSection(name string) ([]gapstone.Instruction, error) {
+	s, _ := f.section(name)
+	if s == nil {
+		return nil, ErrNoSection
+	}
+	seg, err := f.segment(s)
+	if err != nil {
+		return nil, err
+	}
+	return f.DisasmSegment(seg)
+}
+
+// Disassemble a function by symbol name, eg "_main". Mach-O symbols have no
+// size, so the function is assumed to end at the next symbol in the same
+// section.
+func (f *File) DisasmSymbol(name string) ([]gapstone.Instruction, error) {
+	syms := f.definedSymbols()
+	for i, sym := range syms {
+		if sym.Name != name {
+			continue
+		}
+		s := f.Sections[sym.Sect-1]
+		if sym.Value < s.Addr || sym.Value >= s.Addr+s.Size || s.Flags&sectionType == sZerofill {
+			return nil, ErrNoData
+		}
+		end := s.Addr + s.Size
+		for _, next := range syms[i+1:] {
+			if next.Sect == sym.Sect && next.Value > sym.Value {
+				end = next.Value
+				break
+			}
+		}
+		data := make([]byte, end-sym.Value)
+		if _, err := s.ReadAt(data, int64(sym.Value-s.Addr)); err != nil {
+			return nil, err
+		}
+		return f.disasm(data, sym.Value, sym.Desc&nArmThumbDef != 0)
+	}
+	return nil, ErrNoSymbol
+}
+
+// Defined, non-debug symbols with a valid section, sorted by address
+func (f *File) definedSymbols() []dmacho.Symbol {
+	if f.Symtab == nil {
+		return nil
+	}
+	var syms []dmacho.Symbol
+	for _, sym := range f.Symtab.Syms {
+		if sym.Type&nStab != 0 || sym.Type&nType != nSect || sym.Sect == 0 || int(sym.Sect) > len(f.Sections) {
+			continue
+		}
+		syms = append(syms, sym)
+	}
+	sort.Stable(byValue(syms))
+	return syms
+}
+
+type byValue []dmacho.Symbol
+
+func (s byValue) Len() int           { return len(s) }
+func (s byValue) Less(i, j int) bool { return s[i].Value < s[j].Value }
+func (s byValue) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+func (f *File) disasm(data []byte, addr uint64, thumb bool) ([]gapstone.Instruction, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if f.Arch != gapstone.CS_ARCH_ARM || f.Mode&gapstone.CS_MODE_MCLASS != 0 {
+		insns, err := f.Engine.Disasm(data, addr, 0)
+		if err != nil && err != gapstone.ErrOK {
+			return insns, err
+		}
+		return insns, nil
+	}
+
+	// Runs of ARM or Thumb code, starting at each symbol
+	type run struct {
+		addr  uint64
+		thumb bool
+	}
+	end := addr + uint64(len(data))
+	runs := []run{{addr, thumb}}
+	for _, sym := range f.definedSymbols() {
+		if sym.Value < addr || sym.Value >= end {
+			continue
+		}
+		if sym.Value == addr {
+			runs[0].thumb = sym.Desc&nArmThumbDef != 0
+			continue
+		}
+		if t := sym.Desc&nArmThumbDef != 0; t != runs[len(runs)-1].thumb {
+			runs = append(runs, run{sym.Value, t})
+		}
+	}
+
+	defer f.Engine.SetOption(gapstone.CS_OPT_MODE, f.Mode)
+	var insns []gapstone.Instruction
+	for i, r := range runs {
+		next := end
+		if i+1 < len(runs) {
+			next = runs[i+1].addr
+		}
+		mode := f.Mode &^ gapstone.CS_MODE_THUMB
+		if r.thumb {
+			mode |= gapstone.CS_MODE_THUMB
+		}
+		if mode != f.Engine.Mode() {
+			if err := f.Engine.SetOption(gapstone.CS_OPT_MODE, mode); err != nil {
+				return insns, err
+			}
+		}
+		out, err := f.Engine.Disasm(data[r.addr-addr:next-addr], r.addr, 0)
+		if err != nil && err != gapstone.ErrOK {
+			return insns, err
+		}
+		insns = append(insns, out...)
+	}
+	return insns, nil
+}
@@ -0,0 +1,303 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package macho
+
+import (
+	"bytes"
+	dmacho "debug/macho"
+	"encoding/binary"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/bnagy/gapstone"
+)
+
+const testBase = 0x100000000
+
+func name16(s string) (b [16]byte) {
+	copy(b[:], s)
+	return
+}
+
+// A minimal x86-64 executable: _main calls _printf through a __stubs entry,
+// which jumps through __la_symbol_ptr.
+func testImage() []byte {
+	le := binary.LittleEndian
+	file := make([]byte, 0x680)
+
+	// call 0x100000410 ; xor eax, eax ; ret
+	copy(file[0x400:], []byte{0xe8, 0x0b, 0x00, 0x00, 0x00, 0x31, 0xc0, 0xc3})
+	// jmp qword ptr [rip + 0xbea]
+	copy(file[0x410:], []byte{0xff, 0x25, 0xea, 0x0b, 0x00, 0x00})
+
+	var cmds bytes.Buffer
+	binary.Write(&cmds, le, dmacho.Segment64{
+		Cmd: dmacho.LoadCmdSegment64, Len: 72 + 2*80, Name: name16("__TEXT"),
+		Addr: testBase, Memsz: 0x1000, Filesz: 0x500, Maxprot: 5, Prot: 5, Nsect: 2,
+	})
+	binary.Write(&cmds, le, dmacho.Section64{
+		Name: name16("__text"), Seg: name16("__TEXT"), Addr: testBase + 0x400, Size: 8, Offset: 0x400,
+		Flags: sAttrPureInstructions | sAttrSomeInstructions,
+	})
+	binary.Write(&cmds, le, dmacho.Section64{
+		Name: name16("__stubs"), Seg: name16("__TEXT"), Addr: testBase + 0x410, Size: 6, Offset: 0x410,
+		Flags: sSymbolStubs | sAttrPureInstructions | sAttrSomeInstructions, Reserve1: 0, Reserve2: 6,
+	})
+	binary.Write(&cmds, le, dmacho.Segment64{
+		Cmd: dmacho.LoadCmdSegment64, Len: 72 + 80, Name: name16("__DATA"),
+		Addr: testBase + 0x1000, Memsz: 0x1000, Offset: 0x500, Filesz: 0x100, Maxprot: 3, Prot: 3, Nsect: 1,
+	})
+	binary.Write(&cmds, le, dmacho.Section64{
+		Name: name16("__la_symbol_ptr"), Seg: name16("__DATA"), Addr: testBase + 0x1000, Size: 8, Offset: 0x500,
+		Flags: sLazySymbolPointers, Reserve1: 1,
+	})
+	binary.Write(&cmds, le, dmacho.SymtabCmd{
+		Cmd: dmacho.LoadCmdSymtab, Len: 24, Symoff: 0x600, Nsyms: 2, Stroff: 0x640, Strsize: 0x10,
+	})
+	binary.Write(&cmds, le, dmacho.DysymtabCmd{
+		Cmd: dmacho.LoadCmdDysymtab, Len: 80, Nextdefsym: 1, Iundefsym: 1, Nundefsym: 1,
+		Indirectsymoff: 0x660, Nindirectsyms: 2,
+	})
+	// LC_MAIN
+	binary.Write(&cmds, le, []uint32{0x80000028, 24, 0x400, 0, 0, 0})
+
+	var hdr bytes.Buffer
+	binary.Write(&hdr, le, dmacho.FileHeader{
+		Magic: dmacho.Magic64, Cpu: dmacho.CpuAmd64, SubCpu: 3, Type: dmacho.TypeExec,
+		Ncmd: 5, Cmdsz: uint32(cmds.Len()),
+	})
+	hdr.Write(make([]byte, 4))
+	copy(file, hdr.Bytes())
+	copy(file[hdr.Len():], cmds.Bytes())
+
+	var syms bytes.Buffer
+	binary.Write(&syms, le, dmacho.Nlist64{Name: 1, Type: 0x0f, Sect: 1, Value: testBase + 0x400})
+	binary.Write(&syms, le, dmacho.Nlist64{Name: 7, Type: 0x01})
+	copy(file[0x600:], syms.Bytes())
+	copy(file[0x640:], "\x00_main\x00_printf\x00")
+	le.PutUint32(file[0x660:], 1)
+	le.PutUint32(file[0x664:], 1)
+	return file
+}
+
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		cpu    dmacho.Cpu
+		subCpu uint32
+		arch   int
+		mode   uint
+	}{
+		{dmacho.Cpu386, 3, gapstone.CS_ARCH_X86, gapstone.CS_MODE_32},
+		{dmacho.CpuAmd64, 3, gapstone.CS_ARCH_X86, gapstone.CS_MODE_64},
+		{dmacho.CpuArm, 9, gapstone.CS_ARCH_ARM, gapstone.CS_MODE_ARM},
+		{dmacho.CpuArm, subCpuArmV7EM, gapstone.CS_ARCH_ARM, gapstone.CS_MODE_THUMB | gapstone.CS_MODE_MCLASS},
+		{dmacho.CpuArm64, 0x80000002, gapstone.CS_ARCH_ARM64, gapstone.CS_MODE_ARM},
+		{dmacho.CpuPpc, 0, gapstone.CS_ARCH_PPC, gapstone.CS_MODE_32 | gapstone.CS_MODE_BIG_ENDIAN},
+		{dmacho.CpuPpc64, 0, gapstone.CS_ARCH_PPC, gapstone.CS_MODE_64 | gapstone.CS_MODE_BIG_ENDIAN},
+	}
+	for i, test := range tests {
+		arch, mode, err := Target(test.cpu, test.subCpu)
+		if err != nil || arch != test.arch || mode != test.mode {
+			t.Errorf("%2d> %v: want %v/%#x got %v/%#x (%v)", i, test.cpu, test.arch, test.mode, arch, mode, err)
+		}
+	}
+	if _, _, err := Target(dmacho.Cpu(99), 0); err != ErrMachine {
+		t.Errorf("cpu 99: want %v got %v", ErrMachine, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	mf, err := slice(bytes.NewReader(testImage()), 0)
+	if err != nil {
+		t.Fatalf("Failed to parse test image %v", err)
+	}
+	f, err := load(mf)
+	if err != nil {
+		t.Fatalf("Failed to load test image %v", err)
+	}
+
+	imports := map[uint64]string{
+		testBase + 0x410:  "_printf",
+		testBase + 0x1000: "_printf",
+	}
+	if !reflect.DeepEqual(f.Imports, imports) {
+		t.Errorf("imports: want %v got %v", imports, f.Imports)
+	}
+	if f.Entry() != testBase+0x400 {
+		t.Errorf("entry: want %#x got %#x", testBase+0x400, f.Entry())
+	}
+	segs, err := f.ExecSegments()
+	if err != nil || len(segs) != 2 || segs[0].Name != "__TEXT,__text" || segs[1].Addr != testBase+0x410 {
+		t.Errorf("segments: got %+v (%v)", segs, err)
+	}
+	if s, _ := f.section("__DATA,__la_symbol_ptr"); s == nil {
+		t.Errorf("__DATA,__la_symbol_ptr not found")
+	}
+	if s, _ := f.section("__TEXT,__la_symbol_ptr"); s != nil {
+		t.Errorf("__TEXT,__la_symbol_ptr found")
+	}
+
+	syms := f.SymbolTable()
+	symbols := []struct {
+		addr uint64
+		name string
+		off  uint64
+	}{
+		{testBase + 0x400, "_main", 0},
+		{testBase + 0x407, "_main", 7},
+		{testBase + 0x410, "_printf", 0},
+		{testBase + 0x1000, "_printf@ptr", 0},
+	}
+	for i, test := range symbols {
+		if name, off, ok := syms.Lookup(test.addr); !ok || name != test.name || off != test.off {
+			t.Errorf("%2d> %#x: want %s+%#x got %s+%#x (%v)", i, test.addr, test.name, test.off, name, off, ok)
+		}
+	}
+	if name, _, ok := syms.Lookup(testBase + 0x1008); ok {
+		t.Errorf("%#x: past the symbol pointers got %s", testBase+0x1008, name)
+	}
+}
+
+// testdata/x86_64.s and arm.s, built as in their headers. Object files
+// start their sections at 0.
+func TestSlices(t *testing.T) {
+	universal, err := os.Open("testdata/fat.o")
+	if err != nil {
+		t.Fatalf("Failed to open %v", err)
+	}
+	defer universal.Close()
+	thin, err := os.Open("testdata/x86_64.o")
+	if err != nil {
+		t.Fatalf("Failed to open %v", err)
+	}
+	defer thin.Close()
+
+	cpus, err := Slices(universal)
+	if err != nil || !reflect.DeepEqual(cpus, []dmacho.Cpu{dmacho.CpuAmd64, dmacho.CpuArm}) {
+		t.Errorf("fat: got %v (%v)", cpus, err)
+	}
+	if cpus, err := Slices(thin); err != nil || len(cpus) != 1 || cpus[0] != dmacho.CpuAmd64 {
+		t.Errorf("thin: got %v (%v)", cpus, err)
+	}
+
+	if mf, err := slice(universal, dmacho.CpuArm); err != nil || mf.Cpu != dmacho.CpuArm {
+		t.Errorf("fat arm: got %v", err)
+	}
+	if mf, err := slice(universal, 0); err != nil || mf.Cpu != dmacho.CpuAmd64 {
+		t.Errorf("fat first slice: got %v", err)
+	}
+	if _, err := slice(universal, dmacho.CpuArm64); err != ErrNoSlice {
+		t.Errorf("fat arm64: want %v got %v", ErrNoSlice, err)
+	}
+	if _, err := slice(thin, dmacho.CpuArm64); err != ErrNoSlice {
+		t.Errorf("thin arm64: want %v got %v", ErrNoSlice, err)
+	}
+
+	mf, err := slice(universal, dmacho.CpuArm)
+	if err != nil {
+		t.Fatalf("fat arm: %v", err)
+	}
+	f, err := load(mf)
+	if err != nil {
+		t.Fatalf("Failed to load arm slice %v", err)
+	}
+	if f.Arch != gapstone.CS_ARCH_ARM || f.Mode != gapstone.CS_MODE_ARM {
+		t.Errorf("arm slice: want ARM got %v/%#x", f.Arch, f.Mode)
+	}
+	if imports := map[uint64]string{0x14: "_puts"}; !reflect.DeepEqual(f.Imports, imports) {
+		t.Errorf("arm slice imports: want %v got %v", imports, f.Imports)
+	}
+}
+
+func TestImportEngine(t *testing.T) {
+
+	f, err := Open("testdata/x86_64.o", 0)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer f.Close()
+
+	imports := map[uint64]string{0xf: "_puts", 0x18: "_puts", 0x20: "_puts"}
+	if !reflect.DeepEqual(f.Imports, imports) {
+		t.Errorf("imports: want %v got %v", imports, f.Imports)
+	}
+
+	// call to the stub, and a load of the non-lazy pointer
+	main, err := f.DisasmSymbol("_main")
+	if err != nil || len(main) != 4 {
+		t.Fatalf("_main: want 4 instructions got %d (%v)", len(main), err)
+	}
+	for _, insn := range main[:2] {
+		if name, ok := f.Import(insn); !ok || name != "_puts" {
+			t.Errorf("%s %s: want _puts got %q", insn.Mnemonic, insn.OpStr, name)
+		}
+	}
+	stubs, err := f.DisasmSection("__TEXT,__stubs")
+	if err != nil || len(stubs) != 1 {
+		t.Fatalf("__stubs: %v", err)
+	}
+	if name, ok := f.Import(stubs[0]); !ok || name != "_puts" {
+		t.Errorf("%s %s: want _puts got %q", stubs[0].Mnemonic, stubs[0].OpStr, name)
+	}
+	if _, err := f.DisasmSection("__DATA,__bss"); err != ErrNoSection {
+		t.Errorf("__bss: want %v got %v", ErrNoSection, err)
+	}
+}
+
+func TestThumbEngine(t *testing.T) {
+
+	// _thumb_func is N_ARM_THUMB_DEF, between two ARM functions. Instruction
+	// sizes show the mode: 4 bytes for ARM, 2 for these Thumb instructions.
+	f, err := Open("testdata/fat.o", dmacho.CpuArm)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer f.Close()
+
+	type armInsn struct {
+		addr     uint
+		size     uint
+		mnemonic string
+	}
+	check := func(what string, insns []gapstone.Instruction, want []armInsn) {
+		if len(insns) != len(want) {
+			t.Errorf("%s: want %d instructions got %d", what, len(want), len(insns))
+			return
+		}
+		for i, insn := range insns {
+			if insn.Address != want[i].addr || insn.Size != want[i].size || insn.Mnemonic != want[i].mnemonic {
+				t.Errorf("%2d> %s: want %#x %s (%d bytes) got %#x %s (%d bytes)", i, what,
+					want[i].addr, want[i].mnemonic, want[i].size, insn.Address, insn.Mnemonic, insn.Size)
+			}
+		}
+	}
+
+	insns, err := f.DisasmSymbol("_thumb_func")
+	if err != nil {
+		t.Fatalf("_thumb_func: %v", err)
+	}
+	check("_thumb_func", insns, []armInsn{{8, 2, "movs"}, {0xa, 2, "bx"}})
+
+	insns, err = f.DisasmSection("__TEXT,__text")
+	if err != nil {
+		t.Fatalf("__text: %v", err)
+	}
+	check("__text", insns, []armInsn{
+		{0, 4, "mov"}, {4, 4, "bx"},
+		{8, 2, "movs"}, {0xa, 2, "bx"},
+		{0xc, 4, "mov"}, {0x10, 4, "bx"},
+	})
+
+	if f.Engine.Mode() != gapstone.CS_MODE_ARM {
+		t.Errorf("engine mode not restored: got %#x", f.Engine.Mode())
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package macho
+
+import (
+	dmacho "debug/macho"
+
+	"github.com/bnagy/gapstone"
+)
+
+// A SymbolTable for Instruction.SymbolicOpStr, from the defined symbols plus
+// the Imports. Stubs take the imported name, eg "_printf", and symbol
+// pointers get an "@ptr" suffix, eg "_printf@ptr", so that the two can be
+// told apart.
+func (f *File) SymbolTable() *gapstone.SymbolMap {
+	var syms []gapstone.Symbol
+	for _, sym := range f.definedSymbols() {
+		syms = append(syms, gapstone.Symbol{Name: sym.Name, Addr: sym.Value})
+	}
+
+	ptr := uint64(4)
+	if f.Magic == dmacho.Magic64 {
+		ptr = 8
+	}
+	reserved := f.sectionReserved()
+	for i, s := range f.Sections {
+		var size uint64
+		suffix := "@ptr"
+		switch s.Flags & sectionType {
+		case sSymbolStubs:
+			if i < len(reserved) {
+				size = uint64(reserved[i][1])
+			}
+			suffix = ""
+		case sNonLazySymbolPointers, sLazySymbolPointers, sThreadLocalVariablePtr:
+			size = ptr
+		default:
+			continue
+		}
+		for addr, name := range f.Imports {
+			if addr >= s.Addr && addr < s.Addr+s.Size {
+				syms = append(syms, gapstone.Symbol{Name: name + suffix, Addr: addr, Size: size})
+			}
+		}
+	}
+	return gapstone.NewSymbolMap(syms)
+}
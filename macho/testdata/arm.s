@@ -0,0 +1,32 @@
+@ An ARMv7 object mixing ARM and Thumb functions, and a universal binary
+@ holding it and x86_64.o:
+@
+@	llvm-mc -triple=armv7-apple-ios -filetype=obj -o arm.o arm.s
+@	llvm-lipo -create x86_64.o arm.o -output fat.o
+
+	.syntax unified
+	.section __TEXT,__text,regular,pure_instructions
+	.arm
+	.globl	_arm_func
+_arm_func:
+	mov	r0, #0
+	bx	lr
+
+	.thumb
+	.globl	_thumb_func
+	.thumb_func	_thumb_func
+_thumb_func:
+	movs	r0, #1
+	bx	lr
+
+	.arm
+	.globl	_arm_again
+_arm_again:
+	mov	r0, #2
+	bx	lr
+
+	.section __DATA,__nl_symbol_ptr,non_lazy_symbol_pointers
+	.p2align 2
+L_puts$ptr:
+	.indirect_symbol _puts
+	.long	0
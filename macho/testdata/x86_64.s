@@ -0,0 +1,31 @@
+# An x86-64 object where _main calls _puts through a symbol stub and loads
+# its non-lazy pointer:
+#
+#	llvm-mc -triple=x86_64-apple-macos10.15 -filetype=obj -o x86_64.o x86_64.s
+#
+# fat.o holds this and arm.o, see arm.s
+
+	.section __TEXT,__text,regular,pure_instructions
+	.globl	_main
+_main:
+	callq	L_puts$stub
+	movq	L_puts$ptr(%rip), %rax
+	xorl	%eax, %eax
+	retq
+
+	.section __TEXT,__stubs,symbol_stubs,pure_instructions,6
+L_puts$stub:
+	.indirect_symbol _puts
+	jmpq	*L_puts$lazy(%rip)
+
+	.section __DATA,__la_symbol_ptr,lazy_symbol_pointers
+	.p2align 3
+L_puts$lazy:
+	.indirect_symbol _puts
+	.quad	0
+
+	.section __DATA,__nl_symbol_ptr,non_lazy_symbol_pointers
+	.p2align 3
+L_puts$ptr:
+	.indirect_symbol _puts
+	.quad	0
@@ -0,0 +1,605 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+// One access to memory made by an Instruction
+type MemoryAccess struct {
+	Operand int    // index into the arch specific Operands slice, -1 for implicit stack accesses
+	Access  Access // AccessRead, AccessWrite or AccessReadWrite
+	Size    uint   // total bytes transferred, 0 if unknown
+	Count   int    // registers or vector elements transferred (ldm, ldp, ld4...), otherwise 1
+	Stack   bool   // the address is based on the stack or frame pointer
+}
+
+// The memory accessed by this Instruction, in operand order. Each memory
+// operand gives one MemoryAccess, with the same direction as OperandAccess.
+// Sizes come from:
+//
+//	X86:   the operand size
+//	ARM:   the instruction (ldrb, ldrh, ldrd...) or the transfer register
+//	ARM64: the instruction (ldrb, ldrsw, ldp...), the transfer register or
+//	       the vector arrangement / element size of ld1-ld4 and st1-st4
+//	MIPS, PPC, SPARC, XCore: the instruction (lb, lw, ld, stfd, stx...)
+//	SysZ:  the operand length for storage to storage forms (mvc, clc...),
+//	       otherwise the instruction
+//
+// Transfers which have no memory operand are reported too: ARM ldm / stm and
+// vldm / vstm against their base register operand, PPC indexed (X form) loads
+// and stores such as lwzx and stdux against operand 1 (rA, or rB when rA is
+// 0), and the stack accesses of ARM push / pop and x86 push / pop with
+// Operand -1.
+//
+// Operands which only form an address (lea, prefetches, SysZ la, SPARC jmpl)
+// are not accesses. Returns ErrDetail without detail.
+func (insn Instruction) MemoryAccesses() ([]MemoryAccess, error) {
+	arch, ok := detailArch(&insn)
+	if !ok {
+		return nil, ErrDetail
+	}
+	m, err := NewRegModel(arch)
+	if err != nil {
+		return nil, err
+	}
+	if memAddressOnly[arch][insn.Id] {
+		return nil, nil
+	}
+
+	ops, _, _ := insn.accessOperands()
+	var accesses []MemoryAccess
+	for i, op := range ops {
+		if !op.mem {
+			continue
+		}
+		size, count := insn.memSize(arch, m, i)
+		accesses = append(accesses, MemoryAccess{
+			Operand: i,
+			Access:  insn.memAccess(i, len(ops)),
+			Size:    size,
+			Count:   count,
+			Stack:   len(op.addr) > 0 && stackReg(m, op.addr[0]),
+		})
+	}
+	return append(accesses, insn.listAccesses(arch, m)...), nil
+}
+
+// The access to memory made through the memory operand at idx
+func (insn *Instruction) memAccess(idx, nops int) Access {
+	if a, ok := memDirections[insn.archOf()][insn.Id]; ok {
+		return a
+	}
+	return insn.accessRule(nops).access(idx, nops, true)
+}
+
+// Atomics whose memory operand doesn't follow the register operand rule
+var memDirections = map[int]map[uint]Access{
+	CS_ARCH_MIPS: {
+		MIPS_INS_SC: AccessWrite, MIPS_INS_SCD: AccessWrite,
+	},
+	CS_ARCH_SPARC: {
+		SPARC_INS_CAS: AccessReadWrite, SPARC_INS_CASX: AccessReadWrite,
+		SPARC_INS_SWAP: AccessReadWrite,
+	},
+	CS_ARCH_SYSZ: {
+		SYSZ_INS_CS: AccessReadWrite, SYSZ_INS_CSG: AccessReadWrite,
+		SYSZ_INS_CSY: AccessReadWrite, SYSZ_INS_LAA: AccessReadWrite,
+		SYSZ_INS_LAAG: AccessReadWrite, SYSZ_INS_LAAL: AccessReadWrite,
+		SYSZ_INS_LAALG: AccessReadWrite, SYSZ_INS_LAN: AccessReadWrite,
+		SYSZ_INS_LANG: AccessReadWrite, SYSZ_INS_LAO: AccessReadWrite,
+		SYSZ_INS_LAOG: AccessReadWrite, SYSZ_INS_LAX: AccessReadWrite,
+		SYSZ_INS_LAXG: AccessReadWrite,
+	},
+}
+
+// Instructions whose memory operand is only an address
+var memAddressOnly = map[int]insnSet{
+	CS_ARCH_X86: newInsnSet(
+		X86_INS_LEA, X86_INS_NOP, X86_INS_PREFETCH, X86_INS_PREFETCHNTA,
+		X86_INS_PREFETCHT0, X86_INS_PREFETCHT1, X86_INS_PREFETCHT2,
+		X86_INS_PREFETCHW, X86_INS_CLFLUSH, X86_INS_INVLPG,
+	),
+	CS_ARCH_ARM: newInsnSet(
+		ARM_INS_PLD, ARM_INS_PLDW, ARM_INS_PLI,
+	),
+	CS_ARCH_ARM64: newInsnSet(
+		ARM64_INS_PRFM, ARM64_INS_PRFUM,
+	),
+	CS_ARCH_MIPS: newInsnSet(
+		MIPS_INS_CACHE, MIPS_INS_PREF,
+	),
+	CS_ARCH_SPARC: newInsnSet(
+		SPARC_INS_JMPL, SPARC_INS_RETT,
+	),
+	CS_ARCH_SYSZ: newInsnSet(
+		SYSZ_INS_LA, SYSZ_INS_LAY, SYSZ_INS_PFD,
+	),
+	CS_ARCH_XCORE: newInsnSet(
+		XCORE_INS_LDA16, XCORE_INS_LDAP, XCORE_INS_LDAW,
+	),
+}
+
+func stackReg(m *RegModel, reg uint) bool {
+	info, ok := m.Info(reg)
+	return ok && (info.Role == RegRoleStackPointer || info.Role == RegRoleFramePointer)
+}
+
+func regBytes(m *RegModel, reg uint) uint {
+	info, _ := m.Info(reg)
+	return info.Width / 8
+}
+
+// Bytes and elements transferred by the memory operand at idx
+func (insn *Instruction) memSize(arch int, m *RegModel, idx int) (uint, int) {
+	switch arch {
+	case CS_ARCH_X86:
+		return uint(insn.X86.Operands[idx].Size), 1
+	case CS_ARCH_ARM:
+		return insn.armMemSize(m, idx)
+	case CS_ARCH_ARM64:
+		return insn.arm64MemSize(m, idx)
+	case CS_ARCH_MIPS:
+		return mipsMemSizes[insn.Id], 1
+	case CS_ARCH_PPC:
+		if insn.Id == PPC_INS_LMW || insn.Id == PPC_INS_STMW {
+			// every register from rS to r31
+			n := ppcMultiple(insn.PPC.Operands)
+			return 4 * uint(n), n
+		}
+		return ppcMemSizes[insn.Id], 1
+	case CS_ARCH_SPARC:
+		if size, ok := sparcMemSizes[insn.Id]; ok {
+			return size, 1
+		}
+		// ldd / std on integer registers move an even / odd pair
+		if insn.Id == SPARC_INS_LDD || insn.Id == SPARC_INS_STD {
+			if info, _ := m.Info(insn.sparcDataReg(idx)); info.Class == RegClassGeneral {
+				return 8, 2
+			}
+			return 8, 1
+		}
+		return regBytes(m, insn.sparcDataReg(idx)), 1
+	case CS_ARCH_SYSZ:
+		return insn.syszMemSize(idx)
+	case CS_ARCH_XCORE:
+		return xcoreMemSizes[insn.Id], 1
+	}
+	return 0, 1
+}
+
+// Accesses made by register list transfers, push / pop and PPC indexed loads
+// and stores, which have no memory operand
+func (insn *Instruction) listAccesses(arch int, m *RegModel) []MemoryAccess {
+	switch arch {
+	case CS_ARCH_X86:
+		ops := insn.X86.Operands
+		if len(ops) != 1 {
+			break
+		}
+		switch insn.Id {
+		case X86_INS_PUSH:
+			return []MemoryAccess{{Operand: -1, Access: AccessWrite, Size: uint(ops[0].Size), Count: 1, Stack: true}}
+		case X86_INS_POP:
+			return []MemoryAccess{{Operand: -1, Access: AccessRead, Size: uint(ops[0].Size), Count: 1, Stack: true}}
+		}
+	case CS_ARCH_ARM:
+		ops := insn.Arm.Operands
+		var a MemoryAccess
+		var list []ArmOperand
+		switch {
+		case armLoadMultiple[insn.Id] || armStoreMultiple[insn.Id]:
+			if len(ops) < 2 || ops[0].Type != ARM_OP_REG {
+				return nil
+			}
+			a = MemoryAccess{Operand: 0, Access: AccessRead, Stack: stackReg(m, ops[0].Reg)}
+			if armStoreMultiple[insn.Id] {
+				a.Access = AccessWrite
+			}
+			list = ops[1:]
+		case insn.Id == ARM_INS_PUSH || insn.Id == ARM_INS_VPUSH:
+			a = MemoryAccess{Operand: -1, Access: AccessWrite, Stack: true}
+			list = ops
+		case insn.Id == ARM_INS_POP || insn.Id == ARM_INS_VPOP:
+			a = MemoryAccess{Operand: -1, Access: AccessRead, Stack: true}
+			list = ops
+		default:
+			return nil
+		}
+		for _, op := range list {
+			if op.Type == ARM_OP_REG {
+				a.Size += regBytes(m, op.Reg)
+				a.Count++
+			}
+		}
+		return []MemoryAccess{a}
+	case CS_ARCH_PPC:
+		// rD, rA, rB. Capstone prints an rA of r0 as a literal 0 without
+		// an operand, which leaves rB at index 1.
+		ops := insn.PPC.Operands
+		size, ok := ppcIndexedSizes[insn.Id]
+		if !ok || len(ops) < 2 {
+			return nil
+		}
+		a := MemoryAccess{Operand: 1, Access: AccessRead, Size: size, Count: 1}
+		if ppcIndexedStores[insn.Id] {
+			a.Access = AccessWrite
+		}
+		if ops[1].Type == PPC_OP_REG {
+			a.Stack = stackReg(m, ops[1].Reg)
+		}
+		return []MemoryAccess{a}
+	}
+	return nil
+}
+
+// ARM
+
+var armLoadMultiple = newInsnSet(
+	ARM_INS_LDM, ARM_INS_LDMDA, ARM_INS_LDMDB, ARM_INS_LDMIB, ARM_INS_VLDMDB,
+	ARM_INS_VLDMIA,
+)
+
+var armStoreMultiple = newInsnSet(
+	ARM_INS_STM, ARM_INS_STMDA, ARM_INS_STMDB, ARM_INS_STMIB, ARM_INS_VSTMDB,
+	ARM_INS_VSTMIA,
+)
+
+var armMemSizes = map[uint]uint{
+	ARM_INS_LDAB: 1, ARM_INS_LDAEXB: 1, ARM_INS_LDRB: 1, ARM_INS_LDRBT: 1,
+	ARM_INS_LDREXB: 1, ARM_INS_LDRSB: 1, ARM_INS_LDRSBT: 1, ARM_INS_STLB: 1,
+	ARM_INS_STLEXB: 1, ARM_INS_STRB: 1, ARM_INS_STRBT: 1, ARM_INS_STREXB: 1,
+
+	ARM_INS_LDAH: 2, ARM_INS_LDAEXH: 2, ARM_INS_LDRH: 2, ARM_INS_LDRHT: 2,
+	ARM_INS_LDREXH: 2, ARM_INS_LDRSH: 2, ARM_INS_LDRSHT: 2, ARM_INS_STLH: 2,
+	ARM_INS_STLEXH: 2, ARM_INS_STRH: 2, ARM_INS_STRHT: 2, ARM_INS_STREXH: 2,
+}
+
+// ldrd r0, r1, [r2] and friends
+var armPairs = newInsnSet(
+	ARM_INS_LDAEXD, ARM_INS_LDRD, ARM_INS_LDREXD, ARM_INS_STLEXD, ARM_INS_STRD,
+	ARM_INS_STREXD,
+)
+
+var armVectorTransfers = newInsnSet(
+	ARM_INS_VLD1, ARM_INS_VLD2, ARM_INS_VLD3, ARM_INS_VLD4, ARM_INS_VST1,
+	ARM_INS_VST2, ARM_INS_VST3, ARM_INS_VST4,
+)
+
+// Coprocessor transfers (ldc / stc) have no transfer register and give 0
+func (insn *Instruction) armMemSize(m *RegModel, idx int) (uint, int) {
+	ops := insn.Arm.Operands
+	if size, ok := armMemSizes[insn.Id]; ok {
+		return size, 1
+	}
+	if armVectorTransfers[insn.Id] {
+		// vld1.32 {d0[1], d1[1]}, [r0] transfers one element per register
+		var size uint
+		n := 0
+		for _, op := range ops[:idx] {
+			if op.Type != ARM_OP_REG {
+				continue
+			}
+			n++
+			if op.VectorIndex >= 0 && insn.Arm.VectorSize > 0 {
+				size += uint(insn.Arm.VectorSize) / 8
+			} else {
+				size += regBytes(m, op.Reg)
+			}
+		}
+		return size, n
+	}
+	// The transfer register is the one before the memory operand
+	if idx == 0 || ops[idx-1].Type != ARM_OP_REG {
+		return 0, 1
+	}
+	size := regBytes(m, ops[idx-1].Reg)
+	if armPairs[insn.Id] {
+		return 2 * size, 2
+	}
+	return size, 1
+}
+
+// ARM64
+
+var arm64MemSizes = map[uint]uint{
+	ARM64_INS_LDARB: 1, ARM64_INS_LDAXRB: 1, ARM64_INS_LDRB: 1,
+	ARM64_INS_LDRSB: 1, ARM64_INS_LDTRB: 1, ARM64_INS_LDTRSB: 1,
+	ARM64_INS_LDURB: 1, ARM64_INS_LDURSB: 1, ARM64_INS_LDXRB: 1,
+	ARM64_INS_STLRB: 1, ARM64_INS_STLXRB: 1, ARM64_INS_STRB: 1,
+	ARM64_INS_STTRB: 1, ARM64_INS_STURB: 1, ARM64_INS_STXRB: 1,
+
+	ARM64_INS_LDARH: 2, ARM64_INS_LDAXRH: 2, ARM64_INS_LDRH: 2,
+	ARM64_INS_LDRSH: 2, ARM64_INS_LDTRH: 2, ARM64_INS_LDTRSH: 2,
+	ARM64_INS_LDURH: 2, ARM64_INS_LDURSH: 2, ARM64_INS_LDXRH: 2,
+	ARM64_INS_STLRH: 2, ARM64_INS_STLXRH: 2, ARM64_INS_STRH: 2,
+	ARM64_INS_STTRH: 2, ARM64_INS_STURH: 2, ARM64_INS_STXRH: 2,
+
+	ARM64_INS_LDRSW: 4, ARM64_INS_LDTRSW: 4, ARM64_INS_LDURSW: 4,
+}
+
+var arm64Pairs = newInsnSet(
+	ARM64_INS_LDAXP, ARM64_INS_LDNP, ARM64_INS_LDP, ARM64_INS_LDXP,
+	ARM64_INS_STLXP, ARM64_INS_STNP, ARM64_INS_STP, ARM64_INS_STXP,
+)
+
+var arm64VectorTransfers = newInsnSet(
+	ARM64_INS_LD1, ARM64_INS_LD2, ARM64_INS_LD3, ARM64_INS_LD4, ARM64_INS_ST1,
+	ARM64_INS_ST2, ARM64_INS_ST3, ARM64_INS_ST4,
+)
+
+// Load and replicate: one element per register
+var arm64Replicates = newInsnSet(
+	ARM64_INS_LD1R, ARM64_INS_LD2R, ARM64_INS_LD3R, ARM64_INS_LD4R,
+)
+
+func (insn *Instruction) arm64MemSize(m *RegModel, idx int) (uint, int) {
+	ops := insn.Arm64.Operands
+	if size, ok := arm64MemSizes[insn.Id]; ok {
+		return size, 1
+	}
+	if arm64VectorTransfers[insn.Id] || arm64Replicates[insn.Id] {
+		var size uint
+		n := 0
+		for _, op := range ops[:idx] {
+			if op.Type != ARM64_OP_REG {
+				continue
+			}
+			n++
+			switch {
+			case op.Vess != ARM64_VESS_INVALID:
+				// ld1 {v0.s}[1], [x0]
+				size += arm64ElementBytes(op.Vess, op.Vas)
+			case arm64Replicates[insn.Id]:
+				size += arm64ElementBytes(ARM64_VESS_INVALID, op.Vas)
+			default:
+				size += arm64VasBytes(op.Vas)
+			}
+		}
+		return size, n
+	}
+	if idx == 0 || ops[idx-1].Type != ARM64_OP_REG {
+		return 0, 1
+	}
+	if insn.Id == ARM64_INS_LDPSW {
+		return 8, 2
+	}
+	size := regBytes(m, ops[idx-1].Reg)
+	if arm64Pairs[insn.Id] {
+		return 2 * size, 2
+	}
+	return size, 1
+}
+
+// Bytes in a whole vector register arrangement
+func arm64VasBytes(vas int) uint {
+	switch vas {
+	case ARM64_VAS_8B, ARM64_VAS_4H, ARM64_VAS_2S, ARM64_VAS_1D:
+		return 8
+	case ARM64_VAS_16B, ARM64_VAS_8H, ARM64_VAS_4S, ARM64_VAS_2D, ARM64_VAS_1Q:
+		return 16
+	}
+	return 0
+}
+
+// Bytes in one element, from the element size or else the arrangement
+func arm64ElementBytes(vess, vas int) uint {
+	switch vess {
+	case ARM64_VESS_B:
+		return 1
+	case ARM64_VESS_H:
+		return 2
+	case ARM64_VESS_S:
+		return 4
+	case ARM64_VESS_D:
+		return 8
+	}
+	switch vas {
+	case ARM64_VAS_8B, ARM64_VAS_16B:
+		return 1
+	case ARM64_VAS_4H, ARM64_VAS_8H:
+		return 2
+	case ARM64_VAS_2S, ARM64_VAS_4S:
+		return 4
+	case ARM64_VAS_1D, ARM64_VAS_2D:
+		return 8
+	case ARM64_VAS_1Q:
+		return 16
+	}
+	return 0
+}
+
+// MIPS
+
+var mipsMemSizes = map[uint]uint{
+	MIPS_INS_LB: 1, MIPS_INS_LBU: 1, MIPS_INS_LBUX: 1, MIPS_INS_SB: 1,
+
+	MIPS_INS_LH: 2, MIPS_INS_LHU: 2, MIPS_INS_LHX: 2, MIPS_INS_SH: 2,
+
+	MIPS_INS_LL: 4, MIPS_INS_LW: 4, MIPS_INS_LWC1: 4, MIPS_INS_LWC2: 4,
+	MIPS_INS_LWL: 4, MIPS_INS_LWR: 4, MIPS_INS_LWU: 4, MIPS_INS_LWX: 4,
+	MIPS_INS_LWXC1: 4, MIPS_INS_SC: 4, MIPS_INS_SW: 4, MIPS_INS_SWC1: 4,
+	MIPS_INS_SWC2: 4, MIPS_INS_SWL: 4, MIPS_INS_SWR: 4, MIPS_INS_SWXC1: 4,
+
+	MIPS_INS_LD: 8, MIPS_INS_LDC1: 8, MIPS_INS_LDC2: 8, MIPS_INS_LDL: 8,
+	MIPS_INS_LDR: 8, MIPS_INS_LDXC1: 8, MIPS_INS_LLD: 8, MIPS_INS_LUXC1: 8,
+	MIPS_INS_SCD: 8, MIPS_INS_SD: 8, MIPS_INS_SDC1: 8, MIPS_INS_SDC2: 8,
+	MIPS_INS_SDL: 8, MIPS_INS_SDR: 8, MIPS_INS_SDXC1: 8, MIPS_INS_SUXC1: 8,
+	MIPS_INS_LDC3: 8, MIPS_INS_SDC3: 8,
+
+	// MSA st.b / st.h / st.w / st.d. The ld.df forms share MIPS_INS_LD and
+	// report 8.
+	MIPS_INS_ST: 16,
+}
+
+// PPC
+
+// Only D form loads and stores have a memory operand. Indexed (X form)
+// instructions list rA and rB as registers, and are sized by ppcIndexedSizes.
+var ppcMemSizes = map[uint]uint{
+	PPC_INS_LBZ: 1, PPC_INS_LBZU: 1, PPC_INS_STB: 1, PPC_INS_STBU: 1,
+
+	PPC_INS_LHA: 2, PPC_INS_LHAU: 2, PPC_INS_LHZ: 2, PPC_INS_LHZU: 2,
+	PPC_INS_STH: 2, PPC_INS_STHU: 2,
+
+	PPC_INS_LFS: 4, PPC_INS_LFSU: 4, PPC_INS_LWA: 4, PPC_INS_LWZ: 4,
+	PPC_INS_LWZU: 4, PPC_INS_STFS: 4, PPC_INS_STFSU: 4, PPC_INS_STW: 4,
+	PPC_INS_STWU: 4,
+
+	PPC_INS_LD: 8, PPC_INS_LDU: 8, PPC_INS_LFD: 8, PPC_INS_LFDU: 8,
+	PPC_INS_STD: 8, PPC_INS_STDU: 8, PPC_INS_STFD: 8, PPC_INS_STFDU: 8,
+}
+
+var ppcIndexedSizes = map[uint]uint{
+	PPC_INS_LBZUX: 1, PPC_INS_LBZX: 1, PPC_INS_LVEBX: 1, PPC_INS_STBUX: 1,
+	PPC_INS_STBX: 1, PPC_INS_STVEBX: 1,
+
+	PPC_INS_LHAUX: 2, PPC_INS_LHAX: 2, PPC_INS_LHBRX: 2, PPC_INS_LHZUX: 2,
+	PPC_INS_LHZX: 2, PPC_INS_LVEHX: 2, PPC_INS_STHBRX: 2, PPC_INS_STHUX: 2,
+	PPC_INS_STHX: 2, PPC_INS_STVEHX: 2,
+
+	PPC_INS_LFIWAX: 4, PPC_INS_LFIWZX: 4, PPC_INS_LFSUX: 4, PPC_INS_LFSX: 4,
+	PPC_INS_LVEWX: 4, PPC_INS_LWARX: 4, PPC_INS_LWAUX: 4, PPC_INS_LWAX: 4,
+	PPC_INS_LWBRX: 4, PPC_INS_LWZUX: 4, PPC_INS_LWZX: 4, PPC_INS_STFIWX: 4,
+	PPC_INS_STFSUX: 4, PPC_INS_STFSX: 4, PPC_INS_STVEWX: 4, PPC_INS_STWBRX: 4,
+	PPC_INS_STWCX: 4, PPC_INS_STWUX: 4, PPC_INS_STWX: 4,
+
+	PPC_INS_LDARX: 8, PPC_INS_LDBRX: 8, PPC_INS_LDUX: 8, PPC_INS_LDX: 8,
+	PPC_INS_LFDUX: 8, PPC_INS_LFDX: 8, PPC_INS_LXSDX: 8, PPC_INS_LXVDSX: 8,
+	PPC_INS_STDBRX: 8, PPC_INS_STDCX: 8, PPC_INS_STDUX: 8, PPC_INS_STDX: 8,
+	PPC_INS_STFDUX: 8, PPC_INS_STFDX: 8, PPC_INS_STXSDX: 8,
+
+	PPC_INS_LVX: 16, PPC_INS_LVXL: 16, PPC_INS_LXVD2X: 16, PPC_INS_LXVW4X: 16,
+	PPC_INS_STVX: 16, PPC_INS_STVXL: 16, PPC_INS_STXVD2X: 16,
+	PPC_INS_STXVW4X: 16,
+}
+
+var ppcIndexedStores = newInsnSet(
+	PPC_INS_STBUX, PPC_INS_STBX, PPC_INS_STDBRX, PPC_INS_STDCX, PPC_INS_STDUX,
+	PPC_INS_STDX, PPC_INS_STFDUX, PPC_INS_STFDX, PPC_INS_STFIWX,
+	PPC_INS_STFSUX, PPC_INS_STFSX, PPC_INS_STHBRX, PPC_INS_STHUX,
+	PPC_INS_STHX, PPC_INS_STVEBX, PPC_INS_STVEHX, PPC_INS_STVEWX,
+	PPC_INS_STVX, PPC_INS_STVXL, PPC_INS_STWBRX, PPC_INS_STWCX, PPC_INS_STWUX,
+	PPC_INS_STWX, PPC_INS_STXSDX, PPC_INS_STXVD2X, PPC_INS_STXVW4X,
+)
+
+// Registers moved by lmw / stmw rS, d(rA)
+func ppcMultiple(ops []PPCOperand) int {
+	if len(ops) == 0 || ops[0].Type != PPC_OP_REG {
+		return 0
+	}
+	for i, r := range ppcR {
+		if r == ops[0].Reg {
+			return len(ppcR) - i
+		}
+	}
+	return 0
+}
+
+// SPARC
+
+var sparcMemSizes = map[uint]uint{
+	SPARC_INS_LDSB: 1, SPARC_INS_LDUB: 1, SPARC_INS_STB: 1,
+
+	SPARC_INS_LDSH: 2, SPARC_INS_LDUH: 2, SPARC_INS_STH: 2,
+
+	SPARC_INS_CAS: 4, SPARC_INS_LDSW: 4, SPARC_INS_SWAP: 4,
+
+	SPARC_INS_CASX: 8, SPARC_INS_LDX: 8, SPARC_INS_STX: 8,
+
+	SPARC_INS_LDQ: 16, SPARC_INS_STQ: 16,
+}
+
+// ld / st take their size from the transfer register, which comes first for
+// loads and last for stores
+func (insn *Instruction) sparcDataReg(idx int) uint {
+	ops := insn.Sparc.Operands
+	for i := range ops {
+		if i != idx && ops[i].Type == SPARC_OP_REG {
+			return ops[i].Reg
+		}
+	}
+	return SPARC_REG_INVALID
+}
+
+// SYSZ
+
+var syszByte = newInsnSet(
+	SYSZ_INS_CLI, SYSZ_INS_CLIY, SYSZ_INS_IC, SYSZ_INS_ICY, SYSZ_INS_LB,
+	SYSZ_INS_LBH, SYSZ_INS_LGB, SYSZ_INS_LLC, SYSZ_INS_LLCH, SYSZ_INS_LLGC,
+	SYSZ_INS_MVI, SYSZ_INS_MVIY, SYSZ_INS_NI, SYSZ_INS_NIY, SYSZ_INS_OI,
+	SYSZ_INS_OIY, SYSZ_INS_STC, SYSZ_INS_STCH, SYSZ_INS_STCY, SYSZ_INS_TM,
+	SYSZ_INS_TMY, SYSZ_INS_XI, SYSZ_INS_XIY,
+)
+
+var syszHalf = newInsnSet(
+	SYSZ_INS_AH, SYSZ_INS_AHY, SYSZ_INS_CGH, SYSZ_INS_CH, SYSZ_INS_CHHSI,
+	SYSZ_INS_CHY, SYSZ_INS_CLHHSI, SYSZ_INS_LGH, SYSZ_INS_LH, SYSZ_INS_LHH,
+	SYSZ_INS_LHY, SYSZ_INS_LLGH, SYSZ_INS_LLH, SYSZ_INS_LLHH, SYSZ_INS_MH,
+	SYSZ_INS_MHY, SYSZ_INS_MVHHI, SYSZ_INS_SH, SYSZ_INS_SHY, SYSZ_INS_STH,
+	SYSZ_INS_STHH, SYSZ_INS_STHY,
+)
+
+var syszDouble = newInsnSet(
+	SYSZ_INS_ADB, SYSZ_INS_AG, SYSZ_INS_AGSI, SYSZ_INS_ALCG, SYSZ_INS_ALG,
+	SYSZ_INS_CDB, SYSZ_INS_CG, SYSZ_INS_CGHSI, SYSZ_INS_CLG,
+	SYSZ_INS_CLGHSI, SYSZ_INS_CSG, SYSZ_INS_DDB, SYSZ_INS_DLG, SYSZ_INS_DSG,
+	SYSZ_INS_LAAG, SYSZ_INS_LAALG, SYSZ_INS_LANG, SYSZ_INS_LAOG,
+	SYSZ_INS_LAXG, SYSZ_INS_LD, SYSZ_INS_LDY, SYSZ_INS_LG, SYSZ_INS_LOCG,
+	SYSZ_INS_LRVG, SYSZ_INS_LTG, SYSZ_INS_LXDB, SYSZ_INS_MADB, SYSZ_INS_MDB,
+	SYSZ_INS_MLG, SYSZ_INS_MSDB, SYSZ_INS_MSG, SYSZ_INS_MVGHI, SYSZ_INS_MXDB,
+	SYSZ_INS_NG, SYSZ_INS_OG, SYSZ_INS_SDB, SYSZ_INS_SG, SYSZ_INS_SLBG,
+	SYSZ_INS_SLG, SYSZ_INS_SQDB, SYSZ_INS_STD, SYSZ_INS_STDY, SYSZ_INS_STG,
+	SYSZ_INS_STOCG, SYSZ_INS_STRVG, SYSZ_INS_XG,
+)
+
+// Storage to storage forms carry a length. Everything else not listed above
+// is a word: l, st, a, le, lgf...
+func (insn *Instruction) syszMemSize(idx int) (uint, int) {
+	ops := insn.SysZ.Operands
+	// mvc d1(l, b1), d2(b2) - the length applies to both operands
+	for _, op := range ops {
+		if op.Type == SYSZ_OP_MEM && op.Mem.Length != 0 {
+			return uint(op.Mem.Length), 1
+		}
+	}
+	switch {
+	case insn.Id == SYSZ_INS_LMG || insn.Id == SYSZ_INS_STMG:
+		// lmg r1, r3, d(b) wraps from r15 to r0. Capstone 3 doesn't decode the
+		// 32-bit lm / stm / lmy / stmy, which would move 4 bytes a register.
+		if len(ops) < 3 || ops[0].Type != SYSZ_OP_REG || ops[1].Type != SYSZ_OP_REG {
+			return 0, 1
+		}
+		n := int(syszRegNum(ops[1].Reg)-syszRegNum(ops[0].Reg)+16)%16 + 1
+		return 8 * uint(n), n
+	case syszByte[insn.Id]:
+		return 1, 1
+	case syszHalf[insn.Id]:
+		return 2, 1
+	case syszDouble[insn.Id]:
+		return 8, 1
+	}
+	return 4, 1
+}
+
+func syszRegNum(reg uint) int {
+	for i, r := range syszR {
+		if r == reg {
+			return i
+		}
+	}
+	return 0
+}
+
+// XCORE
+
+var xcoreMemSizes = map[uint]uint{
+	XCORE_INS_LD8U: 1, XCORE_INS_ST8: 1,
+	XCORE_INS_LD16S: 2, XCORE_INS_ST16: 2,
+	XCORE_INS_LDW: 4, XCORE_INS_STW: 4,
+}
@@ -0,0 +1,90 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"reflect"
+	"testing"
+)
+
+var memAccessTests = []struct {
+	arch int
+	mode uint
+	code string
+	insn string
+	want []MemoryAccess
+}{
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x8b\x44\x24\x08", "mov rax, qword ptr [rsp + 8]",
+		[]MemoryAccess{{1, AccessRead, 8, 1, true}}},
+	{CS_ARCH_X86, CS_MODE_64, "\x01\x03", "add dword ptr [rbx], eax",
+		[]MemoryAccess{{0, AccessReadWrite, 4, 1, false}}},
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x8d\x43\x08", "lea rax, [rbx + 8]", nil},
+	{CS_ARCH_X86, CS_MODE_64, "\x53", "push rbx",
+		[]MemoryAccess{{-1, AccessWrite, 8, 1, true}}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x01\x00\xc1\xe5", "strb r0, [r1, #1]",
+		[]MemoryAccess{{1, AccessWrite, 1, 1, false}}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x04\x00\x9d\xe5", "ldr r0, [sp, #4]",
+		[]MemoryAccess{{1, AccessRead, 4, 1, true}}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x30\x48\x20\xe9", "stmdb r0!, {r4, r5, r11, lr}",
+		[]MemoryAccess{{0, AccessWrite, 16, 4, false}}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x30\x48\x2d\xe9", "push {r4, r5, r11, lr}",
+		[]MemoryAccess{{-1, AccessWrite, 16, 4, true}}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x10\x80\xbd\xe8", "pop {r4, pc}",
+		[]MemoryAccess{{-1, AccessRead, 8, 2, true}}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\xfd\x7b\xc1\xa8", "ldp x29, x30, [sp], #0x10",
+		[]MemoryAccess{{2, AccessRead, 16, 2, true}}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x20\x00\x40\x79", "ldrh w0, [x1]",
+		[]MemoryAccess{{1, AccessRead, 2, 1, false}}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x00\xa0\x00\x4c", "st1 {v0.16b, v1.16b}, [x0]",
+		[]MemoryAccess{{2, AccessWrite, 32, 2, false}}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x00\x90\x40\x0d", "ld1 {v0.s}[1], [x0]",
+		[]MemoryAccess{{1, AccessRead, 4, 1, false}}},
+	{CS_ARCH_MIPS, CS_MODE_MIPS32 | CS_MODE_BIG_ENDIAN, "\x8f\xa8\x00\x04", "lw $t0, 4($sp)",
+		[]MemoryAccess{{1, AccessRead, 4, 1, true}}},
+	{CS_ARCH_MIPS, CS_MODE_MIPS32 | CS_MODE_BIG_ENDIAN, "\xe0\x88\x00\x00", "sc $t0, 0($a0)",
+		[]MemoryAccess{{1, AccessWrite, 4, 1, false}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\xbf\xa1\xff\xf4", "stmw r29, -12(r1)",
+		[]MemoryAccess{{1, AccessWrite, 12, 3, true}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x7c\x64\x28\x2e", "lwzx r3, r4, r5",
+		[]MemoryAccess{{1, AccessRead, 4, 1, false}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x7c\x64\x28\xae", "lbzx r3, r4, r5",
+		[]MemoryAccess{{1, AccessRead, 1, 1, false}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x7c\x64\x29\x2e", "stwx r3, r4, r5",
+		[]MemoryAccess{{1, AccessWrite, 4, 1, false}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x7c\x21\x01\x6a", "stdux r1, r1, r0",
+		[]MemoryAccess{{1, AccessWrite, 8, 1, true}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x7c\x24\x2c\xae", "lfdx f1, r4, r5",
+		[]MemoryAccess{{1, AccessRead, 8, 1, false}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x7c\x60\x28\x2e", "lwzx r3, 0, r5",
+		[]MemoryAccess{{1, AccessRead, 4, 1, false}}},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xd2\x07\x20\x00\x30\x00", "mvc 0(8, %r2), 0(%r3)",
+		[]MemoryAccess{{0, AccessWrite, 8, 1, false}, {1, AccessRead, 8, 1, false}}},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xeb\xef\xf0\x70\x00\x24", "stmg %r14, %r15, 112(%r15)",
+		[]MemoryAccess{{2, AccessWrite, 16, 2, true}}},
+}
+
+func TestMemoryAccesses(t *testing.T) {
+	for i, test := range memAccessTests {
+		got, err := disasmOne(t, test.arch, test.mode, test.code).MemoryAccesses()
+		if err != nil {
+			t.Errorf("%2d> %s: %v", i, test.insn, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%2d> %s: want %v got %v", i, test.insn, test.want, got)
+		}
+	}
+
+	insn := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_NOP, 0x1000, "\x90")
+	if _, err := insn.MemoryAccesses(); err != ErrDetail {
+		t.Errorf("Want ErrDetail got %v", err)
+	}
+}
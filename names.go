@@ -0,0 +1,148 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Name to ID tables for one arch, the inverse of InsnName, RegName and
+// GroupName. Keys are lower case.
+type nameTable struct {
+	insns  map[string]uint
+	regs   map[string]uint
+	groups map[string]uint
+}
+
+var (
+	nameTablesMu sync.Mutex
+	nameTables   = map[int]*nameTable{}
+)
+
+// The *_INS_ENDING, *_REG_ENDING and *_GRP_ENDING for each arch
+var nameEndings = map[int][3]uint{
+	CS_ARCH_ARM:   {ARM_INS_ENDING, ARM_REG_ENDING, ARM_GRP_ENDING},
+	CS_ARCH_ARM64: {ARM64_INS_ENDING, ARM64_REG_ENDING, ARM64_GRP_ENDING},
+	CS_ARCH_MIPS:  {MIPS_INS_ENDING, MIPS_REG_ENDING, MIPS_GRP_ENDING},
+	CS_ARCH_X86:   {X86_INS_ENDING, X86_REG_ENDING, X86_GRP_ENDING},
+	CS_ARCH_PPC:   {PPC_INS_ENDING, PPC_REG_ENDING, PPC_GRP_ENDING},
+	CS_ARCH_SPARC: {SPARC_INS_ENDING, SPARC_REG_ENDING, SPARC_GRP_ENDING},
+	CS_ARCH_SYSZ:  {SYSZ_INS_ENDING, SYSZ_REG_ENDING, SYSZ_GRP_ENDING},
+	CS_ARCH_XCORE: {XCORE_INS_ENDING, XCORE_REG_ENDING, XCORE_GRP_ENDING},
+}
+
+// The instruction ID for a mnemonic like "cmpxchg", the inverse of InsnName.
+// Case is ignored. ok is false for unknown names.
+//
+// WARNING: Always fails if capstone built with CAPSTONE_DIET
+func (e *Engine) InsnID(name string) (id uint, ok bool) {
+	id, ok = e.names().insns[strings.ToLower(name)]
+	return
+}
+
+// The register ID for a name like "r12", the inverse of RegName. Case and a
+// leading '%' or '$' are ignored, and common aliases are accepted: ARM sb, sl,
+// fp, ip and r13-r15, ARM64 fp, lr, ip0 and ip1, MIPS ABI names and $0-$31,
+// bare PPC register numbers as printed under CS_OPT_SYNTAX_NOREGNAME, and
+// SPARC %o6 / %i6.
+//
+// WARNING: Only the aliases resolve if capstone built with CAPSTONE_DIET
+func (e *Engine) RegID(name string) (id uint, ok bool) {
+	name = strings.TrimLeft(strings.ToLower(name), "%$")
+	id, ok = e.names().regs[name]
+	return
+}
+
+// The group ID for a name like "jump", the inverse of GroupName. Case is
+// ignored.
+//
+// WARNING: Always fails if capstone built with CAPSTONE_DIET
+func (e *Engine) GroupID(name string) (id uint, ok bool) {
+	id, ok = e.names().groups[strings.ToLower(name)]
+	return
+}
+
+// Tables are built once per arch, by enumerating every ID up to the ENDING
+// constants
+func (e *Engine) names() *nameTable {
+	nameTablesMu.Lock()
+	defer nameTablesMu.Unlock()
+
+	if t, ok := nameTables[e.arch]; ok {
+		return t
+	}
+	t := buildNameTable(e.arch, e.InsnName, e.RegName, e.GroupName)
+	nameTables[e.arch] = t
+	return t
+}
+
+func buildNameTable(arch int, insn, reg, group func(uint) string) *nameTable {
+	endings := nameEndings[arch]
+	t := &nameTable{
+		insns:  enumerateNames(endings[0], insn),
+		regs:   enumerateNames(endings[1], reg),
+		groups: enumerateNames(endings[2], group),
+	}
+	// Real names win over aliases
+	for name, id := range regAliases(arch) {
+		if _, ok := t.regs[name]; !ok {
+			t.regs[name] = id
+		}
+	}
+	return t
+}
+
+func enumerateNames(ending uint, name func(uint) string) map[string]uint {
+	m := make(map[string]uint)
+	for id := uint(1); id < ending; id++ {
+		if s := strings.ToLower(name(id)); s != "" {
+			if _, ok := m[s]; !ok {
+				m[s] = id
+			}
+		}
+	}
+	return m
+}
+
+var mipsABINames = [...]string{
+	"zero", "at", "v0", "v1", "a0", "a1", "a2", "a3",
+	"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7",
+	"s0", "s1", "s2", "s3", "s4", "s5", "s6", "s7",
+	"t8", "t9", "k0", "k1", "gp", "sp", "fp", "ra",
+}
+
+// Register aliases, with the leading '%' or '$' removed
+func regAliases(arch int) map[string]uint {
+	m := make(map[string]uint)
+	switch arch {
+	case CS_ARCH_ARM:
+		m["sb"], m["sl"], m["fp"], m["ip"] = ARM_REG_R9, ARM_REG_R10, ARM_REG_R11, ARM_REG_R12
+		m["r13"], m["r14"], m["r15"] = ARM_REG_SP, ARM_REG_LR, ARM_REG_PC
+	case CS_ARCH_ARM64:
+		m["fp"], m["lr"] = ARM64_REG_X29, ARM64_REG_X30
+		m["ip0"], m["ip1"] = ARM64_REG_X16, ARM64_REG_X17
+	case CS_ARCH_MIPS:
+		for i, r := range mipsGPR {
+			m[strconv.Itoa(i)] = r
+			m[mipsABINames[i]] = r
+		}
+		m["s8"] = MIPS_REG_30
+	case CS_ARCH_PPC:
+		for i, r := range ppcR {
+			m[strconv.Itoa(i)] = r
+		}
+	case CS_ARCH_SPARC:
+		m["o6"], m["i6"] = SPARC_REG_SP, SPARC_REG_FP
+	}
+	return m
+}
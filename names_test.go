@@ -0,0 +1,81 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+func TestBuildNameTable(t *testing.T) {
+	names := func(m map[uint]string) func(uint) string {
+		return func(id uint) string { return m[id] }
+	}
+	mips := buildNameTable(CS_ARCH_MIPS,
+		names(map[uint]string{MIPS_INS_ADDIU: "addiu"}),
+		names(map[uint]string{MIPS_REG_29: "sp"}),
+		names(map[uint]string{MIPS_GRP_JUMP: "jump"}),
+	)
+	ppc := buildNameTable(CS_ARCH_PPC, names(nil), names(map[uint]string{PPC_REG_R1: "r1"}), names(nil))
+
+	tests := []struct {
+		table map[string]uint
+		name  string
+		want  uint
+	}{
+		{mips.insns, "addiu", MIPS_INS_ADDIU},
+		{mips.regs, "sp", MIPS_REG_29},
+		{mips.regs, "zero", MIPS_REG_0},
+		{mips.regs, "0", MIPS_REG_0},
+		{mips.regs, "ra", MIPS_REG_31},
+		{mips.regs, "s8", MIPS_REG_30},
+		{mips.groups, "jump", MIPS_GRP_JUMP},
+		{ppc.regs, "r1", PPC_REG_R1},
+		{ppc.regs, "31", PPC_REG_R31},
+	}
+	for i, test := range tests {
+		if got, ok := test.table[test.name]; !ok || got != test.want {
+			t.Errorf("%2d> %s: want %v got %v (%v)", i, test.name, test.want, got, ok)
+		}
+	}
+	if _, ok := mips.insns["nope"]; ok {
+		t.Errorf("Unknown mnemonic resolved")
+	}
+}
+
+func TestNameLookupEngine(t *testing.T) {
+
+	engine, err := New(CS_ARCH_ARM, CS_MODE_ARM)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer engine.Close()
+
+	if dietMode {
+		t.Skip("names unavailable in diet mode")
+	}
+
+	if id, ok := engine.InsnID("LDREX"); !ok || id != ARM_INS_LDREX {
+		t.Errorf("ldrex: want %v got %v (%v)", ARM_INS_LDREX, id, ok)
+	}
+	for _, name := range []string{"r12", "ip", "%IP"} {
+		if id, ok := engine.RegID(name); !ok || id != ARM_REG_R12 {
+			t.Errorf("%s: want %v got %v (%v)", name, ARM_REG_R12, id, ok)
+		}
+	}
+	if id, ok := engine.GroupID("jump"); !ok || id != ARM_GRP_JUMP {
+		t.Errorf("jump: want %v got %v (%v)", ARM_GRP_JUMP, id, ok)
+	}
+	// Names aren't guaranteed unique, so compare them rather than IDs
+	for id := uint(1); id < ARM_INS_ENDING; id++ {
+		name := engine.InsnName(id)
+		if got, ok := engine.InsnID(name); !ok || engine.InsnName(got) != name {
+			t.Errorf("%s: round trip want %v got %v", name, id, got)
+		}
+	}
+}
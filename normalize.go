@@ -0,0 +1,433 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// How much of an instruction Normalize discards. Addresses are always made
+// relative to the instruction; the flags abstract further.
+type NormFlags uint
+
+const (
+	NormImmediates    NormFlags = 1 << iota // drop immediate values
+	NormDisplacements                       // drop memory displacements
+	NormRegisters                           // replace registers with their class and width
+	NormTargets                             // drop branch targets and PC-relative addresses
+
+	NormStrict NormFlags = 0
+	NormLoose            = NormImmediates | NormDisplacements | NormRegisters | NormTargets
+)
+
+// The kind of a NormOperand
+type NormKind int
+
+const (
+	NormReg    NormKind = iota // a register
+	NormImm                    // an immediate
+	NormMem                    // a memory reference
+	NormTarget                 // a branch target or PC-relative address, as an offset
+	NormOther                  // anything else (system registers, barriers, fp immediates ...)
+)
+
+// A register in a normalized operand. With NormRegisters, Reg is zero and
+// only the class and width remain - except for stack pointers, program
+// counters and zero registers, which keep their identity.
+type NormRegister struct {
+	Reg   uint
+	Class RegClass
+	Width uint
+}
+
+// One operand of a Normalized instruction
+type NormOperand struct {
+	Kind NormKind
+	Reg  NormRegister // NormReg
+
+	// NormImm value, NormTarget offset from the instruction address, or the
+	// raw NormOther value
+	Value int64
+
+	// NormMem
+	Segment NormRegister
+	Base    NormRegister
+	Index   NormRegister
+	Scale   int
+	Disp    int64
+	// Disp is the offset of a statically known address from the instruction
+	// address, rather than the encoded displacement
+	Relative bool
+
+	// Value or Disp was dropped by the NormFlags
+	Abstract bool
+
+	// Arch specific shift, extend, vector and length modifiers, compared
+	// verbatim
+	Modifier uint64
+}
+
+// An address independent canonical form of an Instruction, as returned by
+// Instruction.Normalize()
+type Normalized struct {
+	Arch     int
+	Id       uint
+	Mnemonic string
+	Operands []NormOperand
+
+	// Without detail there are no Operands, and the OpStr is used instead.
+	// The text of a known branch target is still made relative.
+	OpStr string
+}
+
+// Convert the instruction to a canonical form which doesn't depend on where
+// it was loaded:
+//
+//   - branch targets, PC-relative operands (x86 [rip + disp], ARM literal
+//     loads, ARM64 adr / adrp, SysZ larl) and absolute memory addresses are
+//     stored as offsets from the instruction address
+//   - NormImmediates, NormDisplacements and NormRegisters abstract the
+//     remaining operand values, NormTargets the relative offsets too
+//
+// Operands come from the detail, so without CS_OPT_DETAIL only the
+// mnemonic, OpStr and any direct branch target are available.
+func (insn Instruction) Normalize(flags NormFlags) Normalized {
+	n := Normalized{Arch: insn.archOf(), Id: insn.Id, Mnemonic: insn.Mnemonic}
+
+	arch, detail := detailArch(&insn)
+	if !detail {
+		n.OpStr = insn.OpStr
+		if f := insn.Flow(); f.HasTarget {
+			if flags&NormTargets != 0 {
+				n.OpStr = "<target>"
+			} else {
+				n.OpStr = fmt.Sprintf("<%+#x>", int64(f.Target-uint64(insn.Address)))
+			}
+		}
+		return n
+	}
+
+	ops := rawNormOperands(&insn)
+	statics := map[int]uint64{}
+	for _, ref := range insn.References() {
+		if ref.Operand >= 0 {
+			statics[ref.Operand] = ref.Address
+		}
+	}
+
+	for i := range ops {
+		op := &ops[i]
+		op.Reg = normReg(arch, op.Reg, flags)
+		op.Segment = normReg(arch, op.Segment, flags)
+		op.Base = normReg(arch, op.Base, flags)
+		op.Index = normReg(arch, op.Index, flags)
+
+		if addr, ok := statics[i]; ok {
+			off := int64(addr - uint64(insn.Address))
+			switch op.Kind {
+			case NormImm:
+				op.Kind, op.Value = NormTarget, off
+			case NormMem:
+				op.Disp, op.Relative = off, true
+			}
+			if flags&NormTargets != 0 {
+				op.Value, op.Disp, op.Abstract = 0, 0, true
+			}
+			continue
+		}
+
+		switch {
+		case op.Kind == NormImm && flags&NormImmediates != 0:
+			op.Value, op.Abstract = 0, true
+		case op.Kind == NormMem && flags&NormDisplacements != 0:
+			op.Disp, op.Abstract = 0, true
+		}
+	}
+	n.Operands = ops
+	return n
+}
+
+func normReg(arch int, r NormRegister, flags NormFlags) NormRegister {
+	if r.Reg == 0 {
+		return NormRegister{}
+	}
+	info, ok := RegInfo(arch, r.Reg)
+	if !ok {
+		return r
+	}
+	r.Class, r.Width = info.Class, info.Width
+	if flags&NormRegisters != 0 {
+		switch info.Role {
+		case RegRoleStackPointer, RegRoleProgramCounter, RegRoleZero:
+		default:
+			r.Reg = 0
+		}
+	}
+	return r
+}
+
+// The canonical text, eg "mov r35/0x8, [r41+@+0x107]/0x8" or, with
+// NormLoose, "mov general64/0x8, [r41+?]/0x8". Registers are shown by id,
+// not name, and operand modifiers follow a slash.
+func (n Normalized) String() string {
+	var b bytes.Buffer
+	b.WriteString(n.Mnemonic)
+	if n.Operands == nil {
+		if n.OpStr != "" {
+			b.WriteByte(' ')
+			b.WriteString(n.OpStr)
+		}
+		return b.String()
+	}
+	for i, op := range n.Operands {
+		if i == 0 {
+			b.WriteByte(' ')
+		} else {
+			b.WriteString(", ")
+		}
+		switch op.Kind {
+		case NormReg:
+			b.WriteString(op.Reg.String())
+		case NormImm:
+			if op.Abstract {
+				b.WriteString("#?")
+			} else {
+				fmt.Fprintf(&b, "#%#x", op.Value)
+			}
+		case NormTarget:
+			if op.Abstract {
+				b.WriteString("<?>")
+			} else {
+				fmt.Fprintf(&b, "<%+#x>", op.Value)
+			}
+		case NormMem:
+			b.WriteByte('[')
+			if op.Segment.Reg != 0 || op.Segment.Class != RegClassInvalid {
+				b.WriteString(op.Segment.String())
+				b.WriteByte(':')
+			}
+			if op.Base != (NormRegister{}) {
+				b.WriteString(op.Base.String())
+				b.WriteByte('+')
+			}
+			if op.Index != (NormRegister{}) {
+				fmt.Fprintf(&b, "%s*%d+", op.Index, op.Scale)
+			}
+			switch {
+			case op.Abstract:
+				b.WriteByte('?')
+			case op.Relative:
+				fmt.Fprintf(&b, "@%+#x", op.Disp)
+			default:
+				fmt.Fprintf(&b, "%#x", op.Disp)
+			}
+			b.WriteByte(']')
+		default:
+			fmt.Fprintf(&b, "{%#x}", op.Value)
+		}
+		if op.Modifier != 0 {
+			fmt.Fprintf(&b, "/%#x", op.Modifier)
+		}
+	}
+	return b.String()
+}
+
+func (r NormRegister) String() string {
+	if r.Reg != 0 {
+		return fmt.Sprintf("r%d", r.Reg)
+	}
+	return fmt.Sprintf("%s%d", r.Class, r.Width)
+}
+
+// Whether two normalized instructions are the same
+func (n Normalized) Equal(o Normalized) bool {
+	return n.Arch == o.Arch && n.Id == o.Id && n.String() == o.String()
+}
+
+// A 64-bit FNV-1a hash of the canonical form. Equal instructions hash
+// equally.
+func (n Normalized) Hash() uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d/%d/%s", n.Arch, n.Id, n.String())
+	return h.Sum64()
+}
+
+// Compare two instructions after normalizing both with flags
+func NormEqual(a, b Instruction, flags NormFlags) bool {
+	return a.Normalize(flags).Equal(b.Normalize(flags))
+}
+
+// Hash an instruction after normalizing it with flags
+func NormHash(insn Instruction, flags NormFlags) uint64 {
+	return insn.Normalize(flags).Hash()
+}
+
+// Per arch operand conversion, before any normalization
+
+func normRegOp(reg uint) NormOperand {
+	return NormOperand{Kind: NormReg, Reg: NormRegister{Reg: reg}}
+}
+
+func normImmOp(imm int64) NormOperand {
+	return NormOperand{Kind: NormImm, Value: imm}
+}
+
+func normMemOp(base, index uint, scale int, disp int64) NormOperand {
+	return NormOperand{Kind: NormMem, Base: NormRegister{Reg: base}, Index: NormRegister{Reg: index}, Scale: scale, Disp: disp}
+}
+
+func normOtherOp(v int64) NormOperand {
+	return NormOperand{Kind: NormOther, Value: v}
+}
+
+func rawNormOperands(insn *Instruction) []NormOperand {
+	var ops []NormOperand
+	switch {
+	case insn.X86 != nil:
+		for _, op := range insn.X86.Operands {
+			var n NormOperand
+			switch op.Type {
+			case X86_OP_REG:
+				n = normRegOp(op.Reg)
+			case X86_OP_IMM:
+				n = normImmOp(op.Imm)
+			case X86_OP_MEM:
+				n = normMemOp(op.Mem.Base, op.Mem.Index, op.Mem.Scale, op.Mem.Disp)
+				n.Segment = NormRegister{Reg: op.Mem.Segment}
+			default:
+				n = normOtherOp(int64(math.Float64bits(op.FP)))
+			}
+			n.Modifier = uint64(op.Size) | uint64(op.AvxBcast)<<8
+			ops = append(ops, n)
+		}
+	case insn.Arm != nil:
+		for _, op := range insn.Arm.Operands {
+			var n NormOperand
+			switch op.Type {
+			case ARM_OP_REG:
+				n = normRegOp(op.Reg)
+			case ARM_OP_IMM:
+				n = normImmOp(int64(op.Imm))
+			case ARM_OP_MEM:
+				n = normMemOp(op.Mem.Base, op.Mem.Index, op.Mem.Scale, int64(op.Mem.Disp))
+			case ARM_OP_FP:
+				n = normOtherOp(int64(math.Float64bits(op.FP)))
+			case ARM_OP_SETEND:
+				n = normOtherOp(int64(op.Setend))
+			case ARM_OP_SYSREG:
+				n = normOtherOp(int64(op.Reg))
+			default:
+				// coprocessor registers and immediates
+				n = normOtherOp(int64(op.Imm))
+			}
+			n.Modifier = uint64(op.Shift.Type)<<8 | uint64(op.Shift.Value)
+			if op.Subtracted {
+				n.Modifier |= 1 << 16
+			}
+			ops = append(ops, n)
+		}
+	case insn.Arm64 != nil:
+		for _, op := range insn.Arm64.Operands {
+			var n NormOperand
+			switch op.Type {
+			case ARM64_OP_REG:
+				n = normRegOp(op.Reg)
+			case ARM64_OP_IMM, ARM64_OP_CIMM:
+				n = normImmOp(op.Imm)
+			case ARM64_OP_MEM:
+				n = normMemOp(op.Mem.Base, op.Mem.Index, 1, int64(op.Mem.Disp))
+			case ARM64_OP_FP:
+				n = normOtherOp(int64(math.Float64bits(op.FP)))
+			case ARM64_OP_REG_MRS, ARM64_OP_REG_MSR:
+				n = normOtherOp(int64(op.Reg))
+			case ARM64_OP_PSTATE:
+				n = normOtherOp(int64(op.PState))
+			case ARM64_OP_SYS:
+				n = normOtherOp(int64(op.Sys))
+			case ARM64_OP_PREFETCH:
+				n = normOtherOp(int64(op.Prefetch))
+			default:
+				n = normOtherOp(int64(op.Barrier))
+			}
+			n.Modifier = uint64(op.Shift.Type)<<8 | uint64(op.Shift.Value) | uint64(op.Ext)<<16 |
+				uint64(op.Vas)<<24 | uint64(op.Vess)<<32 | uint64(op.VectorIndex+1)<<40
+			ops = append(ops, n)
+		}
+	case insn.Mips != nil:
+		for _, op := range insn.Mips.Operands {
+			switch op.Type {
+			case MIPS_OP_REG:
+				ops = append(ops, normRegOp(op.Reg))
+			case MIPS_OP_IMM:
+				ops = append(ops, normImmOp(op.Imm))
+			default:
+				ops = append(ops, normMemOp(op.Mem.Base, 0, 1, op.Mem.Disp))
+			}
+		}
+	case insn.PPC != nil:
+		for _, op := range insn.PPC.Operands {
+			switch op.Type {
+			case PPC_OP_REG:
+				ops = append(ops, normRegOp(op.Reg))
+			case PPC_OP_IMM:
+				ops = append(ops, normImmOp(int64(op.Imm)))
+			case PPC_OP_MEM:
+				ops = append(ops, normMemOp(op.Mem.Base, 0, 1, int64(op.Mem.Disp)))
+			default:
+				ops = append(ops, normOtherOp(int64(op.CRX.Scale)<<32|int64(op.CRX.Reg)<<16|int64(op.CRX.Cond)))
+			}
+		}
+	case insn.Sparc != nil:
+		for _, op := range insn.Sparc.Operands {
+			switch op.Type {
+			case SPARC_OP_REG:
+				ops = append(ops, normRegOp(op.Reg))
+			case SPARC_OP_IMM:
+				ops = append(ops, normImmOp(int64(op.Imm)))
+			default:
+				ops = append(ops, normMemOp(uint(op.Mem.Base), uint(op.Mem.Index), 1, int64(op.Mem.Disp)))
+			}
+		}
+	case insn.SysZ != nil:
+		for _, op := range insn.SysZ.Operands {
+			switch op.Type {
+			case SYSZ_OP_REG:
+				ops = append(ops, normRegOp(op.Reg))
+			case SYSZ_OP_IMM:
+				ops = append(ops, normImmOp(op.Imm))
+			case SYSZ_OP_MEM:
+				n := normMemOp(uint(op.Mem.Base), uint(op.Mem.Index), 1, op.Mem.Disp)
+				n.Modifier = op.Mem.Length
+				ops = append(ops, n)
+			default:
+				// access registers
+				ops = append(ops, normOtherOp(int64(op.Reg)))
+			}
+		}
+	case insn.Xcore != nil:
+		for _, op := range insn.Xcore.Operands {
+			switch op.Type {
+			case XCORE_OP_REG:
+				ops = append(ops, normRegOp(op.Reg))
+			case XCORE_OP_IMM:
+				ops = append(ops, normImmOp(int64(op.Imm)))
+			default:
+				n := normMemOp(uint(op.Mem.Base), uint(op.Mem.Index), 1, int64(op.Mem.Disp))
+				n.Modifier = uint64(int64(op.Mem.Direct))
+				ops = append(ops, n)
+			}
+		}
+	}
+	return ops
+}
@@ -0,0 +1,163 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Disassemble the last instruction in code, with detail, at addr
+func disasmAt(t *testing.T, arch int, mode uint, code string, addr uint64) Instruction {
+	engine, err := New(arch, mode)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer engine.Close()
+	engine.SetOption(CS_OPT_DETAIL, CS_OPT_ON)
+
+	insns, err := engine.Disasm([]byte(code), addr, 0)
+	if err != nil || len(insns) == 0 {
+		t.Fatalf("Disassembly error: %v", err)
+	}
+	return insns[len(insns)-1]
+}
+
+func TestNormalize(t *testing.T) {
+	// call 0x2000 at 0x1000, the same call moved to 0x5000, and a call to 0x3000
+	call1 := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_CALL, 0x1000, "\xe8\xfb\x0f\x00\x00")
+	call1.Mnemonic, call1.OpStr = "call", "0x2000"
+	call2 := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_CALL, 0x5000, "\xe8\xfb\x0f\x00\x00")
+	call2.Mnemonic, call2.OpStr = "call", "0x6000"
+	call3 := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_CALL, 0x1000, "\xe8\xfb\x1f\x00\x00")
+	call3.Mnemonic, call3.OpStr = "call", "0x3000"
+
+	tests := []struct {
+		comment string
+		a, b    Instruction
+		flags   NormFlags
+		want    bool
+	}{
+		{"moved call", call1, call2, NormStrict, true},
+		{"different call", call1, call3, NormStrict, false},
+		{"different call, targets dropped", call1, call3, NormTargets, true},
+	}
+
+	for i, test := range tests {
+		if got := NormEqual(test.a, test.b, test.flags); got != test.want {
+			t.Errorf("%2d> %s: want %v got %v (%s / %s)", i, test.comment, test.want, got,
+				test.a.Normalize(test.flags), test.b.Normalize(test.flags))
+		}
+	}
+
+	if got := call1.Normalize(NormStrict).String(); got != "call <+0x1000>" {
+		t.Errorf("Want call <+0x1000> got %v", got)
+	}
+}
+
+type normTest struct {
+	comment string
+	arch    int
+	mode    uint
+	a, b    string
+	addrB   uint64
+	flags   NormFlags
+	want    bool
+}
+
+// a is disassembled at 0x1000 and b at addrB
+var normTests = []normTest{
+	{"moved rip load", CS_ARCH_X86, CS_MODE_64,
+		"\x48\x8b\x05\x00\x01\x00\x00", "\x48\x8b\x05\x00\x01\x00\x00", 0x8000, NormStrict, true},
+	{"different immediates", CS_ARCH_X86, CS_MODE_64,
+		"\x48\xc7\xc0\x05\x00\x00\x00", "\x48\xc7\xc0\x06\x00\x00\x00", 0x1000, NormStrict, false},
+	{"immediates dropped", CS_ARCH_X86, CS_MODE_64,
+		"\x48\xc7\xc0\x05\x00\x00\x00", "\x48\xc7\xc0\x06\x00\x00\x00", 0x1000, NormImmediates, true},
+	{"registers renamed", CS_ARCH_X86, CS_MODE_64,
+		"\x48\x89\xd8", "\x48\x89\xd1", 0x1000, NormRegisters, true},
+	{"registers renamed, different widths", CS_ARCH_X86, CS_MODE_64,
+		"\x48\x89\xd8", "\x89\xd8", 0x1000, NormRegisters, false},
+	{"stack pointer keeps its identity", CS_ARCH_X86, CS_MODE_64,
+		"\x48\x89\xdc", "\x48\x89\xd9", 0x1000, NormRegisters, false},
+	{"different instructions", CS_ARCH_X86, CS_MODE_64,
+		"\x48\x89\xd8", "\x48\x01\xd8", 0x1000, NormLoose, false},
+	{"moved ldr r0, [pc, #4]", CS_ARCH_ARM, CS_MODE_ARM,
+		"\x04\x00\x9f\xe5", "\x04\x00\x9f\xe5", 0x8000, NormStrict, true},
+	{"moved thumb ldr r0, [pc, #8]", CS_ARCH_ARM, CS_MODE_THUMB,
+		"\x02\x48", "\x02\x48", 0x8000, NormStrict, true},
+	// pc is word aligned, so the literal is 0xa past 0x1002 but 0xc past 0x8000
+	{"thumb ldr r0, [pc, #8] at a different alignment", CS_ARCH_ARM, CS_MODE_THUMB,
+		"\x00\xbf\x02\x48", "\x02\x48", 0x8000, NormStrict, false},
+	{"moved adrp x0, #0x2000", CS_ARCH_ARM64, CS_MODE_ARM,
+		"\x00\x00\x00\xb0", "\x00\x00\x00\xb0", 0x8000, NormStrict, true},
+	{"moved ldr x0, #0x1010", CS_ARCH_ARM64, CS_MODE_ARM,
+		"\x80\x00\x00\x58", "\x80\x00\x00\x58", 0x8000, NormStrict, true},
+	{"moved strl %r1, 0x1010", CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN,
+		"\xc4\x1f\x00\x00\x00\x08", "\xc4\x1f\x00\x00\x00\x08", 0x8000, NormStrict, true},
+}
+
+type normOffsetTest struct {
+	arch    int
+	mode    uint
+	code    string
+	insn    string
+	operand int
+	want    int64
+}
+
+// The offset, from the instruction, that Normalize gives a PC-relative operand
+var normOffsetTests = []normOffsetTest{
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x8b\x05\x00\x01\x00\x00", "mov rax, qword ptr [rip + 0x100]", 1, 0x107},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x04\x00\x9f\xe5", "ldr r0, [pc, #4]", 1, 0xc},
+	{CS_ARCH_ARM, CS_MODE_THUMB, "\x00\xbf\x02\x48", "ldr r0, [pc, #8] at 0x1002", 1, 0xa},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x00\x00\x00\xb0", "adrp x0, #0x2000", 1, 0x1000},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x80\x00\x00\x58", "ldr x0, #0x1010", 1, 0x10},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xc4\x1f\x00\x00\x00\x08", "strl %r1, 0x1010", 1, 0x10},
+}
+
+func TestNormalizeEngine(t *testing.T) {
+	for i, test := range normTests {
+		a := disasmAt(t, test.arch, test.mode, test.a, address)
+		b := disasmAt(t, test.arch, test.mode, test.b, test.addrB)
+		if got := NormEqual(a, b, test.flags); got != test.want {
+			t.Errorf("%2d> %s: want %v got %v (%s / %s)", i, test.comment, test.want, got,
+				a.Normalize(test.flags), b.Normalize(test.flags))
+		}
+		if test.want && NormHash(a, test.flags) != NormHash(b, test.flags) {
+			t.Errorf("%2d> %s: equal instructions hash differently", i, test.comment)
+		}
+	}
+
+	for i, test := range normOffsetTests {
+		n := disasmLast(t, test.arch, test.mode, test.code).Normalize(NormStrict)
+		if test.operand >= len(n.Operands) {
+			t.Errorf("%2d> %s: no operand %d in %s", i, test.insn, test.operand, n)
+			continue
+		}
+		op := n.Operands[test.operand]
+		switch {
+		case op.Kind == NormTarget && op.Value == test.want:
+		case op.Kind == NormMem && op.Relative && op.Disp == test.want:
+		default:
+			t.Errorf("%2d> %s: want offset %+#x got %s", i, test.insn, test.want, n)
+		}
+	}
+
+	load := disasmLast(t, CS_ARCH_X86, CS_MODE_64, "\x48\x8b\x05\x00\x01\x00\x00")
+	want := fmt.Sprintf("mov r%d/0x8, [r%d+@+0x107]/0x8", X86_REG_RAX, X86_REG_RIP)
+	if got := load.Normalize(NormStrict).String(); got != want {
+		t.Errorf("Want %v got %v", want, got)
+	}
+	want = fmt.Sprintf("mov general64/0x8, [r%d+?]/0x8", X86_REG_RIP)
+	if got := load.Normalize(NormLoose).String(); got != want {
+		t.Errorf("Want %v got %v", want, got)
+	}
+}
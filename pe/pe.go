@@ -0,0 +1,471 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+// Package pe opens Windows PE images with debug/pe, maps their sections at
+// image base + RVA and disassembles them with an Engine configured from the
+// COFF machine field. Import address table slots are resolved to DLL!name so
+// that indirect calls through the IAT can be annotated.
+package pe
+
+import (
+	"bytes"
+	dpe "debug/pe"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+var (
+	ErrMachine   = errors.New("gapstone/pe: unsupported machine")
+	ErrNoSection = errors.New("gapstone/pe: no such section")
+	ErrNoSymbol  = errors.New("gapstone/pe: no such symbol")
+	ErrNoData    = errors.New("gapstone/pe: no file data at address")
+)
+
+// A PE image with an Engine set up for its machine. The Engine has
+// CS_OPT_DETAIL turned on.
+type File struct {
+	*dpe.File
+	Engine    gapstone.Engine
+	Arch      int
+	Mode      uint
+	ImageBase uint64
+	// Import address table slots, by virtual address, as "KERNEL32!CreateFileW"
+	// or "WS2_32!#23" for imports by ordinal. Delay load imports are included.
+	Imports  map[uint64]string
+	sections []Segment
+	closer   io.Closer
+}
+
+// A section mapped at its virtual address
+type Segment struct {
+	Name            string
+	Addr            uint64 // Virtual address, image base + RVA
+	Data            []byte // File contents, trimmed to the virtual size
+	Characteristics uint32
+}
+
+// An exported symbol. Forwarded exports have no Addr.
+type Export struct {
+	Name    string // empty for exports by ordinal only
+	Ordinal uint32
+	Addr    uint64
+	Forward string // eg "NTDLL.RtlAllocateHeap"
+}
+
+// Open the named file and create an Engine for it. Call Close when done.
+func Open(name string) (*File, error) {
+	fh, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := NewFile(fh)
+	if err != nil {
+		fh.Close()
+		return nil, err
+	}
+	f.closer = fh
+	return f, nil
+}
+
+// Parse a PE image from r and create an Engine for it. Call Close when done.
+func NewFile(r io.ReaderAt) (*File, error) {
+	pf, err := dpe.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+	f, err := load(pf)
+	if err != nil {
+		return nil, err
+	}
+	f.Engine, err = gapstone.New(f.Arch, f.Mode)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Engine.SetOption(gapstone.CS_OPT_DETAIL, gapstone.CS_OPT_ON); err != nil {
+		f.Engine.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// Close the Engine, and the underlying file if it was opened with Open.
+func (f *File) Close() error {
+	err := f.Engine.Close()
+	if f.closer != nil {
+		if cerr := f.closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Everything except the Engine
+func load(pf *dpe.File) (*File, error) {
+	arch, mode, err := Target(pf.Machine)
+	if err != nil {
+		return nil, err
+	}
+	f := &File{File: pf, Arch: arch, Mode: mode, Imports: make(map[uint64]string)}
+	switch oh := pf.OptionalHeader.(type) {
+	case *dpe.OptionalHeader32:
+		f.ImageBase = uint64(oh.ImageBase)
+	case *dpe.OptionalHeader64:
+		f.ImageBase = oh.ImageBase
+	default:
+		return nil, ErrNoData
+	}
+
+	for _, s := range pf.Sections {
+		data, err := s.Data()
+		if err != nil {
+			return nil, err
+		}
+		if s.VirtualSize != 0 && int64(s.VirtualSize) < int64(len(data)) {
+			data = data[:s.VirtualSize]
+		}
+		f.sections = append(f.sections, Segment{
+			Name:            s.Name,
+			Addr:            f.ImageBase + uint64(s.VirtualAddress),
+			Data:            data,
+			Characteristics: s.Characteristics,
+		})
+	}
+	f.readImports()
+	f.readDelayImports()
+	return f, nil
+}
+
+// Choose the CS_ARCH_* and CS_MODE_* for a COFF machine type. ARMNT images
+// are Thumb-2.
+func Target(machine uint16) (arch int, mode uint, err error) {
+	switch machine {
+	case dpe.IMAGE_FILE_MACHINE_I386:
+		return gapstone.CS_ARCH_X86, gapstone.CS_MODE_32, nil
+	case dpe.IMAGE_FILE_MACHINE_AMD64:
+		return gapstone.CS_ARCH_X86, gapstone.CS_MODE_64, nil
+	case dpe.IMAGE_FILE_MACHINE_ARM:
+		return gapstone.CS_ARCH_ARM, gapstone.CS_MODE_ARM, nil
+	case dpe.IMAGE_FILE_MACHINE_ARMNT, dpe.IMAGE_FILE_MACHINE_THUMB:
+		return gapstone.CS_ARCH_ARM, gapstone.CS_MODE_THUMB, nil
+	case dpe.IMAGE_FILE_MACHINE_ARM64:
+		return gapstone.CS_ARCH_ARM64, gapstone.CS_MODE_ARM, nil
+	}
+	return 0, 0, ErrMachine
+}
+
+func (f *File) pe64() bool {
+	_, ok := f.OptionalHeader.(*dpe.OptionalHeader64)
+	return ok
+}
+
+func (f *File) dataDirectory(idx int) dpe.DataDirectory {
+	switch oh := f.OptionalHeader.(type) {
+	case *dpe.OptionalHeader32:
+		if uint32(idx) < oh.NumberOfRvaAndSizes {
+			return oh.DataDirectory[idx]
+		}
+	case *dpe.OptionalHeader64:
+		if uint32(idx) < oh.NumberOfRvaAndSizes {
+			return oh.DataDirectory[idx]
+		}
+	}
+	return dpe.DataDirectory{}
+}
+
+// The virtual address of the entry point, or 0 if there isn't one (eg a
+// resource only DLL)
+func (f *File) Entry() uint64 {
+	var rva uint32
+	switch oh := f.OptionalHeader.(type) {
+	case *dpe.OptionalHeader32:
+		rva = oh.AddressOfEntryPoint
+	case *dpe.OptionalHeader64:
+		rva = oh.AddressOfEntryPoint
+	}
+	if rva == 0 {
+		return 0
+	}
+	return f.ImageBase + uint64(rva)
+}
+
+// Returns the file backed bytes from va to the end of its section, or nil
+func (f *File) ReadVA(va uint64) []byte {
+	for _, s := range f.sections {
+		if va >= s.Addr && va < s.Addr+uint64(len(s.Data)) {
+			return s.Data[va-s.Addr:]
+		}
+	}
+	return nil
+}
+
+func (f *File) rva(rva uint32) []byte {
+	return f.ReadVA(f.ImageBase + uint64(rva))
+}
+
+func (f *File) rvaString(rva uint32) string {
+	b := f.rva(rva)
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+// "KERNEL32.dll" -> "KERNEL32"
+func dllName(name string) string {
+	if i := strings.LastIndexByte(name, '.'); i > 0 {
+		switch strings.ToLower(name[i:]) {
+		case ".dll", ".exe", ".sys", ".drv", ".ocx", ".cpl":
+			return name[:i]
+		}
+	}
+	return name
+}
+
+// Walk one import lookup table, naming the matching IAT slots
+func (f *File) readThunks(dll string, ilt, iat uint32) {
+	size, ordFlag := uint32(4), uint64(1)<<31
+	if f.pe64() {
+		size, ordFlag = 8, uint64(1)<<63
+	}
+	if ilt == 0 {
+		ilt = iat
+	}
+	for i := uint32(0); ; i++ {
+		b := f.rva(ilt + i*size)
+		if uint32(len(b)) < size {
+			return
+		}
+		var thunk uint64
+		if size == 8 {
+			thunk = binary.LittleEndian.Uint64(b)
+		} else {
+			thunk = uint64(binary.LittleEndian.Uint32(b))
+		}
+		if thunk == 0 {
+			return
+		}
+		var name string
+		if thunk&ordFlag != 0 {
+			name = "#" + strconv.FormatUint(thunk&0xffff, 10)
+		} else {
+			// skip the hint
+			name = f.rvaString(uint32(thunk) + 2)
+		}
+		f.Imports[f.ImageBase+uint64(iat+i*size)] = dll + "!" + name
+	}
+}
+
+// IMAGE_IMPORT_DESCRIPTOR
+func (f *File) readImports() {
+	dir := f.dataDirectory(dpe.IMAGE_DIRECTORY_ENTRY_IMPORT)
+	if dir.VirtualAddress == 0 {
+		return
+	}
+	for off := dir.VirtualAddress; ; off += 20 {
+		b := f.rva(off)
+		if len(b) < 20 {
+			return
+		}
+		ilt := binary.LittleEndian.Uint32(b)
+		name := binary.LittleEndian.Uint32(b[12:])
+		iat := binary.LittleEndian.Uint32(b[16:])
+		if iat == 0 && name == 0 {
+			return
+		}
+		f.readThunks(dllName(f.rvaString(name)), ilt, iat)
+	}
+}
+
+// IMAGE_DELAYLOAD_DESCRIPTOR. Version 1 descriptors hold VAs, not RVAs.
+func (f *File) readDelayImports() {
+	dir := f.dataDirectory(dpe.IMAGE_DIRECTORY_ENTRY_DELAY_IMPORT)
+	if dir.VirtualAddress == 0 {
+		return
+	}
+	for off := dir.VirtualAddress; ; off += 32 {
+		b := f.rva(off)
+		if len(b) < 32 {
+			return
+		}
+		attrs := binary.LittleEndian.Uint32(b)
+		name := binary.LittleEndian.Uint32(b[4:])
+		iat := binary.LittleEndian.Uint32(b[12:])
+		ilt := binary.LittleEndian.Uint32(b[16:])
+		if name == 0 {
+			return
+		}
+		if attrs&1 == 0 {
+			base := uint32(f.ImageBase)
+			name, iat, ilt = name-base, iat-base, ilt-base
+		}
+		f.readThunks(dllName(f.rvaString(name)), ilt, iat)
+	}
+}
+
+// The exported symbols, in ordinal order
+func (f *File) Exports() []Export {
+	dir := f.dataDirectory(dpe.IMAGE_DIRECTORY_ENTRY_EXPORT)
+	b := f.rva(dir.VirtualAddress)
+	if dir.VirtualAddress == 0 || len(b) < 40 {
+		return nil
+	}
+	base := binary.LittleEndian.Uint32(b[16:])
+	nfuncs := binary.LittleEndian.Uint32(b[20:])
+	nnames := binary.LittleEndian.Uint32(b[24:])
+	funcs := f.rva(binary.LittleEndian.Uint32(b[28:]))
+	names := f.rva(binary.LittleEndian.Uint32(b[32:]))
+	ordinals := f.rva(binary.LittleEndian.Uint32(b[36:]))
+
+	if uint64(len(funcs)) < 4*uint64(nfuncs) {
+		nfuncs = uint32(len(funcs) / 4)
+	}
+	exports := make([]Export, nfuncs)
+	for i := range exports {
+		rva := binary.LittleEndian.Uint32(funcs[4*i:])
+		exports[i].Ordinal = base + uint32(i)
+		switch {
+		case rva == 0:
+		case rva >= dir.VirtualAddress && rva < dir.VirtualAddress+dir.Size:
+			exports[i].Forward = f.rvaString(rva)
+		default:
+			exports[i].Addr = f.ImageBase + uint64(rva)
+		}
+	}
+	for i := uint32(0); i < nnames; i++ {
+		if len(names) < int(4*i+4) || len(ordinals) < int(2*i+2) {
+			break
+		}
+		idx := binary.LittleEndian.Uint16(ordinals[2*i:])
+		if uint32(idx) < nfuncs {
+			exports[idx].Name = f.rvaString(binary.LittleEndian.Uint32(names[4*i:]))
+		}
+	}
+
+	// Drop unused ordinals
+	out := exports[:0]
+	for _, e := range exports {
+		if e.Addr != 0 || e.Forward != "" {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// The IAT slot referenced by an instruction, eg KERNEL32!CreateFileW for
+// call qword ptr [rip + 0x2f12]. Requires CS_OPT_DETAIL for anything but
+// direct branches.
+func (f *File) Import(insn gapstone.Instruction) (string, bool) {
+	for _, ref := range insn.References() {
+		if name, ok := f.Imports[ref.Address]; ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// The sections with IMAGE_SCN_MEM_EXECUTE or IMAGE_SCN_CNT_CODE, in section
+// table order
+func (f *File) ExecSegments() []Segment {
+	var segs []Segment
+	for _, s := range f.sections {
+		if s.Characteristics&(dpe.IMAGE_SCN_MEM_EXECUTE|dpe.IMAGE_SCN_CNT_CODE) != 0 && len(s.Data) > 0 {
+			segs = append(segs, s)
+		}
+	}
+	return segs
+}
+
+// Disassemble a segment from ExecSegments at its virtual address.
+func (f *File) DisasmSegment(s Segment) ([]gapstone.Instruction, error) {
+	if len(s.Data) == 0 {
+		return nil, nil
+	}
+	return f.Engine.Disasm(s.Data, s.Addr, 0)
+}
+
+// Disassemble a section by name, eg ".text", at its virtual address.
+func (f *File) DisasmSection(name string) ([]gapstone.Instruction, error) {
+	for _, s := range f.sections {
+		if s.Name == name {
+			if len(s.Data) == 0 {
+				return nil, ErrNoData
+			}
+			return f.DisasmSegment(s)
+		}
+	}
+	return nil, ErrNoSection
+}
+
+// Disassemble up to count instructions at va, 0 for the rest of the section.
+// Use f.Entry() to disassemble from the entry point.
+func (f *File) DisasmAt(va, count uint64) ([]gapstone.Instruction, error) {
+	data := f.ReadVA(va)
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	return f.Engine.Disasm(data, va, count)
+}
+
+// Disassemble a function by name, from the exports or the COFF symbol table.
+// PE symbols have no size, so the function is assumed to end at the next
+// known symbol or export in the same section.
+func (f *File) DisasmSymbol(name string) ([]gapstone.Instruction, error) {
+	start, ok := f.lookup(name)
+	if !ok {
+		return nil, ErrNoSymbol
+	}
+	data := f.ReadVA(start)
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	if next := f.next(start); next > start && next-start < uint64(len(data)) {
+		data = data[:next-start]
+	}
+	return f.Engine.Disasm(data, start, 0)
+}
+
+func (f *File) lookup(name string) (uint64, bool) {
+	for _, e := range f.Exports() {
+		if e.Name == name && e.Addr != 0 {
+			return e.Addr, true
+		}
+	}
+	for _, sym := range f.Symbols {
+		if sym.Name == name && sym.SectionNumber > 0 && int(sym.SectionNumber) <= len(f.sections) {
+			return f.sections[sym.SectionNumber-1].Addr + uint64(sym.Value), true
+		}
+	}
+	return 0, false
+}
+
+// The lowest symbol or export address above va, or 0
+func (f *File) next(va uint64) uint64 {
+	var next uint64
+	above := func(a uint64) {
+		if a > va && (next == 0 || a < next) {
+			next = a
+		}
+	}
+	for _, e := range f.Exports() {
+		above(e.Addr)
+	}
+	for _, sym := range f.Symbols {
+		if sym.SectionNumber > 0 && int(sym.SectionNumber) <= len(f.sections) {
+			above(f.sections[sym.SectionNumber-1].Addr + uint64(sym.Value))
+		}
+	}
+	return next
+}
@@ -0,0 +1,151 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package pe
+
+import (
+	"bytes"
+	dpe "debug/pe"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/bnagy/gapstone"
+)
+
+// testdata/test32.s and test64.s, built as in their headers. Both export
+// DoThing, Other and a forwarder, and DoThing calls through the IAT slots of
+// an import by name, an import by ordinal and a delay load import.
+var testImages = []struct {
+	file    string
+	base    uint64
+	imports map[uint64]string
+}{
+	{"test32.dll", 0x10000000, map[uint64]string{
+		0x10005060: "KERNEL32!CreateFileW",
+		0x10005068: "WS2_32!#23",
+		0x10002000: "USER32!MessageBoxW",
+	}},
+	{"test64.dll", 0x180000000, map[uint64]string{
+		0x180005070: "KERNEL32!CreateFileW",
+		0x180005080: "WS2_32!#23",
+		0x180002000: "USER32!MessageBoxW",
+	}},
+}
+
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		machine uint16
+		arch    int
+		mode    uint
+	}{
+		{dpe.IMAGE_FILE_MACHINE_I386, gapstone.CS_ARCH_X86, gapstone.CS_MODE_32},
+		{dpe.IMAGE_FILE_MACHINE_AMD64, gapstone.CS_ARCH_X86, gapstone.CS_MODE_64},
+		{dpe.IMAGE_FILE_MACHINE_ARMNT, gapstone.CS_ARCH_ARM, gapstone.CS_MODE_THUMB},
+		{dpe.IMAGE_FILE_MACHINE_ARM64, gapstone.CS_ARCH_ARM64, gapstone.CS_MODE_ARM},
+	}
+	for i, test := range tests {
+		arch, mode, err := Target(test.machine)
+		if err != nil || arch != test.arch || mode != test.mode {
+			t.Errorf("%2d> %#x: want %v/%#x got %v/%#x (%v)", i, test.machine, test.arch, test.mode, arch, mode, err)
+		}
+	}
+	if _, _, err := Target(dpe.IMAGE_FILE_MACHINE_IA64); err != ErrMachine {
+		t.Errorf("IA64: want %v got %v", ErrMachine, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	for _, img := range testImages {
+		fh, err := os.Open("testdata/" + img.file)
+		if err != nil {
+			t.Fatalf("Failed to open %v", err)
+		}
+		defer fh.Close()
+		pf, err := dpe.NewFile(fh)
+		if err != nil {
+			t.Fatalf("Failed to parse %s %v", img.file, err)
+		}
+		f, err := load(pf)
+		if err != nil {
+			t.Fatalf("Failed to load %s %v", img.file, err)
+		}
+		base := img.base
+
+		// linked with -e 0
+		if f.ImageBase != base || f.Entry() != 0 {
+			t.Errorf("%s: want base %#x entry 0 got %#x %#x", img.file, base, f.ImageBase, f.Entry())
+		}
+		if !reflect.DeepEqual(f.Imports, img.imports) {
+			t.Errorf("%s: imports: want %v got %v", img.file, img.imports, f.Imports)
+		}
+		exports := []Export{
+			{Name: "Alloc", Ordinal: 1, Forward: "NTDLL.RtlAllocateHeap"},
+			{Name: "DoThing", Ordinal: 2, Addr: base + 0x1000},
+			{Name: "Other", Ordinal: 3, Addr: base + 0x1013},
+		}
+		if got := f.Exports(); !reflect.DeepEqual(got, exports) {
+			t.Errorf("%s: exports: want %+v got %+v", img.file, exports, got)
+		}
+		if segs := f.ExecSegments(); len(segs) != 1 || segs[0].Addr != base+0x1000 {
+			t.Errorf("%s: segments: want one at %#x got %+v", img.file, base+0x1000, segs)
+		}
+		if start, ok := f.lookup("Other"); !ok || start != base+0x1013 {
+			t.Errorf("%s: Other: want %#x got %#x (%v)", img.file, base+0x1013, start, ok)
+		}
+		if next := f.next(base + 0x1000); next != base+0x1013 {
+			t.Errorf("%s: symbol after DoThing: want %#x got %#x", img.file, base+0x1013, next)
+		}
+		// call [CreateFileW]
+		if b := f.ReadVA(base + 0x1000); !bytes.HasPrefix(b, []byte{0xff, 0x15}) {
+			t.Errorf("%s: ReadVA: got % x", img.file, b)
+		}
+		if b := f.ReadVA(base + 0x1800); b != nil {
+			t.Errorf("%s: ReadVA past virtual size: got %d bytes", img.file, len(b))
+		}
+
+		syms := f.SymbolTable()
+		for addr, name := range img.imports {
+			if got, off, ok := syms.Lookup(addr + 2); !ok || got != name || off != 2 {
+				t.Errorf("%s: %#x: want %s+2 got %s+%#x (%v)", img.file, addr+2, name, got, off, ok)
+			}
+			if got, ok := syms.Address(name); !ok || got != addr {
+				t.Errorf("%s: %s: want %#x got %#x (%v)", img.file, name, addr, got, ok)
+			}
+		}
+	}
+}
+
+func TestImportEngine(t *testing.T) {
+	for _, img := range testImages {
+		f, err := Open("testdata/" + img.file)
+		if err != nil {
+			t.Fatalf("Failed to initialize engine %v", err)
+		}
+		defer f.Close()
+
+		insns, err := f.DisasmSymbol("DoThing")
+		if err != nil || len(insns) != 4 {
+			t.Fatalf("%s: DoThing: want 4 instructions got %d (%v)", img.file, len(insns), err)
+		}
+		want := []string{"KERNEL32!CreateFileW", "WS2_32!#23", "USER32!MessageBoxW"}
+		for i, insn := range insns[:3] {
+			if name, ok := f.Import(insn); !ok || name != want[i] {
+				t.Errorf("%2d> %s: %s %s: want %s got %q", i, img.file, insn.Mnemonic, insn.OpStr, want[i], name)
+			}
+		}
+		if _, ok := f.Import(insns[3]); ok {
+			t.Errorf("%s: ret resolved to an import", img.file)
+		}
+		if _, err := f.DisasmSection(".bss"); err != ErrNoSection {
+			t.Errorf("%s: .bss: want %v got %v", img.file, ErrNoSection, err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package pe
+
+import (
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+const imageSymClassStatic = 3
+
+// A SymbolTable for Instruction.SymbolicOpStr, from the named exports, the
+// COFF symbol table and the IAT slots, which are named like Imports. The IAT
+// slots mean call qword ptr [rip + 0x2f12] comes out as
+// call qword ptr [rip + KERNEL32!CreateFileW].
+func (f *File) SymbolTable() *gapstone.SymbolMap {
+	var syms []gapstone.Symbol
+	for _, e := range f.Exports() {
+		if e.Name != "" && e.Addr != 0 {
+			syms = append(syms, gapstone.Symbol{Name: e.Name, Addr: e.Addr})
+		}
+	}
+	for _, sym := range f.Symbols {
+		if sym.SectionNumber <= 0 || int(sym.SectionNumber) > len(f.sections) {
+			continue
+		}
+		// Section symbols like .text, and local labels
+		if sym.StorageClass == imageSymClassStatic && strings.HasPrefix(sym.Name, ".") {
+			continue
+		}
+		syms = append(syms, gapstone.Symbol{Name: sym.Name, Addr: f.sections[sym.SectionNumber-1].Addr + uint64(sym.Value)})
+	}
+	size := uint64(4)
+	if f.pe64() {
+		size = 8
+	}
+	for addr, name := range f.Imports {
+		syms = append(syms, gapstone.Symbol{Name: name, Addr: addr, Size: size})
+	}
+	return gapstone.NewSymbolMap(syms)
+}
@@ -0,0 +1,57 @@
+# An x86 DLL with imports by name and ordinal, a delay load import, and
+# exports including a forwarder:
+#
+#	llvm-mc -triple=i686-windows-gnu -filetype=obj -o test32.o test32.s
+#	llvm-dlltool -m i386 -d kernel32.def -l kernel32.a
+#	llvm-dlltool -m i386 -d ws2_32.def -l ws2_32.a
+#	ld -m i386pe --dll -e 0 --image-base 0x10000000 -o test32.dll \
+#		test32.o test.def kernel32.a ws2_32.a
+#
+# ld can't build a delay import directory, so point data directory 13 at
+# delay_desc by hand:
+#
+#	rva=$(( 0x$(nm test32.dll | awk '/ _delay_desc$/ {print $1}') - 0x10000000 ))
+#	printf "$(printf '\\%03o' $((rva&255)) $((rva>>8&255)) $((rva>>16&255)) \
+#		$((rva>>24)) 64 0 0 0)" |
+#		dd of=test32.dll bs=1 seek=$((0x80+24+96+13*8)) conv=notrunc
+
+	.text
+	.globl	_DoThing
+_DoThing:
+	calll	*__imp__CreateFileW
+	calll	*__imp__socket
+	calll	*delay_iat
+	retl
+
+	.globl	_Other
+_Other:
+	xorl	%eax, %eax
+	retl
+
+	.section .rdata,"dr"
+	.p2align 2
+	.globl	_delay_desc
+_delay_desc:
+	.long	1		# RVAs, not VAs
+	.rva	delay_dll
+	.rva	delay_hmod
+	.rva	delay_iat
+	.rva	delay_int
+	.long	0, 0, 0
+	.zero	32
+delay_int:
+	.rva	delay_hint
+	.long	0
+delay_hint:
+	.short	0
+	.asciz	"MessageBoxW"
+delay_dll:
+	.asciz	"USER32.dll"
+
+	.data
+	.p2align 2
+delay_iat:
+	.long	0
+	.long	0
+delay_hmod:
+	.long	0
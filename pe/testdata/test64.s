@@ -0,0 +1,58 @@
+# An x86-64 DLL with imports by name and ordinal, a delay load import, and
+# exports including a forwarder:
+#
+#	llvm-mc -triple=x86_64-windows-gnu -filetype=obj -o test64.o test64.s
+#	llvm-dlltool -m i386:x86-64 -d kernel32.def -l kernel32.a
+#	llvm-dlltool -m i386:x86-64 -d ws2_32.def -l ws2_32.a
+#	ld -m i386pep --dll -e 0 --image-base 0x180000000 -o test64.dll \
+#		test64.o test.def kernel32.a ws2_32.a
+#
+# ld can't build a delay import directory, so point data directory 13 at
+# delay_desc by hand:
+#
+#	rva=$(( 0x$(nm test64.dll | awk '/ delay_desc$/ {print $1}') - 0x180000000 ))
+#	printf "$(printf '\\%03o' $((rva&255)) $((rva>>8&255)) $((rva>>16&255)) \
+#		$((rva>>24)) 64 0 0 0)" |
+#		dd of=test64.dll bs=1 seek=$((0x80+24+112+13*8)) conv=notrunc
+
+	.text
+	.globl	DoThing
+DoThing:
+	callq	*__imp_CreateFileW(%rip)
+	callq	*__imp_socket(%rip)
+	callq	*delay_iat(%rip)
+	retq
+
+	.globl	Other
+Other:
+	xorl	%eax, %eax
+	retq
+
+	.section .rdata,"dr"
+	.p2align 3
+	.globl	delay_desc
+delay_desc:
+	.long	1		# RVAs, not VAs
+	.rva	delay_dll
+	.rva	delay_hmod
+	.rva	delay_iat
+	.rva	delay_int
+	.long	0, 0, 0
+	.zero	32
+delay_int:
+	.rva	delay_hint
+	.long	0
+	.quad	0
+delay_hint:
+	.short	0
+	.asciz	"MessageBoxW"
+delay_dll:
+	.asciz	"USER32.dll"
+
+	.data
+	.p2align 3
+delay_iat:
+	.quad	0
+	.quad	0
+delay_hmod:
+	.quad	0
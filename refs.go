@@ -0,0 +1,230 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+// What a Reference points at
+type RefKind int
+
+const (
+	RefCode    RefKind = iota // branch or call destination
+	RefData                   // memory read or written by the instruction
+	RefAddress                // address formed but not dereferenced (lea, adr, adrp, larl) - code or data
+)
+
+var refKindNames = [...]string{
+	RefCode:    "code",
+	RefData:    "data",
+	RefAddress: "address",
+}
+
+func (k RefKind) String() string {
+	if k >= 0 && int(k) < len(refKindNames) {
+		return refKindNames[k]
+	}
+	return "unknown"
+}
+
+// A statically known address used by an instruction, as returned by
+// Instruction.References()
+type Reference struct {
+	Address uint64
+	Kind    RefKind
+	Access  Access // how RefData memory is used, AccessNone for the other kinds
+	Operand int    // index into the arch specific Operands slice, or -1
+}
+
+// The addresses this Instruction refers to which can be resolved without
+// knowing any register values:
+//
+//   - direct branch and call targets (see Flow)
+//   - PC-relative memory operands: x86 [rip + disp], ARM [pc, #imm] literal
+//     loads (pc reads as address + 8, or Align(address + 4, 4) in Thumb)
+//   - absolute memory operands: x86 [disp], MIPS disp($zero), PPC disp(0),
+//     SPARC [%g0 + disp]
+//   - addresses materialised as immediates: ARM adr / add rX, pc, #imm,
+//     ARM64 adr / adrp / ldr literal, SysZ larl and the *rl relative long
+//     loads, stores and compares
+//
+// Everything except x86 branch targets requires CS_OPT_DETAIL. Returns nil if
+// there are no static references.
+func (insn Instruction) References() []Reference {
+	var refs []Reference
+
+	ops, _, detail := insn.accessOperands()
+	if detail {
+		for i := range ops {
+			if !ops[i].mem {
+				continue
+			}
+			ea, err := insn.EffectiveAddress(i, noRegisters)
+			if err != nil {
+				continue
+			}
+			ref := Reference{Address: ea, Kind: RefData, Access: insn.OperandAccess(i), Operand: i}
+			if insn.addressOnly() {
+				ref.Kind, ref.Access = RefAddress, AccessNone
+			}
+			refs = append(refs, ref)
+		}
+		refs = append(refs, insn.immReferences()...)
+	}
+
+	if f := insn.Flow(); f.HasTarget {
+		refs = append(refs, Reference{Address: f.Target, Kind: RefCode, Operand: insn.lastImmOperand()})
+	}
+
+	return refs
+}
+
+// The index of the last immediate operand, or -1. Branch targets come after
+// any condition register, compare value or bit number (cbz r0, tbz w0, #3,
+// bdnzt 4*cr5+eq, clije %r1, 0xc1), so this is the operand carrying them.
+func (insn *Instruction) lastImmOperand() int {
+	last := -1
+	switch {
+	case insn.X86 != nil:
+		for i, op := range insn.X86.Operands {
+			if op.Type == X86_OP_IMM {
+				last = i
+			}
+		}
+	case insn.Arm != nil:
+		for i, op := range insn.Arm.Operands {
+			if op.Type == ARM_OP_IMM {
+				last = i
+			}
+		}
+	case insn.Arm64 != nil:
+		for i, op := range insn.Arm64.Operands {
+			if op.Type == ARM64_OP_IMM {
+				last = i
+			}
+		}
+	case insn.Mips != nil:
+		for i, op := range insn.Mips.Operands {
+			if op.Type == MIPS_OP_IMM {
+				last = i
+			}
+		}
+	case insn.PPC != nil:
+		for i, op := range insn.PPC.Operands {
+			if op.Type == PPC_OP_IMM {
+				last = i
+			}
+		}
+	case insn.Sparc != nil:
+		for i, op := range insn.Sparc.Operands {
+			if op.Type == SPARC_OP_IMM {
+				last = i
+			}
+		}
+	case insn.SysZ != nil:
+		for i, op := range insn.SysZ.Operands {
+			if op.Type == SYSZ_OP_IMM {
+				last = i
+			}
+		}
+	case insn.Xcore != nil:
+		for i, op := range insn.Xcore.Operands {
+			if op.Type == XCORE_OP_IMM {
+				last = i
+			}
+		}
+	}
+	return last
+}
+
+// A RegisterValues that knows nothing, so only static addresses resolve
+func noRegisters(reg uint) (uint64, bool) {
+	return 0, false
+}
+
+// Memory operands which only compute an address
+func (insn *Instruction) addressOnly() bool {
+	return insn.X86 != nil && (insn.Id == X86_INS_LEA || insn.Id == X86_INS_NOP)
+}
+
+var syszRelativeLoads = newInsnSet(
+	SYSZ_INS_LGFRL, SYSZ_INS_LGHRL, SYSZ_INS_LGRL, SYSZ_INS_LHRL,
+	SYSZ_INS_LLGFRL, SYSZ_INS_LLGHRL, SYSZ_INS_LLHRL, SYSZ_INS_LRL,
+	SYSZ_INS_CGFRL, SYSZ_INS_CGHRL, SYSZ_INS_CGRL, SYSZ_INS_CHRL,
+	SYSZ_INS_CLGFRL, SYSZ_INS_CLGHRL, SYSZ_INS_CLGRL, SYSZ_INS_CLHRL,
+	SYSZ_INS_CLRL, SYSZ_INS_CRL, SYSZ_INS_PFDRL,
+)
+
+var syszRelativeStores = newInsnSet(
+	SYSZ_INS_STGRL, SYSZ_INS_STHRL, SYSZ_INS_STRL,
+)
+
+// References carried by immediate operands. Capstone reports ARM64 and SysZ
+// PC-relative immediates as absolute addresses, and ARM adr as an offset.
+func (insn *Instruction) immReferences() []Reference {
+	switch {
+	case insn.Arm != nil:
+		ops := insn.Arm.Operands
+		switch insn.Id {
+		case ARM_INS_ADR:
+			if off, ok := armImm(insn.Arm, 1); ok {
+				ea := (insn.armPC() &^ 3) + off
+				return []Reference{{Address: ea & 0xffffffff, Kind: RefAddress, Operand: 1}}
+			}
+		case ARM_INS_ADD, ARM_INS_SUB:
+			if len(ops) != 3 || armReg(insn.Arm, 1) != ARM_REG_PC {
+				break
+			}
+			off, ok := armImm(insn.Arm, 2)
+			if !ok {
+				break
+			}
+			ea := insn.armPC() &^ 3
+			if insn.Id == ARM_INS_SUB {
+				ea -= off
+			} else {
+				ea += off
+			}
+			return []Reference{{Address: ea & 0xffffffff, Kind: RefAddress, Operand: 2}}
+		}
+	case insn.Arm64 != nil:
+		last := len(insn.Arm64.Operands) - 1
+		ea, ok := arm64LastImm(insn.Arm64)
+		if !ok {
+			break
+		}
+		switch insn.Id {
+		case ARM64_INS_ADR, ARM64_INS_ADRP:
+			return []Reference{{Address: ea, Kind: RefAddress, Operand: last}}
+		case ARM64_INS_LDR, ARM64_INS_LDRSW, ARM64_INS_PRFM:
+			// ldr literal - the only form without a memory operand. The
+			// post-indexed form ends with its immediate too.
+			for _, op := range insn.Arm64.Operands {
+				if op.Type == ARM64_OP_MEM {
+					return nil
+				}
+			}
+			return []Reference{{Address: ea, Kind: RefData, Access: AccessRead, Operand: last}}
+		}
+	case insn.SysZ != nil:
+		last := len(insn.SysZ.Operands) - 1
+		ea, ok := syszLastImm(insn)
+		if !ok {
+			break
+		}
+		switch {
+		case insn.Id == SYSZ_INS_LARL:
+			return []Reference{{Address: ea, Kind: RefAddress, Operand: last}}
+		case syszRelativeLoads[insn.Id]:
+			return []Reference{{Address: ea, Kind: RefData, Access: AccessRead, Operand: last}}
+		case syszRelativeStores[insn.Id]:
+			return []Reference{{Address: ea, Kind: RefData, Access: AccessWrite, Operand: last}}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"reflect"
+	"testing"
+)
+
+var referenceTests = []struct {
+	arch    int
+	mode    uint
+	code    string
+	comment string
+	want    []Reference
+}{
+	{CS_ARCH_X86, CS_MODE_64, "\x89\x05\x10\x00\x00\x00", "mov dword ptr [rip + 0x10], eax",
+		[]Reference{{0x1016, RefData, AccessWrite, 0}}},
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x8d\x3d\x20\x00\x00\x00", "lea rdi, [rip + 0x20]",
+		[]Reference{{0x1027, RefAddress, AccessNone, 1}}},
+	{CS_ARCH_X86, CS_MODE_32, "\x8b\x43\x04", "mov eax, dword ptr [ebx + 4]", nil},
+	{CS_ARCH_X86, CS_MODE_32, "\xe8\xfb\x0f\x00\x00", "call 0x2000",
+		[]Reference{{0x2000, RefCode, AccessNone, 0}}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x04\x00\x9f\xe5", "ldr r0, [pc, #4]",
+		[]Reference{{0x100c, RefData, AccessRead, 1}}},
+	// The ldr is at 0x1002, pc reads as 0x1004
+	{CS_ARCH_ARM, CS_MODE_THUMB, "\x00\xbf\x02\x48", "nop; ldr r0, [pc, #8]",
+		[]Reference{{0x100c, RefData, AccessRead, 1}}},
+	{CS_ARCH_ARM, CS_MODE_THUMB, "\x10\xb1", "cbz r0, 0x1008",
+		[]Reference{{0x1008, RefCode, AccessNone, 1}}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x20\x00\x00\x90", "adrp x0, 0x5000",
+		[]Reference{{0x5000, RefAddress, AccessNone, 1}}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x01\x08\x00\x58", "ldr x1, 0x1100",
+		[]Reference{{0x1100, RefData, AccessRead, 1}}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x20\x84\x40\xf8", "ldr x0, [x1], #8", nil},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\x20\x8c\x40\xf8", "ldr x0, [x1, #8]!", nil},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\xa0\xff\x1f\x37", "tbnz w0, #3, 0xff4",
+		[]Reference{{0xff4, RefCode, AccessNone, 2}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x48\x00\x01\x01", "bl 0x1100",
+		[]Reference{{0x1100, RefCode, AccessNone, 0}}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x41\x56\xff\x17", "bdztla 4*cr5+eq, 0xffffff14",
+		[]Reference{{0xffffff14, RefCode, AccessNone, 1}}},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xc4\x1f\x00\x00\x08\x00", "strl %r1, 0x2000",
+		[]Reference{{0x2000, RefData, AccessWrite, 1}}},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xec\x18\x00\x00\xc1\x7f", "clije %r1, 0xc1, 0x1000",
+		[]Reference{{0x1000, RefCode, AccessNone, 2}}},
+}
+
+func TestReferences(t *testing.T) {
+
+	for i, rt := range referenceTests {
+		insn := disasmLast(t, rt.arch, rt.mode, rt.code)
+		if got := insn.References(); !reflect.DeepEqual(got, rt.want) {
+			t.Errorf("%2d> %s: want %v got %v", i, rt.comment, rt.want, got)
+		}
+	}
+
+	// Without detail only the x86 branch target is known, and not which
+	// operand carries it
+	call := bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_CALL, 0x1000, "\xe8\xfb\x0f\x00\x00")
+	want := []Reference{{0x2000, RefCode, AccessNone, -1}}
+	if got := call.References(); !reflect.DeepEqual(got, want) {
+		t.Errorf("call 0x2000 without detail: want %v got %v", want, got)
+	}
+}
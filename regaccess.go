@@ -0,0 +1,969 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+// How an instruction uses an operand or register
+type Access uint8
+
+const (
+	AccessNone      Access = 0
+	AccessRead      Access = 1 << 0
+	AccessWrite     Access = 1 << 1
+	AccessReadWrite        = AccessRead | AccessWrite
+)
+
+func (a Access) String() string {
+	switch a {
+	case AccessNone:
+		return "none"
+	case AccessRead:
+		return "read"
+	case AccessWrite:
+		return "write"
+	case AccessReadWrite:
+		return "read|write"
+	}
+	return "invalid"
+}
+
+// The full sets of registers read and written by this Instruction. Unlike
+// RegistersRead and RegistersWritten, which only list implicit registers,
+// these include explicit register operands, the base / index registers of
+// memory operands, writeback (ARM, ARM64, PPC update forms) and flags
+// (UpdateFlags, UpdateCR0, condition codes). A register which is both read
+// and written appears in both lists.
+//
+// Operand direction comes from per-arch instruction tables, so it requires
+// CS_OPT_DETAIL. Without detail ErrDetail is returned.
+func (insn Instruction) RegsAccess() (read, written []uint, err error) {
+	ops, ra, ok := insn.accessOperands()
+	if !ok {
+		return nil, nil, ErrDetail
+	}
+
+	ra.add(AccessRead, insn.RegistersRead...)
+	ra.add(AccessWrite, insn.RegistersWritten...)
+
+	rule := insn.accessRule(len(ops))
+	for i, op := range ops {
+		ra.add(rule.access(i, len(ops), op.mem), op.reg)
+		ra.add(AccessRead, op.addr...)
+		if op.writeback {
+			ra.add(AccessWrite, op.addr[0])
+		}
+	}
+	ra.add(AccessRead, ra.flagsRead...)
+	ra.add(AccessWrite, ra.flagsWritten...)
+
+	return ra.read, ra.written, nil
+}
+
+// How this Instruction uses its explicit operand at idx (an index into the
+// arch specific Operands slice). For memory operands this is the access to
+// memory itself - the address registers are always read. Returns AccessNone
+// for out of range operands or when detail is unavailable.
+func (insn Instruction) OperandAccess(idx int) Access {
+	ops, _, ok := insn.accessOperands()
+	if !ok || idx < 0 || idx >= len(ops) {
+		return AccessNone
+	}
+	if ops[idx].mem {
+		return insn.memAccess(idx, len(ops))
+	}
+	return insn.accessRule(len(ops)).access(idx, len(ops), false)
+}
+
+// An explicit operand, reduced to the registers it names
+type accessOperand struct {
+	reg       uint   // register operand
+	mem       bool   // memory operand
+	addr      []uint // registers read to form an address (or a shift amount)
+	writeback bool   // addr[0] is updated
+}
+
+// Ordered, duplicate free register sets
+type regAccess struct {
+	invalid      uint
+	read         []uint
+	written      []uint
+	flagsRead    []uint
+	flagsWritten []uint
+}
+
+func (ra *regAccess) add(a Access, regs ...uint) {
+	for _, reg := range regs {
+		if reg == ra.invalid {
+			continue
+		}
+		if a&AccessRead != 0 {
+			ra.read = appendReg(ra.read, reg)
+		}
+		if a&AccessWrite != 0 {
+			ra.written = appendReg(ra.written, reg)
+		}
+	}
+}
+
+func appendReg(regs []uint, reg uint) []uint {
+	for _, r := range regs {
+		if r == reg {
+			return regs
+		}
+	}
+	return append(regs, reg)
+}
+
+// How explicit operands are used, by position. Every arch has a default
+// rule, and tables of the instructions that don't follow it.
+type accessRule uint8
+
+const (
+	ruleDestFirst   accessRule = iota // first operand written, the rest read
+	ruleUpdateFirst                   // first operand read and written, the rest read
+	ruleUpdateAll                     // every operand read and written
+	ruleReadAll                       // every operand read
+	ruleStore                         // memory operands written, the rest read
+	ruleStoreStatus                   // as ruleStore, and the first operand receives a status
+	ruleLoadRegs                      // register operands written, memory read
+	ruleLoadList                      // first operand (a base register) read, the rest written
+	ruleDestTwo                       // first two operands written, the rest read
+	ruleUpdateTwo                     // first two operands read and written, the rest read
+	ruleDestSecond                    // second operand written, the rest read
+	ruleDestLast                      // last operand written, the rest read
+	ruleUpdateLast                    // last operand read and written, the rest read
+)
+
+func (r accessRule) access(idx, nops int, mem bool) Access {
+	switch r {
+	case ruleDestFirst:
+		if idx == 0 {
+			return AccessWrite
+		}
+	case ruleUpdateFirst:
+		if idx == 0 {
+			return AccessReadWrite
+		}
+	case ruleUpdateAll:
+		return AccessReadWrite
+	case ruleStore:
+		if mem {
+			return AccessWrite
+		}
+	case ruleStoreStatus:
+		if mem || idx == 0 {
+			return AccessWrite
+		}
+	case ruleLoadRegs:
+		if !mem {
+			return AccessWrite
+		}
+	case ruleLoadList:
+		if idx > 0 {
+			return AccessWrite
+		}
+	case ruleDestTwo:
+		if idx < 2 {
+			return AccessWrite
+		}
+	case ruleUpdateTwo:
+		if idx < 2 {
+			return AccessReadWrite
+		}
+	case ruleDestSecond:
+		if idx == 1 {
+			return AccessWrite
+		}
+	case ruleDestLast:
+		if idx == nops-1 {
+			return AccessWrite
+		}
+	case ruleUpdateLast:
+		if idx == nops-1 {
+			return AccessReadWrite
+		}
+	}
+	return AccessRead
+}
+
+// Instructions which don't follow their arch's default rule
+type accessTable map[accessRule]insnSet
+
+func (t accessTable) lookup(id uint) (accessRule, bool) {
+	for rule, set := range t {
+		if set[id] {
+			return rule, true
+		}
+	}
+	return ruleDestFirst, false
+}
+
+func inGroup(groups []uint, want ...uint) bool {
+	for _, g := range groups {
+		for _, w := range want {
+			if g == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (insn *Instruction) branches() bool {
+	return insn.Flow().Kind != FlowFallthrough
+}
+
+func (insn *Instruction) accessRule(nops int) accessRule {
+	switch {
+	case insn.X86 != nil:
+		return x86AccessRule(insn, nops)
+	case insn.Arm != nil:
+		if rule, ok := armAccess.lookup(insn.Id); ok {
+			return rule
+		}
+	case insn.Arm64 != nil:
+		if rule, ok := arm64Access.lookup(insn.Id); ok {
+			return rule
+		}
+	case insn.Mips != nil:
+		return mipsAccessRule(insn, nops)
+	case insn.PPC != nil:
+		return ppcAccessRule(insn)
+	case insn.Sparc != nil:
+		if rule, ok := sparcAccess.lookup(insn.Id); ok {
+			return rule
+		}
+		if insn.branches() {
+			return ruleReadAll
+		}
+		return ruleDestLast
+	case insn.SysZ != nil:
+		return syszAccessRule(insn, nops)
+	case insn.Xcore != nil:
+		if rule, ok := xcoreAccess.lookup(insn.Id); ok {
+			return rule
+		}
+		if insn.branches() {
+			return ruleReadAll
+		}
+	}
+	return ruleDestFirst
+}
+
+// Reduce the arch specific operands, and collect the flag registers implied
+// by the arch specific detail.
+func (insn *Instruction) accessOperands() ([]accessOperand, *regAccess, bool) {
+	switch {
+	case insn.X86 != nil:
+		return x86AccessOperands(insn.X86), &regAccess{invalid: X86_REG_INVALID}, true
+	case insn.Arm != nil:
+		return armAccessOperands(insn.Arm)
+	case insn.Arm64 != nil:
+		return arm64AccessOperands(insn.Arm64)
+	case insn.Mips != nil:
+		return mipsAccessOperands(insn.Mips), &regAccess{invalid: MIPS_REG_INVALID}, true
+	case insn.PPC != nil:
+		return ppcAccessOperands(insn)
+	case insn.Sparc != nil:
+		return sparcAccessOperands(insn.Sparc), &regAccess{invalid: SPARC_REG_INVALID}, true
+	case insn.SysZ != nil:
+		return syszAccessOperands(insn.SysZ), &regAccess{invalid: SYSZ_REG_INVALID}, true
+	case insn.Xcore != nil:
+		return xcoreAccessOperands(insn.Xcore), &regAccess{invalid: XCORE_REG_INVALID}, true
+	}
+	return nil, nil, false
+}
+
+// X86
+
+var x86Access = accessTable{
+	ruleReadAll: newInsnSet(
+		X86_INS_BOUND, X86_INS_BT, X86_INS_CLFLUSH, X86_INS_CMP, X86_INS_CMPSB,
+		X86_INS_CMPSQ, X86_INS_CMPSW, X86_INS_COMISD, X86_INS_COMISS,
+		X86_INS_FCOMP, X86_INS_FCOMPI, X86_INS_FCOMI, X86_INS_FCOM,
+		X86_INS_DIV, X86_INS_ENTER, X86_INS_FBLD, X86_INS_FICOM,
+		X86_INS_FICOMP, X86_INS_FLDCW, X86_INS_FLDENV, X86_INS_FRSTOR,
+		X86_INS_FXRSTOR, X86_INS_FXRSTOR64, X86_INS_IDIV, X86_INS_FILD,
+		X86_INS_INVLPG, X86_INS_INVLPGA, X86_INS_INVPCID, X86_INS_UCOMISD,
+		X86_INS_UCOMISS, X86_INS_VCOMISD, X86_INS_VCOMISS, X86_INS_VUCOMISD,
+		X86_INS_VUCOMISS, X86_INS_FLD, X86_INS_LGDT, X86_INS_LIDT,
+		X86_INS_LLDT, X86_INS_LMSW, X86_INS_LTR, X86_INS_MONITOR, X86_INS_MUL,
+		X86_INS_NOP, X86_INS_OUT, X86_INS_OUTSB, X86_INS_OUTSD, X86_INS_OUTSW,
+		X86_INS_PCMPESTRI, X86_INS_PCMPESTRM, X86_INS_PCMPISTRI,
+		X86_INS_PCMPISTRM, X86_INS_PREFETCH, X86_INS_PREFETCHNTA,
+		X86_INS_PREFETCHT0, X86_INS_PREFETCHT1, X86_INS_PREFETCHT2,
+		X86_INS_PREFETCHW, X86_INS_PTEST, X86_INS_PUSH, X86_INS_SCASB,
+		X86_INS_SCASD, X86_INS_SCASQ, X86_INS_SCASW, X86_INS_TEST,
+		X86_INS_FTST, X86_INS_FUCOMPI, X86_INS_FUCOMI, X86_INS_FUCOMP,
+		X86_INS_FUCOM, X86_INS_VERR, X86_INS_VERW, X86_INS_VMCLEAR,
+		X86_INS_VMPTRLD, X86_INS_VMWRITE, X86_INS_VMXON, X86_INS_VPCMPESTRI,
+		X86_INS_VPCMPESTRM, X86_INS_VPCMPISTRI, X86_INS_VPCMPISTRM,
+		X86_INS_VPTEST, X86_INS_VTESTPD, X86_INS_VTESTPS, X86_INS_WRFSBASE,
+		X86_INS_WRGSBASE, X86_INS_XRSTOR, X86_INS_XRSTOR64,
+	),
+	ruleDestFirst: newInsnSet(
+		X86_INS_AESIMC, X86_INS_AESKEYGENASSIST, X86_INS_ANDN, X86_INS_BEXTR,
+		X86_INS_BLCFILL, X86_INS_BLCI, X86_INS_BLCIC, X86_INS_BLCMSK,
+		X86_INS_BLCS, X86_INS_BLSFILL, X86_INS_BLSI, X86_INS_BLSIC,
+		X86_INS_BLSMSK, X86_INS_BLSR, X86_INS_BSF, X86_INS_BSR, X86_INS_BZHI,
+		X86_INS_CVTDQ2PD, X86_INS_CVTDQ2PS, X86_INS_CVTPD2DQ, X86_INS_CVTPD2PS,
+		X86_INS_CVTPS2DQ, X86_INS_CVTPS2PD, X86_INS_CVTSD2SI, X86_INS_CVTSD2SS,
+		X86_INS_CVTSI2SD, X86_INS_CVTSI2SS, X86_INS_CVTSS2SD, X86_INS_CVTSS2SI,
+		X86_INS_CVTTPD2DQ, X86_INS_CVTTPS2DQ, X86_INS_CVTTSD2SI,
+		X86_INS_CVTTSS2SI, X86_INS_EXTRACTPS, X86_INS_FBSTP, X86_INS_FNSTCW,
+		X86_INS_FNSTSW, X86_INS_FNSAVE, X86_INS_FNSTENV, X86_INS_FXSAVE,
+		X86_INS_FXSAVE64, X86_INS_MOVAPD, X86_INS_MOVAPS, X86_INS_IN,
+		X86_INS_FISTTP, X86_INS_FIST, X86_INS_FISTP, X86_INS_LAR, X86_INS_LDS,
+		X86_INS_LEA, X86_INS_LES, X86_INS_LFS, X86_INS_LGS, X86_INS_LODSB,
+		X86_INS_LODSD, X86_INS_LODSQ, X86_INS_LODSW, X86_INS_LSL, X86_INS_LSS,
+		X86_INS_LZCNT, X86_INS_CVTPD2PI, X86_INS_CVTPI2PD, X86_INS_CVTPI2PS,
+		X86_INS_CVTPS2PI, X86_INS_CVTTPD2PI, X86_INS_CVTTPS2PI, X86_INS_MOVD,
+		X86_INS_MOVQ, X86_INS_PEXTRW, X86_INS_PMOVMSKB, X86_INS_MOV,
+		X86_INS_MOVABS, X86_INS_MOVBE, X86_INS_MOVDDUP, X86_INS_MOVDQA,
+		X86_INS_MOVDQU, X86_INS_MOVMSKPD, X86_INS_MOVMSKPS, X86_INS_MOVNTDQA,
+		X86_INS_MOVNTDQ, X86_INS_MOVNTI, X86_INS_MOVNTPD, X86_INS_MOVNTPS,
+		X86_INS_MOVSB, X86_INS_MOVSD, X86_INS_MOVSHDUP, X86_INS_MOVSLDUP,
+		X86_INS_MOVSQ, X86_INS_MOVSS, X86_INS_MOVSW, X86_INS_MOVSX,
+		X86_INS_MOVSXD, X86_INS_MOVUPD, X86_INS_MOVUPS, X86_INS_MOVZX,
+		X86_INS_PDEP, X86_INS_PEXT, X86_INS_PEXTRB, X86_INS_PEXTRD,
+		X86_INS_PEXTRQ, X86_INS_PHMINPOSUW, X86_INS_PMOVSXBD, X86_INS_PMOVSXBQ,
+		X86_INS_PMOVSXBW, X86_INS_PMOVSXDQ, X86_INS_PMOVSXWD, X86_INS_PMOVSXWQ,
+		X86_INS_PMOVZXBD, X86_INS_PMOVZXBQ, X86_INS_PMOVZXBW, X86_INS_PMOVZXDQ,
+		X86_INS_PMOVZXWD, X86_INS_PMOVZXWQ, X86_INS_POP, X86_INS_POPCNT,
+		X86_INS_PSHUFD, X86_INS_PSHUFHW, X86_INS_PSHUFLW, X86_INS_RCPPS,
+		X86_INS_RDRAND, X86_INS_RDSEED, X86_INS_RORX, X86_INS_ROUNDPD,
+		X86_INS_ROUNDPS, X86_INS_RSQRTPS, X86_INS_SARX, X86_INS_SETAE,
+		X86_INS_SETA, X86_INS_SETBE, X86_INS_SETB, X86_INS_SETE, X86_INS_SETGE,
+		X86_INS_SETG, X86_INS_SETLE, X86_INS_SETL, X86_INS_SETNE,
+		X86_INS_SETNO, X86_INS_SETNP, X86_INS_SETNS, X86_INS_SETO,
+		X86_INS_SETP, X86_INS_SETS, X86_INS_SGDT, X86_INS_SHLX, X86_INS_SHRX,
+		X86_INS_SIDT, X86_INS_SLDT, X86_INS_SMSW, X86_INS_SQRTPD,
+		X86_INS_SQRTPS, X86_INS_STOSB, X86_INS_STOSD, X86_INS_STOSQ,
+		X86_INS_STOSW, X86_INS_STR, X86_INS_FST, X86_INS_FSTP, X86_INS_T1MSKC,
+		X86_INS_TZCNT, X86_INS_TZMSK, X86_INS_VMPTRST, X86_INS_VMREAD,
+		X86_INS_XSAVE, X86_INS_XSAVE64, X86_INS_XSAVEOPT, X86_INS_XSAVEOPT64,
+	),
+	ruleUpdateFirst: newInsnSet(
+		X86_INS_VPERMI2D, X86_INS_VPERMI2PD, X86_INS_VPERMI2PS,
+		X86_INS_VPERMI2Q, X86_INS_VPERMT2D, X86_INS_VPERMT2PD,
+		X86_INS_VPERMT2PS, X86_INS_VPERMT2Q,
+	),
+	ruleUpdateAll: newInsnSet(
+		X86_INS_XADD, X86_INS_XCHG, X86_INS_FXCH,
+	),
+}
+
+// x87 arithmetic with a single (memory) operand reads it into st(0)
+var x86ReadUnary = newInsnSet(
+	X86_INS_FADD, X86_INS_FIADD, X86_INS_FDIVR, X86_INS_FIDIVR, X86_INS_FDIV,
+	X86_INS_FIDIV, X86_INS_FMUL, X86_INS_FIMUL, X86_INS_FSUBR, X86_INS_FISUBR,
+	X86_INS_FSUB, X86_INS_FISUB,
+)
+
+func x86AccessRule(insn *Instruction, nops int) accessRule {
+	if rule, ok := x86Access.lookup(insn.Id); ok {
+		return rule
+	}
+	switch {
+	case insn.Id == X86_INS_IMUL:
+		switch nops {
+		case 1:
+			return ruleReadAll
+		case 2:
+			return ruleUpdateFirst
+		}
+		return ruleDestFirst
+	case nops == 1 && x86ReadUnary[insn.Id]:
+		return ruleReadAll
+	case insn.branches():
+		return ruleReadAll
+	case inGroup(insn.Groups, X86_GRP_FMA):
+		return ruleUpdateFirst
+	case inGroup(insn.Groups, X86_GRP_AVX, X86_GRP_AVX2, X86_GRP_AVX512,
+		X86_GRP_F16C, X86_GRP_FMA4, X86_GRP_XOP):
+		// VEX / EVEX encodings have a separate destination
+		return ruleDestFirst
+	}
+	// Legacy two operand forms: add eax, ebx
+	return ruleUpdateFirst
+}
+
+func x86AccessOperands(x86 *X86Instruction) []accessOperand {
+	ops := make([]accessOperand, len(x86.Operands))
+	for i, op := range x86.Operands {
+		switch op.Type {
+		case X86_OP_REG:
+			ops[i].reg = op.Reg
+		case X86_OP_MEM:
+			ops[i].reg = X86_REG_INVALID
+			ops[i].mem = true
+			ops[i].addr = []uint{op.Mem.Base, op.Mem.Index, op.Mem.Segment}
+		default:
+			ops[i].reg = X86_REG_INVALID
+		}
+	}
+	return ops
+}
+
+// ARM
+
+var armAccess = accessTable{
+	ruleReadAll: newInsnSet(
+		ARM_INS_BL, ARM_INS_BLX, ARM_INS_BX, ARM_INS_BXJ, ARM_INS_B,
+		ARM_INS_CMN, ARM_INS_CMP, ARM_INS_MCR, ARM_INS_MCR2, ARM_INS_MCRR,
+		ARM_INS_MCRR2, ARM_INS_STMDA, ARM_INS_STMDB, ARM_INS_STM,
+		ARM_INS_STMIB, ARM_INS_TEQ, ARM_INS_TST, ARM_INS_VCMP, ARM_INS_VCMPE,
+		ARM_INS_VSTMDB, ARM_INS_VSTMIA, ARM_INS_TBB, ARM_INS_TBH, ARM_INS_CBNZ,
+		ARM_INS_CBZ, ARM_INS_PUSH, ARM_INS_VPUSH,
+	),
+	ruleStore: newInsnSet(
+		ARM_INS_STC2L, ARM_INS_STC2, ARM_INS_STCL, ARM_INS_STC, ARM_INS_STL,
+		ARM_INS_STLB, ARM_INS_STLH, ARM_INS_STRBT, ARM_INS_STRB, ARM_INS_STRD,
+		ARM_INS_STRH, ARM_INS_STRHT, ARM_INS_STRT, ARM_INS_STR, ARM_INS_VST1,
+		ARM_INS_VST2, ARM_INS_VST3, ARM_INS_VST4, ARM_INS_VSTR,
+	),
+	ruleStoreStatus: newInsnSet(
+		ARM_INS_STLEX, ARM_INS_STLEXB, ARM_INS_STLEXD, ARM_INS_STLEXH,
+		ARM_INS_STREX, ARM_INS_STREXB, ARM_INS_STREXD, ARM_INS_STREXH,
+	),
+	ruleLoadRegs: newInsnSet(
+		ARM_INS_MRC, ARM_INS_MRC2, ARM_INS_MRRC, ARM_INS_MRRC2, ARM_INS_VLD1,
+		ARM_INS_VLD2, ARM_INS_VLD3, ARM_INS_VLD4, ARM_INS_POP, ARM_INS_VPOP,
+	),
+	ruleLoadList: newInsnSet(
+		ARM_INS_LDMDA, ARM_INS_LDMDB, ARM_INS_LDM, ARM_INS_LDMIB,
+		ARM_INS_VLDMDB, ARM_INS_VLDMIA,
+	),
+	ruleDestTwo: newInsnSet(
+		ARM_INS_LDAEXD, ARM_INS_LDRD, ARM_INS_LDREXD, ARM_INS_SMULL,
+		ARM_INS_UMULL,
+	),
+	ruleUpdateTwo: newInsnSet(
+		ARM_INS_SMLAL, ARM_INS_SMLALBB, ARM_INS_SMLALBT, ARM_INS_SMLALD,
+		ARM_INS_SMLALDX, ARM_INS_SMLALTB, ARM_INS_SMLALTT, ARM_INS_SMLSLD,
+		ARM_INS_SMLSLDX, ARM_INS_UMAAL, ARM_INS_UMLAL,
+	),
+	ruleUpdateFirst: newInsnSet(
+		ARM_INS_BFC, ARM_INS_BFI, ARM_INS_MOVT, ARM_INS_VBIF, ARM_INS_VBIT,
+		ARM_INS_VBSL, ARM_INS_VFMA, ARM_INS_VFMS, ARM_INS_VFNMA, ARM_INS_VFNMS,
+		ARM_INS_VMLA, ARM_INS_VMLAL, ARM_INS_VMLS, ARM_INS_VMLSL,
+	),
+}
+
+func armAccessOperands(arm *ArmInstruction) ([]accessOperand, *regAccess, bool) {
+	ra := &regAccess{invalid: ARM_REG_INVALID}
+	ops := make([]accessOperand, len(arm.Operands))
+	hasMem := false
+	for i, op := range arm.Operands {
+		ops[i].reg = ARM_REG_INVALID
+		switch op.Type {
+		case ARM_OP_REG:
+			ops[i].reg = op.Reg
+		case ARM_OP_MEM:
+			hasMem = true
+			ops[i].mem = true
+			ops[i].addr = []uint{op.Mem.Base, op.Mem.Index}
+			ops[i].writeback = arm.Writeback
+		}
+		switch op.Shift.Type {
+		case ARM_SFT_ASR_REG, ARM_SFT_LSL_REG, ARM_SFT_LSR_REG, ARM_SFT_ROR_REG, ARM_SFT_RRX_REG:
+			ops[i].addr = append(ops[i].addr, op.Shift.Value)
+		}
+	}
+	// ldm r0!, {r1, r2} - the base is a plain register operand
+	if arm.Writeback && !hasMem && len(ops) > 0 {
+		ops[0].addr = []uint{ops[0].reg}
+		ops[0].writeback = true
+	}
+	if arm.UpdateFlags {
+		ra.flagsWritten = append(ra.flagsWritten, ARM_REG_CPSR)
+	}
+	if arm.CC != ARM_CC_AL && arm.CC != ARM_CC_INVALID {
+		ra.flagsRead = append(ra.flagsRead, ARM_REG_CPSR)
+	}
+	return ops, ra, true
+}
+
+// ARM64
+
+var arm64Access = accessTable{
+	ruleReadAll: newInsnSet(
+		ARM64_INS_B, ARM64_INS_BL, ARM64_INS_BLR, ARM64_INS_BR, ARM64_INS_CBNZ,
+		ARM64_INS_CBZ, ARM64_INS_CCMN, ARM64_INS_CCMP, ARM64_INS_ERET,
+		ARM64_INS_FCCMP, ARM64_INS_FCCMPE, ARM64_INS_FCMP, ARM64_INS_FCMPE,
+		ARM64_INS_MSR, ARM64_INS_PRFM, ARM64_INS_PRFUM, ARM64_INS_RET,
+		ARM64_INS_SYS, ARM64_INS_TBNZ, ARM64_INS_TBZ, ARM64_INS_CMN,
+		ARM64_INS_TST, ARM64_INS_CMP, ARM64_INS_IC, ARM64_INS_DC, ARM64_INS_AT,
+		ARM64_INS_TLBI,
+	),
+	ruleStore: newInsnSet(
+		ARM64_INS_ST1, ARM64_INS_ST2, ARM64_INS_ST3, ARM64_INS_ST4,
+		ARM64_INS_STLRB, ARM64_INS_STLRH, ARM64_INS_STLR, ARM64_INS_STNP,
+		ARM64_INS_STP, ARM64_INS_STRB, ARM64_INS_STR, ARM64_INS_STRH,
+		ARM64_INS_STTRB, ARM64_INS_STTRH, ARM64_INS_STTR, ARM64_INS_STURB,
+		ARM64_INS_STUR, ARM64_INS_STURH,
+	),
+	ruleStoreStatus: newInsnSet(
+		ARM64_INS_STLXP, ARM64_INS_STLXRB, ARM64_INS_STLXRH, ARM64_INS_STLXR,
+		ARM64_INS_STXP, ARM64_INS_STXRB, ARM64_INS_STXRH, ARM64_INS_STXR,
+	),
+	ruleLoadRegs: newInsnSet(
+		ARM64_INS_LD1, ARM64_INS_LD1R, ARM64_INS_LD2R, ARM64_INS_LD2,
+		ARM64_INS_LD3R, ARM64_INS_LD3, ARM64_INS_LD4, ARM64_INS_LD4R,
+	),
+	ruleDestTwo: newInsnSet(
+		ARM64_INS_LDAXP, ARM64_INS_LDNP, ARM64_INS_LDP, ARM64_INS_LDPSW,
+		ARM64_INS_LDXP,
+	),
+	ruleUpdateFirst: newInsnSet(
+		ARM64_INS_BFM, ARM64_INS_FMLA, ARM64_INS_FMLS, ARM64_INS_INS,
+		ARM64_INS_MLA, ARM64_INS_MLS, ARM64_INS_MOVK, ARM64_INS_SLI,
+		ARM64_INS_SRI, ARM64_INS_TBX, ARM64_INS_BFI, ARM64_INS_BFXIL,
+	),
+}
+
+func arm64AccessOperands(arm64 *Arm64Instruction) ([]accessOperand, *regAccess, bool) {
+	ra := &regAccess{invalid: ARM64_REG_INVALID}
+	ops := make([]accessOperand, len(arm64.Operands))
+	for i, op := range arm64.Operands {
+		ops[i].reg = ARM64_REG_INVALID
+		switch op.Type {
+		case ARM64_OP_REG:
+			ops[i].reg = op.Reg
+		case ARM64_OP_MEM:
+			ops[i].mem = true
+			ops[i].addr = []uint{op.Mem.Base, op.Mem.Index}
+			ops[i].writeback = arm64.Writeback
+		}
+	}
+	if arm64.UpdateFlags {
+		ra.flagsWritten = append(ra.flagsWritten, ARM64_REG_NZCV)
+	}
+	switch arm64.CC {
+	case ARM64_CC_INVALID, ARM64_CC_AL, ARM64_CC_NV:
+	default:
+		ra.flagsRead = append(ra.flagsRead, ARM64_REG_NZCV)
+	}
+	return ops, ra, true
+}
+
+// MIPS
+
+var mipsAccess = accessTable{
+	ruleReadAll: newInsnSet(
+		MIPS_INS_CACHE, MIPS_INS_MTHI, MIPS_INS_MTLO, MIPS_INS_PREF,
+		MIPS_INS_SYNC,
+	),
+	ruleStore: newInsnSet(
+		MIPS_INS_SB, MIPS_INS_SD, MIPS_INS_SDC1, MIPS_INS_SDC2, MIPS_INS_SDC3,
+		MIPS_INS_SDL, MIPS_INS_SDR, MIPS_INS_SDXC1, MIPS_INS_SH,
+		MIPS_INS_SUXC1, MIPS_INS_SW, MIPS_INS_SWC1, MIPS_INS_SWC2,
+		MIPS_INS_SWC3, MIPS_INS_SWL, MIPS_INS_SWR, MIPS_INS_SWXC1,
+	),
+	ruleUpdateFirst: newInsnSet(
+		MIPS_INS_DINS, MIPS_INS_DINSM, MIPS_INS_DINSU, MIPS_INS_INS,
+		MIPS_INS_LDL, MIPS_INS_LDR, MIPS_INS_LWL, MIPS_INS_LWR, MIPS_INS_MOVF,
+		MIPS_INS_MOVN, MIPS_INS_MOVT, MIPS_INS_MOVZ, MIPS_INS_SC, MIPS_INS_SCD,
+	),
+	ruleDestSecond: newInsnSet(
+		MIPS_INS_CTC1, MIPS_INS_DMTC0, MIPS_INS_DMTC1, MIPS_INS_DMTC2,
+		MIPS_INS_MTC0, MIPS_INS_MTC1, MIPS_INS_MTC2, MIPS_INS_MTHC1,
+	),
+}
+
+// Two operand forms write HI / LO implicitly
+var mipsAccumulate = newInsnSet(
+	MIPS_INS_DDIV, MIPS_INS_DDIVU, MIPS_INS_DIV, MIPS_INS_DIVU, MIPS_INS_DMULT,
+	MIPS_INS_DMULTU, MIPS_INS_MADD, MIPS_INS_MADDU, MIPS_INS_MSUB,
+	MIPS_INS_MSUBU, MIPS_INS_MULT, MIPS_INS_MULTU,
+)
+
+var mipsLinkRegister = newInsnSet(
+	MIPS_INS_JALR, MIPS_INS_JALRS, MIPS_INS_JALR_HB,
+)
+
+func mipsAccessRule(insn *Instruction, nops int) accessRule {
+	if rule, ok := mipsAccess.lookup(insn.Id); ok {
+		return rule
+	}
+	switch {
+	case mipsAccumulate[insn.Id] && nops == 2:
+		return ruleReadAll
+	case mipsLinkRegister[insn.Id] && nops == 2:
+		// jalr $rd, $rs
+		return ruleDestFirst
+	case insn.branches():
+		return ruleReadAll
+	}
+	return ruleDestFirst
+}
+
+func mipsAccessOperands(mips *MipsInstruction) []accessOperand {
+	ops := make([]accessOperand, len(mips.Operands))
+	for i, op := range mips.Operands {
+		ops[i].reg = MIPS_REG_INVALID
+		switch op.Type {
+		case MIPS_OP_REG:
+			ops[i].reg = op.Reg
+		case MIPS_OP_MEM:
+			ops[i].mem = true
+			ops[i].addr = []uint{op.Mem.Base}
+		}
+	}
+	return ops
+}
+
+// PPC
+
+var ppcAccess = accessTable{
+	ruleReadAll: newInsnSet(
+		PPC_INS_DCBA, PPC_INS_DCBF, PPC_INS_DCBI, PPC_INS_DCBST, PPC_INS_DCBT,
+		PPC_INS_DCBTST, PPC_INS_DCBZ, PPC_INS_DCBZL, PPC_INS_ICBI,
+		PPC_INS_MTCRF, PPC_INS_MTCTR, PPC_INS_MTDCR, PPC_INS_MTFSB0,
+		PPC_INS_MTFSB1, PPC_INS_MTFSF, PPC_INS_MTLR, PPC_INS_MTMSR,
+		PPC_INS_MTMSRD, PPC_INS_MTOCRF, PPC_INS_MTSPR, PPC_INS_MTSR,
+		PPC_INS_MTSRIN, PPC_INS_MTVSCR, PPC_INS_MTCR, PPC_INS_MTBR0,
+		PPC_INS_MTBR1, PPC_INS_MTBR2, PPC_INS_MTBR3, PPC_INS_MTBR4,
+		PPC_INS_MTBR5, PPC_INS_MTBR6, PPC_INS_MTBR7, PPC_INS_MTXER,
+		PPC_INS_MTDSCR, PPC_INS_MTDSISR, PPC_INS_MTDAR, PPC_INS_MTSRR2,
+		PPC_INS_MTSRR3, PPC_INS_MTCFAR, PPC_INS_MTAMR, PPC_INS_MTPID,
+		PPC_INS_MTTBL, PPC_INS_MTTBU, PPC_INS_MTTBLO, PPC_INS_MTTBHI,
+		PPC_INS_MTDBATU, PPC_INS_MTDBATL, PPC_INS_MTIBATU, PPC_INS_MTIBATL,
+		PPC_INS_MTDCCR, PPC_INS_MTICCR, PPC_INS_MTDEAR, PPC_INS_MTESR,
+		PPC_INS_MTSPEFSCR, PPC_INS_MTTCR,
+	),
+	ruleStore: newInsnSet(
+		PPC_INS_STB, PPC_INS_STBU, PPC_INS_STBUX, PPC_INS_STBX, PPC_INS_STD,
+		PPC_INS_STDBRX, PPC_INS_STDCX, PPC_INS_STDU, PPC_INS_STDUX,
+		PPC_INS_STDX, PPC_INS_STFD, PPC_INS_STFDU, PPC_INS_STFDUX,
+		PPC_INS_STFDX, PPC_INS_STFIWX, PPC_INS_STFS, PPC_INS_STFSU,
+		PPC_INS_STFSUX, PPC_INS_STFSX, PPC_INS_STH, PPC_INS_STHBRX,
+		PPC_INS_STHU, PPC_INS_STHUX, PPC_INS_STHX, PPC_INS_STMW, PPC_INS_STSWI,
+		PPC_INS_STVEBX, PPC_INS_STVEHX, PPC_INS_STVEWX, PPC_INS_STVX,
+		PPC_INS_STVXL, PPC_INS_STW, PPC_INS_STWBRX, PPC_INS_STWCX,
+		PPC_INS_STWU, PPC_INS_STWUX, PPC_INS_STWX, PPC_INS_STXSDX,
+		PPC_INS_STXVD2X, PPC_INS_STXVW4X,
+	),
+	ruleUpdateFirst: newInsnSet(
+		PPC_INS_RLDIMI, PPC_INS_RLWIMI,
+	),
+}
+
+// Load / store with update write the effective address back to rA
+var ppcCompares = newInsnSet(
+	PPC_INS_CMPD, PPC_INS_CMPDI, PPC_INS_CMPLD, PPC_INS_CMPLDI, PPC_INS_CMPLW,
+	PPC_INS_CMPLWI, PPC_INS_CMPW, PPC_INS_CMPWI, PPC_INS_FCMPU,
+)
+
+var ppcUpdateForms = newInsnSet(
+	PPC_INS_LBZU, PPC_INS_LBZUX, PPC_INS_LDU, PPC_INS_LDUX, PPC_INS_LFDU,
+	PPC_INS_LFDUX, PPC_INS_LFSU, PPC_INS_LFSUX, PPC_INS_LHAU, PPC_INS_LHAUX,
+	PPC_INS_LHZU, PPC_INS_LHZUX, PPC_INS_LWAUX, PPC_INS_LWZU, PPC_INS_LWZUX,
+	PPC_INS_STBU, PPC_INS_STBUX, PPC_INS_STDU, PPC_INS_STDUX, PPC_INS_STFDU,
+	PPC_INS_STFDUX, PPC_INS_STFSU, PPC_INS_STFSUX, PPC_INS_STHU, PPC_INS_STHUX,
+	PPC_INS_STWU, PPC_INS_STWUX,
+)
+
+func ppcAccessRule(insn *Instruction) accessRule {
+	if rule, ok := ppcAccess.lookup(insn.Id); ok {
+		return rule
+	}
+	switch {
+	case ppcCompares[insn.Id]:
+		// The target CR field is only an operand when it isn't cr0
+		if len(insn.PPC.Operands) > 0 && ppcIsCR(insn.PPC.Operands[0]) {
+			return ruleDestFirst
+		}
+		return ruleReadAll
+	case insn.branches():
+		return ruleReadAll
+	}
+	return ruleDestFirst
+}
+
+func ppcIsCR(op PPCOperand) bool {
+	if op.Type != PPC_OP_REG {
+		return false
+	}
+	switch op.Reg {
+	case PPC_REG_CR0, PPC_REG_CR1, PPC_REG_CR2, PPC_REG_CR3,
+		PPC_REG_CR4, PPC_REG_CR5, PPC_REG_CR6, PPC_REG_CR7:
+		return true
+	}
+	return false
+}
+
+func ppcAccessOperands(insn *Instruction) ([]accessOperand, *regAccess, bool) {
+	ppc := insn.PPC
+	ra := &regAccess{invalid: PPC_REG_INVALID}
+	ops := make([]accessOperand, len(ppc.Operands))
+	update := ppcUpdateForms[insn.Id]
+	hasMem := false
+	for i, op := range ppc.Operands {
+		ops[i].reg = PPC_REG_INVALID
+		switch op.Type {
+		case PPC_OP_REG:
+			ops[i].reg = op.Reg
+		case PPC_OP_MEM:
+			hasMem = true
+			ops[i].mem = true
+			ops[i].addr = []uint{op.Mem.Base}
+			ops[i].writeback = update
+		case PPC_OP_CRX:
+			ops[i].addr = []uint{op.CRX.Reg}
+		}
+	}
+	// Indexed update forms: lwzux rD, rA, rB
+	if update && !hasMem && len(ops) > 1 {
+		ops[1].addr = []uint{ops[1].reg}
+		ops[1].writeback = true
+	}
+	if ppc.UpdateCR0 {
+		ra.flagsWritten = append(ra.flagsWritten, PPC_REG_CR0)
+	}
+	return ops, ra, true
+}
+
+// SPARC
+
+var sparcAccess = accessTable{
+	ruleReadAll: newInsnSet(
+		SPARC_INS_CMP, SPARC_INS_FCMPD, SPARC_INS_FCMPQ, SPARC_INS_FCMPS,
+		SPARC_INS_FCMPED, SPARC_INS_FCMPES,
+	),
+	ruleStore: newInsnSet(
+		SPARC_INS_STB, SPARC_INS_STD, SPARC_INS_ST, SPARC_INS_STH,
+		SPARC_INS_STQ, SPARC_INS_STX,
+	),
+	ruleDestLast: newInsnSet(
+		SPARC_INS_JMPL,
+	),
+	ruleUpdateLast: newInsnSet(
+		SPARC_INS_CASX, SPARC_INS_CAS, SPARC_INS_FMOVRDGEZ,
+		SPARC_INS_FMOVRQGEZ, SPARC_INS_FMOVRSGEZ, SPARC_INS_FMOVRDGZ,
+		SPARC_INS_FMOVRQGZ, SPARC_INS_FMOVRSGZ, SPARC_INS_FMOVRDLEZ,
+		SPARC_INS_FMOVRQLEZ, SPARC_INS_FMOVRSLEZ, SPARC_INS_FMOVRDLZ,
+		SPARC_INS_FMOVRQLZ, SPARC_INS_FMOVRSLZ, SPARC_INS_FMOVRDNZ,
+		SPARC_INS_FMOVRQNZ, SPARC_INS_FMOVRSNZ, SPARC_INS_FMOVRDZ,
+		SPARC_INS_FMOVRQZ, SPARC_INS_FMOVRSZ, SPARC_INS_MOVRGEZ,
+		SPARC_INS_MOVRGZ, SPARC_INS_MOVRLEZ, SPARC_INS_MOVRLZ,
+		SPARC_INS_MOVRNZ, SPARC_INS_MOVRZ, SPARC_INS_SWAP,
+	),
+}
+
+func sparcAccessOperands(sparc *SparcInstruction) []accessOperand {
+	ops := make([]accessOperand, len(sparc.Operands))
+	for i, op := range sparc.Operands {
+		ops[i].reg = SPARC_REG_INVALID
+		switch op.Type {
+		case SPARC_OP_REG:
+			ops[i].reg = op.Reg
+		case SPARC_OP_MEM:
+			ops[i].mem = true
+			ops[i].addr = []uint{uint(op.Mem.Base), uint(op.Mem.Index)}
+		}
+	}
+	return ops
+}
+
+// SYSZ
+
+var syszAccess = accessTable{
+	ruleReadAll: newInsnSet(
+		SYSZ_INS_CGIJ, SYSZ_INS_CGRJ, SYSZ_INS_CIJ, SYSZ_INS_CLGIJ,
+		SYSZ_INS_CLGRJ, SYSZ_INS_CLIJ, SYSZ_INS_CLRJ, SYSZ_INS_CRJ,
+		SYSZ_INS_CGIJNLH, SYSZ_INS_CGRJNLH, SYSZ_INS_CIJNLH, SYSZ_INS_CLGIJNLH,
+		SYSZ_INS_CLGRJNLH, SYSZ_INS_CLIJNLH, SYSZ_INS_CLRJNLH, SYSZ_INS_CRJNLH,
+		SYSZ_INS_CGIJE, SYSZ_INS_CGRJE, SYSZ_INS_CIJE, SYSZ_INS_CLGIJE,
+		SYSZ_INS_CLGRJE, SYSZ_INS_CLIJE, SYSZ_INS_CLRJE, SYSZ_INS_CRJE,
+		SYSZ_INS_CGIJNLE, SYSZ_INS_CGRJNLE, SYSZ_INS_CIJNLE, SYSZ_INS_CLGIJNLE,
+		SYSZ_INS_CLGRJNLE, SYSZ_INS_CLIJNLE, SYSZ_INS_CLRJNLE, SYSZ_INS_CRJNLE,
+		SYSZ_INS_CGIJH, SYSZ_INS_CGRJH, SYSZ_INS_CIJH, SYSZ_INS_CLGIJH,
+		SYSZ_INS_CLGRJH, SYSZ_INS_CLIJH, SYSZ_INS_CLRJH, SYSZ_INS_CRJH,
+		SYSZ_INS_CGIJNL, SYSZ_INS_CGRJNL, SYSZ_INS_CIJNL, SYSZ_INS_CLGIJNL,
+		SYSZ_INS_CLGRJNL, SYSZ_INS_CLIJNL, SYSZ_INS_CLRJNL, SYSZ_INS_CRJNL,
+		SYSZ_INS_CGIJHE, SYSZ_INS_CGRJHE, SYSZ_INS_CIJHE, SYSZ_INS_CLGIJHE,
+		SYSZ_INS_CLGRJHE, SYSZ_INS_CLIJHE, SYSZ_INS_CLRJHE, SYSZ_INS_CRJHE,
+		SYSZ_INS_CGIJNHE, SYSZ_INS_CGRJNHE, SYSZ_INS_CIJNHE, SYSZ_INS_CLGIJNHE,
+		SYSZ_INS_CLGRJNHE, SYSZ_INS_CLIJNHE, SYSZ_INS_CLRJNHE, SYSZ_INS_CRJNHE,
+		SYSZ_INS_CGIJL, SYSZ_INS_CGRJL, SYSZ_INS_CIJL, SYSZ_INS_CLGIJL,
+		SYSZ_INS_CLGRJL, SYSZ_INS_CLIJL, SYSZ_INS_CLRJL, SYSZ_INS_CRJL,
+		SYSZ_INS_CGIJNH, SYSZ_INS_CGRJNH, SYSZ_INS_CIJNH, SYSZ_INS_CLGIJNH,
+		SYSZ_INS_CLGRJNH, SYSZ_INS_CLIJNH, SYSZ_INS_CLRJNH, SYSZ_INS_CRJNH,
+		SYSZ_INS_CGIJLE, SYSZ_INS_CGRJLE, SYSZ_INS_CIJLE, SYSZ_INS_CLGIJLE,
+		SYSZ_INS_CLGRJLE, SYSZ_INS_CLIJLE, SYSZ_INS_CLRJLE, SYSZ_INS_CRJLE,
+		SYSZ_INS_CGIJNE, SYSZ_INS_CGRJNE, SYSZ_INS_CIJNE, SYSZ_INS_CLGIJNE,
+		SYSZ_INS_CLGRJNE, SYSZ_INS_CLIJNE, SYSZ_INS_CLRJNE, SYSZ_INS_CRJNE,
+		SYSZ_INS_CGIJLH, SYSZ_INS_CGRJLH, SYSZ_INS_CIJLH, SYSZ_INS_CLGIJLH,
+		SYSZ_INS_CLGRJLH, SYSZ_INS_CLIJLH, SYSZ_INS_CLRJLH, SYSZ_INS_CRJLH,
+		SYSZ_INS_C, SYSZ_INS_CDB, SYSZ_INS_CDBR, SYSZ_INS_CEB, SYSZ_INS_CEBR,
+		SYSZ_INS_CFI, SYSZ_INS_CG, SYSZ_INS_CGF, SYSZ_INS_CGFI, SYSZ_INS_CGFR,
+		SYSZ_INS_CGFRL, SYSZ_INS_CGH, SYSZ_INS_CGHI, SYSZ_INS_CGHRL,
+		SYSZ_INS_CGHSI, SYSZ_INS_CGR, SYSZ_INS_CGRL, SYSZ_INS_CH, SYSZ_INS_CHF,
+		SYSZ_INS_CHHSI, SYSZ_INS_CHI, SYSZ_INS_CHRL, SYSZ_INS_CHSI,
+		SYSZ_INS_CHY, SYSZ_INS_CIH, SYSZ_INS_CL, SYSZ_INS_CLC, SYSZ_INS_CLFHSI,
+		SYSZ_INS_CLFI, SYSZ_INS_CLG, SYSZ_INS_CLGF, SYSZ_INS_CLGFI,
+		SYSZ_INS_CLGFR, SYSZ_INS_CLGFRL, SYSZ_INS_CLGHRL, SYSZ_INS_CLGHSI,
+		SYSZ_INS_CLGR, SYSZ_INS_CLGRL, SYSZ_INS_CLHF, SYSZ_INS_CLHHSI,
+		SYSZ_INS_CLHRL, SYSZ_INS_CLI, SYSZ_INS_CLIH, SYSZ_INS_CLIY,
+		SYSZ_INS_CLR, SYSZ_INS_CLRL, SYSZ_INS_CLST, SYSZ_INS_CLY, SYSZ_INS_CR,
+		SYSZ_INS_CRL, SYSZ_INS_CXBR, SYSZ_INS_CY, SYSZ_INS_PFD, SYSZ_INS_PFDRL,
+		SYSZ_INS_TM, SYSZ_INS_TMHH, SYSZ_INS_TMHL, SYSZ_INS_TMLH,
+		SYSZ_INS_TMLL, SYSZ_INS_TMY,
+	),
+	ruleStore: newInsnSet(
+		SYSZ_INS_STOCE, SYSZ_INS_STOCGE, SYSZ_INS_STOCHE, SYSZ_INS_STOCGHE,
+		SYSZ_INS_STOCH, SYSZ_INS_STOCGH, SYSZ_INS_STOCLE, SYSZ_INS_STOCGLE,
+		SYSZ_INS_STOCLH, SYSZ_INS_STOCGLH, SYSZ_INS_STOCL, SYSZ_INS_STOCGL,
+		SYSZ_INS_STOCNE, SYSZ_INS_STOCGNE, SYSZ_INS_STOCNHE, SYSZ_INS_STOCGNHE,
+		SYSZ_INS_STOCNH, SYSZ_INS_STOCGNH, SYSZ_INS_STOCNLE, SYSZ_INS_STOCGNLE,
+		SYSZ_INS_STOCNLH, SYSZ_INS_STOCGNLH, SYSZ_INS_STOCNL, SYSZ_INS_STOCGNL,
+		SYSZ_INS_STOCNO, SYSZ_INS_STOCGNO, SYSZ_INS_STOCO, SYSZ_INS_STOCGO,
+		SYSZ_INS_STOC, SYSZ_INS_STOCG, SYSZ_INS_MVGHI,
+		SYSZ_INS_MVHHI, SYSZ_INS_MVHI, SYSZ_INS_MVI, SYSZ_INS_MVIY,
+		SYSZ_INS_ST, SYSZ_INS_STC, SYSZ_INS_STCH, SYSZ_INS_STCY, SYSZ_INS_STD,
+		SYSZ_INS_STDY, SYSZ_INS_STE, SYSZ_INS_STEY, SYSZ_INS_STFH,
+		SYSZ_INS_STG, SYSZ_INS_STGRL, SYSZ_INS_STH, SYSZ_INS_STHH,
+		SYSZ_INS_STHRL, SYSZ_INS_STHY, SYSZ_INS_STMG, SYSZ_INS_STRL,
+		SYSZ_INS_STRV, SYSZ_INS_STRVG, SYSZ_INS_STY,
+	),
+	ruleDestFirst: newInsnSet(
+		SYSZ_INS_BASR, SYSZ_INS_BRAS, SYSZ_INS_BRASL, SYSZ_INS_CDFBR,
+		SYSZ_INS_CDGBR, SYSZ_INS_CDLFBR, SYSZ_INS_CDLGBR, SYSZ_INS_CEFBR,
+		SYSZ_INS_CEGBR, SYSZ_INS_CELFBR, SYSZ_INS_CELGBR, SYSZ_INS_CFDBR,
+		SYSZ_INS_CFEBR, SYSZ_INS_CFXBR, SYSZ_INS_CGDBR, SYSZ_INS_CGEBR,
+		SYSZ_INS_CGXBR, SYSZ_INS_CLFDBR, SYSZ_INS_CLFEBR, SYSZ_INS_CLFXBR,
+		SYSZ_INS_CLGDBR, SYSZ_INS_CLGEBR, SYSZ_INS_CLGXBR, SYSZ_INS_CPSDR,
+		SYSZ_INS_CXFBR, SYSZ_INS_CXGBR, SYSZ_INS_CXLFBR, SYSZ_INS_CXLGBR,
+		SYSZ_INS_EAR, SYSZ_INS_IPM, SYSZ_INS_L, SYSZ_INS_LA, SYSZ_INS_LAA,
+		SYSZ_INS_LAAG, SYSZ_INS_LAAL, SYSZ_INS_LAALG, SYSZ_INS_LAN,
+		SYSZ_INS_LANG, SYSZ_INS_LAO, SYSZ_INS_LAOG, SYSZ_INS_LARL,
+		SYSZ_INS_LAX, SYSZ_INS_LAXG, SYSZ_INS_LAY, SYSZ_INS_LB, SYSZ_INS_LBH,
+		SYSZ_INS_LBR, SYSZ_INS_LCDBR, SYSZ_INS_LCEBR, SYSZ_INS_LCGFR,
+		SYSZ_INS_LCGR, SYSZ_INS_LCR, SYSZ_INS_LCXBR, SYSZ_INS_LD,
+		SYSZ_INS_LDEB, SYSZ_INS_LDEBR, SYSZ_INS_LDGR, SYSZ_INS_LDR,
+		SYSZ_INS_LDXBR, SYSZ_INS_LDXBRA, SYSZ_INS_LDY, SYSZ_INS_LE,
+		SYSZ_INS_LEDBR, SYSZ_INS_LEDBRA, SYSZ_INS_LER, SYSZ_INS_LEXBR,
+		SYSZ_INS_LEXBRA, SYSZ_INS_LEY, SYSZ_INS_LFH, SYSZ_INS_LG, SYSZ_INS_LGB,
+		SYSZ_INS_LGBR, SYSZ_INS_LGDR, SYSZ_INS_LGF, SYSZ_INS_LGFI,
+		SYSZ_INS_LGFR, SYSZ_INS_LGFRL, SYSZ_INS_LGH, SYSZ_INS_LGHI,
+		SYSZ_INS_LGHR, SYSZ_INS_LGHRL, SYSZ_INS_LGR, SYSZ_INS_LGRL,
+		SYSZ_INS_LH, SYSZ_INS_LHH, SYSZ_INS_LHI, SYSZ_INS_LHR, SYSZ_INS_LHRL,
+		SYSZ_INS_LHY, SYSZ_INS_LLC, SYSZ_INS_LLCH, SYSZ_INS_LLCR,
+		SYSZ_INS_LLGC, SYSZ_INS_LLGCR, SYSZ_INS_LLGF, SYSZ_INS_LLGFR,
+		SYSZ_INS_LLGFRL, SYSZ_INS_LLGH, SYSZ_INS_LLGHR, SYSZ_INS_LLGHRL,
+		SYSZ_INS_LLH, SYSZ_INS_LLHH, SYSZ_INS_LLHR, SYSZ_INS_LLHRL,
+		SYSZ_INS_LLIHF, SYSZ_INS_LLIHH, SYSZ_INS_LLIHL, SYSZ_INS_LLILF,
+		SYSZ_INS_LLILH, SYSZ_INS_LLILL, SYSZ_INS_LMG, SYSZ_INS_LNDBR,
+		SYSZ_INS_LNEBR, SYSZ_INS_LNGFR, SYSZ_INS_LNGR, SYSZ_INS_LNR,
+		SYSZ_INS_LNXBR, SYSZ_INS_LPDBR, SYSZ_INS_LPEBR, SYSZ_INS_LPGFR,
+		SYSZ_INS_LPGR, SYSZ_INS_LPR, SYSZ_INS_LPXBR, SYSZ_INS_LR, SYSZ_INS_LRL,
+		SYSZ_INS_LRV, SYSZ_INS_LRVG, SYSZ_INS_LRVGR, SYSZ_INS_LRVR,
+		SYSZ_INS_LT, SYSZ_INS_LTDBR, SYSZ_INS_LTEBR, SYSZ_INS_LTG,
+		SYSZ_INS_LTGF, SYSZ_INS_LTGFR, SYSZ_INS_LTGR, SYSZ_INS_LTR,
+		SYSZ_INS_LTXBR, SYSZ_INS_LXDB, SYSZ_INS_LXDBR, SYSZ_INS_LXEB,
+		SYSZ_INS_LXEBR, SYSZ_INS_LXR, SYSZ_INS_LY, SYSZ_INS_LZDR,
+		SYSZ_INS_LZER, SYSZ_INS_LZXR, SYSZ_INS_MVC,
+	),
+	ruleUpdateFirst: newInsnSet(
+		SYSZ_INS_LOCE, SYSZ_INS_LOCGE, SYSZ_INS_LOCGRE, SYSZ_INS_LOCRE,
+		SYSZ_INS_LOCHE, SYSZ_INS_LOCGHE, SYSZ_INS_LOCGRHE, SYSZ_INS_LOCRHE,
+		SYSZ_INS_LOCH, SYSZ_INS_LOCGH, SYSZ_INS_LOCGRH, SYSZ_INS_LOCRH,
+		SYSZ_INS_LOCLE, SYSZ_INS_LOCGLE, SYSZ_INS_LOCGRLE, SYSZ_INS_LOCRLE,
+		SYSZ_INS_LOCLH, SYSZ_INS_LOCGLH, SYSZ_INS_LOCGRLH, SYSZ_INS_LOCRLH,
+		SYSZ_INS_LOCL, SYSZ_INS_LOCGL, SYSZ_INS_LOCGRL, SYSZ_INS_LOCRL,
+		SYSZ_INS_LOC, SYSZ_INS_LOCG, SYSZ_INS_LOCGR, SYSZ_INS_LOCR,
+		SYSZ_INS_LOCNE, SYSZ_INS_LOCGNE, SYSZ_INS_LOCGRNE, SYSZ_INS_LOCRNE,
+		SYSZ_INS_LOCNHE, SYSZ_INS_LOCGNHE, SYSZ_INS_LOCGRNHE, SYSZ_INS_LOCRNHE,
+		SYSZ_INS_LOCNH, SYSZ_INS_LOCGNH, SYSZ_INS_LOCGRNH, SYSZ_INS_LOCRNH,
+		SYSZ_INS_LOCNLE, SYSZ_INS_LOCGNLE, SYSZ_INS_LOCGRNLE, SYSZ_INS_LOCRNLE,
+		SYSZ_INS_LOCNLH, SYSZ_INS_LOCGNLH, SYSZ_INS_LOCGRNLH, SYSZ_INS_LOCRNLH,
+		SYSZ_INS_LOCNL, SYSZ_INS_LOCGNL, SYSZ_INS_LOCGRNL, SYSZ_INS_LOCRNL,
+		SYSZ_INS_LOCNO, SYSZ_INS_LOCGNO, SYSZ_INS_LOCGRNO, SYSZ_INS_LOCRNO,
+		SYSZ_INS_LOCO, SYSZ_INS_LOCGO, SYSZ_INS_LOCGRO, SYSZ_INS_LOCRO,
+		SYSZ_INS_BRCT, SYSZ_INS_BRCTG, SYSZ_INS_CS, SYSZ_INS_CSG, SYSZ_INS_CSY,
+		SYSZ_INS_MADB, SYSZ_INS_MADBR, SYSZ_INS_MAEB, SYSZ_INS_MAEBR,
+		SYSZ_INS_MSDB, SYSZ_INS_MSDBR, SYSZ_INS_MSEB, SYSZ_INS_MSEBR,
+		SYSZ_INS_RISBG, SYSZ_INS_RISBHG, SYSZ_INS_RISBLG, SYSZ_INS_RNSBG,
+		SYSZ_INS_ROSBG, SYSZ_INS_RXSBG,
+	),
+}
+
+func syszAccessRule(insn *Instruction, nops int) accessRule {
+	if rule, ok := syszAccess.lookup(insn.Id); ok {
+		return rule
+	}
+	switch {
+	case insn.branches():
+		return ruleReadAll
+	case nops > 2:
+		// Distinct operand forms: ark %r1, %r2, %r3
+		return ruleDestFirst
+	}
+	// ar %r1, %r2
+	return ruleUpdateFirst
+}
+
+func syszAccessOperands(sysz *SysZInstruction) []accessOperand {
+	ops := make([]accessOperand, len(sysz.Operands))
+	for i, op := range sysz.Operands {
+		ops[i].reg = SYSZ_REG_INVALID
+		switch op.Type {
+		case SYSZ_OP_REG, SYSZ_OP_ACREG:
+			ops[i].reg = op.Reg
+		case SYSZ_OP_MEM:
+			ops[i].mem = true
+			ops[i].addr = []uint{uint(op.Mem.Base), uint(op.Mem.Index)}
+		}
+	}
+	return ops
+}
+
+// XCORE
+
+var xcoreAccess = accessTable{
+	ruleReadAll: newInsnSet(
+		XCORE_INS_CHKCT, XCORE_INS_CLRPT, XCORE_INS_ECALLF, XCORE_INS_ECALLT,
+		XCORE_INS_EDU, XCORE_INS_EEF, XCORE_INS_EET, XCORE_INS_EEU,
+		XCORE_INS_FREER, XCORE_INS_MJOIN, XCORE_INS_MSYNC, XCORE_INS_OUTCT,
+		XCORE_INS_OUTPW, XCORE_INS_OUTSHR, XCORE_INS_OUTT, XCORE_INS_OUT,
+		XCORE_INS_SETCLK, XCORE_INS_SETC, XCORE_INS_SETD, XCORE_INS_SETEV,
+		XCORE_INS_SETN, XCORE_INS_SETPSC, XCORE_INS_SETPT, XCORE_INS_SETRDY,
+		XCORE_INS_SETSR, XCORE_INS_SETTW, XCORE_INS_SETV, XCORE_INS_SYNCR,
+		XCORE_INS_TSETMR,
+	),
+	ruleStore: newInsnSet(
+		XCORE_INS_ST16, XCORE_INS_ST8, XCORE_INS_STW,
+	),
+	ruleDestTwo: newInsnSet(
+		XCORE_INS_LADD, XCORE_INS_LDIVU, XCORE_INS_LMUL, XCORE_INS_LSUB,
+	),
+	ruleUpdateTwo: newInsnSet(
+		XCORE_INS_MACCS, XCORE_INS_MACCU,
+	),
+	ruleUpdateFirst: newInsnSet(
+		XCORE_INS_CRC8, XCORE_INS_CRC32, XCORE_INS_SEXT, XCORE_INS_ZEXT,
+	),
+}
+
+func xcoreAccessOperands(xcore *XcoreInstruction) []accessOperand {
+	ops := make([]accessOperand, len(xcore.Operands))
+	for i, op := range xcore.Operands {
+		ops[i].reg = XCORE_REG_INVALID
+		switch op.Type {
+		case XCORE_OP_REG:
+			ops[i].reg = op.Reg
+		case XCORE_OP_MEM:
+			ops[i].mem = true
+			ops[i].addr = []uint{uint(op.Mem.Base), uint(op.Mem.Index)}
+		}
+	}
+	return ops
+}
@@ -0,0 +1,127 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"reflect"
+	"testing"
+)
+
+var regsAccessTests = []struct {
+	arch          int
+	mode          uint
+	code, insn    string
+	read, written []uint
+}{
+	{CS_ARCH_X86, CS_MODE_32, "\x01\xd8", "add eax, ebx",
+		[]uint{X86_REG_EAX, X86_REG_EBX},
+		[]uint{X86_REG_EFLAGS, X86_REG_EAX}},
+	{CS_ARCH_X86, CS_MODE_32, "\x89\x14\x88", "mov dword ptr [eax + ecx*4], edx",
+		[]uint{X86_REG_EAX, X86_REG_ECX, X86_REG_EDX},
+		nil},
+	{CS_ARCH_X86, CS_MODE_32, "\x39\xd8", "cmp eax, ebx",
+		[]uint{X86_REG_EAX, X86_REG_EBX},
+		[]uint{X86_REG_EFLAGS}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x04\x00\xb1\xe5", "ldr r0, [r1, #4]!",
+		[]uint{ARM_REG_R1},
+		[]uint{ARM_REG_R0, ARM_REG_R1}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x12\x03\x91\x00", "addseq r0, r1, r2, lsl r3",
+		[]uint{ARM_REG_R1, ARM_REG_R2, ARM_REG_R3, ARM_REG_CPSR},
+		[]uint{ARM_REG_R0, ARM_REG_CPSR}},
+	// stmdb sp!, {r4, r5, r11, lr}: Capstone reports sp as implicit
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x30\x48\x2d\xe9", "push {r4, r5, r11, lr}",
+		[]uint{ARM_REG_SP, ARM_REG_R4, ARM_REG_R5, ARM_REG_R11, ARM_REG_LR},
+		[]uint{ARM_REG_SP}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\xfd\x7b\xc1\xa8", "ldp x29, x30, [sp], #0x10",
+		[]uint{ARM64_REG_SP},
+		[]uint{ARM64_REG_X29, ARM64_REG_X30, ARM64_REG_SP}},
+	{CS_ARCH_MIPS, CS_MODE_32, "\x08\x00\xa4\xaf", "sw $a0, 8($sp)",
+		[]uint{MIPS_REG_A0, MIPS_REG_SP},
+		nil},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x94\x21\xff\xf0", "stwu r1, -16(r1)",
+		[]uint{PPC_REG_R1},
+		[]uint{PPC_REG_R1}},
+	{CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, "\x86\x00\x40\x02", "add %g1, %g2, %g3",
+		[]uint{SPARC_REG_G1, SPARC_REG_G2},
+		[]uint{SPARC_REG_G3}},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\x1a\x12", "ar %r1, %r2",
+		[]uint{SYSZ_REG_1, SYSZ_REG_2},
+		[]uint{SYSZ_REG_CC, SYSZ_REG_1}},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xd2\x07\x20\x00\x30\x00", "mvc 0(8, %r2), 0(%r3)",
+		[]uint{SYSZ_REG_2, SYSZ_REG_3},
+		nil},
+}
+
+// Disassemble a single instruction with detail on
+func disasmOne(t *testing.T, arch int, mode uint, code string) Instruction {
+	engine, err := New(arch, mode)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer engine.Close()
+	engine.SetOption(CS_OPT_DETAIL, CS_OPT_ON)
+
+	insns, err := engine.Disasm([]byte(code), address, 1)
+	if err != nil || len(insns) != 1 {
+		t.Fatalf("Disassembly error: %v", err)
+	}
+	return insns[0]
+}
+
+func TestRegsAccess(t *testing.T) {
+	for i, rt := range regsAccessTests {
+		read, written, err := disasmOne(t, rt.arch, rt.mode, rt.code).RegsAccess()
+		if err != nil {
+			t.Errorf("%2d> %s: unexpected error %v", i, rt.insn, err)
+			continue
+		}
+		if !reflect.DeepEqual(read, rt.read) {
+			t.Errorf("%2d> %s: read want %v got %v", i, rt.insn, rt.read, read)
+		}
+		if !reflect.DeepEqual(written, rt.written) {
+			t.Errorf("%2d> %s: written want %v got %v", i, rt.insn, rt.written, written)
+		}
+	}
+
+	bare := bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_NOP, 0x1000, "\x90")
+	if _, _, err := bare.RegsAccess(); err != ErrDetail {
+		t.Errorf("Want ErrDetail without detail, got %v", err)
+	}
+}
+
+func TestOperandAccess(t *testing.T) {
+	tests := []struct {
+		arch       int
+		mode       uint
+		code, insn string
+		accs       []Access
+	}{
+		{CS_ARCH_X86, CS_MODE_32, "\x89\x14\x88", "mov dword ptr [eax + ecx*4], edx",
+			[]Access{AccessWrite, AccessRead}},
+		{CS_ARCH_X86, CS_MODE_32, "\x87\xd8", "xchg ebx, eax",
+			[]Access{AccessReadWrite, AccessReadWrite}},
+		{CS_ARCH_MIPS, CS_MODE_32, "\x08\x00\xa4\xaf", "sw $a0, 8($sp)",
+			[]Access{AccessRead, AccessWrite}},
+		{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xd2\x07\x20\x00\x30\x00", "mvc 0(8, %r2), 0(%r3)",
+			[]Access{AccessWrite, AccessRead}},
+	}
+	for _, ot := range tests {
+		insn := disasmOne(t, ot.arch, ot.mode, ot.code)
+		for i, a := range ot.accs {
+			if got := insn.OperandAccess(i); got != a {
+				t.Errorf("%s: operand %v want %v got %v", ot.insn, i, a, got)
+			}
+		}
+		if got := insn.OperandAccess(len(ot.accs)); got != AccessNone {
+			t.Errorf("%s: out of range operand want none got %v", ot.insn, got)
+		}
+	}
+}
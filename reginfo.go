@@ -0,0 +1,301 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "sort"
+
+// Broad register classes
+type RegClass int
+
+const (
+	RegClassInvalid   RegClass = iota
+	RegClassGeneral            // general purpose / integer
+	RegClassFloat              // scalar floating point
+	RegClassVector             // SIMD
+	RegClassSegment            // x86 segment selectors
+	RegClassControl            // system, control and special purpose registers
+	RegClassFlags              // condition codes and status flags
+	RegClassPredicate          // AVX-512 opmask registers
+)
+
+var regClassNames = [...]string{
+	RegClassInvalid:   "invalid",
+	RegClassGeneral:   "general",
+	RegClassFloat:     "float",
+	RegClassVector:    "vector",
+	RegClassSegment:   "segment",
+	RegClassControl:   "control",
+	RegClassFlags:     "flags",
+	RegClassPredicate: "predicate",
+}
+
+func (c RegClass) String() string {
+	if c < 0 || int(c) >= len(regClassNames) {
+		return "invalid"
+	}
+	return regClassNames[c]
+}
+
+// The ABI or architectural role of a register, if it has one. Frame pointers
+// are the conventional ones for each arch - compilers are free to use them as
+// general registers.
+type RegRole int
+
+const (
+	RegRoleNone           RegRole = iota
+	RegRoleStackPointer           // x86 rsp, ARM sp, MIPS $sp ...
+	RegRoleFramePointer           // x86 rbp, ARM r11, ARM64 x29 ...
+	RegRoleLinkRegister           // ARM lr, MIPS $ra, PPC lr ...
+	RegRoleProgramCounter         // x86 rip, ARM pc ...
+	RegRoleZero                   // always reads as zero: MIPS $zero, ARM64 xzr
+)
+
+var regRoleNames = [...]string{
+	RegRoleNone:           "none",
+	RegRoleStackPointer:   "stack pointer",
+	RegRoleFramePointer:   "frame pointer",
+	RegRoleLinkRegister:   "link register",
+	RegRoleProgramCounter: "program counter",
+	RegRoleZero:           "zero",
+}
+
+func (r RegRole) String() string {
+	if r < 0 || int(r) >= len(regRoleNames) {
+		return "invalid"
+	}
+	return regRoleNames[r]
+}
+
+// Metadata for one register
+type RegisterInfo struct {
+	Reg   uint // *_REG_*
+	Width uint // in bits
+	Class RegClass
+	Role  RegRole
+}
+
+// Look up the RegisterInfo for a register constant like ARM64_REG_X29 or
+// insn.Arm64.Operands[0].Reg. ok is false for an unknown arch or register.
+func RegInfo(arch int, reg uint) (info RegisterInfo, ok bool) {
+	m, err := NewRegModel(arch)
+	if err != nil {
+		return RegisterInfo{}, false
+	}
+	return m.Info(reg)
+}
+
+// The RegisterInfo for reg on this model's arch
+func (m *RegModel) Info(reg uint) (RegisterInfo, bool) {
+	info, ok := m.info[reg]
+	return info, ok
+}
+
+// Every register in a class, in ascending order of constant value. Aliases
+// appear once.
+func (m *RegModel) Registers(class RegClass) []uint {
+	var regs regList
+	for r, info := range m.info {
+		if info.Class == class {
+			regs = append(regs, r)
+		}
+	}
+	sort.Sort(regs)
+	return regs
+}
+
+type regList []uint
+
+func (l regList) Len() int           { return len(l) }
+func (l regList) Less(i, j int) bool { return l[i] < l[j] }
+func (l regList) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+
+var regInfoBuilders = map[int]func(*RegModel){
+	CS_ARCH_ARM:   armRegInfo,
+	CS_ARCH_ARM64: arm64RegInfo,
+	CS_ARCH_MIPS:  mipsRegInfo,
+	CS_ARCH_X86:   x86RegInfo,
+	CS_ARCH_PPC:   ppcRegInfo,
+	CS_ARCH_SPARC: sparcRegInfo,
+	CS_ARCH_SYSZ:  syszRegInfo,
+	CS_ARCH_XCORE: xcoreRegInfo,
+}
+
+// Record the class of each register. Widths come from the model, so the
+// registers must already be in it.
+func (m *RegModel) class(class RegClass, regs ...uint) {
+	for _, r := range regs {
+		if _, ok := m.info[r]; ok {
+			continue
+		}
+		_, width, _ := m.BitRange(r)
+		m.info[r] = RegisterInfo{Reg: r, Width: width, Class: class}
+	}
+}
+
+func (m *RegModel) role(role RegRole, regs ...uint) {
+	for _, r := range regs {
+		if info, ok := m.info[r]; ok {
+			info.Role = role
+			m.info[r] = info
+		}
+	}
+}
+
+// X86
+
+func x86RegInfo(m *RegModel) {
+	for _, g := range x86GPRs[:16] {
+		for _, r := range g {
+			if r != X86_REG_INVALID {
+				m.class(RegClassGeneral, r)
+			}
+		}
+	}
+	m.class(RegClassGeneral, X86_REG_RIZ, X86_REG_EIZ)
+	m.class(RegClassControl, X86_REG_RIP, X86_REG_EIP, X86_REG_IP)
+	m.class(RegClassControl, x86CR[:]...)
+	m.class(RegClassControl, x86DR[:]...)
+	m.class(RegClassSegment, X86_REG_CS, X86_REG_DS, X86_REG_ES,
+		X86_REG_FS, X86_REG_GS, X86_REG_SS)
+	m.class(RegClassFlags, X86_REG_EFLAGS, X86_REG_FPSW)
+	m.class(RegClassFloat, x86FP[:]...)
+	m.class(RegClassFloat, x86ST[:]...)
+	m.class(RegClassVector, x86MM[:]...)
+	m.class(RegClassVector, x86XMM[:]...)
+	m.class(RegClassVector, x86YMM[:]...)
+	m.class(RegClassVector, x86ZMM[:]...)
+	m.class(RegClassPredicate, x86K[:]...)
+
+	m.role(RegRoleStackPointer, X86_REG_RSP, X86_REG_ESP, X86_REG_SP, X86_REG_SPL)
+	m.role(RegRoleFramePointer, X86_REG_RBP, X86_REG_EBP, X86_REG_BP, X86_REG_BPL)
+	m.role(RegRoleProgramCounter, X86_REG_RIP, X86_REG_EIP, X86_REG_IP)
+}
+
+// ARM
+
+func armRegInfo(m *RegModel) {
+	m.class(RegClassGeneral, armR[:]...)
+	m.class(RegClassGeneral, ARM_REG_SP, ARM_REG_LR, ARM_REG_PC)
+	m.class(RegClassFloat, armS[:]...)
+	m.class(RegClassFloat, armD[:]...)
+	m.class(RegClassVector, armQ[:]...)
+	m.class(RegClassFlags, ARM_REG_CPSR, ARM_REG_APSR, ARM_REG_APSR_NZCV,
+		ARM_REG_SPSR, ARM_REG_FPSCR, ARM_REG_FPSCR_NZCV, ARM_REG_ITSTATE)
+	m.class(RegClassControl, ARM_REG_FPEXC, ARM_REG_FPINST, ARM_REG_FPINST2,
+		ARM_REG_FPSID, ARM_REG_MVFR0, ARM_REG_MVFR1, ARM_REG_MVFR2)
+
+	// r11 is the ARM state frame pointer. Thumb code uses r7.
+	m.role(RegRoleStackPointer, ARM_REG_SP)
+	m.role(RegRoleFramePointer, ARM_REG_R11)
+	m.role(RegRoleLinkRegister, ARM_REG_LR)
+	m.role(RegRoleProgramCounter, ARM_REG_PC)
+}
+
+// ARM64
+
+func arm64RegInfo(m *RegModel) {
+	m.class(RegClassGeneral, arm64X[:]...)
+	m.class(RegClassGeneral, arm64W[:]...)
+	m.class(RegClassGeneral, ARM64_REG_SP, ARM64_REG_WSP, ARM64_REG_XZR, ARM64_REG_WZR)
+	m.class(RegClassFloat, arm64B[:]...)
+	m.class(RegClassFloat, arm64H[:]...)
+	m.class(RegClassFloat, arm64S[:]...)
+	m.class(RegClassFloat, arm64D[:]...)
+	m.class(RegClassVector, arm64Q[:]...)
+	m.class(RegClassVector, arm64V[:]...)
+	m.class(RegClassFlags, ARM64_REG_NZCV)
+
+	m.role(RegRoleStackPointer, ARM64_REG_SP, ARM64_REG_WSP)
+	m.role(RegRoleFramePointer, ARM64_REG_X29)
+	m.role(RegRoleLinkRegister, ARM64_REG_X30)
+	m.role(RegRoleZero, ARM64_REG_XZR, ARM64_REG_WZR)
+}
+
+// MIPS
+
+func mipsRegInfo(m *RegModel) {
+	m.class(RegClassGeneral, mipsGPR[:]...)
+	m.class(RegClassGeneral, MIPS_REG_AC0, MIPS_REG_AC1, MIPS_REG_AC2,
+		MIPS_REG_AC3, MIPS_REG_HI, MIPS_REG_LO, MIPS_REG_P0, MIPS_REG_P1,
+		MIPS_REG_P2, MIPS_REG_MPL0, MIPS_REG_MPL1, MIPS_REG_MPL2)
+	m.class(RegClassFloat, mipsF[:]...)
+	m.class(RegClassVector, mipsW[:]...)
+	m.class(RegClassFlags, mipsCC[:]...)
+	m.class(RegClassFlags, mipsFCC[:]...)
+	m.class(RegClassFlags, MIPS_REG_DSPCARRY, MIPS_REG_DSPCCOND,
+		MIPS_REG_DSPOUTFLAG, MIPS_REG_DSPOUTFLAG16_19, MIPS_REG_DSPOUTFLAG20,
+		MIPS_REG_DSPOUTFLAG21, MIPS_REG_DSPOUTFLAG22, MIPS_REG_DSPOUTFLAG23)
+	m.class(RegClassControl, MIPS_REG_DSPPOS, MIPS_REG_DSPSCOUNT, MIPS_REG_DSPEFI)
+
+	m.role(RegRoleZero, MIPS_REG_ZERO)
+	m.role(RegRoleStackPointer, MIPS_REG_SP)
+	m.role(RegRoleFramePointer, MIPS_REG_FP)
+	m.role(RegRoleLinkRegister, MIPS_REG_RA)
+}
+
+// PPC
+
+func ppcRegInfo(m *RegModel) {
+	m.class(RegClassGeneral, ppcR[:]...)
+	m.class(RegClassFloat, ppcF[:]...)
+	m.class(RegClassVector, ppcV[:]...)
+	m.class(RegClassVector, ppcVS[:]...)
+	m.class(RegClassFlags, ppcCR[:]...)
+	m.class(RegClassFlags, PPC_REG_CC, PPC_REG_CR1EQ, PPC_REG_CARRY)
+	m.class(RegClassControl, PPC_REG_CTR, PPC_REG_CTR8, PPC_REG_LR,
+		PPC_REG_LR8, PPC_REG_RM, PPC_REG_VRSAVE)
+
+	m.role(RegRoleStackPointer, PPC_REG_R1)
+	m.role(RegRoleLinkRegister, PPC_REG_LR, PPC_REG_LR8)
+}
+
+// SPARC
+
+func sparcRegInfo(m *RegModel) {
+	m.class(RegClassGeneral, sparcGPR[:]...)
+	m.class(RegClassFloat, sparcF[:]...)
+	m.class(RegClassFlags, SPARC_REG_ICC, SPARC_REG_XCC, SPARC_REG_FCC0,
+		SPARC_REG_FCC1, SPARC_REG_FCC2, SPARC_REG_FCC3)
+	m.class(RegClassControl, SPARC_REG_Y)
+
+	// call leaves the return address in %o7
+	m.role(RegRoleZero, SPARC_REG_G0)
+	m.role(RegRoleStackPointer, SPARC_REG_SP)
+	m.role(RegRoleFramePointer, SPARC_REG_FP)
+	m.role(RegRoleLinkRegister, SPARC_REG_O7)
+}
+
+// SYSZ
+
+func syszRegInfo(m *RegModel) {
+	m.class(RegClassGeneral, syszR[:]...)
+	m.class(RegClassGeneral, SYSZ_REG_R0L)
+	m.class(RegClassFloat, syszF[:]...)
+	m.class(RegClassFlags, SYSZ_REG_CC)
+
+	m.role(RegRoleFramePointer, SYSZ_REG_11)
+	m.role(RegRoleLinkRegister, SYSZ_REG_14)
+	m.role(RegRoleStackPointer, SYSZ_REG_15)
+}
+
+// XCORE
+
+func xcoreRegInfo(m *RegModel) {
+	m.class(RegClassGeneral, xcoreR[:12]...)
+	m.class(RegClassGeneral, XCORE_REG_CP, XCORE_REG_DP, XCORE_REG_SP, XCORE_REG_LR)
+	m.class(RegClassControl, XCORE_REG_PC, XCORE_REG_SCP, XCORE_REG_SSR,
+		XCORE_REG_ET, XCORE_REG_ED, XCORE_REG_SED, XCORE_REG_KEP,
+		XCORE_REG_KSP, XCORE_REG_ID)
+
+	m.role(RegRoleStackPointer, XCORE_REG_SP)
+	m.role(RegRoleLinkRegister, XCORE_REG_LR)
+	m.role(RegRoleProgramCounter, XCORE_REG_PC)
+}
@@ -0,0 +1,78 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+func TestRegInfo(t *testing.T) {
+	tests := []struct {
+		arch  int
+		reg   uint
+		width uint
+		class RegClass
+		role  RegRole
+	}{
+		{CS_ARCH_X86, X86_REG_RSP, 64, RegClassGeneral, RegRoleStackPointer},
+		{CS_ARCH_X86, X86_REG_AH, 8, RegClassGeneral, RegRoleNone},
+		{CS_ARCH_X86, X86_REG_EIP, 32, RegClassControl, RegRoleProgramCounter},
+		{CS_ARCH_X86, X86_REG_FS, 16, RegClassSegment, RegRoleNone},
+		{CS_ARCH_X86, X86_REG_EFLAGS, 32, RegClassFlags, RegRoleNone},
+		{CS_ARCH_X86, X86_REG_YMM7, 256, RegClassVector, RegRoleNone},
+		{CS_ARCH_X86, X86_REG_K1, 64, RegClassPredicate, RegRoleNone},
+		{CS_ARCH_ARM, ARM_REG_R13, 32, RegClassGeneral, RegRoleStackPointer},
+		{CS_ARCH_ARM, ARM_REG_PC, 32, RegClassGeneral, RegRoleProgramCounter},
+		{CS_ARCH_ARM, ARM_REG_D4, 64, RegClassFloat, RegRoleNone},
+		{CS_ARCH_ARM, ARM_REG_CPSR, 32, RegClassFlags, RegRoleNone},
+		{CS_ARCH_ARM64, ARM64_REG_X29, 64, RegClassGeneral, RegRoleFramePointer},
+		{CS_ARCH_ARM64, ARM64_REG_LR, 64, RegClassGeneral, RegRoleLinkRegister},
+		{CS_ARCH_ARM64, ARM64_REG_WZR, 32, RegClassGeneral, RegRoleZero},
+		{CS_ARCH_ARM64, ARM64_REG_Q2, 128, RegClassVector, RegRoleNone},
+		{CS_ARCH_MIPS, MIPS_REG_ZERO, 64, RegClassGeneral, RegRoleZero},
+		{CS_ARCH_MIPS, MIPS_REG_RA, 64, RegClassGeneral, RegRoleLinkRegister},
+		{CS_ARCH_PPC, PPC_REG_R1, 64, RegClassGeneral, RegRoleStackPointer},
+		{CS_ARCH_PPC, PPC_REG_CR2, 4, RegClassFlags, RegRoleNone},
+		{CS_ARCH_SPARC, SPARC_REG_O6, 64, RegClassGeneral, RegRoleStackPointer},
+		{CS_ARCH_SYSZ, SYSZ_REG_15, 64, RegClassGeneral, RegRoleStackPointer},
+		{CS_ARCH_XCORE, XCORE_REG_PC, 32, RegClassControl, RegRoleProgramCounter},
+	}
+	for i, rt := range tests {
+		info, ok := RegInfo(rt.arch, rt.reg)
+		if !ok {
+			t.Errorf("%2d> no info for reg %v on arch %v", i, rt.reg, rt.arch)
+			continue
+		}
+		if info.Reg != rt.reg || info.Width != rt.width || info.Class != rt.class || info.Role != rt.role {
+			t.Errorf("%2d> want %v/%v/%v got %v/%v/%v", i,
+				rt.width, rt.class, rt.role, info.Width, info.Class, info.Role)
+		}
+	}
+
+	if _, ok := RegInfo(CS_ARCH_X86, X86_REG_INVALID); ok {
+		t.Errorf("Want no info for the invalid register")
+	}
+	if _, ok := RegInfo(CS_ARCH_MAX, 1); ok {
+		t.Errorf("Want no info for an unknown arch")
+	}
+}
+
+func TestRegInfoRegisters(t *testing.T) {
+	m := mustRegModel(t, CS_ARCH_X86)
+	if segs := m.Registers(RegClassSegment); len(segs) != 6 {
+		t.Errorf("Want 6 x86 segment registers, got %v", segs)
+	}
+	preds := m.Registers(RegClassPredicate)
+	for i := 1; i < len(preds); i++ {
+		if preds[i-1] >= preds[i] {
+			t.Errorf("Registers should be sorted, got %v", preds)
+			break
+		}
+	}
+}
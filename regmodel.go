@@ -0,0 +1,641 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "sync"
+
+// A RegModel describes how the registers of one arch nest inside each other,
+// eg that al, ah, ax and eax are all parts of rax. Every register the model
+// knows about sits at a bit range inside a canonical (widest) register, and
+// registers overlap when their ranges within the same canonical register
+// intersect. Registers are keyed by the *_REG_* constants, so aliases with
+// the same value (ARM_REG_SP and ARM_REG_R13) are the same register.
+//
+// Widths are for the widest variant of each arch (x86-64, MIPS64, PPC64,
+// SPARC V9). Registers which the model doesn't know about are treated as
+// standalone: they are their own canonical register and only overlap
+// themselves.
+type RegModel struct {
+	arch    int
+	slots   map[uint]regSlot
+	members map[uint][]uint // canonical register -> every register inside it
+	info    map[uint]RegisterInfo
+}
+
+type regSlot struct {
+	canon  uint
+	offset uint
+	width  uint
+}
+
+var (
+	regModelMu sync.Mutex
+	regModels  = map[int]*RegModel{}
+)
+
+var regModelBuilders = map[int]func(*RegModel){
+	CS_ARCH_ARM:   buildArmRegs,
+	CS_ARCH_ARM64: buildArm64Regs,
+	CS_ARCH_MIPS:  buildMipsRegs,
+	CS_ARCH_X86:   buildX86Regs,
+	CS_ARCH_PPC:   buildPPCRegs,
+	CS_ARCH_SPARC: buildSparcRegs,
+	CS_ARCH_SYSZ:  buildSysZRegs,
+	CS_ARCH_XCORE: buildXcoreRegs,
+}
+
+// The register model for a CS_ARCH_*. Models are built once and shared, so
+// they must not be modified. Returns ErrArch for an unknown arch.
+func NewRegModel(arch int) (*RegModel, error) {
+	regModelMu.Lock()
+	defer regModelMu.Unlock()
+
+	if m, ok := regModels[arch]; ok {
+		return m, nil
+	}
+	build, ok := regModelBuilders[arch]
+	if !ok {
+		return nil, ErrArch
+	}
+	m := &RegModel{
+		arch:    arch,
+		slots:   make(map[uint]regSlot),
+		members: make(map[uint][]uint),
+		info:    make(map[uint]RegisterInfo),
+	}
+	build(m)
+	regInfoBuilders[arch](m)
+	regModels[arch] = m
+	return m, nil
+}
+
+// The CS_ARCH_* this model describes
+func (m *RegModel) Arch() int { return m.arch }
+
+// The widest register containing reg, eg X86_REG_RAX for X86_REG_AH
+func (m *RegModel) Canonical(reg uint) uint {
+	if s, ok := m.slots[reg]; ok {
+		return s.canon
+	}
+	return reg
+}
+
+// The position of reg inside its Canonical register, in bits from the least
+// significant end. ok is false for registers the model doesn't know.
+func (m *RegModel) BitRange(reg uint) (offset, width uint, ok bool) {
+	s, ok := m.slots[reg]
+	return s.offset, s.width, ok
+}
+
+// Whether writing one register can change the value of the other.
+func (m *RegModel) Overlaps(a, b uint) bool {
+	if a == b {
+		return true
+	}
+	sa, okA := m.slots[a]
+	sb, okB := m.slots[b]
+	if !okA || !okB || sa.canon != sb.canon {
+		return false
+	}
+	return sa.offset < sb.offset+sb.width && sb.offset < sa.offset+sa.width
+}
+
+// Registers wholly contained in reg, not including reg itself. Eg the
+// SubRegisters of X86_REG_AX are X86_REG_AL and X86_REG_AH.
+func (m *RegModel) SubRegisters(reg uint) []uint {
+	s, ok := m.slots[reg]
+	if !ok {
+		return nil
+	}
+	var subs []uint
+	for _, r := range m.members[s.canon] {
+		rs := m.slots[r]
+		if r != reg && rs.offset >= s.offset && rs.offset+rs.width <= s.offset+s.width {
+			subs = append(subs, r)
+		}
+	}
+	return subs
+}
+
+// Registers which wholly contain reg, widest first, not including reg
+// itself. Eg the SuperRegisters of X86_REG_AX are X86_REG_RAX and
+// X86_REG_EAX.
+func (m *RegModel) SuperRegisters(reg uint) []uint {
+	s, ok := m.slots[reg]
+	if !ok {
+		return nil
+	}
+	var supers []uint
+	for _, r := range m.members[s.canon] {
+		rs := m.slots[r]
+		if r != reg && rs.offset <= s.offset && rs.offset+rs.width >= s.offset+s.width {
+			supers = append(supers, r)
+		}
+	}
+	return supers
+}
+
+// Add a canonical register. Registers must be added widest first.
+func (m *RegModel) full(reg, width uint) {
+	m.part(reg, reg, 0, width)
+}
+
+// Add reg at a bit range inside canon. Aliases which share a constant value
+// are only recorded once.
+func (m *RegModel) part(reg, canon, offset, width uint) {
+	if _, ok := m.slots[reg]; ok {
+		return
+	}
+	m.slots[reg] = regSlot{canon, offset, width}
+	m.members[canon] = append(m.members[canon], reg)
+}
+
+// Add each register as a standalone canonical register
+func (m *RegModel) standalone(width uint, regs ...uint) {
+	for _, r := range regs {
+		m.full(r, width)
+	}
+}
+
+// X86
+
+// 64 bit register, then the 32 bit, 16 bit, low 8 bit and high 8 bit parts
+var x86GPRs = [...][5]uint{
+	{X86_REG_RAX, X86_REG_EAX, X86_REG_AX, X86_REG_AL, X86_REG_AH},
+	{X86_REG_RBX, X86_REG_EBX, X86_REG_BX, X86_REG_BL, X86_REG_BH},
+	{X86_REG_RCX, X86_REG_ECX, X86_REG_CX, X86_REG_CL, X86_REG_CH},
+	{X86_REG_RDX, X86_REG_EDX, X86_REG_DX, X86_REG_DL, X86_REG_DH},
+	{X86_REG_RSI, X86_REG_ESI, X86_REG_SI, X86_REG_SIL, X86_REG_INVALID},
+	{X86_REG_RDI, X86_REG_EDI, X86_REG_DI, X86_REG_DIL, X86_REG_INVALID},
+	{X86_REG_RBP, X86_REG_EBP, X86_REG_BP, X86_REG_BPL, X86_REG_INVALID},
+	{X86_REG_RSP, X86_REG_ESP, X86_REG_SP, X86_REG_SPL, X86_REG_INVALID},
+	{X86_REG_R8, X86_REG_R8D, X86_REG_R8W, X86_REG_R8B, X86_REG_INVALID},
+	{X86_REG_R9, X86_REG_R9D, X86_REG_R9W, X86_REG_R9B, X86_REG_INVALID},
+	{X86_REG_R10, X86_REG_R10D, X86_REG_R10W, X86_REG_R10B, X86_REG_INVALID},
+	{X86_REG_R11, X86_REG_R11D, X86_REG_R11W, X86_REG_R11B, X86_REG_INVALID},
+	{X86_REG_R12, X86_REG_R12D, X86_REG_R12W, X86_REG_R12B, X86_REG_INVALID},
+	{X86_REG_R13, X86_REG_R13D, X86_REG_R13W, X86_REG_R13B, X86_REG_INVALID},
+	{X86_REG_R14, X86_REG_R14D, X86_REG_R14W, X86_REG_R14B, X86_REG_INVALID},
+	{X86_REG_R15, X86_REG_R15D, X86_REG_R15W, X86_REG_R15B, X86_REG_INVALID},
+	{X86_REG_RIP, X86_REG_EIP, X86_REG_IP, X86_REG_INVALID, X86_REG_INVALID},
+	{X86_REG_RIZ, X86_REG_EIZ, X86_REG_INVALID, X86_REG_INVALID, X86_REG_INVALID},
+}
+
+var x86ZMM = [...]uint{
+	X86_REG_ZMM0, X86_REG_ZMM1, X86_REG_ZMM2, X86_REG_ZMM3, X86_REG_ZMM4,
+	X86_REG_ZMM5, X86_REG_ZMM6, X86_REG_ZMM7, X86_REG_ZMM8, X86_REG_ZMM9,
+	X86_REG_ZMM10, X86_REG_ZMM11, X86_REG_ZMM12, X86_REG_ZMM13, X86_REG_ZMM14,
+	X86_REG_ZMM15, X86_REG_ZMM16, X86_REG_ZMM17, X86_REG_ZMM18, X86_REG_ZMM19,
+	X86_REG_ZMM20, X86_REG_ZMM21, X86_REG_ZMM22, X86_REG_ZMM23, X86_REG_ZMM24,
+	X86_REG_ZMM25, X86_REG_ZMM26, X86_REG_ZMM27, X86_REG_ZMM28, X86_REG_ZMM29,
+	X86_REG_ZMM30, X86_REG_ZMM31,
+}
+var x86YMM = [...]uint{
+	X86_REG_YMM0, X86_REG_YMM1, X86_REG_YMM2, X86_REG_YMM3, X86_REG_YMM4,
+	X86_REG_YMM5, X86_REG_YMM6, X86_REG_YMM7, X86_REG_YMM8, X86_REG_YMM9,
+	X86_REG_YMM10, X86_REG_YMM11, X86_REG_YMM12, X86_REG_YMM13, X86_REG_YMM14,
+	X86_REG_YMM15, X86_REG_YMM16, X86_REG_YMM17, X86_REG_YMM18, X86_REG_YMM19,
+	X86_REG_YMM20, X86_REG_YMM21, X86_REG_YMM22, X86_REG_YMM23, X86_REG_YMM24,
+	X86_REG_YMM25, X86_REG_YMM26, X86_REG_YMM27, X86_REG_YMM28, X86_REG_YMM29,
+	X86_REG_YMM30, X86_REG_YMM31,
+}
+var x86XMM = [...]uint{
+	X86_REG_XMM0, X86_REG_XMM1, X86_REG_XMM2, X86_REG_XMM3, X86_REG_XMM4,
+	X86_REG_XMM5, X86_REG_XMM6, X86_REG_XMM7, X86_REG_XMM8, X86_REG_XMM9,
+	X86_REG_XMM10, X86_REG_XMM11, X86_REG_XMM12, X86_REG_XMM13, X86_REG_XMM14,
+	X86_REG_XMM15, X86_REG_XMM16, X86_REG_XMM17, X86_REG_XMM18, X86_REG_XMM19,
+	X86_REG_XMM20, X86_REG_XMM21, X86_REG_XMM22, X86_REG_XMM23, X86_REG_XMM24,
+	X86_REG_XMM25, X86_REG_XMM26, X86_REG_XMM27, X86_REG_XMM28, X86_REG_XMM29,
+	X86_REG_XMM30, X86_REG_XMM31,
+}
+var x86FP = [...]uint{
+	X86_REG_FP0, X86_REG_FP1, X86_REG_FP2, X86_REG_FP3, X86_REG_FP4,
+	X86_REG_FP5, X86_REG_FP6, X86_REG_FP7,
+}
+var x86MM = [...]uint{
+	X86_REG_MM0, X86_REG_MM1, X86_REG_MM2, X86_REG_MM3, X86_REG_MM4,
+	X86_REG_MM5, X86_REG_MM6, X86_REG_MM7,
+}
+var x86ST = [...]uint{
+	X86_REG_ST0, X86_REG_ST1, X86_REG_ST2, X86_REG_ST3, X86_REG_ST4,
+	X86_REG_ST5, X86_REG_ST6, X86_REG_ST7,
+}
+
+func buildX86Regs(m *RegModel) {
+	for _, g := range x86GPRs {
+		m.full(g[0], 64)
+		for i, width := range []uint{32, 16, 8} {
+			if g[i+1] != X86_REG_INVALID {
+				m.part(g[i+1], g[0], 0, width)
+			}
+		}
+		if g[4] != X86_REG_INVALID {
+			m.part(g[4], g[0], 8, 8)
+		}
+	}
+	for i := range x86ZMM {
+		m.full(x86ZMM[i], 512)
+		m.part(x86YMM[i], x86ZMM[i], 0, 256)
+		m.part(x86XMM[i], x86ZMM[i], 0, 128)
+	}
+	// MMX registers are the mantissa of the physical x87 registers. The
+	// ST(i) stack registers depend on TOP at runtime, so they stand alone.
+	for i := range x86FP {
+		m.full(x86FP[i], 80)
+		m.part(x86MM[i], x86FP[i], 0, 64)
+	}
+	m.standalone(80, x86ST[:]...)
+	m.standalone(32, X86_REG_EFLAGS)
+	m.standalone(16, X86_REG_FPSW, X86_REG_CS, X86_REG_DS, X86_REG_ES,
+		X86_REG_FS, X86_REG_GS, X86_REG_SS)
+	m.standalone(64, x86CR[:]...)
+	m.standalone(64, x86DR[:]...)
+	m.standalone(64, x86K[:]...)
+}
+
+var x86CR = [...]uint{
+	X86_REG_CR0, X86_REG_CR1, X86_REG_CR2, X86_REG_CR3, X86_REG_CR4,
+	X86_REG_CR5, X86_REG_CR6, X86_REG_CR7, X86_REG_CR8, X86_REG_CR9,
+	X86_REG_CR10, X86_REG_CR11, X86_REG_CR12, X86_REG_CR13, X86_REG_CR14,
+	X86_REG_CR15,
+}
+var x86DR = [...]uint{
+	X86_REG_DR0, X86_REG_DR1, X86_REG_DR2, X86_REG_DR3, X86_REG_DR4,
+	X86_REG_DR5, X86_REG_DR6, X86_REG_DR7,
+}
+var x86K = [...]uint{
+	X86_REG_K0, X86_REG_K1, X86_REG_K2, X86_REG_K3, X86_REG_K4, X86_REG_K5,
+	X86_REG_K6, X86_REG_K7,
+}
+
+// ARM
+
+var armR = [...]uint{
+	ARM_REG_R0, ARM_REG_R1, ARM_REG_R2, ARM_REG_R3, ARM_REG_R4, ARM_REG_R5,
+	ARM_REG_R6, ARM_REG_R7, ARM_REG_R8, ARM_REG_R9, ARM_REG_R10, ARM_REG_R11,
+	ARM_REG_R12,
+}
+var armQ = [...]uint{
+	ARM_REG_Q0, ARM_REG_Q1, ARM_REG_Q2, ARM_REG_Q3, ARM_REG_Q4, ARM_REG_Q5,
+	ARM_REG_Q6, ARM_REG_Q7, ARM_REG_Q8, ARM_REG_Q9, ARM_REG_Q10, ARM_REG_Q11,
+	ARM_REG_Q12, ARM_REG_Q13, ARM_REG_Q14, ARM_REG_Q15,
+}
+var armD = [...]uint{
+	ARM_REG_D0, ARM_REG_D1, ARM_REG_D2, ARM_REG_D3, ARM_REG_D4, ARM_REG_D5,
+	ARM_REG_D6, ARM_REG_D7, ARM_REG_D8, ARM_REG_D9, ARM_REG_D10, ARM_REG_D11,
+	ARM_REG_D12, ARM_REG_D13, ARM_REG_D14, ARM_REG_D15, ARM_REG_D16,
+	ARM_REG_D17, ARM_REG_D18, ARM_REG_D19, ARM_REG_D20, ARM_REG_D21,
+	ARM_REG_D22, ARM_REG_D23, ARM_REG_D24, ARM_REG_D25, ARM_REG_D26,
+	ARM_REG_D27, ARM_REG_D28, ARM_REG_D29, ARM_REG_D30, ARM_REG_D31,
+}
+var armS = [...]uint{
+	ARM_REG_S0, ARM_REG_S1, ARM_REG_S2, ARM_REG_S3, ARM_REG_S4, ARM_REG_S5,
+	ARM_REG_S6, ARM_REG_S7, ARM_REG_S8, ARM_REG_S9, ARM_REG_S10, ARM_REG_S11,
+	ARM_REG_S12, ARM_REG_S13, ARM_REG_S14, ARM_REG_S15, ARM_REG_S16,
+	ARM_REG_S17, ARM_REG_S18, ARM_REG_S19, ARM_REG_S20, ARM_REG_S21,
+	ARM_REG_S22, ARM_REG_S23, ARM_REG_S24, ARM_REG_S25, ARM_REG_S26,
+	ARM_REG_S27, ARM_REG_S28, ARM_REG_S29, ARM_REG_S30, ARM_REG_S31,
+}
+
+func buildArmRegs(m *RegModel) {
+	// SP, LR and PC share their values with R13, R14 and R15
+	m.standalone(32, armR[:]...)
+	m.standalone(32, ARM_REG_SP, ARM_REG_LR, ARM_REG_PC)
+	// Each Q register is a pair of D registers, and the low 16 D registers
+	// are each a pair of S registers.
+	for i := range armQ {
+		m.full(armQ[i], 128)
+		m.part(armD[2*i], armQ[i], 0, 64)
+		m.part(armD[2*i+1], armQ[i], 64, 64)
+	}
+	for i := range armS {
+		d := armD[i/2]
+		m.part(armS[i], m.Canonical(d), m.slots[d].offset+uint(i%2)*32, 32)
+	}
+	m.full(ARM_REG_CPSR, 32)
+	m.part(ARM_REG_APSR, ARM_REG_CPSR, 16, 16)
+	m.part(ARM_REG_APSR_NZCV, ARM_REG_CPSR, 28, 4)
+	m.full(ARM_REG_FPSCR, 32)
+	m.part(ARM_REG_FPSCR_NZCV, ARM_REG_FPSCR, 28, 4)
+	m.standalone(32, ARM_REG_SPSR, ARM_REG_ITSTATE, ARM_REG_FPEXC,
+		ARM_REG_FPINST, ARM_REG_FPINST2, ARM_REG_FPSID, ARM_REG_MVFR0,
+		ARM_REG_MVFR1, ARM_REG_MVFR2)
+}
+
+// ARM64
+
+var arm64X = [...]uint{
+	ARM64_REG_X0, ARM64_REG_X1, ARM64_REG_X2, ARM64_REG_X3, ARM64_REG_X4,
+	ARM64_REG_X5, ARM64_REG_X6, ARM64_REG_X7, ARM64_REG_X8, ARM64_REG_X9,
+	ARM64_REG_X10, ARM64_REG_X11, ARM64_REG_X12, ARM64_REG_X13, ARM64_REG_X14,
+	ARM64_REG_X15, ARM64_REG_X16, ARM64_REG_X17, ARM64_REG_X18, ARM64_REG_X19,
+	ARM64_REG_X20, ARM64_REG_X21, ARM64_REG_X22, ARM64_REG_X23, ARM64_REG_X24,
+	ARM64_REG_X25, ARM64_REG_X26, ARM64_REG_X27, ARM64_REG_X28, ARM64_REG_X29,
+	ARM64_REG_X30,
+}
+var arm64W = [...]uint{
+	ARM64_REG_W0, ARM64_REG_W1, ARM64_REG_W2, ARM64_REG_W3, ARM64_REG_W4,
+	ARM64_REG_W5, ARM64_REG_W6, ARM64_REG_W7, ARM64_REG_W8, ARM64_REG_W9,
+	ARM64_REG_W10, ARM64_REG_W11, ARM64_REG_W12, ARM64_REG_W13, ARM64_REG_W14,
+	ARM64_REG_W15, ARM64_REG_W16, ARM64_REG_W17, ARM64_REG_W18, ARM64_REG_W19,
+	ARM64_REG_W20, ARM64_REG_W21, ARM64_REG_W22, ARM64_REG_W23, ARM64_REG_W24,
+	ARM64_REG_W25, ARM64_REG_W26, ARM64_REG_W27, ARM64_REG_W28, ARM64_REG_W29,
+	ARM64_REG_W30,
+}
+var arm64V = [...]uint{
+	ARM64_REG_V0, ARM64_REG_V1, ARM64_REG_V2, ARM64_REG_V3, ARM64_REG_V4,
+	ARM64_REG_V5, ARM64_REG_V6, ARM64_REG_V7, ARM64_REG_V8, ARM64_REG_V9,
+	ARM64_REG_V10, ARM64_REG_V11, ARM64_REG_V12, ARM64_REG_V13, ARM64_REG_V14,
+	ARM64_REG_V15, ARM64_REG_V16, ARM64_REG_V17, ARM64_REG_V18, ARM64_REG_V19,
+	ARM64_REG_V20, ARM64_REG_V21, ARM64_REG_V22, ARM64_REG_V23, ARM64_REG_V24,
+	ARM64_REG_V25, ARM64_REG_V26, ARM64_REG_V27, ARM64_REG_V28, ARM64_REG_V29,
+	ARM64_REG_V30, ARM64_REG_V31,
+}
+var arm64Q = [...]uint{
+	ARM64_REG_Q0, ARM64_REG_Q1, ARM64_REG_Q2, ARM64_REG_Q3, ARM64_REG_Q4,
+	ARM64_REG_Q5, ARM64_REG_Q6, ARM64_REG_Q7, ARM64_REG_Q8, ARM64_REG_Q9,
+	ARM64_REG_Q10, ARM64_REG_Q11, ARM64_REG_Q12, ARM64_REG_Q13, ARM64_REG_Q14,
+	ARM64_REG_Q15, ARM64_REG_Q16, ARM64_REG_Q17, ARM64_REG_Q18, ARM64_REG_Q19,
+	ARM64_REG_Q20, ARM64_REG_Q21, ARM64_REG_Q22, ARM64_REG_Q23, ARM64_REG_Q24,
+	ARM64_REG_Q25, ARM64_REG_Q26, ARM64_REG_Q27, ARM64_REG_Q28, ARM64_REG_Q29,
+	ARM64_REG_Q30, ARM64_REG_Q31,
+}
+var arm64D = [...]uint{
+	ARM64_REG_D0, ARM64_REG_D1, ARM64_REG_D2, ARM64_REG_D3, ARM64_REG_D4,
+	ARM64_REG_D5, ARM64_REG_D6, ARM64_REG_D7, ARM64_REG_D8, ARM64_REG_D9,
+	ARM64_REG_D10, ARM64_REG_D11, ARM64_REG_D12, ARM64_REG_D13, ARM64_REG_D14,
+	ARM64_REG_D15, ARM64_REG_D16, ARM64_REG_D17, ARM64_REG_D18, ARM64_REG_D19,
+	ARM64_REG_D20, ARM64_REG_D21, ARM64_REG_D22, ARM64_REG_D23, ARM64_REG_D24,
+	ARM64_REG_D25, ARM64_REG_D26, ARM64_REG_D27, ARM64_REG_D28, ARM64_REG_D29,
+	ARM64_REG_D30, ARM64_REG_D31,
+}
+var arm64S = [...]uint{
+	ARM64_REG_S0, ARM64_REG_S1, ARM64_REG_S2, ARM64_REG_S3, ARM64_REG_S4,
+	ARM64_REG_S5, ARM64_REG_S6, ARM64_REG_S7, ARM64_REG_S8, ARM64_REG_S9,
+	ARM64_REG_S10, ARM64_REG_S11, ARM64_REG_S12, ARM64_REG_S13, ARM64_REG_S14,
+	ARM64_REG_S15, ARM64_REG_S16, ARM64_REG_S17, ARM64_REG_S18, ARM64_REG_S19,
+	ARM64_REG_S20, ARM64_REG_S21, ARM64_REG_S22, ARM64_REG_S23, ARM64_REG_S24,
+	ARM64_REG_S25, ARM64_REG_S26, ARM64_REG_S27, ARM64_REG_S28, ARM64_REG_S29,
+	ARM64_REG_S30, ARM64_REG_S31,
+}
+var arm64H = [...]uint{
+	ARM64_REG_H0, ARM64_REG_H1, ARM64_REG_H2, ARM64_REG_H3, ARM64_REG_H4,
+	ARM64_REG_H5, ARM64_REG_H6, ARM64_REG_H7, ARM64_REG_H8, ARM64_REG_H9,
+	ARM64_REG_H10, ARM64_REG_H11, ARM64_REG_H12, ARM64_REG_H13, ARM64_REG_H14,
+	ARM64_REG_H15, ARM64_REG_H16, ARM64_REG_H17, ARM64_REG_H18, ARM64_REG_H19,
+	ARM64_REG_H20, ARM64_REG_H21, ARM64_REG_H22, ARM64_REG_H23, ARM64_REG_H24,
+	ARM64_REG_H25, ARM64_REG_H26, ARM64_REG_H27, ARM64_REG_H28, ARM64_REG_H29,
+	ARM64_REG_H30, ARM64_REG_H31,
+}
+var arm64B = [...]uint{
+	ARM64_REG_B0, ARM64_REG_B1, ARM64_REG_B2, ARM64_REG_B3, ARM64_REG_B4,
+	ARM64_REG_B5, ARM64_REG_B6, ARM64_REG_B7, ARM64_REG_B8, ARM64_REG_B9,
+	ARM64_REG_B10, ARM64_REG_B11, ARM64_REG_B12, ARM64_REG_B13, ARM64_REG_B14,
+	ARM64_REG_B15, ARM64_REG_B16, ARM64_REG_B17, ARM64_REG_B18, ARM64_REG_B19,
+	ARM64_REG_B20, ARM64_REG_B21, ARM64_REG_B22, ARM64_REG_B23, ARM64_REG_B24,
+	ARM64_REG_B25, ARM64_REG_B26, ARM64_REG_B27, ARM64_REG_B28, ARM64_REG_B29,
+	ARM64_REG_B30, ARM64_REG_B31,
+}
+
+func buildArm64Regs(m *RegModel) {
+	// FP and LR share their values with X29 and X30
+	for i := range arm64X {
+		m.full(arm64X[i], 64)
+		m.part(arm64W[i], arm64X[i], 0, 32)
+	}
+	m.full(ARM64_REG_SP, 64)
+	m.part(ARM64_REG_WSP, ARM64_REG_SP, 0, 32)
+	m.full(ARM64_REG_XZR, 64)
+	m.part(ARM64_REG_WZR, ARM64_REG_XZR, 0, 32)
+	for i := range arm64V {
+		m.full(arm64V[i], 128)
+		m.part(arm64Q[i], arm64V[i], 0, 128)
+		m.part(arm64D[i], arm64V[i], 0, 64)
+		m.part(arm64S[i], arm64V[i], 0, 32)
+		m.part(arm64H[i], arm64V[i], 0, 16)
+		m.part(arm64B[i], arm64V[i], 0, 8)
+	}
+	m.standalone(32, ARM64_REG_NZCV)
+}
+
+// MIPS
+
+var mipsGPR = [...]uint{
+	MIPS_REG_0, MIPS_REG_1, MIPS_REG_2, MIPS_REG_3, MIPS_REG_4, MIPS_REG_5,
+	MIPS_REG_6, MIPS_REG_7, MIPS_REG_8, MIPS_REG_9, MIPS_REG_10, MIPS_REG_11,
+	MIPS_REG_12, MIPS_REG_13, MIPS_REG_14, MIPS_REG_15, MIPS_REG_16,
+	MIPS_REG_17, MIPS_REG_18, MIPS_REG_19, MIPS_REG_20, MIPS_REG_21,
+	MIPS_REG_22, MIPS_REG_23, MIPS_REG_24, MIPS_REG_25, MIPS_REG_26,
+	MIPS_REG_27, MIPS_REG_28, MIPS_REG_29, MIPS_REG_30, MIPS_REG_31,
+}
+var mipsW = [...]uint{
+	MIPS_REG_W0, MIPS_REG_W1, MIPS_REG_W2, MIPS_REG_W3, MIPS_REG_W4,
+	MIPS_REG_W5, MIPS_REG_W6, MIPS_REG_W7, MIPS_REG_W8, MIPS_REG_W9,
+	MIPS_REG_W10, MIPS_REG_W11, MIPS_REG_W12, MIPS_REG_W13, MIPS_REG_W14,
+	MIPS_REG_W15, MIPS_REG_W16, MIPS_REG_W17, MIPS_REG_W18, MIPS_REG_W19,
+	MIPS_REG_W20, MIPS_REG_W21, MIPS_REG_W22, MIPS_REG_W23, MIPS_REG_W24,
+	MIPS_REG_W25, MIPS_REG_W26, MIPS_REG_W27, MIPS_REG_W28, MIPS_REG_W29,
+	MIPS_REG_W30, MIPS_REG_W31,
+}
+var mipsF = [...]uint{
+	MIPS_REG_F0, MIPS_REG_F1, MIPS_REG_F2, MIPS_REG_F3, MIPS_REG_F4,
+	MIPS_REG_F5, MIPS_REG_F6, MIPS_REG_F7, MIPS_REG_F8, MIPS_REG_F9,
+	MIPS_REG_F10, MIPS_REG_F11, MIPS_REG_F12, MIPS_REG_F13, MIPS_REG_F14,
+	MIPS_REG_F15, MIPS_REG_F16, MIPS_REG_F17, MIPS_REG_F18, MIPS_REG_F19,
+	MIPS_REG_F20, MIPS_REG_F21, MIPS_REG_F22, MIPS_REG_F23, MIPS_REG_F24,
+	MIPS_REG_F25, MIPS_REG_F26, MIPS_REG_F27, MIPS_REG_F28, MIPS_REG_F29,
+	MIPS_REG_F30, MIPS_REG_F31,
+}
+
+func buildMipsRegs(m *RegModel) {
+	// ZERO..RA share their values with MIPS_REG_0..31
+	m.standalone(64, mipsGPR[:]...)
+	// FPRs are the low half of the MSA vector registers
+	for i := range mipsW {
+		m.full(mipsW[i], 128)
+		m.part(mipsF[i], mipsW[i], 0, 64)
+	}
+	// HI0..3 and LO0..3 share their values with AC0..3, so HI and LO are
+	// modelled as the halves of AC0
+	m.full(MIPS_REG_AC0, 64)
+	m.part(MIPS_REG_LO, MIPS_REG_AC0, 0, 32)
+	m.part(MIPS_REG_HI, MIPS_REG_AC0, 32, 32)
+	m.standalone(64, MIPS_REG_AC1, MIPS_REG_AC2, MIPS_REG_AC3)
+	// DSPControl fields
+	m.full(MIPS_REG_DSPOUTFLAG, 8)
+	m.part(MIPS_REG_DSPOUTFLAG16_19, MIPS_REG_DSPOUTFLAG, 0, 4)
+	m.part(MIPS_REG_DSPOUTFLAG20, MIPS_REG_DSPOUTFLAG, 4, 1)
+	m.part(MIPS_REG_DSPOUTFLAG21, MIPS_REG_DSPOUTFLAG, 5, 1)
+	m.part(MIPS_REG_DSPOUTFLAG22, MIPS_REG_DSPOUTFLAG, 6, 1)
+	m.part(MIPS_REG_DSPOUTFLAG23, MIPS_REG_DSPOUTFLAG, 7, 1)
+	m.standalone(6, MIPS_REG_DSPPOS, MIPS_REG_DSPSCOUNT)
+	m.standalone(4, MIPS_REG_DSPCCOND)
+	m.standalone(1, MIPS_REG_DSPCARRY, MIPS_REG_DSPEFI)
+	m.standalone(1, mipsCC[:]...)
+	m.standalone(1, mipsFCC[:]...)
+	m.standalone(64, MIPS_REG_P0, MIPS_REG_P1, MIPS_REG_P2,
+		MIPS_REG_MPL0, MIPS_REG_MPL1, MIPS_REG_MPL2)
+}
+
+var mipsCC = [...]uint{
+	MIPS_REG_CC0, MIPS_REG_CC1, MIPS_REG_CC2, MIPS_REG_CC3, MIPS_REG_CC4,
+	MIPS_REG_CC5, MIPS_REG_CC6, MIPS_REG_CC7,
+}
+var mipsFCC = [...]uint{
+	MIPS_REG_FCC0, MIPS_REG_FCC1, MIPS_REG_FCC2, MIPS_REG_FCC3, MIPS_REG_FCC4,
+	MIPS_REG_FCC5, MIPS_REG_FCC6, MIPS_REG_FCC7,
+}
+
+// PPC
+
+var ppcR = [...]uint{
+	PPC_REG_R0, PPC_REG_R1, PPC_REG_R2, PPC_REG_R3, PPC_REG_R4, PPC_REG_R5,
+	PPC_REG_R6, PPC_REG_R7, PPC_REG_R8, PPC_REG_R9, PPC_REG_R10, PPC_REG_R11,
+	PPC_REG_R12, PPC_REG_R13, PPC_REG_R14, PPC_REG_R15, PPC_REG_R16,
+	PPC_REG_R17, PPC_REG_R18, PPC_REG_R19, PPC_REG_R20, PPC_REG_R21,
+	PPC_REG_R22, PPC_REG_R23, PPC_REG_R24, PPC_REG_R25, PPC_REG_R26,
+	PPC_REG_R27, PPC_REG_R28, PPC_REG_R29, PPC_REG_R30, PPC_REG_R31,
+}
+var ppcF = [...]uint{
+	PPC_REG_F0, PPC_REG_F1, PPC_REG_F2, PPC_REG_F3, PPC_REG_F4, PPC_REG_F5,
+	PPC_REG_F6, PPC_REG_F7, PPC_REG_F8, PPC_REG_F9, PPC_REG_F10, PPC_REG_F11,
+	PPC_REG_F12, PPC_REG_F13, PPC_REG_F14, PPC_REG_F15, PPC_REG_F16,
+	PPC_REG_F17, PPC_REG_F18, PPC_REG_F19, PPC_REG_F20, PPC_REG_F21,
+	PPC_REG_F22, PPC_REG_F23, PPC_REG_F24, PPC_REG_F25, PPC_REG_F26,
+	PPC_REG_F27, PPC_REG_F28, PPC_REG_F29, PPC_REG_F30, PPC_REG_F31,
+}
+var ppcV = [...]uint{
+	PPC_REG_V0, PPC_REG_V1, PPC_REG_V2, PPC_REG_V3, PPC_REG_V4, PPC_REG_V5,
+	PPC_REG_V6, PPC_REG_V7, PPC_REG_V8, PPC_REG_V9, PPC_REG_V10, PPC_REG_V11,
+	PPC_REG_V12, PPC_REG_V13, PPC_REG_V14, PPC_REG_V15, PPC_REG_V16,
+	PPC_REG_V17, PPC_REG_V18, PPC_REG_V19, PPC_REG_V20, PPC_REG_V21,
+	PPC_REG_V22, PPC_REG_V23, PPC_REG_V24, PPC_REG_V25, PPC_REG_V26,
+	PPC_REG_V27, PPC_REG_V28, PPC_REG_V29, PPC_REG_V30, PPC_REG_V31,
+}
+var ppcVS = [...]uint{
+	PPC_REG_VS0, PPC_REG_VS1, PPC_REG_VS2, PPC_REG_VS3, PPC_REG_VS4,
+	PPC_REG_VS5, PPC_REG_VS6, PPC_REG_VS7, PPC_REG_VS8, PPC_REG_VS9,
+	PPC_REG_VS10, PPC_REG_VS11, PPC_REG_VS12, PPC_REG_VS13, PPC_REG_VS14,
+	PPC_REG_VS15, PPC_REG_VS16, PPC_REG_VS17, PPC_REG_VS18, PPC_REG_VS19,
+	PPC_REG_VS20, PPC_REG_VS21, PPC_REG_VS22, PPC_REG_VS23, PPC_REG_VS24,
+	PPC_REG_VS25, PPC_REG_VS26, PPC_REG_VS27, PPC_REG_VS28, PPC_REG_VS29,
+	PPC_REG_VS30, PPC_REG_VS31, PPC_REG_VS32, PPC_REG_VS33, PPC_REG_VS34,
+	PPC_REG_VS35, PPC_REG_VS36, PPC_REG_VS37, PPC_REG_VS38, PPC_REG_VS39,
+	PPC_REG_VS40, PPC_REG_VS41, PPC_REG_VS42, PPC_REG_VS43, PPC_REG_VS44,
+	PPC_REG_VS45, PPC_REG_VS46, PPC_REG_VS47, PPC_REG_VS48, PPC_REG_VS49,
+	PPC_REG_VS50, PPC_REG_VS51, PPC_REG_VS52, PPC_REG_VS53, PPC_REG_VS54,
+	PPC_REG_VS55, PPC_REG_VS56, PPC_REG_VS57, PPC_REG_VS58, PPC_REG_VS59,
+	PPC_REG_VS60, PPC_REG_VS61, PPC_REG_VS62, PPC_REG_VS63,
+}
+var ppcCR = [...]uint{
+	PPC_REG_CR0, PPC_REG_CR1, PPC_REG_CR2, PPC_REG_CR3, PPC_REG_CR4,
+	PPC_REG_CR5, PPC_REG_CR6, PPC_REG_CR7,
+}
+
+func buildPPCRegs(m *RegModel) {
+	m.standalone(64, ppcR[:]...)
+	// VSX: VS0..31 hold the FPRs in their most significant doubleword, and
+	// VS32..63 are the Altivec registers
+	for i := range ppcF {
+		m.full(ppcVS[i], 128)
+		m.part(ppcF[i], ppcVS[i], 64, 64)
+	}
+	for i := range ppcV {
+		m.full(ppcVS[32+i], 128)
+		m.part(ppcV[i], ppcVS[32+i], 0, 128)
+	}
+	// PPC_REG_CC is the whole condition register. CR fields are numbered
+	// from the most significant end.
+	m.full(PPC_REG_CC, 32)
+	for i, cr := range ppcCR {
+		m.part(cr, PPC_REG_CC, uint(7-i)*4, 4)
+	}
+	m.part(PPC_REG_CR1EQ, PPC_REG_CC, 25, 1)
+	m.full(PPC_REG_CTR8, 64)
+	m.part(PPC_REG_CTR, PPC_REG_CTR8, 0, 32)
+	m.full(PPC_REG_LR8, 64)
+	m.part(PPC_REG_LR, PPC_REG_LR8, 0, 32)
+	m.standalone(1, PPC_REG_CARRY)
+	m.standalone(2, PPC_REG_RM)
+	m.standalone(32, PPC_REG_VRSAVE)
+}
+
+// SPARC
+
+var sparcGPR = [...]uint{
+	SPARC_REG_G0, SPARC_REG_G1, SPARC_REG_G2, SPARC_REG_G3, SPARC_REG_G4,
+	SPARC_REG_G5, SPARC_REG_G6, SPARC_REG_G7, SPARC_REG_O0, SPARC_REG_O1,
+	SPARC_REG_O2, SPARC_REG_O3, SPARC_REG_O4, SPARC_REG_O5, SPARC_REG_SP,
+	SPARC_REG_O7, SPARC_REG_L0, SPARC_REG_L1, SPARC_REG_L2, SPARC_REG_L3,
+	SPARC_REG_L4, SPARC_REG_L5, SPARC_REG_L6, SPARC_REG_L7, SPARC_REG_I0,
+	SPARC_REG_I1, SPARC_REG_I2, SPARC_REG_I3, SPARC_REG_I4, SPARC_REG_I5,
+	SPARC_REG_FP, SPARC_REG_I7,
+}
+var sparcF = [...]uint{
+	SPARC_REG_F0, SPARC_REG_F1, SPARC_REG_F2, SPARC_REG_F3, SPARC_REG_F4,
+	SPARC_REG_F5, SPARC_REG_F6, SPARC_REG_F7, SPARC_REG_F8, SPARC_REG_F9,
+	SPARC_REG_F10, SPARC_REG_F11, SPARC_REG_F12, SPARC_REG_F13, SPARC_REG_F14,
+	SPARC_REG_F15, SPARC_REG_F16, SPARC_REG_F17, SPARC_REG_F18, SPARC_REG_F19,
+	SPARC_REG_F20, SPARC_REG_F21, SPARC_REG_F22, SPARC_REG_F23, SPARC_REG_F24,
+	SPARC_REG_F25, SPARC_REG_F26, SPARC_REG_F27, SPARC_REG_F28, SPARC_REG_F29,
+	SPARC_REG_F30, SPARC_REG_F31, SPARC_REG_F32, SPARC_REG_F34, SPARC_REG_F36,
+	SPARC_REG_F38, SPARC_REG_F40, SPARC_REG_F42, SPARC_REG_F44, SPARC_REG_F46,
+	SPARC_REG_F48, SPARC_REG_F50, SPARC_REG_F52, SPARC_REG_F54, SPARC_REG_F56,
+	SPARC_REG_F58, SPARC_REG_F60, SPARC_REG_F62,
+}
+
+func buildSparcRegs(m *RegModel) {
+	// SP and FP share their values with O6 and I6. The double precision
+	// F32..F62 have no single precision halves.
+	m.standalone(64, sparcGPR[:]...)
+	m.standalone(32, sparcF[:32]...)
+	m.standalone(64, sparcF[32:]...)
+	m.standalone(4, SPARC_REG_ICC, SPARC_REG_XCC)
+	m.standalone(2, SPARC_REG_FCC0, SPARC_REG_FCC1, SPARC_REG_FCC2, SPARC_REG_FCC3)
+	m.standalone(32, SPARC_REG_Y)
+}
+
+// SYSZ
+
+var syszR = [...]uint{
+	SYSZ_REG_0, SYSZ_REG_1, SYSZ_REG_2, SYSZ_REG_3, SYSZ_REG_4, SYSZ_REG_5,
+	SYSZ_REG_6, SYSZ_REG_7, SYSZ_REG_8, SYSZ_REG_9, SYSZ_REG_10, SYSZ_REG_11,
+	SYSZ_REG_12, SYSZ_REG_13, SYSZ_REG_14, SYSZ_REG_15,
+}
+var syszF = [...]uint{
+	SYSZ_REG_F0, SYSZ_REG_F1, SYSZ_REG_F2, SYSZ_REG_F3, SYSZ_REG_F4,
+	SYSZ_REG_F5, SYSZ_REG_F6, SYSZ_REG_F7, SYSZ_REG_F8, SYSZ_REG_F9,
+	SYSZ_REG_F10, SYSZ_REG_F11, SYSZ_REG_F12, SYSZ_REG_F13, SYSZ_REG_F14,
+	SYSZ_REG_F15,
+}
+
+func buildSysZRegs(m *RegModel) {
+	for _, r := range syszR {
+		m.full(r, 64)
+	}
+	m.part(SYSZ_REG_R0L, SYSZ_REG_0, 0, 32)
+	m.standalone(64, syszF[:]...)
+	m.standalone(2, SYSZ_REG_CC)
+}
+
+// XCORE
+
+var xcoreR = [...]uint{
+	XCORE_REG_R0, XCORE_REG_R1, XCORE_REG_R2, XCORE_REG_R3, XCORE_REG_R4,
+	XCORE_REG_R5, XCORE_REG_R6, XCORE_REG_R7, XCORE_REG_R8, XCORE_REG_R9,
+	XCORE_REG_R10, XCORE_REG_R11, XCORE_REG_CP, XCORE_REG_DP, XCORE_REG_SP,
+	XCORE_REG_LR, XCORE_REG_PC, XCORE_REG_SCP, XCORE_REG_SSR, XCORE_REG_ET,
+	XCORE_REG_ED, XCORE_REG_SED, XCORE_REG_KEP, XCORE_REG_KSP, XCORE_REG_ID,
+}
+
+func buildXcoreRegs(m *RegModel) {
+	m.standalone(32, xcoreR[:]...)
+}
@@ -0,0 +1,126 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"reflect"
+	"testing"
+)
+
+func mustRegModel(t *testing.T, arch int) *RegModel {
+	m, err := NewRegModel(arch)
+	if err != nil {
+		t.Fatalf("Failed to get register model for arch %v: %v", arch, err)
+	}
+	return m
+}
+
+func TestRegModelX86(t *testing.T) {
+	m := mustRegModel(t, CS_ARCH_X86)
+
+	for _, reg := range []uint{X86_REG_AL, X86_REG_AH, X86_REG_AX, X86_REG_EAX, X86_REG_RAX} {
+		if c := m.Canonical(reg); c != X86_REG_RAX {
+			t.Errorf("Canonical(%v) want rax got %v", reg, c)
+		}
+	}
+	overlaps := []struct {
+		a, b uint
+		want bool
+	}{
+		{X86_REG_AL, X86_REG_RAX, true},
+		{X86_REG_AH, X86_REG_EAX, true},
+		{X86_REG_AL, X86_REG_AH, false},
+		{X86_REG_EAX, X86_REG_EBX, false},
+		{X86_REG_XMM3, X86_REG_ZMM3, true},
+		{X86_REG_XMM3, X86_REG_YMM4, false},
+		{X86_REG_MM2, X86_REG_FP2, true},
+		{X86_REG_CR0, X86_REG_CR0, true},
+	}
+	for _, o := range overlaps {
+		if got := m.Overlaps(o.a, o.b); got != o.want {
+			t.Errorf("Overlaps(%v, %v) want %v got %v", o.a, o.b, o.want, got)
+		}
+	}
+
+	if subs := m.SubRegisters(X86_REG_AX); !reflect.DeepEqual(subs, []uint{X86_REG_AL, X86_REG_AH}) {
+		t.Errorf("SubRegisters(ax) want al, ah got %v", subs)
+	}
+	if supers := m.SuperRegisters(X86_REG_AX); !reflect.DeepEqual(supers, []uint{X86_REG_RAX, X86_REG_EAX}) {
+		t.Errorf("SuperRegisters(ax) want rax, eax got %v", supers)
+	}
+	if off, width, ok := m.BitRange(X86_REG_AH); !ok || off != 8 || width != 8 {
+		t.Errorf("BitRange(ah) want 8, 8 got %v, %v, %v", off, width, ok)
+	}
+}
+
+func TestRegModelArm(t *testing.T) {
+	arm := mustRegModel(t, CS_ARCH_ARM)
+	if arm.Canonical(ARM_REG_SP) != arm.Canonical(ARM_REG_R13) || !arm.Overlaps(ARM_REG_SP, ARM_REG_R13) {
+		t.Errorf("ARM sp and r13 should be the same register")
+	}
+	if !arm.Overlaps(ARM_REG_S3, ARM_REG_D1) || arm.Overlaps(ARM_REG_S3, ARM_REG_D0) {
+		t.Errorf("ARM s3 should be part of d1 only")
+	}
+	if off, width, _ := arm.BitRange(ARM_REG_D3); arm.Canonical(ARM_REG_D3) != ARM_REG_Q1 || off != 64 || width != 64 {
+		t.Errorf("ARM d3 should be the high half of q1")
+	}
+
+	arm64 := mustRegModel(t, CS_ARCH_ARM64)
+	if off, width, ok := arm64.BitRange(ARM64_REG_W3); arm64.Canonical(ARM64_REG_W3) != ARM64_REG_X3 || off != 0 || width != 32 || !ok {
+		t.Errorf("ARM64 w3 should be the low half of x3")
+	}
+	for _, r := range []uint{ARM64_REG_S0, ARM64_REG_D0, ARM64_REG_Q0} {
+		if !arm64.Overlaps(r, ARM64_REG_V0) {
+			t.Errorf("ARM64 %v should overlap v0", r)
+		}
+	}
+	if arm64.Overlaps(ARM64_REG_S0, ARM64_REG_S1) {
+		t.Errorf("ARM64 s0 and s1 should not overlap")
+	}
+	if subs := arm64.SubRegisters(ARM64_REG_D5); !reflect.DeepEqual(subs, []uint{ARM64_REG_S5, ARM64_REG_H5, ARM64_REG_B5}) {
+		t.Errorf("ARM64 SubRegisters(d5) want s5, h5, b5 got %v", subs)
+	}
+}
+
+func TestRegModelOthers(t *testing.T) {
+	ppc := mustRegModel(t, CS_ARCH_PPC)
+	if !ppc.Overlaps(PPC_REG_CR0, PPC_REG_CC) || ppc.Overlaps(PPC_REG_CR0, PPC_REG_CR1) {
+		t.Errorf("PPC cr0 should be part of the condition register")
+	}
+	if off, width, _ := ppc.BitRange(PPC_REG_CR0); off != 28 || width != 4 {
+		t.Errorf("PPC cr0 want bits 28..31 got %v, %v", off, width)
+	}
+	if !ppc.Overlaps(PPC_REG_CR1EQ, PPC_REG_CR1) {
+		t.Errorf("PPC cr1eq should be part of cr1")
+	}
+
+	mips := mustRegModel(t, CS_ARCH_MIPS)
+	if !mips.Overlaps(MIPS_REG_SP, MIPS_REG_29) || !mips.Overlaps(MIPS_REG_F4, MIPS_REG_W4) {
+		t.Errorf("MIPS aliases should overlap")
+	}
+	if mips.Overlaps(MIPS_REG_HI, MIPS_REG_LO) {
+		t.Errorf("MIPS hi and lo should not overlap")
+	}
+
+	sysz := mustRegModel(t, CS_ARCH_SYSZ)
+	if sysz.Canonical(SYSZ_REG_R0L) != SYSZ_REG_0 {
+		t.Errorf("SysZ r0l should be part of r0")
+	}
+
+	xcore := mustRegModel(t, CS_ARCH_XCORE)
+	if xcore.Overlaps(XCORE_REG_R0, XCORE_REG_R1) || xcore.SubRegisters(XCORE_REG_R0) != nil {
+		t.Errorf("XCore registers should stand alone")
+	}
+
+	if _, err := NewRegModel(CS_ARCH_MAX); err != ErrArch {
+		t.Errorf("Want ErrArch for an unknown arch, got %v", err)
+	}
+}
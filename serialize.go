@@ -0,0 +1,1147 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math"
+)
+
+// Compact binary encoding for []Instruction, intended for caching the results
+// of Engine.Disasm. A stream looks like:
+//
+//	magic "GAPS" | version byte | header block | instruction blocks... | end block
+//
+// Every block is framed as
+//
+//	uvarint insn count | uvarint payload length | payload | CRC32 (IEEE, LE)
+//
+// The header block has a count of 0 and the end block is empty. Integers are
+// varints, addresses are stored as deltas from the end of the previous
+// instruction and mnemonics are interned, so a typical x86 instruction costs
+// only a few bytes more than its raw encoding.
+
+const (
+	streamMagic     = "GAPS"
+	streamVersion   = 1
+	streamBlockSize = 64 * 1024 // flush instruction blocks at about this size
+)
+
+var (
+	ErrStreamMagic    = errors.New("gapstone: not an instruction stream")
+	ErrStreamVersion  = errors.New("gapstone: unsupported instruction stream version")
+	ErrStreamChecksum = errors.New("gapstone: instruction stream checksum mismatch")
+	ErrStreamCorrupt  = errors.New("gapstone: corrupt instruction stream")
+	ErrStreamArch     = errors.New("gapstone: instruction does not match stream arch")
+	ErrStreamClosed   = errors.New("gapstone: write to closed instruction stream")
+)
+
+// Per instruction flags
+const (
+	streamHasText   = 1 << iota // Mnemonic and OpStr follow
+	streamHasDetail             // arch specific detail follows
+	streamOddSize               // Size != len(Bytes), Size follows
+	streamStamped               // arch and mode were recorded by Disasm
+	streamOddMode               // stamped mode differs from the header, mode follows
+)
+
+// Describes how the instructions in a stream were produced. Use
+// Engine.StreamHeader to fill one in from a configured Engine.
+type StreamHeader struct {
+	Major   int // capstone major version
+	Minor   int // capstone minor version
+	Arch    int // CS_ARCH_*
+	Mode    uint
+	Options []EngineOption
+}
+
+// Build a StreamHeader describing this Engine's version, arch, mode and
+// options.
+func (e *Engine) StreamHeader() StreamHeader {
+	maj, min := e.Version()
+	return StreamHeader{
+		Major:   maj,
+		Minor:   min,
+		Arch:    e.arch,
+		Mode:    e.mode,
+		Options: e.Options(),
+	}
+}
+
+// Writes instructions to an underlying io.Writer. Close must be called to
+// flush the final block and write the end marker.
+type StreamWriter struct {
+	w      *bufio.Writer
+	hdr    StreamHeader
+	block  streamBuf
+	count  int
+	next   uint64 // address following the last instruction written
+	mnems  map[string]uint64
+	closed bool
+}
+
+// Create a StreamWriter and write the stream header.
+func NewStreamWriter(w io.Writer, hdr StreamHeader) (*StreamWriter, error) {
+	sw := &StreamWriter{
+		w:     bufio.NewWriter(w),
+		hdr:   hdr,
+		mnems: make(map[string]uint64),
+	}
+
+	if _, err := sw.w.WriteString(streamMagic); err != nil {
+		return nil, err
+	}
+	if err := sw.w.WriteByte(streamVersion); err != nil {
+		return nil, err
+	}
+
+	var h streamBuf
+	h.varint(int64(hdr.Major))
+	h.varint(int64(hdr.Minor))
+	h.varint(int64(hdr.Arch))
+	h.uvarint(uint64(hdr.Mode))
+	h.uvarint(uint64(len(hdr.Options)))
+	for _, opt := range hdr.Options {
+		h.uvarint(uint64(opt.Type))
+		h.uvarint(uint64(opt.Value))
+	}
+	if err := writeStreamBlock(sw.w, 0, h.b); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+// Append instructions to the stream. Instructions with decomposer detail must
+// belong to the arch recorded in the header.
+func (sw *StreamWriter) Write(insns ...Instruction) error {
+	if sw.closed {
+		return ErrStreamClosed
+	}
+	for i := range insns {
+		if err := sw.encode(&insns[i]); err != nil {
+			return err
+		}
+		sw.count++
+		if len(sw.block.b) >= streamBlockSize {
+			if err := sw.flushBlock(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Flush any buffered instructions and write the end marker. Close does not
+// close the underlying io.Writer.
+func (sw *StreamWriter) Close() error {
+	if sw.closed {
+		return nil
+	}
+	sw.closed = true
+	if err := sw.flushBlock(); err != nil {
+		return err
+	}
+	if err := writeStreamBlock(sw.w, 0, nil); err != nil {
+		return err
+	}
+	return sw.w.Flush()
+}
+
+func (sw *StreamWriter) flushBlock() error {
+	if sw.count == 0 {
+		return nil
+	}
+	err := writeStreamBlock(sw.w, sw.count, sw.block.b)
+	sw.block.b = sw.block.b[:0]
+	sw.count = 0
+	return err
+}
+
+func writeStreamBlock(w *bufio.Writer, count int, payload []byte) error {
+	var frame streamBuf
+	frame.uvarint(uint64(count))
+	frame.uvarint(uint64(len(payload)))
+	if _, err := w.Write(frame.b); err != nil {
+		return err
+	}
+	if _, err := w.Write(payload); err != nil {
+		return err
+	}
+	var sum [4]byte
+	binary.LittleEndian.PutUint32(sum[:], crc32.ChecksumIEEE(payload))
+	_, err := w.Write(sum[:])
+	return err
+}
+
+func (sw *StreamWriter) encode(insn *Instruction) error {
+
+	if arch, ok := detailArch(insn); ok && arch != sw.hdr.Arch {
+		return ErrStreamArch
+	}
+	if insn.stamped && insn.arch != sw.hdr.Arch {
+		return ErrStreamArch
+	}
+
+	b := &sw.block
+	flags := uint64(0)
+	if insn.Mnemonic != "" || insn.OpStr != "" {
+		flags |= streamHasText
+	}
+	if _, ok := detailArch(insn); ok {
+		flags |= streamHasDetail
+	}
+	if insn.Size != uint(len(insn.Bytes)) {
+		flags |= streamOddSize
+	}
+	if insn.stamped {
+		flags |= streamStamped
+		if insn.mode != sw.hdr.Mode {
+			flags |= streamOddMode
+		}
+	}
+
+	b.uvarint(flags)
+	b.uvarint(uint64(insn.Id))
+	b.varint(int64(uint64(insn.Address) - sw.next))
+	b.bytes(insn.Bytes)
+	if flags&streamOddSize != 0 {
+		b.uvarint(uint64(insn.Size))
+	}
+	if flags&streamOddMode != 0 {
+		b.uvarint(uint64(insn.mode))
+	}
+	sw.next = uint64(insn.Address) + uint64(insn.Size)
+
+	if flags&streamHasText != 0 {
+		// Mnemonics are interned: 0 introduces a new string, otherwise the
+		// value is the 1-based index of an earlier one.
+		if idx, ok := sw.mnems[insn.Mnemonic]; ok {
+			b.uvarint(idx)
+		} else {
+			b.uvarint(0)
+			b.string(insn.Mnemonic)
+			sw.mnems[insn.Mnemonic] = uint64(len(sw.mnems) + 1)
+		}
+		b.string(insn.OpStr)
+	}
+
+	b.uints(insn.RegistersRead)
+	b.uints(insn.RegistersWritten)
+	b.uints(insn.Groups)
+
+	if flags&streamHasDetail == 0 {
+		return nil
+	}
+
+	switch sw.hdr.Arch {
+	case CS_ARCH_ARM:
+		b.encodeArm(insn.Arm)
+	case CS_ARCH_ARM64:
+		b.encodeArm64(insn.Arm64)
+	case CS_ARCH_MIPS:
+		b.encodeMips(insn.Mips)
+	case CS_ARCH_X86:
+		b.encodeX86(insn.X86)
+	case CS_ARCH_PPC:
+		b.encodePPC(insn.PPC)
+	case CS_ARCH_SYSZ:
+		b.encodeSysZ(insn.SysZ)
+	case CS_ARCH_SPARC:
+		b.encodeSparc(insn.Sparc)
+	case CS_ARCH_XCORE:
+		b.encodeXcore(insn.Xcore)
+	default:
+		return ErrArch
+	}
+	return nil
+}
+
+// Reads instructions written by a StreamWriter.
+type StreamReader struct {
+	r     *bufio.Reader
+	hdr   StreamHeader
+	block streamCursor
+	left  int // instructions remaining in the current block
+	next  uint64
+	mnems []string
+	done  bool
+}
+
+// Create a StreamReader, reading and validating the stream header.
+func NewStreamReader(r io.Reader) (*StreamReader, error) {
+	sr := &StreamReader{r: bufio.NewReader(r)}
+
+	var magic [len(streamMagic) + 1]byte
+	if _, err := io.ReadFull(sr.r, magic[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, ErrStreamMagic
+		}
+		return nil, err
+	}
+	if string(magic[:len(streamMagic)]) != streamMagic {
+		return nil, ErrStreamMagic
+	}
+	if magic[len(streamMagic)] != streamVersion {
+		return nil, ErrStreamVersion
+	}
+
+	count, payload, err := readStreamBlock(sr.r)
+	if err != nil {
+		return nil, err
+	}
+	if count != 0 {
+		return nil, ErrStreamCorrupt
+	}
+
+	c := streamCursor{b: payload}
+	sr.hdr.Major = int(c.varint())
+	sr.hdr.Minor = int(c.varint())
+	sr.hdr.Arch = int(c.varint())
+	sr.hdr.Mode = uint(c.uvarint())
+	nopts := c.length()
+	for i := 0; i < nopts && c.err == nil; i++ {
+		ty := uint(c.uvarint())
+		sr.hdr.Options = append(sr.hdr.Options, EngineOption{ty, uint(c.uvarint())})
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return sr, nil
+}
+
+// The header the stream was written with.
+func (sr *StreamReader) Header() StreamHeader { return sr.hdr }
+
+// Read the next instruction. Returns io.EOF after the last one.
+func (sr *StreamReader) Next() (Instruction, error) {
+	for sr.left == 0 {
+		if sr.done {
+			return Instruction{}, io.EOF
+		}
+		if sr.block.err == nil && sr.block.off != len(sr.block.b) {
+			return Instruction{}, ErrStreamCorrupt
+		}
+		count, payload, err := readStreamBlock(sr.r)
+		if err != nil {
+			return Instruction{}, err
+		}
+		if count == 0 {
+			if len(payload) != 0 {
+				return Instruction{}, ErrStreamCorrupt
+			}
+			sr.done = true
+			continue
+		}
+		sr.block = streamCursor{b: payload}
+		sr.left = count
+	}
+
+	insn, err := sr.decode()
+	if err != nil {
+		return Instruction{}, err
+	}
+	sr.left--
+	return insn, nil
+}
+
+// Read every remaining instruction in the stream.
+func (sr *StreamReader) ReadAll() ([]Instruction, error) {
+	insns := []Instruction{}
+	for {
+		insn, err := sr.Next()
+		if err == io.EOF {
+			return insns, nil
+		}
+		if err != nil {
+			return insns, err
+		}
+		insns = append(insns, insn)
+	}
+}
+
+func readStreamBlock(r *bufio.Reader) (int, []byte, error) {
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, unexpectedEOF(err)
+	}
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, unexpectedEOF(err)
+	}
+	if count > math.MaxInt32 || length > math.MaxInt32 {
+		return 0, nil, ErrStreamCorrupt
+	}
+	payload := make([]byte, int(length)+4)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, unexpectedEOF(err)
+	}
+	sum := binary.LittleEndian.Uint32(payload[length:])
+	payload = payload[:length]
+	if crc32.ChecksumIEEE(payload) != sum {
+		return 0, nil, ErrStreamChecksum
+	}
+	return int(count), payload, nil
+}
+
+// A stream that stops without an end block is truncated, not finished.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (sr *StreamReader) decode() (Instruction, error) {
+
+	c := &sr.block
+	insn := Instruction{}
+
+	flags := c.uvarint()
+	insn.Id = uint(c.uvarint())
+	insn.Address = uint(sr.next + uint64(c.varint()))
+	insn.Bytes = c.bytes()
+	if flags&streamOddSize != 0 {
+		insn.Size = uint(c.uvarint())
+	} else {
+		insn.Size = uint(len(insn.Bytes))
+	}
+	if flags&streamStamped != 0 {
+		insn.arch = sr.hdr.Arch
+		insn.mode = sr.hdr.Mode
+		insn.stamped = true
+		if flags&streamOddMode != 0 {
+			insn.mode = uint(c.uvarint())
+		}
+	}
+	sr.next = uint64(insn.Address) + uint64(insn.Size)
+
+	if flags&streamHasText != 0 {
+		idx := c.uvarint()
+		switch {
+		case idx == 0:
+			insn.Mnemonic = c.string()
+			sr.mnems = append(sr.mnems, insn.Mnemonic)
+		case idx <= uint64(len(sr.mnems)):
+			insn.Mnemonic = sr.mnems[idx-1]
+		default:
+			c.fail()
+		}
+		insn.OpStr = c.string()
+	}
+
+	insn.RegistersRead = c.uints()
+	insn.RegistersWritten = c.uints()
+	insn.Groups = c.uints()
+
+	if flags&streamHasDetail != 0 {
+		switch sr.hdr.Arch {
+		case CS_ARCH_ARM:
+			insn.Arm = c.decodeArm()
+		case CS_ARCH_ARM64:
+			insn.Arm64 = c.decodeArm64()
+		case CS_ARCH_MIPS:
+			insn.Mips = c.decodeMips()
+		case CS_ARCH_X86:
+			insn.X86 = c.decodeX86()
+		case CS_ARCH_PPC:
+			insn.PPC = c.decodePPC()
+		case CS_ARCH_SYSZ:
+			insn.SysZ = c.decodeSysZ()
+		case CS_ARCH_SPARC:
+			insn.Sparc = c.decodeSparc()
+		case CS_ARCH_XCORE:
+			insn.Xcore = c.decodeXcore()
+		default:
+			return Instruction{}, ErrArch
+		}
+	}
+
+	if c.err != nil {
+		return Instruction{}, c.err
+	}
+	return insn, nil
+}
+
+// Encode instructions into a complete in-memory stream.
+func MarshalInstructions(hdr StreamHeader, insns []Instruction) ([]byte, error) {
+	var buf bytes.Buffer
+	sw, err := NewStreamWriter(&buf, hdr)
+	if err != nil {
+		return nil, err
+	}
+	if err := sw.Write(insns...); err != nil {
+		return nil, err
+	}
+	if err := sw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decode a complete in-memory stream created by MarshalInstructions or a
+// StreamWriter.
+func UnmarshalInstructions(data []byte) (StreamHeader, []Instruction, error) {
+	sr, err := NewStreamReader(bytes.NewReader(data))
+	if err != nil {
+		return StreamHeader{}, nil, err
+	}
+	insns, err := sr.ReadAll()
+	return sr.hdr, insns, err
+}
+
+// Low level primitives. Slice lengths are stored +1 so that nil and empty
+// slices survive the round trip unchanged.
+
+type streamBuf struct {
+	b   []byte
+	tmp [binary.MaxVarintLen64]byte
+}
+
+func (s *streamBuf) uvarint(v uint64) {
+	n := binary.PutUvarint(s.tmp[:], v)
+	s.b = append(s.b, s.tmp[:n]...)
+}
+
+func (s *streamBuf) varint(v int64) {
+	n := binary.PutVarint(s.tmp[:], v)
+	s.b = append(s.b, s.tmp[:n]...)
+}
+
+func (s *streamBuf) bool(v bool) {
+	if v {
+		s.b = append(s.b, 1)
+		return
+	}
+	s.b = append(s.b, 0)
+}
+
+func (s *streamBuf) float(f float64) {
+	binary.LittleEndian.PutUint64(s.tmp[:8], math.Float64bits(f))
+	s.b = append(s.b, s.tmp[:8]...)
+}
+
+func (s *streamBuf) length(n int, isNil bool) {
+	if isNil {
+		s.uvarint(0)
+		return
+	}
+	s.uvarint(uint64(n) + 1)
+}
+
+func (s *streamBuf) bytes(b []byte) {
+	s.length(len(b), b == nil)
+	s.b = append(s.b, b...)
+}
+
+func (s *streamBuf) string(str string) {
+	s.uvarint(uint64(len(str)))
+	s.b = append(s.b, str...)
+}
+
+func (s *streamBuf) uints(v []uint) {
+	s.length(len(v), v == nil)
+	for _, x := range v {
+		s.uvarint(uint64(x))
+	}
+}
+
+type streamCursor struct {
+	b   []byte
+	off int
+	err error
+}
+
+func (c *streamCursor) fail() {
+	if c.err == nil {
+		c.err = ErrStreamCorrupt
+	}
+}
+
+func (c *streamCursor) uvarint() uint64 {
+	if c.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(c.b[c.off:])
+	if n <= 0 {
+		c.fail()
+		return 0
+	}
+	c.off += n
+	return v
+}
+
+func (c *streamCursor) varint() int64 {
+	if c.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(c.b[c.off:])
+	if n <= 0 {
+		c.fail()
+		return 0
+	}
+	c.off += n
+	return v
+}
+
+func (c *streamCursor) take(n int) []byte {
+	if c.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(c.b)-c.off {
+		c.fail()
+		return nil
+	}
+	b := c.b[c.off : c.off+n]
+	c.off += n
+	return b
+}
+
+func (c *streamCursor) bool() bool {
+	b := c.take(1)
+	return b != nil && b[0] != 0
+}
+
+func (c *streamCursor) float() float64 {
+	b := c.take(8)
+	if b == nil {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b))
+}
+
+// Decode a plain count, guarding against counts larger than the remaining
+// payload could possibly hold.
+func (c *streamCursor) length() int {
+	n := c.uvarint()
+	if n > uint64(len(c.b)-c.off) {
+		c.fail()
+		return 0
+	}
+	return int(n)
+}
+
+// Decode a nil-preserving slice length.
+func (c *streamCursor) sliceLen() (int, bool) {
+	n := c.uvarint()
+	if n == 0 || c.err != nil {
+		return 0, false
+	}
+	if n-1 > uint64(len(c.b)-c.off) {
+		c.fail()
+		return 0, false
+	}
+	return int(n - 1), true
+}
+
+func (c *streamCursor) bytes() []byte {
+	n, ok := c.sliceLen()
+	if !ok {
+		return nil
+	}
+	return append(make([]byte, 0, n), c.take(n)...)
+}
+
+func (c *streamCursor) string() string {
+	n := c.length()
+	return string(c.take(n))
+}
+
+func (c *streamCursor) uints() []uint {
+	n, ok := c.sliceLen()
+	if !ok {
+		return nil
+	}
+	v := make([]uint, n)
+	for i := range v {
+		v[i] = uint(c.uvarint())
+	}
+	return v
+}
+
+// Arch detail. Only the operand union member selected by the operand type is
+// stored, mirroring what the decomposers fill in.
+
+func (s *streamBuf) encodeArm(arm *ArmInstruction) {
+	s.bool(arm.UserMode)
+	s.varint(int64(arm.VectorSize))
+	s.varint(int64(arm.VectorData))
+	s.varint(int64(arm.CPSMode))
+	s.varint(int64(arm.CPSFlag))
+	s.uvarint(uint64(arm.CC))
+	s.bool(arm.UpdateFlags)
+	s.bool(arm.Writeback)
+	s.varint(int64(arm.MemBarrier))
+	s.length(len(arm.Operands), arm.Operands == nil)
+	for _, op := range arm.Operands {
+		s.varint(int64(op.VectorIndex))
+		s.uvarint(uint64(op.Shift.Type))
+		s.uvarint(uint64(op.Shift.Value))
+		s.uvarint(uint64(op.Type))
+		s.bool(op.Subtracted)
+		switch op.Type {
+		case ARM_OP_IMM, ARM_OP_CIMM, ARM_OP_PIMM:
+			s.varint(int64(op.Imm))
+		case ARM_OP_FP:
+			s.float(op.FP)
+		case ARM_OP_REG, ARM_OP_SYSREG:
+			s.uvarint(uint64(op.Reg))
+		case ARM_OP_MEM:
+			s.uvarint(uint64(op.Mem.Base))
+			s.uvarint(uint64(op.Mem.Index))
+			s.varint(int64(op.Mem.Scale))
+			s.varint(int64(op.Mem.Disp))
+		case ARM_OP_SETEND:
+			s.varint(int64(op.Setend))
+		}
+	}
+}
+
+func (c *streamCursor) decodeArm() *ArmInstruction {
+	arm := ArmInstruction{
+		UserMode:    c.bool(),
+		VectorSize:  int(c.varint()),
+		VectorData:  int(c.varint()),
+		CPSMode:     int(c.varint()),
+		CPSFlag:     int(c.varint()),
+		CC:          uint(c.uvarint()),
+		UpdateFlags: c.bool(),
+		Writeback:   c.bool(),
+		MemBarrier:  int(c.varint()),
+	}
+	n, ok := c.sliceLen()
+	if ok {
+		arm.Operands = make([]ArmOperand, 0, n)
+	}
+	for i := 0; i < n && c.err == nil; i++ {
+		op := ArmOperand{VectorIndex: int(c.varint())}
+		op.Shift.Type = uint(c.uvarint())
+		op.Shift.Value = uint(c.uvarint())
+		op.Type = uint(c.uvarint())
+		op.Subtracted = c.bool()
+		switch op.Type {
+		case ARM_OP_IMM, ARM_OP_CIMM, ARM_OP_PIMM:
+			op.Imm = int32(c.varint())
+		case ARM_OP_FP:
+			op.FP = c.float()
+		case ARM_OP_REG, ARM_OP_SYSREG:
+			op.Reg = uint(c.uvarint())
+		case ARM_OP_MEM:
+			op.Mem.Base = uint(c.uvarint())
+			op.Mem.Index = uint(c.uvarint())
+			op.Mem.Scale = int(c.varint())
+			op.Mem.Disp = int(c.varint())
+		case ARM_OP_SETEND:
+			op.Setend = int(c.varint())
+		}
+		arm.Operands = append(arm.Operands, op)
+	}
+	return &arm
+}
+
+func (s *streamBuf) encodeArm64(arm64 *Arm64Instruction) {
+	s.uvarint(uint64(arm64.CC))
+	s.bool(arm64.UpdateFlags)
+	s.bool(arm64.Writeback)
+	s.length(len(arm64.Operands), arm64.Operands == nil)
+	for _, op := range arm64.Operands {
+		s.varint(int64(op.VectorIndex))
+		s.varint(int64(op.Vas))
+		s.varint(int64(op.Vess))
+		s.uvarint(uint64(op.Shift.Type))
+		s.uvarint(uint64(op.Shift.Value))
+		s.uvarint(uint64(op.Ext))
+		s.uvarint(uint64(op.Type))
+		switch op.Type {
+		case ARM64_OP_IMM, ARM64_OP_CIMM:
+			s.varint(op.Imm)
+		case ARM64_OP_FP:
+			s.float(op.FP)
+		case ARM64_OP_REG, ARM64_OP_REG_MRS, ARM64_OP_REG_MSR:
+			s.uvarint(uint64(op.Reg))
+		case ARM64_OP_MEM:
+			s.uvarint(uint64(op.Mem.Base))
+			s.uvarint(uint64(op.Mem.Index))
+			s.varint(int64(op.Mem.Disp))
+		case ARM64_OP_PREFETCH:
+			s.varint(int64(op.Prefetch))
+		case ARM64_OP_PSTATE:
+			s.varint(int64(op.PState))
+		case ARM64_OP_BARRIER:
+			s.varint(int64(op.Barrier))
+		case ARM64_OP_SYS:
+			s.uvarint(uint64(op.Sys))
+		}
+	}
+}
+
+func (c *streamCursor) decodeArm64() *Arm64Instruction {
+	arm64 := Arm64Instruction{
+		CC:          uint(c.uvarint()),
+		UpdateFlags: c.bool(),
+		Writeback:   c.bool(),
+	}
+	n, ok := c.sliceLen()
+	if ok {
+		arm64.Operands = make([]Arm64Operand, 0, n)
+	}
+	for i := 0; i < n && c.err == nil; i++ {
+		op := Arm64Operand{
+			VectorIndex: int(c.varint()),
+			Vas:         int(c.varint()),
+			Vess:        int(c.varint()),
+		}
+		op.Shift.Type = uint(c.uvarint())
+		op.Shift.Value = uint(c.uvarint())
+		op.Ext = uint(c.uvarint())
+		op.Type = uint(c.uvarint())
+		switch op.Type {
+		case ARM64_OP_IMM, ARM64_OP_CIMM:
+			op.Imm = c.varint()
+		case ARM64_OP_FP:
+			op.FP = c.float()
+		case ARM64_OP_REG, ARM64_OP_REG_MRS, ARM64_OP_REG_MSR:
+			op.Reg = uint(c.uvarint())
+		case ARM64_OP_MEM:
+			op.Mem.Base = uint(c.uvarint())
+			op.Mem.Index = uint(c.uvarint())
+			op.Mem.Disp = int32(c.varint())
+		case ARM64_OP_PREFETCH:
+			op.Prefetch = int(c.varint())
+		case ARM64_OP_PSTATE:
+			op.PState = int(c.varint())
+		case ARM64_OP_BARRIER:
+			op.Barrier = int(c.varint())
+		case ARM64_OP_SYS:
+			op.Sys = uint(c.uvarint())
+		}
+		arm64.Operands = append(arm64.Operands, op)
+	}
+	return &arm64
+}
+
+func (s *streamBuf) encodeMips(mips *MipsInstruction) {
+	s.length(len(mips.Operands), mips.Operands == nil)
+	for _, op := range mips.Operands {
+		s.uvarint(uint64(op.Type))
+		switch op.Type {
+		case MIPS_OP_IMM:
+			s.varint(op.Imm)
+		case MIPS_OP_REG:
+			s.uvarint(uint64(op.Reg))
+		case MIPS_OP_MEM:
+			s.uvarint(uint64(op.Mem.Base))
+			s.varint(op.Mem.Disp)
+		}
+	}
+}
+
+func (c *streamCursor) decodeMips() *MipsInstruction {
+	mips := MipsInstruction{}
+	n, ok := c.sliceLen()
+	if ok {
+		mips.Operands = make([]MipsOperand, 0, n)
+	}
+	for i := 0; i < n && c.err == nil; i++ {
+		op := MipsOperand{Type: uint(c.uvarint())}
+		switch op.Type {
+		case MIPS_OP_IMM:
+			op.Imm = c.varint()
+		case MIPS_OP_REG:
+			op.Reg = uint(c.uvarint())
+		case MIPS_OP_MEM:
+			op.Mem.Base = uint(c.uvarint())
+			op.Mem.Disp = c.varint()
+		}
+		mips.Operands = append(mips.Operands, op)
+	}
+	return &mips
+}
+
+func (s *streamBuf) encodeX86(x86 *X86Instruction) {
+	s.bytes(x86.Prefix)
+	s.bytes(x86.Opcode)
+	s.b = append(s.b, x86.Rex, x86.AddrSize, x86.ModRM, x86.Sib)
+	s.varint(int64(x86.Disp))
+	s.uvarint(uint64(x86.SibIndex))
+	s.varint(int64(x86.SibScale))
+	s.uvarint(uint64(x86.SibBase))
+	s.uvarint(uint64(x86.SseCC))
+	s.uvarint(uint64(x86.AvxCC))
+	s.bool(x86.AvxSAE)
+	s.uvarint(uint64(x86.AvxRM))
+	s.length(len(x86.Operands), x86.Operands == nil)
+	for _, op := range x86.Operands {
+		s.uvarint(uint64(op.Type))
+		s.b = append(s.b, op.Size)
+		s.uvarint(uint64(op.AvxBcast))
+		s.bool(op.AvxZeroOpmask)
+		switch op.Type {
+		case X86_OP_IMM:
+			s.varint(op.Imm)
+		case X86_OP_FP:
+			s.float(op.FP)
+		case X86_OP_REG:
+			s.uvarint(uint64(op.Reg))
+		case X86_OP_MEM:
+			s.uvarint(uint64(op.Mem.Segment))
+			s.uvarint(uint64(op.Mem.Base))
+			s.uvarint(uint64(op.Mem.Index))
+			s.varint(int64(op.Mem.Scale))
+			s.varint(op.Mem.Disp)
+		}
+	}
+}
+
+func (c *streamCursor) decodeX86() *X86Instruction {
+	x86 := X86Instruction{
+		Prefix: c.bytes(),
+		Opcode: c.bytes(),
+	}
+	if b := c.take(4); b != nil {
+		x86.Rex, x86.AddrSize, x86.ModRM, x86.Sib = b[0], b[1], b[2], b[3]
+	}
+	x86.Disp = int32(c.varint())
+	x86.SibIndex = uint(c.uvarint())
+	x86.SibScale = int8(c.varint())
+	x86.SibBase = uint(c.uvarint())
+	x86.SseCC = uint(c.uvarint())
+	x86.AvxCC = uint(c.uvarint())
+	x86.AvxSAE = c.bool()
+	x86.AvxRM = uint(c.uvarint())
+	n, ok := c.sliceLen()
+	if ok {
+		x86.Operands = make([]X86Operand, 0, n)
+	}
+	for i := 0; i < n && c.err == nil; i++ {
+		op := X86Operand{Type: uint(c.uvarint())}
+		if b := c.take(1); b != nil {
+			op.Size = b[0]
+		}
+		op.AvxBcast = uint(c.uvarint())
+		op.AvxZeroOpmask = c.bool()
+		switch op.Type {
+		case X86_OP_IMM:
+			op.Imm = c.varint()
+		case X86_OP_FP:
+			op.FP = c.float()
+		case X86_OP_REG:
+			op.Reg = uint(c.uvarint())
+		case X86_OP_MEM:
+			op.Mem.Segment = uint(c.uvarint())
+			op.Mem.Base = uint(c.uvarint())
+			op.Mem.Index = uint(c.uvarint())
+			op.Mem.Scale = int(c.varint())
+			op.Mem.Disp = c.varint()
+		}
+		x86.Operands = append(x86.Operands, op)
+	}
+	return &x86
+}
+
+func (s *streamBuf) encodePPC(ppc *PPCInstruction) {
+	s.varint(int64(ppc.BC))
+	s.varint(int64(ppc.BH))
+	s.bool(ppc.UpdateCR0)
+	s.length(len(ppc.Operands), ppc.Operands == nil)
+	for _, op := range ppc.Operands {
+		s.uvarint(uint64(op.Type))
+		switch op.Type {
+		case PPC_OP_IMM:
+			s.varint(int64(op.Imm))
+		case PPC_OP_REG:
+			s.uvarint(uint64(op.Reg))
+		case PPC_OP_MEM:
+			s.uvarint(uint64(op.Mem.Base))
+			s.varint(int64(op.Mem.Disp))
+		case PPC_OP_CRX:
+			s.uvarint(uint64(op.CRX.Scale))
+			s.uvarint(uint64(op.CRX.Reg))
+			s.uvarint(uint64(op.CRX.Cond))
+		}
+	}
+}
+
+func (c *streamCursor) decodePPC() *PPCInstruction {
+	ppc := PPCInstruction{
+		BC:        int(c.varint()),
+		BH:        int(c.varint()),
+		UpdateCR0: c.bool(),
+	}
+	n, ok := c.sliceLen()
+	if ok {
+		ppc.Operands = make([]PPCOperand, 0, n)
+	}
+	for i := 0; i < n && c.err == nil; i++ {
+		op := PPCOperand{Type: uint(c.uvarint())}
+		switch op.Type {
+		case PPC_OP_IMM:
+			op.Imm = int32(c.varint())
+		case PPC_OP_REG:
+			op.Reg = uint(c.uvarint())
+		case PPC_OP_MEM:
+			op.Mem.Base = uint(c.uvarint())
+			op.Mem.Disp = int(c.varint())
+		case PPC_OP_CRX:
+			op.CRX.Scale = uint(c.uvarint())
+			op.CRX.Reg = uint(c.uvarint())
+			op.CRX.Cond = uint(c.uvarint())
+		}
+		ppc.Operands = append(ppc.Operands, op)
+	}
+	return &ppc
+}
+
+func (s *streamBuf) encodeSysZ(sysz *SysZInstruction) {
+	s.uvarint(uint64(sysz.CC))
+	s.b = append(s.b, sysz.OpCnt)
+	s.length(len(sysz.Operands), sysz.Operands == nil)
+	for _, op := range sysz.Operands {
+		s.uvarint(uint64(op.Type))
+		switch op.Type {
+		case SYSZ_OP_IMM:
+			s.varint(op.Imm)
+		case SYSZ_OP_REG, SYSZ_OP_ACREG:
+			s.uvarint(uint64(op.Reg))
+		case SYSZ_OP_MEM:
+			s.b = append(s.b, op.Mem.Base, op.Mem.Index)
+			s.uvarint(op.Mem.Length)
+			s.varint(op.Mem.Disp)
+		}
+	}
+}
+
+func (c *streamCursor) decodeSysZ() *SysZInstruction {
+	sysz := SysZInstruction{CC: uint(c.uvarint())}
+	if b := c.take(1); b != nil {
+		sysz.OpCnt = b[0]
+	}
+	n, ok := c.sliceLen()
+	if ok {
+		sysz.Operands = make([]SysZOperand, 0, n)
+	}
+	for i := 0; i < n && c.err == nil; i++ {
+		op := SysZOperand{Type: uint(c.uvarint())}
+		switch op.Type {
+		case SYSZ_OP_IMM:
+			op.Imm = c.varint()
+		case SYSZ_OP_REG, SYSZ_OP_ACREG:
+			op.Reg = uint(c.uvarint())
+		case SYSZ_OP_MEM:
+			if b := c.take(2); b != nil {
+				op.Mem.Base, op.Mem.Index = b[0], b[1]
+			}
+			op.Mem.Length = c.uvarint()
+			op.Mem.Disp = c.varint()
+		}
+		sysz.Operands = append(sysz.Operands, op)
+	}
+	return &sysz
+}
+
+func (s *streamBuf) encodeSparc(sparc *SparcInstruction) {
+	s.uvarint(uint64(sparc.CC))
+	s.uvarint(uint64(sparc.Hint))
+	s.b = append(s.b, sparc.OpCnt)
+	s.length(len(sparc.Operands), sparc.Operands == nil)
+	for _, op := range sparc.Operands {
+		s.uvarint(uint64(op.Type))
+		switch op.Type {
+		case SPARC_OP_IMM:
+			s.varint(int64(op.Imm))
+		case SPARC_OP_REG:
+			s.uvarint(uint64(op.Reg))
+		case SPARC_OP_MEM:
+			s.b = append(s.b, op.Mem.Base, op.Mem.Index)
+			s.varint(int64(op.Mem.Disp))
+		}
+	}
+}
+
+func (c *streamCursor) decodeSparc() *SparcInstruction {
+	sparc := SparcInstruction{
+		CC:   uint(c.uvarint()),
+		Hint: uint(c.uvarint()),
+	}
+	if b := c.take(1); b != nil {
+		sparc.OpCnt = b[0]
+	}
+	n, ok := c.sliceLen()
+	if ok {
+		sparc.Operands = make([]SparcOperand, 0, n)
+	}
+	for i := 0; i < n && c.err == nil; i++ {
+		op := SparcOperand{Type: uint(c.uvarint())}
+		switch op.Type {
+		case SPARC_OP_IMM:
+			op.Imm = int32(c.varint())
+		case SPARC_OP_REG:
+			op.Reg = uint(c.uvarint())
+		case SPARC_OP_MEM:
+			if b := c.take(2); b != nil {
+				op.Mem.Base, op.Mem.Index = b[0], b[1]
+			}
+			op.Mem.Disp = int32(c.varint())
+		}
+		sparc.Operands = append(sparc.Operands, op)
+	}
+	return &sparc
+}
+
+func (s *streamBuf) encodeXcore(xcore *XcoreInstruction) {
+	s.b = append(s.b, xcore.OpCnt)
+	s.length(len(xcore.Operands), xcore.Operands == nil)
+	for _, op := range xcore.Operands {
+		s.uvarint(uint64(op.Type))
+		switch op.Type {
+		case XCORE_OP_IMM:
+			s.varint(int64(op.Imm))
+		case XCORE_OP_REG:
+			s.uvarint(uint64(op.Reg))
+		case XCORE_OP_MEM:
+			s.b = append(s.b, op.Mem.Base, op.Mem.Index)
+			s.varint(int64(op.Mem.Disp))
+			s.varint(int64(op.Mem.Direct))
+		}
+	}
+}
+
+func (c *streamCursor) decodeXcore() *XcoreInstruction {
+	xcore := XcoreInstruction{}
+	if b := c.take(1); b != nil {
+		xcore.OpCnt = b[0]
+	}
+	n, ok := c.sliceLen()
+	if ok {
+		xcore.Operands = make([]XcoreOperand, 0, n)
+	}
+	for i := 0; i < n && c.err == nil; i++ {
+		op := XcoreOperand{Type: uint(c.uvarint())}
+		switch op.Type {
+		case XCORE_OP_IMM:
+			op.Imm = int32(c.varint())
+		case XCORE_OP_REG:
+			op.Reg = uint(c.uvarint())
+		case XCORE_OP_MEM:
+			if b := c.take(2); b != nil {
+				op.Mem.Base, op.Mem.Index = b[0], b[1]
+			}
+			op.Mem.Disp = int32(c.varint())
+			op.Mem.Direct = int(c.varint())
+		}
+		xcore.Operands = append(xcore.Operands, op)
+	}
+	return &xcore
+}
@@ -0,0 +1,244 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestStreamRoundTrip(t *testing.T) {
+
+	for i, platform := range detailTests {
+
+		engine, err := New(platform.arch, platform.mode)
+		if err != nil {
+			t.Errorf("Failed to initialize engine %v", err)
+			return
+		}
+		defer engine.Close()
+
+		for _, opt := range platform.options {
+			engine.SetOption(opt.ty, opt.value)
+		}
+
+		insns, err := engine.Disasm([]byte(platform.code), address, 0)
+		if err != nil {
+			t.Errorf("Disassembly error: %v\n", err)
+			continue
+		}
+
+		hdr := engine.StreamHeader()
+		data, err := MarshalInstructions(hdr, insns)
+		if err != nil {
+			t.Errorf("%2d> %s: marshal failed: %v", i, platform.comment, err)
+			continue
+		}
+
+		gotHdr, got, err := UnmarshalInstructions(data)
+		if err != nil {
+			t.Errorf("%2d> %s: unmarshal failed: %v", i, platform.comment, err)
+			continue
+		}
+		if !reflect.DeepEqual(gotHdr, hdr) {
+			t.Errorf("%2d> %s: header mismatch, want %v got %v", i, platform.comment, hdr, gotHdr)
+		}
+		if !reflect.DeepEqual(got, insns) {
+			t.Errorf("%2d> %s: instructions did not survive the round trip", i, platform.comment)
+		}
+		t.Logf("%2d> %s: %v instructions in %v bytes", i, platform.comment, len(insns), len(data))
+	}
+}
+
+func streamTestInsns() []Instruction {
+	return []Instruction{
+		{
+			InstructionHeader: InstructionHeader{
+				Id:       X86_INS_PUSH,
+				Address:  0x1000,
+				Size:     1,
+				Bytes:    []byte{0x55},
+				Mnemonic: "push",
+				OpStr:    "rbp",
+				Groups:   []uint{},
+			},
+			X86: &X86Instruction{
+				Prefix:   []byte{0, 0, 0, 0},
+				Opcode:   []byte{0x55, 0, 0, 0},
+				Operands: []X86Operand{{Type: X86_OP_REG, Reg: X86_REG_RBP, Size: 8}},
+			},
+			arch:    CS_ARCH_X86,
+			mode:    CS_MODE_64,
+			stamped: true,
+		},
+		{
+			InstructionHeader: InstructionHeader{
+				Id:       X86_INS_MOV,
+				Address:  0x1001,
+				Size:     7,
+				Bytes:    []byte{0x48, 0x8b, 0x05, 0xb8, 0x13, 0x00, 0x00},
+				Mnemonic: "mov",
+				OpStr:    "rax, qword ptr [rip + 0x13b8]",
+			},
+			X86: &X86Instruction{
+				Prefix: []byte{0, 0, 0, 0},
+				Opcode: []byte{0x8b, 0, 0, 0},
+				Rex:    0x48,
+				ModRM:  0x05,
+				Disp:   0x13b8,
+				Operands: []X86Operand{
+					{Type: X86_OP_REG, Reg: X86_REG_RAX, Size: 8},
+					{Type: X86_OP_MEM, Size: 8, Mem: X86MemoryOperand{Base: X86_REG_RIP, Scale: 1, Disp: 0x13b8}},
+				},
+			},
+		},
+		{
+			// Recorded in a different mode to the stream header
+			InstructionHeader: InstructionHeader{
+				Id:      X86_INS_NOP,
+				Address: 0x1008,
+				Size:    1,
+				Bytes:   []byte{0x90},
+			},
+			arch:    CS_ARCH_X86,
+			mode:    CS_MODE_32,
+			stamped: true,
+		},
+		{
+			// No detail, and an address that goes backwards
+			InstructionHeader: InstructionHeader{
+				Id:       X86_INS_PUSH,
+				Address:  0x800,
+				Size:     1,
+				Bytes:    []byte{0x55},
+				Mnemonic: "push",
+				OpStr:    "rbp",
+			},
+		},
+	}
+}
+
+func TestEngineOptions(t *testing.T) {
+
+	engine, err := New(CS_ARCH_ARM, CS_MODE_ARM)
+	if err != nil {
+		t.Fatalf("Failed to initialize engine %v", err)
+	}
+	defer engine.Close()
+
+	// movs r0, #1 in Thumb, which is two bytes of an ARM instruction
+	code := []byte("\x01\x20\x70\x47")
+	if err := engine.SetOption(CS_OPT_MODE, CS_MODE_THUMB); err != nil {
+		t.Fatalf("Failed to set Thumb mode %v", err)
+	}
+	engine.SetOption(CS_OPT_DETAIL, CS_OPT_ON)
+	if engine.Mode() != CS_MODE_THUMB {
+		t.Errorf("Thumb: want mode %#x got %#x", CS_MODE_THUMB, engine.Mode())
+	}
+	insns, err := engine.Disasm(code, address, 0)
+	if err != nil || len(insns) != 2 || insns[0].Size != 2 {
+		t.Errorf("Thumb: want 2 two byte instructions got %d (%v)", len(insns), err)
+	}
+
+	engine.SetOption(CS_OPT_MODE, CS_MODE_ARM)
+	if engine.Mode() != CS_MODE_ARM {
+		t.Errorf("ARM: want mode %#x got %#x", CS_MODE_ARM, engine.Mode())
+	}
+	insns, err = engine.Disasm(code, address, 0)
+	if err != nil || len(insns) != 1 || insns[0].Size != 4 {
+		t.Errorf("ARM: want 1 four byte instruction got %d (%v)", len(insns), err)
+	}
+
+	// The mode keeps its place when it's set again
+	want := []EngineOption{{CS_OPT_MODE, CS_MODE_ARM}, {CS_OPT_DETAIL, CS_OPT_ON}}
+	if got := engine.Options(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Options: want %v got %v", want, got)
+	}
+}
+
+func TestStreamPreservesShape(t *testing.T) {
+
+	hdr := StreamHeader{
+		Major:   3,
+		Minor:   0,
+		Arch:    CS_ARCH_X86,
+		Mode:    CS_MODE_64,
+		Options: []EngineOption{{CS_OPT_DETAIL, CS_OPT_ON}},
+	}
+	insns := streamTestInsns()
+
+	var buf bytes.Buffer
+	sw, err := NewStreamWriter(&buf, hdr)
+	if err != nil {
+		t.Fatalf("Failed to create writer: %v", err)
+	}
+	for _, insn := range insns {
+		if err := sw.Write(insn); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if err := sw.Write(insns[0]); err != ErrStreamClosed {
+		t.Errorf("Want ErrStreamClosed writing after Close, got %v", err)
+	}
+
+	sr, err := NewStreamReader(&buf)
+	if err != nil {
+		t.Fatalf("Failed to create reader: %v", err)
+	}
+	if !reflect.DeepEqual(sr.Header(), hdr) {
+		t.Errorf("Header mismatch, want %v got %v", hdr, sr.Header())
+	}
+	for i := range insns {
+		got, err := sr.Next()
+		if err != nil {
+			t.Fatalf("Next failed at %v: %v", i, err)
+		}
+		if !reflect.DeepEqual(got, insns[i]) {
+			t.Errorf("Instruction %v mismatch:\nwant %#v\ngot  %#v", i, insns[i], got)
+		}
+	}
+	if _, err := sr.Next(); err != io.EOF {
+		t.Errorf("Want io.EOF at end of stream, got %v", err)
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+
+	hdr := StreamHeader{Arch: CS_ARCH_X86, Mode: CS_MODE_64}
+	data, err := MarshalInstructions(hdr, streamTestInsns())
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	if _, _, err := UnmarshalInstructions([]byte("nope")); err != ErrStreamMagic {
+		t.Errorf("Want ErrStreamMagic, got %v", err)
+	}
+
+	flipped := append([]byte(nil), data...)
+	flipped[len(flipped)/2] ^= 0x40
+	if _, _, err := UnmarshalInstructions(flipped); err != ErrStreamChecksum && err != ErrStreamCorrupt {
+		t.Errorf("Want checksum failure for a flipped bit, got %v", err)
+	}
+
+	if _, _, err := UnmarshalInstructions(data[:len(data)-3]); err != io.ErrUnexpectedEOF {
+		t.Errorf("Want io.ErrUnexpectedEOF for a truncated stream, got %v", err)
+	}
+
+	hdr.Arch = CS_ARCH_ARM
+	if _, err := MarshalInstructions(hdr, streamTestInsns()); err != ErrStreamArch {
+		t.Errorf("Want ErrStreamArch for mismatched detail, got %v", err)
+	}
+}
@@ -0,0 +1,598 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+// How an instruction changes the stack pointer
+type StackEffectKind int
+
+const (
+	StackKnown        StackEffectKind = iota // the stack pointer changes by Delta, which may be 0
+	StackUnknown                             // the stack pointer is written with a value that isn't known statically
+	StackFrameSetup                          // a frame is set up from the stack pointer, which changes by Delta
+	StackFrameRestore                        // the stack pointer is restored from the frame pointer or back chain
+)
+
+var stackEffectNames = [...]string{
+	StackKnown:        "known",
+	StackUnknown:      "unknown",
+	StackFrameSetup:   "frame setup",
+	StackFrameRestore: "frame restore",
+}
+
+func (k StackEffectKind) String() string {
+	if k < 0 || int(k) >= len(stackEffectNames) {
+		return "invalid"
+	}
+	return stackEffectNames[k]
+}
+
+// The effect of one instruction on the stack pointer
+type StackEffect struct {
+	Kind  StackEffectKind
+	Delta int64 // bytes added to the stack pointer, negative as the stack grows
+}
+
+// The change this Instruction makes to the stack pointer, assuming it
+// executes. Recognised forms are:
+//
+//	X86:   push / pop (and pushf, pusha...), call, ret / retf imm16,
+//	       add / sub / lea rsp, enter, leave, mov rsp, rbp / mov rbp, rsp
+//	ARM:   push / pop, vpush / vpop, ldm / stm / vldm / vstm sp!, loads and
+//	       stores with sp writeback, add / sub sp, mov sp, fp / mov fp, sp
+//	ARM64: loads and stores with sp writeback (stp x29, x30, [sp, #-16]!),
+//	       add / sub sp, mov sp, x29 / mov x29, sp
+//	MIPS:  addiu / daddiu $sp, move $sp, $fp / move $fp, $sp
+//	PPC:   stwu / stdu r1, addi r1, mr r1, r31 / mr r31, r1, and reloading
+//	       r1 from the back chain (lwz r1, 0(r1))
+//	SPARC: save %sp, imm, %sp, restore, add / sub %sp
+//	SysZ:  aghi / la / lay %r15, lgr %r15, %r11 / lgr %r11, %r15, and lmg
+//	       ranges which reload %r15
+//	XCore: entsp / extsp / retsp
+//
+// The frame pointer is the one from RegInfo, with r7 in Thumb code and r31 on
+// PPC. Any other instruction which writes the stack pointer is StackUnknown,
+// and everything else is StackKnown with a Delta of 0. Returns ErrDetail
+// without detail.
+func (insn Instruction) StackDelta() (StackEffect, error) {
+	arch, ok := detailArch(&insn)
+	if !ok {
+		return StackEffect{}, ErrDetail
+	}
+	m, err := NewRegModel(arch)
+	if err != nil {
+		return StackEffect{}, err
+	}
+	s := &stackRegs{m: m, thumb: arch == CS_ARCH_ARM && insn.mode&CS_MODE_THUMB != 0}
+
+	var e StackEffect
+	switch arch {
+	case CS_ARCH_X86:
+		e, ok = insn.x86StackDelta(s)
+	case CS_ARCH_ARM:
+		e, ok = insn.armStackDelta(s)
+	case CS_ARCH_ARM64:
+		e, ok = insn.arm64StackDelta(s)
+	case CS_ARCH_MIPS:
+		e, ok = insn.mipsStackDelta(s)
+	case CS_ARCH_PPC:
+		e, ok = insn.ppcStackDelta(s)
+	case CS_ARCH_SPARC:
+		e, ok = insn.sparcStackDelta(s)
+	case CS_ARCH_SYSZ:
+		e, ok = insn.syszStackDelta(s)
+	case CS_ARCH_XCORE:
+		e, ok = insn.xcoreStackDelta(s)
+	}
+	if ok {
+		return e, nil
+	}
+
+	_, written, err := insn.RegsAccess()
+	if err != nil {
+		return StackEffect{}, err
+	}
+	for _, r := range written {
+		if s.sp(r) {
+			return stackUnknown, nil
+		}
+	}
+	return StackEffect{}, nil
+}
+
+func stackKnown(delta int64) (StackEffect, bool) {
+	return StackEffect{Kind: StackKnown, Delta: delta}, true
+}
+
+var (
+	stackUnknown = StackEffect{Kind: StackUnknown}
+	frameRestore = StackEffect{Kind: StackFrameRestore}
+)
+
+type stackRegs struct {
+	m     *RegModel
+	thumb bool
+}
+
+func (s *stackRegs) sp(reg uint) bool {
+	info, ok := s.m.Info(reg)
+	return ok && info.Role == RegRoleStackPointer
+}
+
+func (s *stackRegs) fp(reg uint) bool {
+	switch s.m.Arch() {
+	case CS_ARCH_ARM:
+		if s.thumb {
+			return reg == ARM_REG_R7
+		}
+	case CS_ARCH_PPC:
+		return reg == PPC_REG_R31
+	}
+	info, ok := s.m.Info(reg)
+	return ok && info.Role == RegRoleFramePointer
+}
+
+// The total size of a register list
+func (s *stackRegs) listBytes(regs []uint) int64 {
+	var n int64
+	for _, r := range regs {
+		info, _ := s.m.Info(r)
+		n += int64(info.Width / 8)
+	}
+	return n
+}
+
+// X86
+
+func (insn *Instruction) x86StackDelta(s *stackRegs) (StackEffect, bool) {
+	ops := insn.X86.Operands
+	word := int64(8)
+	switch {
+	case insn.mode&CS_MODE_16 != 0:
+		word = 2
+	case insn.mode&CS_MODE_32 != 0:
+		word = 4
+	}
+
+	switch insn.Id {
+	case X86_INS_PUSH, X86_INS_POP:
+		if len(ops) != 1 {
+			return StackEffect{}, false
+		}
+		if insn.Id == X86_INS_POP && ops[0].Type == X86_OP_REG && s.sp(ops[0].Reg) {
+			return stackUnknown, true
+		}
+		// Segment registers and immediates move a whole word
+		size := int64(ops[0].Size)
+		if info, _ := s.m.Info(ops[0].Reg); ops[0].Type == X86_OP_IMM || size == 0 ||
+			(ops[0].Type == X86_OP_REG && info.Class == RegClassSegment) {
+			size = word
+		}
+		if insn.Id == X86_INS_PUSH {
+			return stackKnown(-size)
+		}
+		return stackKnown(size)
+	case X86_INS_PUSHF:
+		return stackKnown(-2)
+	case X86_INS_PUSHFD:
+		return stackKnown(-4)
+	case X86_INS_PUSHFQ:
+		return stackKnown(-8)
+	case X86_INS_POPF:
+		return stackKnown(2)
+	case X86_INS_POPFD:
+		return stackKnown(4)
+	case X86_INS_POPFQ:
+		return stackKnown(8)
+	case X86_INS_PUSHAW:
+		return stackKnown(-16)
+	case X86_INS_PUSHAL:
+		return stackKnown(-32)
+	case X86_INS_POPAW:
+		return stackKnown(16)
+	case X86_INS_POPAL:
+		return stackKnown(32)
+	case X86_INS_CALL:
+		return stackKnown(-word)
+	case X86_INS_RET:
+		return stackKnown(word + x86Imm(ops, 0))
+	case X86_INS_RETF:
+		// 32 bit operands by default, even in long mode
+		if word == 8 {
+			return stackKnown(8 + x86Imm(ops, 0))
+		}
+		return stackKnown(2*word + x86Imm(ops, 0))
+	case X86_INS_RETFQ:
+		return stackKnown(16 + x86Imm(ops, 0))
+	case X86_INS_IRET, X86_INS_IRETD, X86_INS_IRETQ:
+		return stackUnknown, true
+	case X86_INS_ENTER:
+		// push rbp, level - 1 saved frame pointers and the new frame pointer,
+		// then reserve the locals
+		level := x86Imm(ops, 1) & 31
+		return StackEffect{Kind: StackFrameSetup, Delta: -(word*(1+level) + x86Imm(ops, 0))}, true
+	case X86_INS_LEAVE:
+		return frameRestore, true
+	}
+
+	if len(ops) != 2 || ops[0].Type != X86_OP_REG {
+		return StackEffect{}, false
+	}
+	dst, src := ops[0].Reg, ops[1]
+	switch {
+	case s.sp(dst):
+		switch {
+		case (insn.Id == X86_INS_ADD || insn.Id == X86_INS_SUB) && src.Type == X86_OP_IMM:
+			if insn.Id == X86_INS_SUB {
+				return stackKnown(-src.Imm)
+			}
+			return stackKnown(src.Imm)
+		case insn.Id == X86_INS_LEA && src.Mem.Index == X86_REG_INVALID && s.sp(src.Mem.Base):
+			return stackKnown(src.Mem.Disp)
+		case insn.Id == X86_INS_LEA && s.fp(src.Mem.Base):
+			return frameRestore, true
+		case insn.Id == X86_INS_MOV && src.Type == X86_OP_REG && s.fp(src.Reg):
+			return frameRestore, true
+		}
+	case s.fp(dst):
+		if insn.Id == X86_INS_MOV && src.Type == X86_OP_REG && s.sp(src.Reg) ||
+			insn.Id == X86_INS_LEA && s.sp(src.Mem.Base) {
+			return StackEffect{Kind: StackFrameSetup}, true
+		}
+	}
+	return StackEffect{}, false
+}
+
+func x86Imm(ops []X86Operand, idx int) int64 {
+	if idx >= len(ops) || ops[idx].Type != X86_OP_IMM {
+		return 0
+	}
+	return ops[idx].Imm
+}
+
+// ARM
+
+// Register list transfers which move the base up
+var armIncrement = newInsnSet(
+	ARM_INS_LDM, ARM_INS_LDMIB, ARM_INS_STM, ARM_INS_STMIB, ARM_INS_VLDMIA,
+	ARM_INS_VSTMIA,
+)
+
+func (insn *Instruction) armStackDelta(s *stackRegs) (StackEffect, bool) {
+	arm := insn.Arm
+	ops := arm.Operands
+	regs := func(ops []ArmOperand) []uint {
+		var l []uint
+		for _, op := range ops {
+			if op.Type == ARM_OP_REG {
+				l = append(l, op.Reg)
+			}
+		}
+		return l
+	}
+
+	switch {
+	case insn.Id == ARM_INS_PUSH || insn.Id == ARM_INS_VPUSH:
+		return stackKnown(-s.listBytes(regs(ops)))
+	case insn.Id == ARM_INS_POP || insn.Id == ARM_INS_VPOP:
+		for _, r := range regs(ops) {
+			if s.sp(r) {
+				return stackUnknown, true
+			}
+		}
+		return stackKnown(s.listBytes(regs(ops)))
+	case armLoadMultiple[insn.Id] || armStoreMultiple[insn.Id]:
+		if len(ops) < 2 || !arm.Writeback || !s.sp(armReg(arm, 0)) {
+			return StackEffect{}, false
+		}
+		list := regs(ops[1:])
+		for _, r := range list {
+			if s.sp(r) && armLoadMultiple[insn.Id] {
+				return stackUnknown, true
+			}
+		}
+		if armIncrement[insn.Id] {
+			return stackKnown(s.listBytes(list))
+		}
+		return stackKnown(-s.listBytes(list))
+	}
+
+	// ldr r0, [sp], #4 / str lr, [sp, #-4]!
+	for i, op := range ops {
+		if op.Type != ARM_OP_MEM || !arm.Writeback || !s.sp(op.Mem.Base) {
+			continue
+		}
+		if i+1 < len(ops) {
+			post := ops[i+1]
+			if post.Type != ARM_OP_IMM {
+				return stackUnknown, true
+			}
+			if post.Subtracted {
+				return stackKnown(-int64(post.Imm))
+			}
+			return stackKnown(int64(post.Imm))
+		}
+		if op.Mem.Index != ARM_REG_INVALID {
+			return stackUnknown, true
+		}
+		return stackKnown(int64(op.Mem.Disp))
+	}
+
+	// add sp, sp, #8 or Thumb add sp, #8
+	dst := armReg(arm, 0)
+	switch insn.Id {
+	case ARM_INS_ADD, ARM_INS_SUB:
+		src, imm := uint(ARM_REG_SP), 1
+		if len(ops) == 3 {
+			src, imm = armReg(arm, 1), 2
+		}
+		if s.sp(dst) && s.fp(src) {
+			return frameRestore, true
+		}
+		if s.fp(dst) && s.sp(src) {
+			return StackEffect{Kind: StackFrameSetup}, true
+		}
+		n, ok := armImm(arm, imm)
+		if !ok || !s.sp(dst) || !s.sp(src) {
+			return StackEffect{}, false
+		}
+		if insn.Id == ARM_INS_SUB {
+			return stackKnown(-int64(n))
+		}
+		return stackKnown(int64(n))
+	case ARM_INS_MOV:
+		src := armReg(arm, 1)
+		if s.sp(dst) && s.fp(src) {
+			return frameRestore, true
+		}
+		if s.fp(dst) && s.sp(src) {
+			return StackEffect{Kind: StackFrameSetup}, true
+		}
+	}
+	return StackEffect{}, false
+}
+
+// ARM64
+
+func (insn *Instruction) arm64StackDelta(s *stackRegs) (StackEffect, bool) {
+	a64 := insn.Arm64
+	ops := a64.Operands
+	reg := func(idx int) uint {
+		if idx >= len(ops) || ops[idx].Type != ARM64_OP_REG {
+			return ARM64_REG_INVALID
+		}
+		return ops[idx].Reg
+	}
+
+	// stp x29, x30, [sp, #-16]! / ldp x29, x30, [sp], #16
+	for i, op := range ops {
+		if op.Type != ARM64_OP_MEM || !a64.Writeback || !s.sp(op.Mem.Base) {
+			continue
+		}
+		if i+1 < len(ops) {
+			if ops[i+1].Type != ARM64_OP_IMM {
+				return stackUnknown, true
+			}
+			return stackKnown(ops[i+1].Imm)
+		}
+		return stackKnown(int64(op.Mem.Disp))
+	}
+
+	dst, src := reg(0), reg(1)
+	switch insn.Id {
+	case ARM64_INS_ADD, ARM64_INS_SUB:
+		if s.sp(dst) && s.fp(src) {
+			return frameRestore, true
+		}
+		if s.fp(dst) && s.sp(src) {
+			return StackEffect{Kind: StackFrameSetup}, true
+		}
+		if len(ops) != 3 || ops[2].Type != ARM64_OP_IMM || !s.sp(dst) || !s.sp(src) {
+			return StackEffect{}, false
+		}
+		n := ops[2].Imm
+		if ops[2].Shift.Type == ARM64_SFT_LSL {
+			n <<= ops[2].Shift.Value
+		}
+		if insn.Id == ARM64_INS_SUB {
+			return stackKnown(-n)
+		}
+		return stackKnown(n)
+	case ARM64_INS_MOV:
+		if s.sp(dst) && s.fp(src) {
+			return frameRestore, true
+		}
+		if s.fp(dst) && s.sp(src) {
+			return StackEffect{Kind: StackFrameSetup}, true
+		}
+	}
+	return StackEffect{}, false
+}
+
+// MIPS
+
+func (insn *Instruction) mipsStackDelta(s *stackRegs) (StackEffect, bool) {
+	ops := insn.Mips.Operands
+	reg := func(idx int) uint {
+		if idx >= len(ops) || ops[idx].Type != MIPS_OP_REG {
+			return MIPS_REG_INVALID
+		}
+		return ops[idx].Reg
+	}
+	dst, src := reg(0), reg(1)
+
+	switch insn.Id {
+	case MIPS_INS_ADDIU, MIPS_INS_ADDI, MIPS_INS_DADDIU, MIPS_INS_DADDI:
+		if len(ops) != 3 || ops[2].Type != MIPS_OP_IMM || !s.sp(dst) {
+			return StackEffect{}, false
+		}
+		if s.fp(src) {
+			return frameRestore, true
+		}
+		if s.sp(src) {
+			return stackKnown(ops[2].Imm)
+		}
+	case MIPS_INS_MOVE, MIPS_INS_ADDU, MIPS_INS_DADDU, MIPS_INS_OR:
+		// move is addu / or with $zero
+		if len(ops) == 3 {
+			if reg(2) != MIPS_REG_ZERO {
+				return StackEffect{}, false
+			}
+		}
+		if s.sp(dst) && s.fp(src) {
+			return frameRestore, true
+		}
+		if s.fp(dst) && s.sp(src) {
+			return StackEffect{Kind: StackFrameSetup}, true
+		}
+	}
+	return StackEffect{}, false
+}
+
+// PPC
+
+func (insn *Instruction) ppcStackDelta(s *stackRegs) (StackEffect, bool) {
+	ops := insn.PPC.Operands
+	reg := func(idx int) uint {
+		if idx >= len(ops) || ops[idx].Type != PPC_OP_REG {
+			return PPC_REG_INVALID
+		}
+		return ops[idx].Reg
+	}
+	dst := reg(0)
+
+	switch insn.Id {
+	case PPC_INS_STWU, PPC_INS_STDU:
+		// stwu r1, -16(r1) stores the back chain and allocates the frame
+		if len(ops) == 2 && ops[1].Type == PPC_OP_MEM && s.sp(ops[1].Mem.Base) {
+			return stackKnown(int64(ops[1].Mem.Disp))
+		}
+	case PPC_INS_LWZ, PPC_INS_LD:
+		if len(ops) == 2 && s.sp(dst) && ops[1].Type == PPC_OP_MEM && s.sp(ops[1].Mem.Base) {
+			return frameRestore, true
+		}
+	case PPC_INS_ADDI:
+		if len(ops) != 3 || ops[2].Type != PPC_OP_IMM || !s.sp(dst) {
+			return StackEffect{}, false
+		}
+		if s.fp(reg(1)) {
+			return frameRestore, true
+		}
+		if s.sp(reg(1)) {
+			return stackKnown(int64(ops[2].Imm))
+		}
+	case PPC_INS_MR:
+		if s.sp(dst) && s.fp(reg(1)) {
+			return frameRestore, true
+		}
+		if s.fp(dst) && s.sp(reg(1)) {
+			return StackEffect{Kind: StackFrameSetup}, true
+		}
+	}
+	return StackEffect{}, false
+}
+
+// SPARC
+
+func (insn *Instruction) sparcStackDelta(s *stackRegs) (StackEffect, bool) {
+	ops := insn.Sparc.Operands
+	// rs1, rs2 / simm13, rd
+	form := len(ops) == 3 && ops[0].Type == SPARC_OP_REG && s.sp(ops[0].Reg) &&
+		ops[1].Type == SPARC_OP_IMM && ops[2].Type == SPARC_OP_REG && s.sp(ops[2].Reg)
+
+	switch insn.Id {
+	case SPARC_INS_SAVE:
+		// The new window's %sp
+		if form {
+			return StackEffect{Kind: StackFrameSetup, Delta: int64(ops[1].Imm)}, true
+		}
+		return stackUnknown, true
+	case SPARC_INS_RESTORE:
+		return frameRestore, true
+	case SPARC_INS_ADD:
+		if form {
+			return stackKnown(int64(ops[1].Imm))
+		}
+	case SPARC_INS_SUB:
+		if form {
+			return stackKnown(-int64(ops[1].Imm))
+		}
+	}
+	return StackEffect{}, false
+}
+
+// SYSZ
+
+func (insn *Instruction) syszStackDelta(s *stackRegs) (StackEffect, bool) {
+	ops := insn.SysZ.Operands
+	reg := func(idx int) uint {
+		if idx >= len(ops) || ops[idx].Type != SYSZ_OP_REG {
+			return SYSZ_REG_INVALID
+		}
+		return ops[idx].Reg
+	}
+	dst := reg(0)
+
+	switch insn.Id {
+	case SYSZ_INS_AGHI, SYSZ_INS_AGFI, SYSZ_INS_AHI:
+		if len(ops) == 2 && s.sp(dst) && ops[1].Type == SYSZ_OP_IMM {
+			return stackKnown(ops[1].Imm)
+		}
+	case SYSZ_INS_LA, SYSZ_INS_LAY:
+		if len(ops) != 2 || !s.sp(dst) || ops[1].Type != SYSZ_OP_MEM {
+			return StackEffect{}, false
+		}
+		mem := ops[1].Mem
+		if uint(mem.Index) != SYSZ_REG_INVALID {
+			return stackUnknown, true
+		}
+		if s.fp(uint(mem.Base)) {
+			return frameRestore, true
+		}
+		if s.sp(uint(mem.Base)) {
+			return stackKnown(mem.Disp)
+		}
+	case SYSZ_INS_LGR:
+		if s.sp(dst) && s.fp(reg(1)) {
+			return frameRestore, true
+		}
+		if s.fp(dst) && s.sp(reg(1)) {
+			return StackEffect{Kind: StackFrameSetup}, true
+		}
+	case SYSZ_INS_LMG:
+		// lmg %r6, %r15, 48(%r15) - the epilogue reloads the caller's %r15
+		// and the range wraps from %r15 to %r0
+		if len(ops) == 3 && dst != SYSZ_REG_INVALID && reg(1) != SYSZ_REG_INVALID {
+			first, last := syszRegNum(dst), syszRegNum(reg(1))
+			if (15-first+16)%16 <= (last-first+16)%16 {
+				return frameRestore, true
+			}
+		}
+	}
+	return StackEffect{}, false
+}
+
+// XCORE
+
+func (insn *Instruction) xcoreStackDelta(s *stackRegs) (StackEffect, bool) {
+	ops := insn.Xcore.Operands
+	if len(ops) != 1 || ops[0].Type != XCORE_OP_IMM {
+		return StackEffect{}, false
+	}
+	// Sizes are in words
+	n := 4 * int64(ops[0].Imm)
+	switch insn.Id {
+	case XCORE_INS_ENTSP, XCORE_INS_EXTSP:
+		return stackKnown(-n)
+	case XCORE_INS_RETSP:
+		return stackKnown(n)
+	}
+	return StackEffect{}, false
+}
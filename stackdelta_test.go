@@ -0,0 +1,70 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+var stackDeltaTests = []struct {
+	arch int
+	mode uint
+	code string
+	insn string
+	want StackEffect
+}{
+	{CS_ARCH_X86, CS_MODE_64, "\x55", "push rbp", StackEffect{StackKnown, -8}},
+	{CS_ARCH_X86, CS_MODE_32, "\x6a\x10", "push 0x10", StackEffect{StackKnown, -4}},
+	{CS_ARCH_X86, CS_MODE_32, "\x66\x58", "pop ax", StackEffect{StackKnown, 2}},
+	{CS_ARCH_X86, CS_MODE_64, "\xff\xd0", "call rax", StackEffect{StackKnown, -8}},
+	{CS_ARCH_X86, CS_MODE_64, "\xc2\x0c\x00", "ret 0xc", StackEffect{StackKnown, 16}},
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x83\xec\x28", "sub rsp, 0x28", StackEffect{StackKnown, -0x28}},
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x83\xe4\xf0", "and rsp, 0xfffffffffffffff0", StackEffect{StackUnknown, 0}},
+	{CS_ARCH_X86, CS_MODE_64, "\x48\x89\xe5", "mov rbp, rsp", StackEffect{StackFrameSetup, 0}},
+	{CS_ARCH_X86, CS_MODE_32, "\x89\xec", "mov esp, ebp", StackEffect{StackFrameRestore, 0}},
+	{CS_ARCH_X86, CS_MODE_64, "\xc8\x20\x00\x00", "enter 0x20, 0", StackEffect{StackFrameSetup, -0x28}},
+	{CS_ARCH_X86, CS_MODE_64, "\xc9", "leave", StackEffect{StackFrameRestore, 0}},
+	{CS_ARCH_X86, CS_MODE_32, "\x01\xd8", "add eax, ebx", StackEffect{StackKnown, 0}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x30\x48\x2d\xe9", "push {r4, r5, r11, lr}", StackEffect{StackKnown, -16}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x10\x80\xbd\xe8", "pop {r4, pc}", StackEffect{StackKnown, 8}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x04\xf0\x9d\xe4", "ldr pc, [sp], #4", StackEffect{StackKnown, 4}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x10\xd0\x4d\xe2", "sub sp, sp, #16", StackEffect{StackKnown, -16}},
+	{CS_ARCH_ARM, CS_MODE_ARM, "\x00\x70\x8d\xe2", "add r7, sp, #0", StackEffect{StackFrameSetup, 0}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\xfd\x7b\xbf\xa9", "stp x29, x30, [sp, #-0x10]!", StackEffect{StackKnown, -16}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\xfd\x7b\xc1\xa8", "ldp x29, x30, [sp], #0x10", StackEffect{StackKnown, 16}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\xff\x07\x40\xd1", "sub sp, sp, #1, lsl #12", StackEffect{StackKnown, -4096}},
+	{CS_ARCH_ARM64, CS_MODE_ARM, "\xbf\x03\x00\x91", "mov sp, x29", StackEffect{StackFrameRestore, 0}},
+	{CS_ARCH_MIPS, CS_MODE_MIPS32 | CS_MODE_BIG_ENDIAN, "\x27\xbd\xff\xe0", "addiu $sp, $sp, -0x20", StackEffect{StackKnown, -32}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x94\x21\xff\xf0", "stwu r1, -16(r1)", StackEffect{StackKnown, -16}},
+	{CS_ARCH_PPC, CS_MODE_BIG_ENDIAN, "\x80\x21\x00\x00", "lwz r1, 0(r1)", StackEffect{StackFrameRestore, 0}},
+	{CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, "\x9d\xe3\xbf\xa0", "save %sp, -96, %sp", StackEffect{StackFrameSetup, -96}},
+	{CS_ARCH_SPARC, CS_MODE_BIG_ENDIAN, "\x81\xe8\x00\x00", "restore", StackEffect{StackFrameRestore, 0}},
+	{CS_ARCH_SYSZ, CS_MODE_BIG_ENDIAN, "\xeb\x6f\xf0\xd0\x00\x04", "lmg %r6, %r15, 208(%r15)", StackEffect{StackFrameRestore, 0}},
+}
+
+func TestStackDelta(t *testing.T) {
+	for i, test := range stackDeltaTests {
+		got, err := disasmOne(t, test.arch, test.mode, test.code).StackDelta()
+		if err != nil {
+			t.Errorf("%2d> %s: %v", i, test.insn, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%2d> %s: want %v got %v", i, test.insn, test.want, got)
+		}
+	}
+
+	insn := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_PUSH, 0x1000, "\x55")
+	if _, err := insn.StackDelta(); err != ErrDetail {
+		t.Errorf("Want ErrDetail got %v", err)
+	}
+	if got := StackEffectKind(99).String(); got != "invalid" {
+		t.Errorf("Want invalid got %v", got)
+	}
+}
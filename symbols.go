@@ -0,0 +1,231 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Maps between addresses and names, for symbolized output. The elf, pe and
+// macho subpackages build these from binaries, and symfile from nm output
+// and linker maps.
+type SymbolTable interface {
+	// The symbol containing addr, and addr's offset into it. ok is false if
+	// no symbol covers addr.
+	Lookup(addr uint64) (name string, offset uint64, ok bool)
+	// The address of a symbol by name
+	Address(name string) (addr uint64, ok bool)
+}
+
+// A named address. A Size of 0 means unknown.
+type Symbol struct {
+	Name string
+	Addr uint64
+	Size uint64
+}
+
+// A SymbolTable backed by a sorted slice. A symbol with a Size covers
+// [Addr, Addr+Size). One without covers everything up to the next symbol,
+// like objdump's <name+0x10>. Where several symbols share an address, the
+// first added wins.
+type SymbolMap struct {
+	syms   []Symbol
+	sorted bool
+	byName map[string]uint64
+}
+
+// Create a SymbolMap holding syms
+func NewSymbolMap(syms []Symbol) *SymbolMap {
+	m := &SymbolMap{byName: make(map[string]uint64)}
+	m.Add(syms...)
+	m.sort()
+	return m
+}
+
+// Add symbols. Empty names are ignored, and a name already present keeps its
+// first address. The symbols are sorted in on the next Lookup or Symbols, so
+// build the map with NewSymbolMap rather than adding to one shared between
+// goroutines.
+func (m *SymbolMap) Add(syms ...Symbol) {
+	for _, sym := range syms {
+		if sym.Name == "" {
+			continue
+		}
+		if _, ok := m.byName[sym.Name]; !ok {
+			m.byName[sym.Name] = sym.Addr
+		}
+		m.syms = append(m.syms, sym)
+		m.sorted = false
+	}
+}
+
+// Sort symbols added since the last sort. Stable, so the first added still
+// wins at a shared address.
+func (m *SymbolMap) sort() {
+	if !m.sorted {
+		sort.Stable(symbolsByAddr(m.syms))
+		m.sorted = true
+	}
+}
+
+// The symbols, sorted by address
+func (m *SymbolMap) Symbols() []Symbol {
+	m.sort()
+	return m.syms
+}
+
+func (m *SymbolMap) Lookup(addr uint64) (string, uint64, bool) {
+	m.sort()
+	// First symbol above addr, then walk back over those at the same address
+	i := sort.Search(len(m.syms), func(i int) bool { return m.syms[i].Addr > addr })
+	if i == 0 {
+		return "", 0, false
+	}
+	at := m.syms[i-1].Addr
+	for i > 1 && m.syms[i-2].Addr == at {
+		i--
+	}
+	// Prefer a sized symbol which covers addr, then an unsized one
+	var found *Symbol
+	for j := i - 1; j < len(m.syms) && m.syms[j].Addr == at; j++ {
+		s := &m.syms[j]
+		if s.Size != 0 && addr < s.Addr+s.Size {
+			found = s
+			break
+		}
+		if s.Size == 0 && found == nil {
+			found = s
+		}
+	}
+	if found == nil {
+		return "", 0, false
+	}
+	return found.Name, addr - found.Addr, true
+}
+
+func (m *SymbolMap) Address(name string) (uint64, bool) {
+	addr, ok := m.byName[name]
+	return addr, ok
+}
+
+type symbolsByAddr []Symbol
+
+func (s symbolsByAddr) Len() int           { return len(s) }
+func (s symbolsByAddr) Less(i, j int) bool { return s[i].Addr < s[j].Addr }
+func (s symbolsByAddr) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// name, or name+0x10
+func symbolText(table SymbolTable, addr uint64) (string, bool) {
+	name, off, ok := table.Lookup(addr)
+	if !ok {
+		return "", false
+	}
+	if off != 0 {
+		return fmt.Sprintf("%s+%#x", name, off), true
+	}
+	return name, true
+}
+
+// The OpStr with the addresses from References() replaced by symbol names,
+// eg "printf@plt" for call 0x401030, or "[rip + .LC0]" for a RIP-relative
+// operand (".LC0(%rip)" in AT&T syntax). Addresses that don't appear in the
+// text, like ARM [pc, #8] literal loads, are added as a trailing comment:
+// "r0, [pc, #8] ; counter". Addresses without a symbol are left alone.
+func (insn Instruction) SymbolicOpStr(table SymbolTable) string {
+	op := insn.OpStr
+	var comments []string
+	done := map[uint64]bool{}
+	for _, ref := range insn.References() {
+		if done[ref.Address] {
+			continue
+		}
+		done[ref.Address] = true
+		name, ok := symbolText(table, ref.Address)
+		if !ok {
+			continue
+		}
+		if s, ok := replaceNumber(op, ref.Address, name); ok {
+			op = s
+			continue
+		}
+		if s, ok := insn.replaceRIP(op, ref, name); ok {
+			op = s
+			continue
+		}
+		comments = append(comments, name)
+	}
+	if len(comments) > 0 {
+		if op != "" {
+			op += " "
+		}
+		op += "; " + strings.Join(comments, ", ")
+	}
+	return op
+}
+
+// Replace the hex text of v, with an optional '#' or '$' prefix, when it
+// isn't part of a longer number
+func replaceNumber(s string, v uint64, name string) (string, bool) {
+	text := fmt.Sprintf("%#x", v)
+	for from := 0; ; {
+		i := strings.Index(s[from:], text)
+		if i < 0 {
+			return s, false
+		}
+		i += from
+		end := i + len(text)
+		if (i == 0 || !isWordByte(s[i-1])) && (end == len(s) || !isWordByte(s[end])) {
+			if i > 0 && (s[i-1] == '#' || s[i-1] == '$') {
+				i--
+			}
+			return s[:i] + name + s[end:], true
+		}
+		from = end
+	}
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// Replace the displacement of an x86 RIP-relative memory operand
+func (insn *Instruction) replaceRIP(s string, ref Reference, name string) (string, bool) {
+	if insn.X86 == nil || ref.Operand < 0 || ref.Operand >= len(insn.X86.Operands) {
+		return s, false
+	}
+	op := insn.X86.Operands[ref.Operand]
+	if op.Type != X86_OP_MEM || (op.Mem.Base != X86_REG_RIP && op.Mem.Base != X86_REG_EIP) {
+		return s, false
+	}
+	disp := op.Mem.Disp
+	sign, mag := "+", uint64(disp)
+	if disp < 0 {
+		sign, mag = "-", uint64(-disp)
+	}
+	for _, reg := range []string{"rip", "eip"} {
+		// Intel
+		intel := fmt.Sprintf("%s %s %#x]", reg, sign, mag)
+		if strings.Contains(s, intel) {
+			return strings.Replace(s, intel, reg+" + "+name+"]", 1), true
+		}
+		// AT&T
+		att := fmt.Sprintf("%#x(%%%s)", disp, reg)
+		if disp < 0 {
+			att = fmt.Sprintf("-%#x(%%%s)", mag, reg)
+		}
+		if strings.Contains(s, att) {
+			return strings.Replace(s, att, name+"(%"+reg+")", 1), true
+		}
+	}
+	return s, false
+}
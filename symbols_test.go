@@ -0,0 +1,134 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+func testSymbols() *SymbolMap {
+	return NewSymbolMap([]Symbol{
+		{Name: "main", Addr: 0x1000, Size: 0x40},
+		{Name: "printf@plt", Addr: 0x2000},
+		{Name: ".LC0", Addr: 0x3000},
+		{Name: "counter", Addr: 0x4000, Size: 4},
+		{Name: "counter_alias", Addr: 0x4000, Size: 4},
+		{Name: "main", Addr: 0x9000},
+	})
+}
+
+func TestSymbolMap(t *testing.T) {
+	m := testSymbols()
+	tests := []struct {
+		addr uint64
+		name string
+		off  uint64
+		ok   bool
+	}{
+		{0xfff, "", 0, false},
+		{0x1000, "main", 0, true},
+		{0x1010, "main", 0x10, true},
+		{0x1040, "", 0, false}, // past the end of main
+		{0x2008, "printf@plt", 8, true},
+		{0x4000, "counter", 0, true},
+		{0x4004, "", 0, false},
+		{0x9000, "main", 0, true},
+	}
+	for i, test := range tests {
+		name, off, ok := m.Lookup(test.addr)
+		if name != test.name || off != test.off || ok != test.ok {
+			t.Errorf("%2d> %#x: want %s+%#x (%v) got %s+%#x (%v)", i, test.addr, test.name, test.off, test.ok, name, off, ok)
+		}
+	}
+	if addr, ok := m.Address("main"); !ok || addr != 0x1000 {
+		t.Errorf("main: want 0x1000 got %#x (%v)", addr, ok)
+	}
+	if _, ok := m.Address("nope"); ok {
+		t.Errorf("nope: found")
+	}
+	if n := len(m.Symbols()); n != 6 {
+		t.Errorf("want 6 symbols got %d", n)
+	}
+
+	// Added after a Lookup, below the existing symbols and at a shared address
+	m.Add(Symbol{Name: "_start", Addr: 0x800}, Symbol{Name: "counter_late", Addr: 0x4000, Size: 4})
+	if name, _, ok := m.Lookup(0x800); !ok || name != "_start" {
+		t.Errorf("_start: got %s (%v)", name, ok)
+	}
+	if name, _, _ := m.Lookup(0x4000); name != "counter" {
+		t.Errorf("0x4000: want counter got %s", name)
+	}
+	if syms := m.Symbols(); len(syms) != 8 || syms[0].Name != "_start" {
+		t.Errorf("want 8 symbols from _start got %v", syms)
+	}
+}
+
+func TestSymbolicOpStr(t *testing.T) {
+	m := testSymbols()
+
+	// call 0x2000, without detail
+	call := bareInsn(CS_ARCH_X86, CS_MODE_32, X86_INS_CALL, 0x1000, "\xe8\xfb\x0f\x00\x00")
+	call.OpStr = "0x2000"
+
+	// lea rdi, [rip + 0x1ff9]
+	lea := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_LEA, 0x1000, "\x48\x8d\x3d\xf9\x1f\x00\x00")
+	lea.X86 = &X86Instruction{Operands: []X86Operand{
+		{Type: X86_OP_REG, Reg: X86_REG_RDI},
+		{Type: X86_OP_MEM, Mem: X86MemoryOperand{Base: X86_REG_RIP, Scale: 1, Disp: 0x1ff9}},
+	}}
+	lea.OpStr = "rdi, [rip + 0x1ff9]"
+	att := lea
+	att.OpStr = "0x1ff9(%rip), %rdi"
+
+	// mov eax, dword ptr [rip + 0x2ffa] - the end of main, no symbol
+	mov := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_MOV, 0x1000, "\x8b\x05\x3a\x00\x00\x00")
+	mov.X86 = &X86Instruction{Operands: []X86Operand{
+		{Type: X86_OP_REG, Reg: X86_REG_EAX},
+		{Type: X86_OP_MEM, Mem: X86MemoryOperand{Base: X86_REG_RIP, Scale: 1, Disp: 0x3a}},
+	}}
+	mov.OpStr = "eax, dword ptr [rip + 0x3a]"
+
+	// ldr r0, [pc, #8] (Thumb) -> 0x100c, main+0xc
+	ldr := bareInsn(CS_ARCH_ARM, CS_MODE_THUMB, ARM_INS_LDR, 0x1002, "\x02\x48")
+	ldr.Arm = &ArmInstruction{CC: ARM_CC_AL, Operands: []ArmOperand{
+		{Type: ARM_OP_REG, Reg: ARM_REG_R0},
+		{Type: ARM_OP_MEM, Mem: ArmMemoryOperand{Base: ARM_REG_PC, Scale: 1, Disp: 8}},
+	}}
+	ldr.OpStr = "r0, [pc, #8]"
+
+	// adrp x0, 0x4000
+	adrp := bareInsn(CS_ARCH_ARM64, CS_MODE_ARM, ARM64_INS_ADRP, 0x1000, "\x20\x00\x00\x90")
+	adrp.Arm64 = &Arm64Instruction{Operands: []Arm64Operand{
+		{Type: ARM64_OP_REG, Reg: ARM64_REG_X0},
+		{Type: ARM64_OP_IMM, Imm: 0x4000},
+	}}
+	adrp.OpStr = "x0, #0x4000"
+
+	// A number which only starts with the address text
+	long := call
+	long.OpStr = "0x20001"
+
+	tests := []struct {
+		insn Instruction
+		want string
+	}{
+		{call, "printf@plt"},
+		{lea, "rdi, [rip + .LC0]"},
+		{att, ".LC0(%rip), %rdi"},
+		{mov, "eax, dword ptr [rip + 0x3a]"},
+		{ldr, "r0, [pc, #8] ; main+0xc"},
+		{adrp, "x0, counter"},
+		{long, "0x20001 ; printf@plt"},
+	}
+	for i, test := range tests {
+		if got := test.insn.SymbolicOpStr(m); got != test.want {
+			t.Errorf("%2d> %s: want %q got %q", i, test.insn.OpStr, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package symfile
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+// Output sections which aren't loaded, and sit at address 0
+var gnuNonAlloc = []string{".debug", ".stab", ".comment", ".ARM.attributes", ".gnu.attributes", ".GCC.command.line"}
+
+// An input section and the symbols listed under it
+type gnuInput struct {
+	addr, size uint64
+	syms       []Symbol
+}
+
+// Parse a GNU ld map, from -Map or --print-map. Only the "Linker script and
+// memory map" part is read. Output sections become Sections. Symbols are
+// sized from the input section they are listed under: up to the next symbol,
+// or the end of the input section, which is exact with -ffunction-sections
+// and -fdata-sections. Symbols from linker script assignments, like
+// _sdata = ., have no size. Names split onto two lines by ld are handled.
+func ParseGNUMap(r io.Reader) (*Map, error) {
+	m := &Map{}
+	var (
+		started bool
+		output  string // current output section
+		pending string // a name on a line of its own, wrapped by ld
+		input   *gnuInput
+	)
+	flush := func() {
+		if input != nil {
+			m.symbols = append(m.symbols, sizeSymbols(input.syms, input.addr+input.size)...)
+			input = nil
+		}
+	}
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), " \t\r")
+		if !started {
+			started = strings.HasPrefix(line, "Linker script and memory map")
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		f := strings.Fields(line)
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+
+		// The tail of a wrapped section line, "0x08000188 0x40 main.o"
+		if pending != "" {
+			name, out := pending, pendingOutput(pending)
+			pending = ""
+			if addr, size, ok := addrSize(f); ok {
+				flush()
+				name = strings.TrimSpace(name)
+				if out {
+					output = m.addOutput(name, addr, size)
+				} else if name != "*fill*" {
+					input = &gnuInput{addr: addr, size: size}
+				}
+				continue
+			}
+		}
+
+		switch {
+		case len(f) == 1 && indent <= 1 && !strings.HasPrefix(f[0], "*"):
+			// Keep the indent, to tell output and input sections apart
+			pending = line
+		case indent == 0 && len(f) >= 3:
+			if addr, size, ok := addrSize(f[1:]); ok {
+				flush()
+				output = m.addOutput(f[0], addr, size)
+			}
+		case indent == 1 && len(f) >= 3:
+			if addr, size, ok := addrSize(f[1:]); ok {
+				flush()
+				if f[0] != "*fill*" {
+					input = &gnuInput{addr: addr, size: size}
+				}
+			}
+		case indent > 1 && len(f) >= 2 && strings.HasPrefix(f[0], "0x"):
+			addr, ok := parseHex(f[0])
+			if !ok || strings.HasPrefix(f[1], "0x") {
+				continue
+			}
+			rest := strings.TrimSpace(line[strings.Index(line, f[0])+len(f[0]):])
+			if i := strings.Index(rest, "="); i >= 0 {
+				// An assignment, "_sdata = ."
+				name := strings.TrimSpace(rest[:i])
+				if isIdent(name) {
+					m.symbols = append(m.symbols, Symbol{gapstone.Symbol{Name: name, Addr: addr}, output})
+				}
+				continue
+			}
+			if input != nil && isIdent(rest) {
+				input.syms = append(input.syms, Symbol{gapstone.Symbol{Name: rest, Addr: addr}, output})
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	if !started {
+		return nil, ErrUnknownFormat
+	}
+	m.finish()
+	return m, nil
+}
+
+// A wrapped name at column 0 is an output section, at column 1 an input one
+func pendingOutput(line string) bool {
+	return line[0] != ' ' && line[0] != '\t'
+}
+
+// Add an output section, unless it isn't loaded. Returns the current output
+// section name.
+func (m *Map) addOutput(name string, addr, size uint64) string {
+	if addr == 0 {
+		for _, prefix := range gnuNonAlloc {
+			if strings.HasPrefix(name, prefix) {
+				return ""
+			}
+		}
+	}
+	if size != 0 {
+		m.sections = append(m.sections, Section{name, addr, size})
+	}
+	return name
+}
+
+// "0x08000188 0x40 ..."
+func addrSize(f []string) (addr, size uint64, ok bool) {
+	if len(f) < 2 || !strings.HasPrefix(f[0], "0x") || !strings.HasPrefix(f[1], "0x") {
+		return 0, 0, false
+	}
+	addr, ok1 := parseHex(f[0])
+	size, ok2 := parseHex(f[1])
+	return addr, size, ok1 && ok2
+}
+
+// Size each symbol up to the next one at a higher address, or end
+func sizeSymbols(syms []Symbol, end uint64) []Symbol {
+	for i := range syms {
+		next := end
+		for _, s := range syms {
+			if s.Addr > syms[i].Addr && s.Addr < next {
+				next = s.Addr
+			}
+		}
+		if next > syms[i].Addr {
+			syms[i].Size = next - syms[i].Addr
+		}
+	}
+	return syms
+}
+
+// C identifiers, plus the '.' and '$' in compiler generated names
+func isIdent(s string) bool {
+	if s == "" || s == "." || s[0] >= '0' && s[0] <= '9' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c == '_' || c == '.' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package symfile
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+// Parse an IAR ILINK map, from --map. Symbols come from the ENTRY LIST and
+// sections from the PLACEMENT SUMMARY, where runs of a section from several
+// objects are merged, so ".text" covers all the contiguous .text. Code
+// entries for Thumb have bit 0 cleared. Both 0x0800'0321 and 0x08000321
+// address styles are accepted.
+func ParseIARMap(r io.Reader) (*Map, error) {
+	m := &Map{}
+	var (
+		part    string // the *** heading
+		pending string // a long entry name, on a line of its own
+		found   bool
+	)
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(line, "***") {
+			if h := strings.TrimSpace(strings.Trim(line, "*")); h != "" {
+				part = h
+				found = found || part == "ENTRY LIST" || part == "PLACEMENT SUMMARY"
+			}
+			continue
+		}
+		f := strings.Fields(line)
+		switch part {
+		case "PLACEMENT SUMMARY":
+			// .text  ro code  0x0800'0040  0x2e0  foo.o [1]
+			k := hexPair(f)
+			if k < 1 || f[0] == "-" || strings.HasPrefix(f[0], "\"") {
+				continue
+			}
+			addr, _ := parseHex(f[k])
+			size, _ := parseHex(f[k+1])
+			m.addMerged(f[0], addr, size)
+
+		case "ENTRY LIST":
+			// main  0x0800'0321  0x24  Code  Gb  main.o [1]
+			if len(f) == 1 {
+				pending = f[0]
+				continue
+			}
+			name := pending
+			pending = ""
+			switch {
+			case name != "" && isAddr(f[0]):
+				// The rest of a long entry
+			case len(f) >= 3 && isAddr(f[1]):
+				name, f = f[0], f[1:]
+			default:
+				continue
+			}
+			addr, _ := parseHex(f[0])
+			sym := Symbol{Symbol: gapstone.Symbol{Name: name, Addr: addr}}
+			if size, ok := parseHex(f[1]); ok && strings.HasPrefix(f[1], "0x") {
+				sym.Size = size
+			}
+			if len(f) >= 3 && f[2] == "Code" {
+				sym.Addr &^= 1
+			}
+			m.symbols = append(m.symbols, sym)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrUnknownFormat
+	}
+	m.finish()
+	return m, nil
+}
+
+// The index of the first field holding an address followed by a size, or -1
+func hexPair(f []string) int {
+	for k := 0; k+1 < len(f); k++ {
+		if isAddr(f[k]) && isAddr(f[k+1]) {
+			return k
+		}
+	}
+	return -1
+}
+
+// Add a section, or grow the last one if it has the same name and this one
+// starts inside or right after it
+func (m *Map) addMerged(name string, addr, size uint64) {
+	if n := len(m.sections); n > 0 {
+		last := &m.sections[n-1]
+		if last.Name == name && addr >= last.Addr && addr <= last.Addr+last.Size {
+			if end := addr + size; end > last.Addr+last.Size {
+				last.Size = end - last.Addr
+			}
+			return
+		}
+	}
+	if size != 0 {
+		m.sections = append(m.sections, Section{name, addr, size})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package symfile
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+// Parse a Keil MDK / ARM Compiler armlink map, from --map --symbols. Symbols
+// come from the Image Symbol Table, local and global, skipping the Number and
+// Section entries. Thumb Code symbols have bit 0 cleared. The execution
+// regions, eg ER_IROM1, become Sections, since armlink doesn't list output
+// sections.
+func ParseKeilMap(r io.Reader) (*Map, error) {
+	m := &Map{}
+	found, symbols := false, false
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(line, "Execution Region ") {
+			if s, ok := keilRegion(line); ok {
+				m.sections = append(m.sections, s)
+				found = true
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "Image Symbol Table") {
+			found, symbols = true, true
+			continue
+		}
+		if strings.HasPrefix(line, "Memory Map of the image") {
+			symbols = false
+		}
+		if !symbols {
+			continue
+		}
+
+		// SystemInit  0x08000185  Thumb Code  78  system_stm32f10x.o(i.SystemInit)
+		f := strings.Fields(line)
+		k := 1
+		for k < len(f) && !isAddr(f[k]) {
+			k++
+		}
+		if k+2 >= len(f) {
+			continue
+		}
+		n := k + 1
+		for n < len(f) && !isDecimal(f[n]) {
+			n++
+		}
+		if n == len(f) || n == k+1 {
+			continue
+		}
+		kind := strings.Join(f[k+1:n], " ")
+		addr, _ := parseHex(f[k])
+		size, _ := strconv.ParseUint(f[n], 10, 64)
+		switch kind {
+		case "Thumb Code":
+			addr &^= 1
+		case "ARM Code", "Data":
+		default:
+			continue
+		}
+		m.symbols = append(m.symbols, Symbol{Symbol: gapstone.Symbol{Name: strings.Join(f[:k], " "), Addr: addr, Size: size}})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrUnknownFormat
+	}
+	m.finish()
+	return m, nil
+}
+
+// "Execution Region ER_IROM1 (Exec base: 0x08000000, Load base: 0x08000000,
+// Size: 0x00000c14, Max: 0x00010000, ABSOLUTE)", or "(Base: ..." in older
+// versions
+func keilRegion(line string) (Section, bool) {
+	f := strings.Fields(line)
+	if len(f) < 3 {
+		return Section{}, false
+	}
+	s := Section{Name: f[2]}
+	var haveBase, haveSize bool
+	for i := 3; i+1 < len(f); i++ {
+		v, ok := parseHex(strings.TrimRight(f[i+1], ",)"))
+		if !ok {
+			continue
+		}
+		switch {
+		case f[i] == "base:" && f[i-1] == "(Exec", f[i] == "(Base:":
+			s.Addr, haveBase = v, true
+		case f[i] == "Size:":
+			s.Size, haveSize = v, true
+		}
+	}
+	return s, haveBase && haveSize
+}
+
+func isDecimal(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
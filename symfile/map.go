@@ -0,0 +1,184 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package symfile
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+// An output section, or a linker region for formats which don't list output
+// sections
+type Section struct {
+	Name string
+	Addr uint64
+	Size uint64
+}
+
+// A symbol and the section it was found in, which may be empty
+type Symbol struct {
+	gapstone.Symbol
+	Section string
+}
+
+// Sections and symbols from a linker map or an objdump listing. A Map is a
+// gapstone.SymbolTable. Addresses without a symbol resolve relative to their
+// section, eg ".bss+0x10".
+type Map struct {
+	sections []Section
+	symbols  []Symbol
+	table    *gapstone.SymbolMap
+}
+
+// The sections, sorted by address
+func (m *Map) Sections() []Section { return m.sections }
+
+// The symbols, sorted by address
+func (m *Map) Symbols() []Symbol { return m.symbols }
+
+// The section containing addr
+func (m *Map) SectionAt(addr uint64) (Section, bool) {
+	i := sort.Search(len(m.sections), func(i int) bool { return m.sections[i].Addr > addr })
+	// The innermost, where sections nest
+	for i--; i >= 0; i-- {
+		if s := m.sections[i]; addr < s.Addr+s.Size {
+			return s, true
+		}
+	}
+	return Section{}, false
+}
+
+func (m *Map) Lookup(addr uint64) (string, uint64, bool) {
+	name, off, ok := m.table.Lookup(addr)
+	s, inSection := m.SectionAt(addr)
+	// Don't let an unsized symbol run on into the next section
+	if ok && (!inSection || addr-off >= s.Addr) {
+		return name, off, true
+	}
+	if inSection {
+		return s.Name, addr - s.Addr, true
+	}
+	return "", 0, false
+}
+
+func (m *Map) Address(name string) (uint64, bool) {
+	if addr, ok := m.table.Address(name); ok {
+		return addr, true
+	}
+	for _, s := range m.sections {
+		if s.Name == name {
+			return s.Addr, true
+		}
+	}
+	return 0, false
+}
+
+// Move a section, and the symbols in it, to addr. Relocatable objects list
+// every section at 0 with section-relative symbols, so each section must be
+// placed before its addresses mean anything. Symbols are moved even when the
+// section itself isn't listed, as with objdump -t alone.
+func (m *Map) Rebase(section string, addr uint64) {
+	var from uint64
+	for i, s := range m.sections {
+		if s.Name == section {
+			from = s.Addr
+			m.sections[i].Addr = addr
+			break
+		}
+	}
+	for i := range m.symbols {
+		if m.symbols[i].Section == section {
+			m.symbols[i].Addr += addr - from
+		}
+	}
+	m.finish()
+}
+
+// Sort everything, fill in missing symbol sections and build the lookup
+// table
+func (m *Map) finish() {
+	sort.Stable(sectionsByAddr(m.sections))
+	sort.Stable(symbolsByAddr(m.symbols))
+	syms := make([]gapstone.Symbol, len(m.symbols))
+	for i, sym := range m.symbols {
+		if sym.Section == "" {
+			if s, ok := m.SectionAt(sym.Addr); ok {
+				m.symbols[i].Section = s.Name
+			}
+		}
+		syms[i] = m.symbols[i].Symbol
+	}
+	m.table = gapstone.NewSymbolMap(syms)
+}
+
+type sectionsByAddr []Section
+
+func (s sectionsByAddr) Len() int           { return len(s) }
+func (s sectionsByAddr) Less(i, j int) bool { return s[i].Addr < s[j].Addr }
+func (s sectionsByAddr) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type symbolsByAddr []Symbol
+
+func (s symbolsByAddr) Len() int           { return len(s) }
+func (s symbolsByAddr) Less(i, j int) bool { return s[i].Addr < s[j].Addr }
+func (s symbolsByAddr) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Parse a linker map from GNU ld, IAR ILINK or Keil / ARM armlink, picking the
+// format from the contents. ErrUnknownFormat means none of them matched.
+func ParseMap(r io.Reader) (*Map, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case bytes.Contains(data, []byte("Linker script and memory map")):
+		return ParseGNUMap(bytes.NewReader(data))
+	case bytes.Contains(data, []byte("*** ENTRY LIST")), bytes.Contains(data, []byte("IAR ELF Linker")):
+		return ParseIARMap(bytes.NewReader(data))
+	case bytes.Contains(data, []byte("Image Symbol Table")), bytes.Contains(data, []byte("Memory Map of the image")):
+		return ParseKeilMap(bytes.NewReader(data))
+	}
+	return nil, ErrUnknownFormat
+}
+
+// Parse the named linker map with ParseMap
+func LoadMap(name string) (*Map, error) {
+	fh, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	return ParseMap(fh)
+}
+
+// Hex numbers as printed by the linkers: 0x0800'0321, 0x08000321 or
+// 08000321
+func parseHex(s string) (uint64, bool) {
+	s = strings.Replace(s, "'", "", -1)
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	v, err := strconv.ParseUint(s, 16, 64)
+	return v, err == nil
+}
+
+// A hex number with the 0x prefix
+func isAddr(s string) bool {
+	_, ok := parseHex(s)
+	return ok && strings.HasPrefix(s, "0x")
+}
@@ -0,0 +1,268 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package symfile
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bnagy/gapstone"
+)
+
+const testGNUMap = `Archive member included to satisfy reference by file (symbol)
+
+Memory Configuration
+
+Name             Origin             Length             Attributes
+FLASH            0x0000000008000000 0x0000000000010000 xr
+*default*        0x0000000000000000 0xffffffffffffffff
+
+Linker script and memory map
+
+LOAD startup.o
+LOAD main.o
+                0x0000000020005000                _estack = 0x20005000
+
+.isr_vector     0x0000000008000000       0x40
+                0x0000000008000000                . = ALIGN (0x4)
+ *(.isr_vector)
+ .isr_vector    0x0000000008000000       0x40 startup.o
+                0x0000000008000000                g_pfnVectors
+
+.text           0x0000000008000040       0x60
+ *(.text)
+ .text          0x0000000008000040        0x0 main.o
+ .text.main     0x0000000008000040       0x24 main.o
+                0x0000000008000040                main
+ .text.Reset_Handler
+                0x0000000008000064       0x30 startup.o
+                0x0000000008000064                Reset_Handler
+                0x0000000008000074                LoopCopyDataInit
+ *fill*         0x0000000008000094        0xc
+                0x00000000080000a0                _etext = .
+
+.data           0x0000000020000000        0x8 load address 0x00000000080000a0
+                0x0000000020000000                _sdata = .
+ .data.counter  0x0000000020000000        0x4 main.o
+                0x0000000020000000                counter
+                0x0000000020000008                _edata = .
+
+.debug_info     0x0000000000000000      0x1f4
+ .debug_info    0x0000000000000000      0x1f4 main.o
+OUTPUT(firmware.elf elf32-littlearm)
+`
+
+const testIARMap = `###############################################################################
+#
+# IAR ELF Linker V8.50.6.265/W32 for ARM                  10/Oct/2026  10:00:00
+#
+###############################################################################
+
+*******************************************************************************
+*** PLACEMENT SUMMARY
+***
+
+  Section            Kind         Address    Size  Object
+  -------            ----         -------    ----  ------
+"A0":                                        0x40
+  .intvec            ro code  0x0800'0000    0x40  vector_table_M.o [4]
+                            - 0x0800'0040    0x40
+
+"P1":                                        0x60
+  .text              ro code  0x0800'0040    0x24  main.o [1]
+  .text              ro code  0x0800'0064    0x3c  startup.o [1]
+                            - 0x0800'00a0    0x60
+
+"P2":                                         0x4
+  .bss               zero     0x2000'0000     0x4  main.o [1]
+                            - 0x2000'0004     0x4
+
+*******************************************************************************
+*** ENTRY LIST
+***
+
+Entry                       Address   Size  Type      Object
+-----                       -------   ----  ----      ------
+.iar.init_table$$Base  0x0800'0090          --   Gb  - Linker created -
+__vector_table         0x0800'0000         Data  Gb  vector_table_M.o [4]
+a_very_long_function_name_that_wraps
+                       0x0800'0065   0x3c  Code  Gb  startup.o [1]
+counter                0x2000'0000    0x4  Data  Gb  main.o [1]
+main                   0x0800'0041   0x24  Code  Gb  main.o [1]
+
+[1] = C:\build\Obj
+`
+
+const testKeilMap = `Component: ARM Compiler 5.06 update 6 (build 750) Tool: armlink [4d35ed]
+
+==============================================================================
+
+Image Symbol Table
+
+    Local Symbols
+
+    Symbol Name                              Value     Ov Type        Size  Object(Section)
+
+    ../clib/microlib/init/entry.s            0x00000000   Number         0  entry.o ABSOLUTE
+    RESET                                    0x08000000   Section       64  startup.o(RESET)
+    .text                                    0x08000040   Section        0  main.o(.text)
+
+    Global Symbols
+
+    Symbol Name                              Value     Ov Type        Size  Object(Section)
+
+    __Vectors                                0x08000000   Data           4  startup.o(RESET)
+    main                                     0x08000041   Thumb Code    36  main.o(i.main)
+    Reset_Handler                            0x08000065   Thumb Code    60  startup.o(.text)
+    counter                                  0x20000000   Data           4  main.o(.data)
+
+==============================================================================
+
+Memory Map of the image
+
+  Image Entry point : 0x08000065
+
+  Load Region LR_IROM1 (Base: 0x08000000, Size: 0x000000a4, Max: 0x00010000, ABSOLUTE)
+
+    Execution Region ER_IROM1 (Exec base: 0x08000000, Load base: 0x08000000, Size: 0x000000a0, Max: 0x00010000, ABSOLUTE)
+
+    Exec Addr    Load Addr    Size         Type   Attr      Idx    E Section Name        Object
+
+    0x08000000   0x08000000   0x00000040   Data   RO            3    RESET               startup.o
+
+    Execution Region RW_IRAM1 (Base: 0x20000000, Size: 0x00000004, Max: 0x00005000, ABSOLUTE)
+`
+
+func sym(name string, addr, size uint64, section string) Symbol {
+	return Symbol{gapstone.Symbol{Name: name, Addr: addr, Size: size}, section}
+}
+
+func TestParseMaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		sections []Section
+		symbols  []Symbol
+	}{
+		{
+			"gnu", testGNUMap,
+			[]Section{
+				{".isr_vector", 0x08000000, 0x40},
+				{".text", 0x08000040, 0x60},
+				{".data", 0x20000000, 8},
+			},
+			[]Symbol{
+				sym("g_pfnVectors", 0x08000000, 0x40, ".isr_vector"),
+				sym("main", 0x08000040, 0x24, ".text"),
+				sym("Reset_Handler", 0x08000064, 0x10, ".text"),
+				sym("LoopCopyDataInit", 0x08000074, 0x20, ".text"),
+				sym("_etext", 0x080000a0, 0, ".text"),
+				sym("_sdata", 0x20000000, 0, ".data"),
+				sym("counter", 0x20000000, 4, ".data"),
+				sym("_edata", 0x20000008, 0, ".data"),
+				sym("_estack", 0x20005000, 0, ""),
+			},
+		},
+		{
+			"iar", testIARMap,
+			[]Section{
+				{".intvec", 0x08000000, 0x40},
+				{".text", 0x08000040, 0x60},
+				{".bss", 0x20000000, 4},
+			},
+			[]Symbol{
+				sym("__vector_table", 0x08000000, 0, ".intvec"),
+				sym("main", 0x08000040, 0x24, ".text"),
+				sym("a_very_long_function_name_that_wraps", 0x08000064, 0x3c, ".text"),
+				sym(".iar.init_table$$Base", 0x08000090, 0, ".text"),
+				sym("counter", 0x20000000, 4, ".bss"),
+			},
+		},
+		{
+			"keil", testKeilMap,
+			[]Section{
+				{"ER_IROM1", 0x08000000, 0xa0},
+				{"RW_IRAM1", 0x20000000, 4},
+			},
+			[]Symbol{
+				sym("__Vectors", 0x08000000, 4, "ER_IROM1"),
+				sym("main", 0x08000040, 36, "ER_IROM1"),
+				sym("Reset_Handler", 0x08000064, 60, "ER_IROM1"),
+				sym("counter", 0x20000000, 4, "RW_IRAM1"),
+			},
+		},
+	}
+	for _, test := range tests {
+		m, err := ParseMap(strings.NewReader(test.text))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(m.Sections(), test.sections) {
+			t.Errorf("%s: want sections %+v got %+v", test.name, test.sections, m.Sections())
+		}
+		if !reflect.DeepEqual(m.Symbols(), test.symbols) {
+			t.Errorf("%s: want symbols %+v got %+v", test.name, test.symbols, m.Symbols())
+		}
+	}
+
+	parsers := []struct {
+		name  string
+		parse func(io.Reader) (*Map, error)
+	}{
+		{"any", ParseMap},
+		{"gnu", ParseGNUMap},
+		{"iar", ParseIARMap},
+		{"keil", ParseKeilMap},
+	}
+	for _, p := range parsers {
+		if _, err := p.parse(strings.NewReader("not a map\n")); err != ErrUnknownFormat {
+			t.Errorf("%s junk: want %v got %v", p.name, ErrUnknownFormat, err)
+		}
+	}
+}
+
+func TestMapLookup(t *testing.T) {
+	m, err := ParseGNUMap(strings.NewReader(testGNUMap))
+	if err != nil {
+		t.Fatalf("ParseGNUMap: %v", err)
+	}
+	tests := []struct {
+		addr uint64
+		name string
+		off  uint64
+		ok   bool
+	}{
+		{0x08000048, "main", 8, true},
+		{0x08000094, ".text", 0x54, true}, // the fill after LoopCopyDataInit
+		{0x08000000, "g_pfnVectors", 0, true},
+		{0x20000004, "_sdata", 4, true}, // past counter
+		{0x20000010, "_edata", 8, true},
+		{0x20005000, "_estack", 0, true}, // outside every section
+		{0x07ffffff, "", 0, false},
+	}
+	for i, test := range tests {
+		name, off, ok := m.Lookup(test.addr)
+		if name != test.name || off != test.off || ok != test.ok {
+			t.Errorf("%2d> %#x: want %s+%#x (%v) got %s+%#x (%v)", i, test.addr, test.name, test.off, test.ok, name, off, ok)
+		}
+	}
+	if addr, ok := m.Address(".text"); !ok || addr != 0x08000040 {
+		t.Errorf(".text: want 0x8000040 got %#x (%v)", addr, ok)
+	}
+
+	var table gapstone.SymbolTable = m
+	if addr, ok := table.Address("counter"); !ok || addr != 0x20000000 {
+		t.Errorf("counter: want 0x20000000 got %#x (%v)", addr, ok)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package symfile
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+// Parse objdump output: section headers from -h and symbols from -t or -T, in
+// any combination, eg objdump -ht. Sections without ALLOC are dropped, as are
+// undefined, common, file and section symbols. Symbol versions and .hidden
+// markers are removed from names.
+//
+// In a relocatable object every section is at 0 and symbols are section
+// relative, so use Rebase to place the sections first.
+func ParseObjdump(r io.Reader) (*Map, error) {
+	m := &Map{}
+	header := -1 // the section whose flags line comes next
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), " \t\r")
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+
+		// CONTENTS, ALLOC, LOAD, READONLY, CODE
+		if header >= 0 && line[0] == ' ' && !isDecimal(f[0]) {
+			if !strings.Contains(line, "ALLOC") || m.sections[header].Size == 0 {
+				m.sections = m.sections[:header]
+			}
+			header = -1
+			continue
+		}
+		header = -1
+
+		// 0 .text  0000016d  0000000000401040  0000000000401040  00001040  2**4
+		if len(f) >= 7 && isDecimal(f[0]) && strings.HasPrefix(f[6], "2**") {
+			size, ok1 := parseHex(f[2])
+			addr, ok2 := parseHex(f[3])
+			if ok1 && ok2 {
+				header = len(m.sections)
+				m.sections = append(m.sections, Section{f[1], addr, size})
+			}
+			continue
+		}
+
+		if sym, ok := parseObjdumpSymbol(line); ok {
+			m.symbols = append(m.symbols, sym)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(m.sections) == 0 && len(m.symbols) == 0 {
+		return nil, ErrUnknownFormat
+	}
+	m.finish()
+	return m, nil
+}
+
+// 0000000000401126 g     F .text	000000000000001b              main
+//
+// The seven flag characters are in fixed columns after the address, and may
+// be blank.
+func parseObjdumpSymbol(line string) (Symbol, bool) {
+	var sym Symbol
+	sp := strings.IndexByte(line, ' ')
+	if sp != 8 && sp != 16 || len(line) < sp+9 {
+		return sym, false
+	}
+	addr, err := strconv.ParseUint(line[:sp], 16, 64)
+	if err != nil {
+		return sym, false
+	}
+	flags := line[sp+1 : sp+8]
+	f := strings.Fields(line[sp+8:])
+	if len(f) < 3 {
+		return sym, false
+	}
+	size, err := strconv.ParseUint(f[1], 16, 64)
+	if err != nil {
+		return sym, false
+	}
+	switch f[0] {
+	case "*UND*", "*COM*", "*ABS*":
+		return sym, false
+	}
+	// Debugging, section and file symbols
+	if strings.ContainsAny(flags, "df") {
+		return sym, false
+	}
+
+	// Everything after the size
+	rest := line[sp+8:]
+	rest = rest[strings.Index(rest, f[0])+len(f[0]):]
+	rest = strings.TrimLeft(rest, " \t")[len(f[1]):]
+	n, ok := objdumpName(rest)
+	if !ok {
+		return sym, false
+	}
+	// Default versions are appended to the name, "memcpy@@GLIBC_2.14"
+	if i := strings.Index(n, "@"); i > 0 {
+		n = n[:i]
+	}
+	sym.Symbol = gapstone.Symbol{Name: n, Addr: addr, Size: size}
+	sym.Section = f[0]
+	return sym, true
+}
+
+// The name from the text after a symbol's size. Symbol versions come first,
+// in a column padded to 11 characters, "  GLIBC_2.2.5", "  Base" or blank,
+// or to 10 for a hidden version, " (GLIBC_2.3)". Files without versions have
+// no column at all. Then come any visibility marker and the name.
+func objdumpName(s string) (string, bool) {
+	switch {
+	case strings.HasPrefix(s, "  "):
+		s = s[2:]
+		n := strings.IndexByte(s, ' ')
+		if n < 11 {
+			n = 11
+		}
+		if n > len(s) {
+			return "", false
+		}
+		s = s[n:]
+	case strings.HasPrefix(s, " ("):
+		n := strings.IndexByte(s, ')')
+		if n < 0 {
+			return "", false
+		}
+		s = s[n+1:]
+		for pad := 10 - (n - 2); pad > 0 && strings.HasPrefix(s, "  "); pad-- {
+			s = s[1:]
+		}
+	}
+	for _, vis := range []string{" .hidden", " .protected", " .internal"} {
+		s = strings.TrimPrefix(s, vis)
+	}
+	if len(s) < 2 || s[0] != ' ' {
+		return "", false
+	}
+	return s[1:], true
+}
@@ -0,0 +1,115 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package symfile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// objdump -ht of a relocatable object built with -ffunction-sections
+const testObjdump = `
+t.o:     file format elf64-x86-64
+
+Sections:
+Idx Name          Size      VMA               LMA               File off  Algn
+  0 .text         00000000  0000000000000000  0000000000000000  00000040  2**0
+                  CONTENTS, ALLOC, LOAD, READONLY, CODE
+  1 .bss          00000004  0000000000000000  0000000000000000  00000040  2**2
+                  ALLOC
+  2 .text.y       0000000b  0000000000000000  0000000000000000  00000040  2**0
+                  CONTENTS, ALLOC, LOAD, READONLY, CODE
+  3 .text.main    0000000b  0000000000000000  0000000000000000  0000004b  2**0
+                  CONTENTS, ALLOC, LOAD, RELOC, READONLY, CODE
+  4 .comment      00000028  0000000000000000  0000000000000000  00000056  2**0
+                  CONTENTS, READONLY
+SYMBOL TABLE:
+0000000000000000 l    df *ABS*	0000000000000000 t.c
+0000000000000000 l    d  .text.y	0000000000000000 .text.y
+0000000000000000 l     F .text.y	000000000000000b y
+0000000000000000 l    d  .text.main	0000000000000000 .text.main
+0000000000000000 g     O .bss	0000000000000004 x
+0000000000000000 g     F .text.main	000000000000000b main
+0000000000000000         *UND*	0000000000000000 printf
+`
+
+// objdump -T
+const testObjdumpDynamic = `
+/bin/ls:     file format elf64-x86-64
+
+DYNAMIC SYMBOL TABLE:
+0000000000000000      DF *UND*	0000000000000000 (GLIBC_2.3)  __ctype_toupper_loc
+0000000000004b20 g    DF .text	0000000000000035  Base        _obstack_memory_used
+0000000000023280 g    DO .bss	0000000000000008 (GLIBC_2.2.5) stderr
+0000000000005000 g    DF .text	0000000000000010  Base        .hidden helper
+000000000007dce0  w   DF .text	000000000000010f  GLIBC_2.2.5 fgetc
+000000000009be60 g   iD  .text	0000000000000109  GLIBC_2.14  memcpy
+0000000000020000 g    DF .text	0000000000000020  GLIBC_PRIVATE __libc_private
+`
+
+func TestParseObjdump(t *testing.T) {
+	m, err := ParseObjdump(strings.NewReader(testObjdump))
+	if err != nil {
+		t.Fatalf("ParseObjdump: %v", err)
+	}
+	sections := []Section{{".bss", 0, 4}, {".text.y", 0, 0xb}, {".text.main", 0, 0xb}}
+	if !reflect.DeepEqual(m.Sections(), sections) {
+		t.Errorf("want sections %+v got %+v", sections, m.Sections())
+	}
+	symbols := []Symbol{
+		sym("y", 0, 0xb, ".text.y"),
+		sym("x", 0, 4, ".bss"),
+		sym("main", 0, 0xb, ".text.main"),
+	}
+	if !reflect.DeepEqual(m.Symbols(), symbols) {
+		t.Errorf("want symbols %+v got %+v", symbols, m.Symbols())
+	}
+
+	// Lay the object out like a linker would
+	m.Rebase(".text.y", 0x1000)
+	m.Rebase(".text.main", 0x100b)
+	m.Rebase(".bss", 0x4000)
+	lookups := []struct {
+		addr uint64
+		name string
+		off  uint64
+	}{
+		{0x1000, "y", 0},
+		{0x100f, "main", 4},
+		{0x4000, "x", 0},
+	}
+	for i, test := range lookups {
+		if name, off, ok := m.Lookup(test.addr); !ok || name != test.name || off != test.off {
+			t.Errorf("%2d> %#x: want %s+%#x got %s+%#x (%v)", i, test.addr, test.name, test.off, name, off, ok)
+		}
+	}
+
+	m, err = ParseObjdump(strings.NewReader(testObjdumpDynamic))
+	if err != nil {
+		t.Fatalf("ParseObjdump -T: %v", err)
+	}
+	symbols = []Symbol{
+		sym("_obstack_memory_used", 0x4b20, 0x35, ".text"),
+		sym("helper", 0x5000, 0x10, ".text"),
+		sym("__libc_private", 0x20000, 0x20, ".text"),
+		sym("stderr", 0x23280, 8, ".bss"),
+		sym("fgetc", 0x7dce0, 0x10f, ".text"),
+		sym("memcpy", 0x9be60, 0x109, ".text"),
+	}
+	if !reflect.DeepEqual(m.Symbols(), symbols) {
+		t.Errorf("-T: want symbols %+v got %+v", symbols, m.Symbols())
+	}
+
+	if _, err := ParseObjdump(strings.NewReader("nothing here\n")); err != ErrUnknownFormat {
+		t.Errorf("junk: want %v got %v", ErrUnknownFormat, err)
+	}
+}
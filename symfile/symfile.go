@@ -0,0 +1,169 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+// Package symfile reads symbols from text listings, for targets that ship
+// without a symbol table in the binary: nm and objdump output, and GNU ld,
+// IAR and Keil linker maps. The results are gapstone.SymbolTables, for
+// Instruction.SymbolicOpStr. Maps and objdump listings also carry sections,
+// so addresses between symbols can still be labelled, eg ".bss+0x10".
+package symfile
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bnagy/gapstone"
+)
+
+var (
+	ErrFormat        = errors.New("gapstone/symfile: malformed line")
+	ErrUnknownFormat = errors.New("gapstone/symfile: no symbols in a known format")
+)
+
+// A line that couldn't be parsed
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("%v %d", e.Err, e.Line)
+}
+
+// Parse nm output, in the default BSD format ("0000000000401126 T main"), with
+// sizes from nm -S ("0000000000401126 000000000000001b T main") or in the
+// POSIX format from nm -P ("main T 401126 1b"). Undefined symbols and
+// debugging symbols are skipped, as are the "file.o:" headers printed for
+// archives and multiple files. Names may contain spaces, as from nm -C.
+//
+// Thumb functions keep bit 0 of their address, as nm prints them. Names which
+// are also hex numbers ("add T 0 f") read both ways, so the format is decided
+// once for the whole listing, by the first line which only reads one way.
+func ParseNm(r io.Reader) (*gapstone.SymbolMap, error) {
+	var lines []string
+	var numbers []int
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasSuffix(s, ":") {
+			continue
+		}
+		lines = append(lines, s)
+		numbers = append(numbers, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	posix := isPOSIX(lines)
+	var syms []gapstone.Symbol
+	for i, s := range lines {
+		sym, kind, ok := parseNmLine(s, posix)
+		if !ok {
+			return nil, &LineError{numbers[i], ErrFormat}
+		}
+		switch kind {
+		case 'U', 'N', '-', '?':
+			continue
+		}
+		syms = append(syms, sym)
+	}
+	return gapstone.NewSymbolMap(syms), nil
+}
+
+// Whether nm output is in the POSIX format. If no line settles it, BSD output
+// is recognised by its addresses, which nm pads to 8 or 16 digits.
+func isPOSIX(lines []string) bool {
+	for _, s := range lines {
+		_, _, bsd := parseNmLine(s, false)
+		_, _, posix := parseNmLine(s, true)
+		if bsd != posix {
+			return posix
+		}
+	}
+	for _, s := range lines {
+		if f := strings.Fields(s); len(f) >= 3 {
+			return !((len(f[0]) == 8 || len(f[0]) == 16) && f[0][0] == '0')
+		}
+	}
+	return false
+}
+
+// One nm line in the BSD or POSIX format, and its type letter. Undefined
+// symbols have no address and come back as 'U'.
+func parseNmLine(s string, posix bool) (gapstone.Symbol, byte, bool) {
+	var sym gapstone.Symbol
+	f := strings.Fields(s)
+	switch {
+	case !posix && len(f) >= 3 && isKind(f[1]) && isHex(f[0]):
+		sym.Addr, _ = strconv.ParseUint(f[0], 16, 64)
+		sym.Name = rest(s, 2)
+		return sym, f[1][0], true
+	case !posix && len(f) >= 4 && isKind(f[2]) && isHex(f[0]) && isHex(f[1]):
+		sym.Addr, _ = strconv.ParseUint(f[0], 16, 64)
+		sym.Size, _ = strconv.ParseUint(f[1], 16, 64)
+		sym.Name = rest(s, 3)
+		return sym, f[2][0], true
+	case posix && len(f) >= 4 && isKind(f[len(f)-3]) && isHex(f[len(f)-2]) && isHex(f[len(f)-1]):
+		// "name T addr size"
+		sym.Name = trimFields(s, 3)
+		sym.Addr, _ = strconv.ParseUint(f[len(f)-2], 16, 64)
+		sym.Size, _ = strconv.ParseUint(f[len(f)-1], 16, 64)
+		return sym, f[len(f)-3][0], true
+	case posix && len(f) >= 3 && isKind(f[len(f)-2]) && isHex(f[len(f)-1]):
+		// "name T addr"
+		sym.Name = trimFields(s, 2)
+		sym.Addr, _ = strconv.ParseUint(f[len(f)-1], 16, 64)
+		return sym, f[len(f)-2][0], true
+	case len(f) >= 2 && (isKind(f[0]) || isKind(f[len(f)-1])):
+		// "U printf" with the address left blank, or POSIX "printf U"
+		return sym, 'U', true
+	}
+	return sym, 0, false
+}
+
+func isKind(s string) bool {
+	return len(s) == 1 && (s[0] >= 'a' && s[0] <= 'z' || s[0] >= 'A' && s[0] <= 'Z' || s[0] == '-' || s[0] == '?')
+}
+
+func isHex(s string) bool {
+	_, err := strconv.ParseUint(s, 16, 64)
+	return err == nil
+}
+
+// s without its first n fields
+func rest(s string, n int) string {
+	for i := 0; i < n; i++ {
+		s = strings.TrimLeft(s, " \t")
+		if j := strings.IndexAny(s, " \t"); j >= 0 {
+			s = s[j:]
+		} else {
+			return ""
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+// s without its last n fields
+func trimFields(s string, n int) string {
+	for i := 0; i < n; i++ {
+		s = strings.TrimRight(s, " \t")
+		if j := strings.LastIndexAny(s, " \t"); j >= 0 {
+			s = s[:j]
+		} else {
+			return ""
+		}
+	}
+	return strings.TrimSpace(s)
+}
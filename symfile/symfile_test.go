@@ -0,0 +1,101 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package symfile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bnagy/gapstone"
+)
+
+const testNm = `
+main.o:
+                 w __gmon_start__
+                 U printf
+0000000000401000 T _init
+0000000000401126 000000000000001b T main
+0000000000404028 0000000000000004 B counter
+0000000000401150 t std::vector<int>::size() const
+0000000000000000 a crt1.c
+0000000000000010 N .debug_info
+`
+
+func TestParseNm(t *testing.T) {
+	m, err := ParseNm(strings.NewReader(testNm))
+	if err != nil {
+		t.Fatalf("ParseNm: %v", err)
+	}
+	want := []gapstone.Symbol{
+		{Name: "crt1.c", Addr: 0},
+		{Name: "_init", Addr: 0x401000},
+		{Name: "main", Addr: 0x401126, Size: 0x1b},
+		{Name: "std::vector<int>::size() const", Addr: 0x401150},
+		{Name: "counter", Addr: 0x404028, Size: 4},
+	}
+	if got := m.Symbols(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v got %+v", want, got)
+	}
+
+	posix := "printf U\nmain T 401126 1b\n_init T 401000\nF[go.shape.interface {}] T 401200 10\n"
+	m, err = ParseNm(strings.NewReader(posix))
+	if err != nil {
+		t.Fatalf("ParseNm POSIX: %v", err)
+	}
+	want = []gapstone.Symbol{
+		{Name: "_init", Addr: 0x401000},
+		{Name: "main", Addr: 0x401126, Size: 0x1b},
+		{Name: "F[go.shape.interface {}]", Addr: 0x401200, Size: 0x10},
+	}
+	if got := m.Symbols(); !reflect.DeepEqual(got, want) {
+		t.Errorf("POSIX: want %+v got %+v", want, got)
+	}
+
+	// Names which are also hex numbers read as either format
+	hexNames := []struct {
+		nm   string
+		want []gapstone.Symbol
+	}{
+		{"add T 0 f\nface T f b\nmain T 1a 17\n", []gapstone.Symbol{
+			{Name: "add", Addr: 0, Size: 0xf},
+			{Name: "face", Addr: 0xf, Size: 0xb},
+			{Name: "main", Addr: 0x1a, Size: 0x17},
+		}},
+		{"add T 401126\nface T 401150 1b\n", []gapstone.Symbol{
+			{Name: "add", Addr: 0x401126},
+			{Name: "face", Addr: 0x401150, Size: 0x1b},
+		}},
+		{"0000000000401126 T add\n0000000000401150 000000000000001b T face\n", []gapstone.Symbol{
+			{Name: "add", Addr: 0x401126},
+			{Name: "face", Addr: 0x401150, Size: 0x1b},
+		}},
+		{"00401126 T face\n00401150 T main\n", []gapstone.Symbol{
+			{Name: "face", Addr: 0x401126},
+			{Name: "main", Addr: 0x401150},
+		}},
+	}
+	for i, tt := range hexNames {
+		m, err := ParseNm(strings.NewReader(tt.nm))
+		if err != nil {
+			t.Errorf("%2d> ParseNm: %v", i, err)
+			continue
+		}
+		if got := m.Symbols(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%2d> want %+v got %+v", i, tt.want, got)
+		}
+	}
+
+	_, err = ParseNm(strings.NewReader("0000000000401000 T _init\nnot nm output\n"))
+	if le, ok := err.(*LineError); !ok || le.Line != 2 || le.Err != ErrFormat {
+		t.Errorf("junk: want line 2 %v got %v", ErrFormat, err)
+	}
+}
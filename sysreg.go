@@ -0,0 +1,597 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import (
+	"fmt"
+	"strings"
+)
+
+// An ARM64 system register, as carried by ARM64_OP_REG_MRS and
+// ARM64_OP_REG_MSR operands. The value is the op0:op1:CRn:CRm:op2 encoding,
+// which is also the value of the ARM64_SYSREG_* constants. Capstone only
+// names the read-only and write-only registers; the common read / write
+// registers (SCTLR_EL1, TTBR0_EL1, VBAR_EL1 ...) are named here as well.
+type Arm64SysReg uint
+
+// Build an Arm64SysReg from its encoding fields
+func NewArm64SysReg(op0, op1, crn, crm, op2 uint) Arm64SysReg {
+	return Arm64SysReg((op0&3)<<14 | (op1&7)<<11 | (crn&15)<<7 | (crm&15)<<3 | op2&7)
+}
+
+// The op0, op1, CRn, CRm and op2 encoding fields
+func (r Arm64SysReg) Fields() (op0, op1, crn, crm, op2 uint) {
+	return uint(r) >> 14 & 3, uint(r) >> 11 & 7, uint(r) >> 7 & 15, uint(r) >> 3 & 15, uint(r) & 7
+}
+
+// The lower case assembler name, or the generic s<op0>_<op1>_c<n>_c<m>_<op2>
+// form for registers without one. DBGDTRRX_EL0 and DBGDTRTX_EL0 share an
+// encoding; String returns the read name.
+func (r Arm64SysReg) String() string {
+	if e, ok := arm64SysRegs[r]; ok {
+		return e.name
+	}
+	op0, op1, crn, crm, op2 := r.Fields()
+	return fmt.Sprintf("s%d_%d_c%d_c%d_%d", op0, op1, crn, crm, op2)
+}
+
+// A short description, or "" for registers without a name
+func (r Arm64SysReg) Description() string {
+	return arm64SysRegs[r].desc
+}
+
+// Whether the register can be read with mrs, written with msr or both.
+// Unnamed registers are assumed to be AccessReadWrite.
+func (r Arm64SysReg) Access() Access {
+	if e, ok := arm64SysRegs[r]; ok {
+		return e.access
+	}
+	return AccessReadWrite
+}
+
+// Look up a system register by name, ignoring case. Both the named and the
+// generic s3_0_c1_c0_0 forms are accepted.
+func Arm64SysRegByName(name string) (Arm64SysReg, bool) {
+	name = strings.ToLower(name)
+	if r, ok := arm64SysRegNames[name]; ok {
+		return r, true
+	}
+	var op0, op1, crn, crm, op2 uint
+	n, err := fmt.Sscanf(name, "s%d_%d_c%d_c%d_%d", &op0, &op1, &crn, &crm, &op2)
+	if err != nil || n != 5 || op0 > 3 || op1 > 7 || crn > 15 || crm > 15 || op2 > 7 {
+		return 0, false
+	}
+	return NewArm64SysReg(op0, op1, crn, crm, op2), true
+}
+
+// The system register operand, for ARM64_OP_REG_MRS and ARM64_OP_REG_MSR
+// operands.
+func (op Arm64Operand) SysReg() (Arm64SysReg, bool) {
+	if op.Type != ARM64_OP_REG_MRS && op.Type != ARM64_OP_REG_MSR {
+		return 0, false
+	}
+	return Arm64SysReg(op.Reg), true
+}
+
+// The system register an mrs or msr instruction accesses, with AccessRead
+// for mrs and AccessWrite for msr. ok is false if there is no system register
+// operand (including msr to a PSTATE field).
+func (insn Arm64Instruction) SysReg() (reg Arm64SysReg, access Access, ok bool) {
+	for _, op := range insn.Operands {
+		switch op.Type {
+		case ARM64_OP_REG_MRS:
+			return Arm64SysReg(op.Reg), AccessRead, true
+		case ARM64_OP_REG_MSR:
+			return Arm64SysReg(op.Reg), AccessWrite, true
+		}
+	}
+	return 0, AccessNone, false
+}
+
+type sysRegEntry struct {
+	reg    Arm64SysReg
+	access Access
+	name   string
+	desc   string
+}
+
+var (
+	arm64SysRegs     = map[Arm64SysReg]sysRegEntry{}
+	arm64SysRegNames = map[string]Arm64SysReg{}
+)
+
+// Registers Capstone names which can only be read with mrs
+var arm64MRSRegs = []sysRegEntry{
+	{ARM64_SYSREG_MDCCSR_EL0, AccessRead, "mdccsr_el0", "Monitor Debug Comms Channel Status Register"},
+	{ARM64_SYSREG_DBGDTRRX_EL0, AccessRead, "dbgdtrrx_el0", "Debug Data Transfer Register, Receive"},
+	{ARM64_SYSREG_MDRAR_EL1, AccessRead, "mdrar_el1", "Monitor Debug ROM Address Register"},
+	{ARM64_SYSREG_OSLSR_EL1, AccessRead, "oslsr_el1", "OS Lock Status Register"},
+	{ARM64_SYSREG_DBGAUTHSTATUS_EL1, AccessRead, "dbgauthstatus_el1", "Debug Authentication Status Register"},
+	{ARM64_SYSREG_PMCEID0_EL0, AccessRead, "pmceid0_el0", "Performance Monitors Common Event Identification Register 0"},
+	{ARM64_SYSREG_PMCEID1_EL0, AccessRead, "pmceid1_el0", "Performance Monitors Common Event Identification Register 1"},
+	{ARM64_SYSREG_MIDR_EL1, AccessRead, "midr_el1", "Main ID Register"},
+	{ARM64_SYSREG_CCSIDR_EL1, AccessRead, "ccsidr_el1", "Current Cache Size ID Register"},
+	{ARM64_SYSREG_CLIDR_EL1, AccessRead, "clidr_el1", "Cache Level ID Register"},
+	{ARM64_SYSREG_CTR_EL0, AccessRead, "ctr_el0", "Cache Type Register"},
+	{ARM64_SYSREG_MPIDR_EL1, AccessRead, "mpidr_el1", "Multiprocessor Affinity Register"},
+	{ARM64_SYSREG_REVIDR_EL1, AccessRead, "revidr_el1", "Revision ID Register"},
+	{ARM64_SYSREG_AIDR_EL1, AccessRead, "aidr_el1", "Auxiliary ID Register"},
+	{ARM64_SYSREG_DCZID_EL0, AccessRead, "dczid_el0", "Data Cache Zero ID Register"},
+	{ARM64_SYSREG_ID_PFR0_EL1, AccessRead, "id_pfr0_el1", "AArch32 Processor Feature Register 0"},
+	{ARM64_SYSREG_ID_PFR1_EL1, AccessRead, "id_pfr1_el1", "AArch32 Processor Feature Register 1"},
+	{ARM64_SYSREG_ID_DFR0_EL1, AccessRead, "id_dfr0_el1", "AArch32 Debug Feature Register 0"},
+	{ARM64_SYSREG_ID_AFR0_EL1, AccessRead, "id_afr0_el1", "AArch32 Auxiliary Feature Register 0"},
+	{ARM64_SYSREG_ID_MMFR0_EL1, AccessRead, "id_mmfr0_el1", "AArch32 Memory Model Feature Register 0"},
+	{ARM64_SYSREG_ID_MMFR1_EL1, AccessRead, "id_mmfr1_el1", "AArch32 Memory Model Feature Register 1"},
+	{ARM64_SYSREG_ID_MMFR2_EL1, AccessRead, "id_mmfr2_el1", "AArch32 Memory Model Feature Register 2"},
+	{ARM64_SYSREG_ID_MMFR3_EL1, AccessRead, "id_mmfr3_el1", "AArch32 Memory Model Feature Register 3"},
+	{ARM64_SYSREG_ID_ISAR0_EL1, AccessRead, "id_isar0_el1", "AArch32 Instruction Set Attribute Register 0"},
+	{ARM64_SYSREG_ID_ISAR1_EL1, AccessRead, "id_isar1_el1", "AArch32 Instruction Set Attribute Register 1"},
+	{ARM64_SYSREG_ID_ISAR2_EL1, AccessRead, "id_isar2_el1", "AArch32 Instruction Set Attribute Register 2"},
+	{ARM64_SYSREG_ID_ISAR3_EL1, AccessRead, "id_isar3_el1", "AArch32 Instruction Set Attribute Register 3"},
+	{ARM64_SYSREG_ID_ISAR4_EL1, AccessRead, "id_isar4_el1", "AArch32 Instruction Set Attribute Register 4"},
+	{ARM64_SYSREG_ID_ISAR5_EL1, AccessRead, "id_isar5_el1", "AArch32 Instruction Set Attribute Register 5"},
+	{ARM64_SYSREG_ID_A64PFR0_EL1, AccessRead, "id_aa64pfr0_el1", "AArch64 Processor Feature Register 0"},
+	{ARM64_SYSREG_ID_A64PFR1_EL1, AccessRead, "id_aa64pfr1_el1", "AArch64 Processor Feature Register 1"},
+	{ARM64_SYSREG_ID_A64DFR0_EL1, AccessRead, "id_aa64dfr0_el1", "AArch64 Debug Feature Register 0"},
+	{ARM64_SYSREG_ID_A64DFR1_EL1, AccessRead, "id_aa64dfr1_el1", "AArch64 Debug Feature Register 1"},
+	{ARM64_SYSREG_ID_A64AFR0_EL1, AccessRead, "id_aa64afr0_el1", "AArch64 Auxiliary Feature Register 0"},
+	{ARM64_SYSREG_ID_A64AFR1_EL1, AccessRead, "id_aa64afr1_el1", "AArch64 Auxiliary Feature Register 1"},
+	{ARM64_SYSREG_ID_A64ISAR0_EL1, AccessRead, "id_aa64isar0_el1", "AArch64 Instruction Set Attribute Register 0"},
+	{ARM64_SYSREG_ID_A64ISAR1_EL1, AccessRead, "id_aa64isar1_el1", "AArch64 Instruction Set Attribute Register 1"},
+	{ARM64_SYSREG_ID_A64MMFR0_EL1, AccessRead, "id_aa64mmfr0_el1", "AArch64 Memory Model Feature Register 0"},
+	{ARM64_SYSREG_ID_A64MMFR1_EL1, AccessRead, "id_aa64mmfr1_el1", "AArch64 Memory Model Feature Register 1"},
+	{ARM64_SYSREG_MVFR0_EL1, AccessRead, "mvfr0_el1", "AArch32 Media and VFP Feature Register 0"},
+	{ARM64_SYSREG_MVFR1_EL1, AccessRead, "mvfr1_el1", "AArch32 Media and VFP Feature Register 1"},
+	{ARM64_SYSREG_MVFR2_EL1, AccessRead, "mvfr2_el1", "AArch32 Media and VFP Feature Register 2"},
+	{ARM64_SYSREG_RVBAR_EL1, AccessRead, "rvbar_el1", "Reset Vector Base Address Register (EL1)"},
+	{ARM64_SYSREG_RVBAR_EL2, AccessRead, "rvbar_el2", "Reset Vector Base Address Register (EL2)"},
+	{ARM64_SYSREG_RVBAR_EL3, AccessRead, "rvbar_el3", "Reset Vector Base Address Register (EL3)"},
+	{ARM64_SYSREG_ISR_EL1, AccessRead, "isr_el1", "Interrupt Status Register"},
+	{ARM64_SYSREG_CNTPCT_EL0, AccessRead, "cntpct_el0", "Counter-timer Physical Count Register"},
+	{ARM64_SYSREG_CNTVCT_EL0, AccessRead, "cntvct_el0", "Counter-timer Virtual Count Register"},
+	{ARM64_SYSREG_TRCSTATR, AccessRead, "trcstatr", "Trace Status Register"},
+	{ARM64_SYSREG_TRCIDR8, AccessRead, "trcidr8", "Trace ID Register 8"},
+	{ARM64_SYSREG_TRCIDR9, AccessRead, "trcidr9", "Trace ID Register 9"},
+	{ARM64_SYSREG_TRCIDR10, AccessRead, "trcidr10", "Trace ID Register 10"},
+	{ARM64_SYSREG_TRCIDR11, AccessRead, "trcidr11", "Trace ID Register 11"},
+	{ARM64_SYSREG_TRCIDR12, AccessRead, "trcidr12", "Trace ID Register 12"},
+	{ARM64_SYSREG_TRCIDR13, AccessRead, "trcidr13", "Trace ID Register 13"},
+	{ARM64_SYSREG_TRCIDR0, AccessRead, "trcidr0", "Trace ID Register 0"},
+	{ARM64_SYSREG_TRCIDR1, AccessRead, "trcidr1", "Trace ID Register 1"},
+	{ARM64_SYSREG_TRCIDR2, AccessRead, "trcidr2", "Trace ID Register 2"},
+	{ARM64_SYSREG_TRCIDR3, AccessRead, "trcidr3", "Trace ID Register 3"},
+	{ARM64_SYSREG_TRCIDR4, AccessRead, "trcidr4", "Trace ID Register 4"},
+	{ARM64_SYSREG_TRCIDR5, AccessRead, "trcidr5", "Trace ID Register 5"},
+	{ARM64_SYSREG_TRCIDR6, AccessRead, "trcidr6", "Trace ID Register 6"},
+	{ARM64_SYSREG_TRCIDR7, AccessRead, "trcidr7", "Trace ID Register 7"},
+	{ARM64_SYSREG_TRCOSLSR, AccessRead, "trcoslsr", "Trace OS Lock Status Register"},
+	{ARM64_SYSREG_TRCPDSR, AccessRead, "trcpdsr", "Trace PowerDown Status Register"},
+	{ARM64_SYSREG_TRCDEVAFF0, AccessRead, "trcdevaff0", "Trace Device Affinity Register 0"},
+	{ARM64_SYSREG_TRCDEVAFF1, AccessRead, "trcdevaff1", "Trace Device Affinity Register 1"},
+	{ARM64_SYSREG_TRCLSR, AccessRead, "trclsr", "Trace Software Lock Status Register"},
+	{ARM64_SYSREG_TRCAUTHSTATUS, AccessRead, "trcauthstatus", "Trace Authentication Status Register"},
+	{ARM64_SYSREG_TRCDEVARCH, AccessRead, "trcdevarch", "Trace Device Architecture Register"},
+	{ARM64_SYSREG_TRCDEVID, AccessRead, "trcdevid", "Trace Device Configuration Register"},
+	{ARM64_SYSREG_TRCDEVTYPE, AccessRead, "trcdevtype", "Trace Device Type Register"},
+	{ARM64_SYSREG_TRCPIDR4, AccessRead, "trcpidr4", "Trace Peripheral Identification Register 4"},
+	{ARM64_SYSREG_TRCPIDR5, AccessRead, "trcpidr5", "Trace Peripheral Identification Register 5"},
+	{ARM64_SYSREG_TRCPIDR6, AccessRead, "trcpidr6", "Trace Peripheral Identification Register 6"},
+	{ARM64_SYSREG_TRCPIDR7, AccessRead, "trcpidr7", "Trace Peripheral Identification Register 7"},
+	{ARM64_SYSREG_TRCPIDR0, AccessRead, "trcpidr0", "Trace Peripheral Identification Register 0"},
+	{ARM64_SYSREG_TRCPIDR1, AccessRead, "trcpidr1", "Trace Peripheral Identification Register 1"},
+	{ARM64_SYSREG_TRCPIDR2, AccessRead, "trcpidr2", "Trace Peripheral Identification Register 2"},
+	{ARM64_SYSREG_TRCPIDR3, AccessRead, "trcpidr3", "Trace Peripheral Identification Register 3"},
+	{ARM64_SYSREG_TRCCIDR0, AccessRead, "trccidr0", "Trace Component Identification Register 0"},
+	{ARM64_SYSREG_TRCCIDR1, AccessRead, "trccidr1", "Trace Component Identification Register 1"},
+	{ARM64_SYSREG_TRCCIDR2, AccessRead, "trccidr2", "Trace Component Identification Register 2"},
+	{ARM64_SYSREG_TRCCIDR3, AccessRead, "trccidr3", "Trace Component Identification Register 3"},
+	{ARM64_SYSREG_ICC_IAR1_EL1, AccessRead, "icc_iar1_el1", "GIC Interrupt Acknowledge Register 1"},
+	{ARM64_SYSREG_ICC_IAR0_EL1, AccessRead, "icc_iar0_el1", "GIC Interrupt Acknowledge Register 0"},
+	{ARM64_SYSREG_ICC_HPPIR1_EL1, AccessRead, "icc_hppir1_el1", "GIC Highest Priority Pending Interrupt Register 1"},
+	{ARM64_SYSREG_ICC_HPPIR0_EL1, AccessRead, "icc_hppir0_el1", "GIC Highest Priority Pending Interrupt Register 0"},
+	{ARM64_SYSREG_ICC_RPR_EL1, AccessRead, "icc_rpr_el1", "GIC Running Priority Register"},
+	{ARM64_SYSREG_ICH_VTR_EL2, AccessRead, "ich_vtr_el2", "GIC VGIC Type Register"},
+	{ARM64_SYSREG_ICH_EISR_EL2, AccessRead, "ich_eisr_el2", "GIC End of Interrupt Status Register"},
+	{ARM64_SYSREG_ICH_ELSR_EL2, AccessRead, "ich_elsr_el2", "GIC Empty List Register Status Register"},
+}
+
+// Registers Capstone names which can only be written with msr
+var arm64MSRRegs = []sysRegEntry{
+	{ARM64_SYSREG_DBGDTRTX_EL0, AccessWrite, "dbgdtrtx_el0", "Debug Data Transfer Register, Transmit"},
+	{ARM64_SYSREG_OSLAR_EL1, AccessWrite, "oslar_el1", "OS Lock Access Register"},
+	{ARM64_SYSREG_PMSWINC_EL0, AccessWrite, "pmswinc_el0", "Performance Monitors Software Increment Register"},
+	{ARM64_SYSREG_TRCOSLAR, AccessWrite, "trcoslar", "Trace OS Lock Access Register"},
+	{ARM64_SYSREG_TRCLAR, AccessWrite, "trclar", "Trace Software Lock Access Register"},
+	{ARM64_SYSREG_ICC_EOIR1_EL1, AccessWrite, "icc_eoir1_el1", "GIC End Of Interrupt Register 1"},
+	{ARM64_SYSREG_ICC_EOIR0_EL1, AccessWrite, "icc_eoir0_el1", "GIC End Of Interrupt Register 0"},
+	{ARM64_SYSREG_ICC_DIR_EL1, AccessWrite, "icc_dir_el1", "GIC Deactivate Interrupt Register"},
+	{ARM64_SYSREG_ICC_SGI1R_EL1, AccessWrite, "icc_sgi1r_el1", "GIC Software Generated Interrupt Group 1 Register"},
+	{ARM64_SYSREG_ICC_ASGI1R_EL1, AccessWrite, "icc_asgi1r_el1", "GIC Alias Software Generated Interrupt Group 1 Register"},
+	{ARM64_SYSREG_ICC_SGI0R_EL1, AccessWrite, "icc_sgi0r_el1", "GIC Software Generated Interrupt Group 0 Register"},
+}
+
+// Read / write registers (and a few read-only ones) Capstone doesn't name
+var arm64OtherRegs = []sysRegEntry{
+	// identification and control
+	{NewArm64SysReg(3, 4, 0, 0, 0), AccessReadWrite, "vpidr_el2", "Virtualization Processor ID Register"},
+	{NewArm64SysReg(3, 4, 0, 0, 5), AccessReadWrite, "vmpidr_el2", "Virtualization Multiprocessor ID Register"},
+	{NewArm64SysReg(3, 2, 0, 0, 0), AccessReadWrite, "csselr_el1", "Cache Size Selection Register"},
+	{NewArm64SysReg(3, 0, 1, 0, 0), AccessReadWrite, "sctlr_el1", "System Control Register (EL1)"},
+	{NewArm64SysReg(3, 0, 1, 0, 1), AccessReadWrite, "actlr_el1", "Auxiliary Control Register (EL1)"},
+	{NewArm64SysReg(3, 0, 1, 0, 2), AccessReadWrite, "cpacr_el1", "Architectural Feature Access Control Register"},
+	{NewArm64SysReg(3, 4, 1, 0, 0), AccessReadWrite, "sctlr_el2", "System Control Register (EL2)"},
+	{NewArm64SysReg(3, 4, 1, 0, 1), AccessReadWrite, "actlr_el2", "Auxiliary Control Register (EL2)"},
+	{NewArm64SysReg(3, 4, 1, 1, 0), AccessReadWrite, "hcr_el2", "Hypervisor Configuration Register"},
+	{NewArm64SysReg(3, 4, 1, 1, 1), AccessReadWrite, "mdcr_el2", "Monitor Debug Configuration Register (EL2)"},
+	{NewArm64SysReg(3, 4, 1, 1, 2), AccessReadWrite, "cptr_el2", "Architectural Feature Trap Register (EL2)"},
+	{NewArm64SysReg(3, 4, 1, 1, 3), AccessReadWrite, "hstr_el2", "Hypervisor System Trap Register"},
+	{NewArm64SysReg(3, 4, 1, 1, 7), AccessReadWrite, "hacr_el2", "Hypervisor Auxiliary Control Register"},
+	{NewArm64SysReg(3, 6, 1, 0, 0), AccessReadWrite, "sctlr_el3", "System Control Register (EL3)"},
+	{NewArm64SysReg(3, 6, 1, 0, 1), AccessReadWrite, "actlr_el3", "Auxiliary Control Register (EL3)"},
+	{NewArm64SysReg(3, 6, 1, 1, 0), AccessReadWrite, "scr_el3", "Secure Configuration Register"},
+	{NewArm64SysReg(3, 6, 1, 1, 1), AccessReadWrite, "sder32_el3", "AArch32 Secure Debug Enable Register"},
+	{NewArm64SysReg(3, 6, 1, 1, 2), AccessReadWrite, "cptr_el3", "Architectural Feature Trap Register (EL3)"},
+	{NewArm64SysReg(3, 6, 1, 3, 1), AccessReadWrite, "mdcr_el3", "Monitor Debug Configuration Register (EL3)"},
+
+	// translation
+	{NewArm64SysReg(3, 0, 2, 0, 0), AccessReadWrite, "ttbr0_el1", "Translation Table Base Register 0 (EL1)"},
+	{NewArm64SysReg(3, 0, 2, 0, 1), AccessReadWrite, "ttbr1_el1", "Translation Table Base Register 1 (EL1)"},
+	{NewArm64SysReg(3, 0, 2, 0, 2), AccessReadWrite, "tcr_el1", "Translation Control Register (EL1)"},
+	{NewArm64SysReg(3, 4, 2, 0, 0), AccessReadWrite, "ttbr0_el2", "Translation Table Base Register 0 (EL2)"},
+	{NewArm64SysReg(3, 4, 2, 0, 2), AccessReadWrite, "tcr_el2", "Translation Control Register (EL2)"},
+	{NewArm64SysReg(3, 4, 2, 1, 0), AccessReadWrite, "vttbr_el2", "Virtualization Translation Table Base Register"},
+	{NewArm64SysReg(3, 4, 2, 1, 2), AccessReadWrite, "vtcr_el2", "Virtualization Translation Control Register"},
+	{NewArm64SysReg(3, 6, 2, 0, 0), AccessReadWrite, "ttbr0_el3", "Translation Table Base Register 0 (EL3)"},
+	{NewArm64SysReg(3, 6, 2, 0, 2), AccessReadWrite, "tcr_el3", "Translation Control Register (EL3)"},
+	{NewArm64SysReg(3, 4, 3, 0, 0), AccessReadWrite, "dacr32_el2", "Domain Access Control Register"},
+	{NewArm64SysReg(3, 0, 7, 4, 0), AccessReadWrite, "par_el1", "Physical Address Register"},
+	{NewArm64SysReg(3, 0, 10, 2, 0), AccessReadWrite, "mair_el1", "Memory Attribute Indirection Register (EL1)"},
+	{NewArm64SysReg(3, 0, 10, 3, 0), AccessReadWrite, "amair_el1", "Auxiliary Memory Attribute Indirection Register (EL1)"},
+	{NewArm64SysReg(3, 4, 10, 2, 0), AccessReadWrite, "mair_el2", "Memory Attribute Indirection Register (EL2)"},
+	{NewArm64SysReg(3, 4, 10, 3, 0), AccessReadWrite, "amair_el2", "Auxiliary Memory Attribute Indirection Register (EL2)"},
+	{NewArm64SysReg(3, 6, 10, 2, 0), AccessReadWrite, "mair_el3", "Memory Attribute Indirection Register (EL3)"},
+	{NewArm64SysReg(3, 6, 10, 3, 0), AccessReadWrite, "amair_el3", "Auxiliary Memory Attribute Indirection Register (EL3)"},
+	{NewArm64SysReg(3, 0, 13, 0, 1), AccessReadWrite, "contextidr_el1", "Context ID Register"},
+
+	// exception handling and processor state
+	{NewArm64SysReg(3, 0, 4, 0, 0), AccessReadWrite, "spsr_el1", "Saved Program Status Register (EL1)"},
+	{NewArm64SysReg(3, 0, 4, 0, 1), AccessReadWrite, "elr_el1", "Exception Link Register (EL1)"},
+	{NewArm64SysReg(3, 0, 4, 1, 0), AccessReadWrite, "sp_el0", "Stack Pointer (EL0)"},
+	{NewArm64SysReg(3, 0, 4, 2, 0), AccessReadWrite, "spsel", "Stack Pointer Select"},
+	{NewArm64SysReg(3, 0, 4, 2, 2), AccessRead, "currentel", "Current Exception Level"},
+	{NewArm64SysReg(3, 3, 4, 2, 0), AccessReadWrite, "nzcv", "Condition Flags"},
+	{NewArm64SysReg(3, 3, 4, 2, 1), AccessReadWrite, "daif", "Interrupt Mask Bits"},
+	{NewArm64SysReg(3, 3, 4, 4, 0), AccessReadWrite, "fpcr", "Floating-point Control Register"},
+	{NewArm64SysReg(3, 3, 4, 4, 1), AccessReadWrite, "fpsr", "Floating-point Status Register"},
+	{NewArm64SysReg(3, 3, 4, 5, 0), AccessReadWrite, "dspsr_el0", "Debug Saved Program Status Register"},
+	{NewArm64SysReg(3, 3, 4, 5, 1), AccessReadWrite, "dlr_el0", "Debug Link Register"},
+	{NewArm64SysReg(3, 4, 4, 0, 0), AccessReadWrite, "spsr_el2", "Saved Program Status Register (EL2)"},
+	{NewArm64SysReg(3, 4, 4, 0, 1), AccessReadWrite, "elr_el2", "Exception Link Register (EL2)"},
+	{NewArm64SysReg(3, 4, 4, 1, 0), AccessReadWrite, "sp_el1", "Stack Pointer (EL1)"},
+	{NewArm64SysReg(3, 4, 4, 3, 0), AccessReadWrite, "spsr_irq", "Saved Program Status Register (IRQ mode)"},
+	{NewArm64SysReg(3, 4, 4, 3, 1), AccessReadWrite, "spsr_abt", "Saved Program Status Register (Abort mode)"},
+	{NewArm64SysReg(3, 4, 4, 3, 2), AccessReadWrite, "spsr_und", "Saved Program Status Register (Undefined mode)"},
+	{NewArm64SysReg(3, 4, 4, 3, 3), AccessReadWrite, "spsr_fiq", "Saved Program Status Register (FIQ mode)"},
+	{NewArm64SysReg(3, 6, 4, 0, 0), AccessReadWrite, "spsr_el3", "Saved Program Status Register (EL3)"},
+	{NewArm64SysReg(3, 6, 4, 0, 1), AccessReadWrite, "elr_el3", "Exception Link Register (EL3)"},
+	{NewArm64SysReg(3, 6, 4, 1, 0), AccessReadWrite, "sp_el2", "Stack Pointer (EL2)"},
+	{NewArm64SysReg(3, 4, 5, 0, 1), AccessReadWrite, "ifsr32_el2", "Instruction Fault Status Register (AArch32)"},
+	{NewArm64SysReg(3, 0, 5, 1, 0), AccessReadWrite, "afsr0_el1", "Auxiliary Fault Status Register 0 (EL1)"},
+	{NewArm64SysReg(3, 0, 5, 1, 1), AccessReadWrite, "afsr1_el1", "Auxiliary Fault Status Register 1 (EL1)"},
+	{NewArm64SysReg(3, 0, 5, 2, 0), AccessReadWrite, "esr_el1", "Exception Syndrome Register (EL1)"},
+	{NewArm64SysReg(3, 4, 5, 2, 0), AccessReadWrite, "esr_el2", "Exception Syndrome Register (EL2)"},
+	{NewArm64SysReg(3, 6, 5, 2, 0), AccessReadWrite, "esr_el3", "Exception Syndrome Register (EL3)"},
+	{NewArm64SysReg(3, 4, 5, 3, 0), AccessReadWrite, "fpexc32_el2", "Floating-point Exception Control Register"},
+	{NewArm64SysReg(3, 0, 6, 0, 0), AccessReadWrite, "far_el1", "Fault Address Register (EL1)"},
+	{NewArm64SysReg(3, 4, 6, 0, 0), AccessReadWrite, "far_el2", "Fault Address Register (EL2)"},
+	{NewArm64SysReg(3, 4, 6, 0, 4), AccessReadWrite, "hpfar_el2", "Hypervisor IPA Fault Address Register"},
+	{NewArm64SysReg(3, 6, 6, 0, 0), AccessReadWrite, "far_el3", "Fault Address Register (EL3)"},
+	{NewArm64SysReg(3, 0, 12, 0, 0), AccessReadWrite, "vbar_el1", "Vector Base Address Register (EL1)"},
+	{NewArm64SysReg(3, 0, 12, 0, 2), AccessReadWrite, "rmr_el1", "Reset Management Register (EL1)"},
+	{NewArm64SysReg(3, 4, 12, 0, 0), AccessReadWrite, "vbar_el2", "Vector Base Address Register (EL2)"},
+	{NewArm64SysReg(3, 4, 12, 0, 2), AccessReadWrite, "rmr_el2", "Reset Management Register (EL2)"},
+	{NewArm64SysReg(3, 6, 12, 0, 0), AccessReadWrite, "vbar_el3", "Vector Base Address Register (EL3)"},
+	{NewArm64SysReg(3, 6, 12, 0, 2), AccessReadWrite, "rmr_el3", "Reset Management Register (EL3)"},
+
+	// thread pointers
+	{NewArm64SysReg(3, 0, 13, 0, 4), AccessReadWrite, "tpidr_el1", "Thread Pointer / ID Register (EL1)"},
+	{NewArm64SysReg(3, 3, 13, 0, 2), AccessReadWrite, "tpidr_el0", "Thread Pointer / ID Register (EL0)"},
+	{NewArm64SysReg(3, 3, 13, 0, 3), AccessReadWrite, "tpidrro_el0", "Thread Pointer / ID Register, EL0 Read-Only"},
+	{NewArm64SysReg(3, 4, 13, 0, 2), AccessReadWrite, "tpidr_el2", "Thread Pointer / ID Register (EL2)"},
+	{NewArm64SysReg(3, 6, 13, 0, 2), AccessReadWrite, "tpidr_el3", "Thread Pointer / ID Register (EL3)"},
+
+	// generic timer
+	{NewArm64SysReg(3, 3, 14, 0, 0), AccessReadWrite, "cntfrq_el0", "Counter-timer Frequency Register"},
+	{NewArm64SysReg(3, 0, 14, 1, 0), AccessReadWrite, "cntkctl_el1", "Counter-timer Kernel Control Register"},
+	{NewArm64SysReg(3, 3, 14, 2, 0), AccessReadWrite, "cntp_tval_el0", "Counter-timer Physical Timer TimerValue Register"},
+	{NewArm64SysReg(3, 3, 14, 2, 1), AccessReadWrite, "cntp_ctl_el0", "Counter-timer Physical Timer Control Register"},
+	{NewArm64SysReg(3, 3, 14, 2, 2), AccessReadWrite, "cntp_cval_el0", "Counter-timer Physical Timer CompareValue Register"},
+	{NewArm64SysReg(3, 3, 14, 3, 0), AccessReadWrite, "cntv_tval_el0", "Counter-timer Virtual Timer TimerValue Register"},
+	{NewArm64SysReg(3, 3, 14, 3, 1), AccessReadWrite, "cntv_ctl_el0", "Counter-timer Virtual Timer Control Register"},
+	{NewArm64SysReg(3, 3, 14, 3, 2), AccessReadWrite, "cntv_cval_el0", "Counter-timer Virtual Timer CompareValue Register"},
+	{NewArm64SysReg(3, 4, 14, 0, 3), AccessReadWrite, "cntvoff_el2", "Counter-timer Virtual Offset Register"},
+	{NewArm64SysReg(3, 4, 14, 1, 0), AccessReadWrite, "cnthctl_el2", "Counter-timer Hypervisor Control Register"},
+	{NewArm64SysReg(3, 4, 14, 2, 0), AccessReadWrite, "cnthp_tval_el2", "Counter-timer Hypervisor Physical Timer TimerValue Register"},
+	{NewArm64SysReg(3, 4, 14, 2, 1), AccessReadWrite, "cnthp_ctl_el2", "Counter-timer Hypervisor Physical Timer Control Register"},
+	{NewArm64SysReg(3, 4, 14, 2, 2), AccessReadWrite, "cnthp_cval_el2", "Counter-timer Hypervisor Physical Timer CompareValue Register"},
+	{NewArm64SysReg(3, 7, 14, 2, 0), AccessReadWrite, "cntps_tval_el1", "Counter-timer Secure Physical Timer TimerValue Register"},
+	{NewArm64SysReg(3, 7, 14, 2, 1), AccessReadWrite, "cntps_ctl_el1", "Counter-timer Secure Physical Timer Control Register"},
+	{NewArm64SysReg(3, 7, 14, 2, 2), AccessReadWrite, "cntps_cval_el1", "Counter-timer Secure Physical Timer CompareValue Register"},
+
+	// performance monitors
+	{NewArm64SysReg(3, 3, 9, 12, 0), AccessReadWrite, "pmcr_el0", "Performance Monitors Control Register"},
+	{NewArm64SysReg(3, 3, 9, 12, 1), AccessReadWrite, "pmcntenset_el0", "Performance Monitors Count Enable Set Register"},
+	{NewArm64SysReg(3, 3, 9, 12, 2), AccessReadWrite, "pmcntenclr_el0", "Performance Monitors Count Enable Clear Register"},
+	{NewArm64SysReg(3, 3, 9, 12, 3), AccessReadWrite, "pmovsclr_el0", "Performance Monitors Overflow Flag Status Clear Register"},
+	{NewArm64SysReg(3, 3, 9, 12, 5), AccessReadWrite, "pmselr_el0", "Performance Monitors Event Counter Selection Register"},
+	{NewArm64SysReg(3, 3, 9, 13, 0), AccessReadWrite, "pmccntr_el0", "Performance Monitors Cycle Count Register"},
+	{NewArm64SysReg(3, 3, 9, 13, 1), AccessReadWrite, "pmxevtyper_el0", "Performance Monitors Selected Event Type Register"},
+	{NewArm64SysReg(3, 3, 9, 13, 2), AccessReadWrite, "pmxevcntr_el0", "Performance Monitors Selected Event Count Register"},
+	{NewArm64SysReg(3, 3, 9, 14, 0), AccessReadWrite, "pmuserenr_el0", "Performance Monitors User Enable Register"},
+	{NewArm64SysReg(3, 0, 9, 14, 1), AccessReadWrite, "pmintenset_el1", "Performance Monitors Interrupt Enable Set Register"},
+	{NewArm64SysReg(3, 0, 9, 14, 2), AccessReadWrite, "pmintenclr_el1", "Performance Monitors Interrupt Enable Clear Register"},
+	{NewArm64SysReg(3, 3, 9, 14, 3), AccessReadWrite, "pmovsset_el0", "Performance Monitors Overflow Flag Status Set Register"},
+	{NewArm64SysReg(3, 3, 14, 15, 7), AccessReadWrite, "pmccfiltr_el0", "Performance Monitors Cycle Count Filter Register"},
+
+	// debug
+	{NewArm64SysReg(2, 0, 0, 2, 0), AccessReadWrite, "mdccint_el1", "Monitor DCC Interrupt Enable Register"},
+	{NewArm64SysReg(2, 0, 0, 2, 2), AccessReadWrite, "mdscr_el1", "Monitor Debug System Control Register"},
+	{NewArm64SysReg(2, 0, 0, 0, 2), AccessReadWrite, "osdtrrx_el1", "OS Lock Data Transfer Register, Receive"},
+	{NewArm64SysReg(2, 0, 0, 3, 2), AccessReadWrite, "osdtrtx_el1", "OS Lock Data Transfer Register, Transmit"},
+	{NewArm64SysReg(2, 0, 0, 6, 2), AccessReadWrite, "oseccr_el1", "OS Lock Exception Catch Control Register"},
+	{NewArm64SysReg(2, 0, 1, 3, 4), AccessReadWrite, "osdlr_el1", "OS Double Lock Register"},
+	{NewArm64SysReg(2, 0, 1, 4, 4), AccessReadWrite, "dbgprcr_el1", "Debug Power Control Register"},
+	{NewArm64SysReg(2, 0, 7, 8, 6), AccessReadWrite, "dbgclaimset_el1", "Debug Claim Tag Set Register"},
+	{NewArm64SysReg(2, 0, 7, 9, 6), AccessReadWrite, "dbgclaimclr_el1", "Debug Claim Tag Clear Register"},
+	{NewArm64SysReg(2, 4, 0, 7, 0), AccessReadWrite, "dbgvcr32_el2", "Debug Vector Catch Register"},
+
+	// GIC CPU interface
+	{NewArm64SysReg(3, 0, 4, 6, 0), AccessReadWrite, "icc_pmr_el1", "GIC Interrupt Priority Mask Register"},
+	{NewArm64SysReg(3, 0, 12, 8, 3), AccessReadWrite, "icc_bpr0_el1", "GIC Binary Point Register 0"},
+	{NewArm64SysReg(3, 0, 12, 12, 3), AccessReadWrite, "icc_bpr1_el1", "GIC Binary Point Register 1"},
+	{NewArm64SysReg(3, 0, 12, 12, 4), AccessReadWrite, "icc_ctlr_el1", "GIC Control Register (EL1)"},
+	{NewArm64SysReg(3, 0, 12, 12, 5), AccessReadWrite, "icc_sre_el1", "GIC System Register Enable Register (EL1)"},
+	{NewArm64SysReg(3, 0, 12, 12, 6), AccessReadWrite, "icc_igrpen0_el1", "GIC Interrupt Group 0 Enable Register"},
+	{NewArm64SysReg(3, 0, 12, 12, 7), AccessReadWrite, "icc_igrpen1_el1", "GIC Interrupt Group 1 Enable Register"},
+	{NewArm64SysReg(3, 4, 12, 9, 5), AccessReadWrite, "icc_sre_el2", "GIC System Register Enable Register (EL2)"},
+	{NewArm64SysReg(3, 6, 12, 12, 4), AccessReadWrite, "icc_ctlr_el3", "GIC Control Register (EL3)"},
+	{NewArm64SysReg(3, 6, 12, 12, 5), AccessReadWrite, "icc_sre_el3", "GIC System Register Enable Register (EL3)"},
+	{NewArm64SysReg(3, 6, 12, 12, 7), AccessReadWrite, "icc_igrpen1_el3", "GIC Interrupt Group 1 Enable Register (EL3)"},
+}
+
+// Numbered register families: breakpoint / watchpoint pairs and the event
+// counters.
+func arm64NumberedRegs() []sysRegEntry {
+	var regs []sysRegEntry
+	for n := uint(0); n < 16; n++ {
+		regs = append(regs,
+			sysRegEntry{NewArm64SysReg(2, 0, 0, n, 4), AccessReadWrite, fmt.Sprintf("dbgbvr%d_el1", n), fmt.Sprintf("Debug Breakpoint Value Register %d", n)},
+			sysRegEntry{NewArm64SysReg(2, 0, 0, n, 5), AccessReadWrite, fmt.Sprintf("dbgbcr%d_el1", n), fmt.Sprintf("Debug Breakpoint Control Register %d", n)},
+			sysRegEntry{NewArm64SysReg(2, 0, 0, n, 6), AccessReadWrite, fmt.Sprintf("dbgwvr%d_el1", n), fmt.Sprintf("Debug Watchpoint Value Register %d", n)},
+			sysRegEntry{NewArm64SysReg(2, 0, 0, n, 7), AccessReadWrite, fmt.Sprintf("dbgwcr%d_el1", n), fmt.Sprintf("Debug Watchpoint Control Register %d", n)},
+		)
+	}
+	for n := uint(0); n < 31; n++ {
+		regs = append(regs,
+			sysRegEntry{NewArm64SysReg(3, 3, 14, 8+n/8, n%8), AccessReadWrite, fmt.Sprintf("pmevcntr%d_el0", n), fmt.Sprintf("Performance Monitors Event Count Register %d", n)},
+			sysRegEntry{NewArm64SysReg(3, 3, 14, 12+n/8, n%8), AccessReadWrite, fmt.Sprintf("pmevtyper%d_el0", n), fmt.Sprintf("Performance Monitors Event Type Register %d", n)},
+		)
+	}
+	return regs
+}
+
+func init() {
+	tables := [][]sysRegEntry{arm64MRSRegs, arm64MSRRegs, arm64OtherRegs, arm64NumberedRegs()}
+	for _, table := range tables {
+		for _, e := range table {
+			arm64SysRegNames[e.name] = e.reg
+			if prev, ok := arm64SysRegs[e.reg]; ok {
+				// dbgdtrrx_el0 / dbgdtrtx_el0
+				prev.access |= e.access
+				arm64SysRegs[e.reg] = prev
+				continue
+			}
+			arm64SysRegs[e.reg] = e
+		}
+	}
+}
+
+// A named operand value with a description
+type namedValue struct {
+	name string
+	desc string
+}
+
+func lookupName(names map[uint]namedValue, v uint) string {
+	if n, ok := names[v]; ok {
+		return n.name
+	}
+	return fmt.Sprintf("#%d", v)
+}
+
+// PSTATE fields
+
+// An ARM64 PSTATE field written by msr <field>, #imm (ARM64_OP_PSTATE)
+type Arm64PState uint
+
+var arm64PStateNames = map[uint]namedValue{
+	ARM64_PSTATE_SPSEL:   {"spsel", "Stack pointer select"},
+	ARM64_PSTATE_DAIFSET: {"daifset", "Set interrupt mask bits"},
+	ARM64_PSTATE_DAIFCLR: {"daifclr", "Clear interrupt mask bits"},
+}
+
+func (p Arm64PState) String() string      { return lookupName(arm64PStateNames, uint(p)) }
+func (p Arm64PState) Description() string { return arm64PStateNames[uint(p)].desc }
+
+// The PSTATE field, for ARM64_OP_PSTATE operands
+func (op Arm64Operand) PStateField() (Arm64PState, bool) {
+	if op.Type != ARM64_OP_PSTATE {
+		return 0, false
+	}
+	return Arm64PState(op.PState), true
+}
+
+// Prefetch operations
+
+// An ARM64 prfm operation (ARM64_OP_PREFETCH)
+type Arm64Prefetch uint
+
+var arm64PrefetchNames = map[uint]namedValue{
+	ARM64_PRFM_PLDL1KEEP: {"pldl1keep", "Prefetch for load, L1, temporal"},
+	ARM64_PRFM_PLDL1STRM: {"pldl1strm", "Prefetch for load, L1, streaming"},
+	ARM64_PRFM_PLDL2KEEP: {"pldl2keep", "Prefetch for load, L2, temporal"},
+	ARM64_PRFM_PLDL2STRM: {"pldl2strm", "Prefetch for load, L2, streaming"},
+	ARM64_PRFM_PLDL3KEEP: {"pldl3keep", "Prefetch for load, L3, temporal"},
+	ARM64_PRFM_PLDL3STRM: {"pldl3strm", "Prefetch for load, L3, streaming"},
+	ARM64_PRFM_PLIL1KEEP: {"plil1keep", "Preload instructions, L1, temporal"},
+	ARM64_PRFM_PLIL1STRM: {"plil1strm", "Preload instructions, L1, streaming"},
+	ARM64_PRFM_PLIL2KEEP: {"plil2keep", "Preload instructions, L2, temporal"},
+	ARM64_PRFM_PLIL2STRM: {"plil2strm", "Preload instructions, L2, streaming"},
+	ARM64_PRFM_PLIL3KEEP: {"plil3keep", "Preload instructions, L3, temporal"},
+	ARM64_PRFM_PLIL3STRM: {"plil3strm", "Preload instructions, L3, streaming"},
+	ARM64_PRFM_PSTL1KEEP: {"pstl1keep", "Prefetch for store, L1, temporal"},
+	ARM64_PRFM_PSTL1STRM: {"pstl1strm", "Prefetch for store, L1, streaming"},
+	ARM64_PRFM_PSTL2KEEP: {"pstl2keep", "Prefetch for store, L2, temporal"},
+	ARM64_PRFM_PSTL2STRM: {"pstl2strm", "Prefetch for store, L2, streaming"},
+	ARM64_PRFM_PSTL3KEEP: {"pstl3keep", "Prefetch for store, L3, temporal"},
+	ARM64_PRFM_PSTL3STRM: {"pstl3strm", "Prefetch for store, L3, streaming"},
+}
+
+func (p Arm64Prefetch) String() string      { return lookupName(arm64PrefetchNames, uint(p)) }
+func (p Arm64Prefetch) Description() string { return arm64PrefetchNames[uint(p)].desc }
+
+// The prefetch operation, for ARM64_OP_PREFETCH operands
+func (op Arm64Operand) PrefetchOp() (Arm64Prefetch, bool) {
+	if op.Type != ARM64_OP_PREFETCH {
+		return 0, false
+	}
+	return Arm64Prefetch(op.Prefetch), true
+}
+
+// Barriers
+
+// An ARM64 dmb / dsb / isb option (ARM64_OP_BARRIER)
+type Arm64Barrier uint
+
+var arm64BarrierNames = map[uint]namedValue{
+	ARM64_BARRIER_OSHLD: {"oshld", "Outer shareable, loads"},
+	ARM64_BARRIER_OSHST: {"oshst", "Outer shareable, stores"},
+	ARM64_BARRIER_OSH:   {"osh", "Outer shareable, all accesses"},
+	ARM64_BARRIER_NSHLD: {"nshld", "Non-shareable, loads"},
+	ARM64_BARRIER_NSHST: {"nshst", "Non-shareable, stores"},
+	ARM64_BARRIER_NSH:   {"nsh", "Non-shareable, all accesses"},
+	ARM64_BARRIER_ISHLD: {"ishld", "Inner shareable, loads"},
+	ARM64_BARRIER_ISHST: {"ishst", "Inner shareable, stores"},
+	ARM64_BARRIER_ISH:   {"ish", "Inner shareable, all accesses"},
+	ARM64_BARRIER_LD:    {"ld", "Full system, loads"},
+	ARM64_BARRIER_ST:    {"st", "Full system, stores"},
+	ARM64_BARRIER_SY:    {"sy", "Full system, all accesses"},
+}
+
+func (b Arm64Barrier) String() string      { return lookupName(arm64BarrierNames, uint(b)) }
+func (b Arm64Barrier) Description() string { return arm64BarrierNames[uint(b)].desc }
+
+// The barrier option, for ARM64_OP_BARRIER operands
+func (op Arm64Operand) BarrierOp() (Arm64Barrier, bool) {
+	if op.Type != ARM64_OP_BARRIER {
+		return 0, false
+	}
+	return Arm64Barrier(op.Barrier), true
+}
+
+// An ARM dmb / dsb option, as ArmInstruction.MemBarrier
+type ArmBarrier uint
+
+var armBarrierNames = map[uint]namedValue{
+	ARM_MB_RESERVED_0:  {"#0x0", "Reserved"},
+	ARM_MB_OSHLD:       {"oshld", "Outer shareable, loads"},
+	ARM_MB_OSHST:       {"oshst", "Outer shareable, stores"},
+	ARM_MB_OSH:         {"osh", "Outer shareable, all accesses"},
+	ARM_MB_RESERVED_4:  {"#0x4", "Reserved"},
+	ARM_MB_NSHLD:       {"nshld", "Non-shareable, loads"},
+	ARM_MB_NSHST:       {"nshst", "Non-shareable, stores"},
+	ARM_MB_NSH:         {"nsh", "Non-shareable, all accesses"},
+	ARM_MB_RESERVED_8:  {"#0x8", "Reserved"},
+	ARM_MB_ISHLD:       {"ishld", "Inner shareable, loads"},
+	ARM_MB_ISHST:       {"ishst", "Inner shareable, stores"},
+	ARM_MB_ISH:         {"ish", "Inner shareable, all accesses"},
+	ARM_MB_RESERVED_12: {"#0xc", "Reserved"},
+	ARM_MB_LD:          {"ld", "Full system, loads"},
+	ARM_MB_ST:          {"st", "Full system, stores"},
+	ARM_MB_SY:          {"sy", "Full system, all accesses"},
+}
+
+func (b ArmBarrier) String() string      { return lookupName(armBarrierNames, uint(b)) }
+func (b ArmBarrier) Description() string { return armBarrierNames[uint(b)].desc }
+
+// The dmb / dsb option, or ARM_MB_INVALID for other instructions
+func (insn ArmInstruction) Barrier() ArmBarrier {
+	return ArmBarrier(insn.MemBarrier)
+}
+
+// CPS
+
+// Whether an ARM cps instruction enables (cpsie) or disables (cpsid)
+// interrupts
+type ArmCPSMode uint
+
+var armCPSModeNames = map[uint]namedValue{
+	ARM_CPSMODE_IE: {"ie", "Interrupt enable"},
+	ARM_CPSMODE_ID: {"id", "Interrupt disable"},
+}
+
+func (m ArmCPSMode) String() string      { return lookupName(armCPSModeNames, uint(m)) }
+func (m ArmCPSMode) Description() string { return armCPSModeNames[uint(m)].desc }
+
+// The exceptions an ARM cps instruction masks or unmasks, a set of
+// ARM_CPSFLAG_A, ARM_CPSFLAG_I and ARM_CPSFLAG_F, or ARM_CPSFLAG_NONE
+type ArmCPSFlag uint
+
+// Assembler order
+var armCPSFlagNames = []struct {
+	flag uint
+	name string
+	desc string
+}{
+	{ARM_CPSFLAG_A, "a", "asynchronous abort"},
+	{ARM_CPSFLAG_I, "i", "IRQ"},
+	{ARM_CPSFLAG_F, "f", "FIQ"},
+}
+
+// The assembler form, eg "if", or "none"
+func (f ArmCPSFlag) String() string {
+	if f == ARM_CPSFLAG_NONE {
+		return "none"
+	}
+	s := ""
+	for _, n := range armCPSFlagNames {
+		if uint(f)&n.flag != 0 {
+			s += n.name
+		}
+	}
+	return s
+}
+
+func (f ArmCPSFlag) Description() string {
+	var parts []string
+	for _, n := range armCPSFlagNames {
+		if uint(f)&n.flag != 0 {
+			parts = append(parts, n.desc)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// The cps mode and flags, both zero (invalid) for other instructions
+func (insn ArmInstruction) CPS() (ArmCPSMode, ArmCPSFlag) {
+	return ArmCPSMode(insn.CPSMode), ArmCPSFlag(insn.CPSFlag)
+}
@@ -0,0 +1,98 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+func TestArm64SysReg(t *testing.T) {
+	tests := []struct {
+		reg    Arm64SysReg
+		name   string
+		access Access
+	}{
+		{NewArm64SysReg(3, 0, 1, 0, 0), "sctlr_el1", AccessReadWrite},
+		{NewArm64SysReg(3, 0, 12, 0, 0), "vbar_el1", AccessReadWrite},
+		{NewArm64SysReg(3, 0, 4, 2, 2), "currentel", AccessRead},
+		{NewArm64SysReg(2, 0, 0, 5, 4), "dbgbvr5_el1", AccessReadWrite},
+		{NewArm64SysReg(3, 3, 14, 11, 6), "pmevcntr30_el0", AccessReadWrite},
+		{Arm64SysReg(ARM64_SYSREG_MIDR_EL1), "midr_el1", AccessRead},
+		{Arm64SysReg(ARM64_SYSREG_OSLAR_EL1), "oslar_el1", AccessWrite},
+		{NewArm64SysReg(3, 7, 15, 2, 0), "s3_7_c15_c2_0", AccessReadWrite},
+	}
+
+	for i, test := range tests {
+		if got := test.reg.String(); got != test.name {
+			t.Errorf("%2d> %#x: want %v got %v", i, uint(test.reg), test.name, got)
+		}
+		if got := test.reg.Access(); got != test.access {
+			t.Errorf("%2d> %s: want %v got %v", i, test.name, test.access, got)
+		}
+		if got, ok := Arm64SysRegByName(test.name); !ok || got != test.reg {
+			t.Errorf("%2d> %s: want %#x got %#x", i, test.name, uint(test.reg), uint(got))
+		}
+	}
+
+	if got, ok := Arm64SysRegByName("SCTLR_EL1"); !ok || got != 0xc080 {
+		t.Errorf("SCTLR_EL1: want 0xc080 got %#x", uint(got))
+	}
+	if got := NewArm64SysReg(3, 0, 1, 0, 0).Description(); got != "System Control Register (EL1)" {
+		t.Errorf("Unexpected description %q", got)
+	}
+	if _, ok := Arm64SysRegByName("sctlr_el9"); ok {
+		t.Errorf("Bogus name resolved")
+	}
+}
+
+func TestArm64SysRegOperands(t *testing.T) {
+	// msr sctlr_el1, x0
+	insn := Arm64Instruction{Operands: []Arm64Operand{
+		{Type: ARM64_OP_REG_MSR, Reg: 0xc080},
+		{Type: ARM64_OP_REG, Reg: ARM64_REG_X0},
+	}}
+	reg, acc, ok := insn.SysReg()
+	if !ok || reg.String() != "sctlr_el1" || acc != AccessWrite {
+		t.Errorf("Want sctlr_el1 write got %v %v %v", reg, acc, ok)
+	}
+	if _, ok := insn.Operands[1].SysReg(); ok {
+		t.Errorf("Register operand reported as a system register")
+	}
+
+	// msr daifset, #2
+	op := Arm64Operand{Type: ARM64_OP_PSTATE, PState: ARM64_PSTATE_DAIFSET}
+	if p, ok := op.PStateField(); !ok || p.String() != "daifset" {
+		t.Errorf("Want daifset got %v", p)
+	}
+	op = Arm64Operand{Type: ARM64_OP_BARRIER, Barrier: ARM64_BARRIER_ISH}
+	if b, ok := op.BarrierOp(); !ok || b.String() != "ish" || b.Description() != "Inner shareable, all accesses" {
+		t.Errorf("Want ish got %v", b)
+	}
+	op = Arm64Operand{Type: ARM64_OP_PREFETCH, Prefetch: ARM64_PRFM_PSTL2STRM}
+	if p, ok := op.PrefetchOp(); !ok || p.String() != "pstl2strm" {
+		t.Errorf("Want pstl2strm got %v", p)
+	}
+}
+
+func TestArmBarrierCPS(t *testing.T) {
+	insn := ArmInstruction{MemBarrier: ARM_MB_ISHST}
+	if got := insn.Barrier().String(); got != "ishst" {
+		t.Errorf("Want ishst got %v", got)
+	}
+
+	// cpsid if
+	insn = ArmInstruction{CPSMode: ARM_CPSMODE_ID, CPSFlag: ARM_CPSFLAG_I | ARM_CPSFLAG_F}
+	mode, flags := insn.CPS()
+	if mode.String() != "id" || flags.String() != "if" || flags.Description() != "IRQ, FIQ" {
+		t.Errorf("Want id if got %v %v (%s)", mode, flags, flags.Description())
+	}
+	if got := ArmCPSFlag(ARM_CPSFLAG_NONE).String(); got != "none" {
+		t.Errorf("Want none got %v", got)
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "errors"
+
+var (
+	ErrX86Prefix      = errors.New("gapstone: truncated x86 VEX / XOP / EVEX prefix")
+	ErrX86VexMismatch = errors.New("gapstone: x86 VEX / EVEX prefix disagrees with instruction detail")
+)
+
+// Decoded legacy prefixes and REX, as returned by X86Instruction.Prefixes()
+type X86Prefixes struct {
+	Lock     bool
+	Rep      bool // f3 - rep / repe, or a mandatory SSE prefix
+	Repne    bool // f2 - repne, or a mandatory SSE prefix
+	Segment  uint // X86_REG_CS .. X86_REG_GS override, or X86_REG_INVALID
+	OpSize   bool // 66
+	AddrSize bool // 67
+	Rex      bool // a REX prefix is present, even 0x40 with no bits set
+	RexW     bool
+	RexR     bool
+	RexX     bool
+	RexB     bool
+}
+
+var x86SegmentPrefixes = map[byte]uint{
+	X86_PREFIX_CS: X86_REG_CS,
+	X86_PREFIX_SS: X86_REG_SS,
+	X86_PREFIX_DS: X86_REG_DS,
+	X86_PREFIX_ES: X86_REG_ES,
+	X86_PREFIX_FS: X86_REG_FS,
+	X86_PREFIX_GS: X86_REG_GS,
+}
+
+// Decode the raw Prefix bytes and Rex. Capstone stores one prefix per group:
+// Prefix[0] lock / rep / repne, [1] segment, [2] operand size, [3] address
+// size.
+func (insn X86Instruction) Prefixes() X86Prefixes {
+	var p X86Prefixes
+	for _, b := range insn.Prefix {
+		switch b {
+		case X86_PREFIX_LOCK:
+			p.Lock = true
+		case X86_PREFIX_REP:
+			p.Rep = true
+		case X86_PREFIX_REPNE:
+			p.Repne = true
+		case X86_PREFIX_OPSIZE:
+			p.OpSize = true
+		case X86_PREFIX_ADDRSIZE:
+			p.AddrSize = true
+		default:
+			if seg, ok := x86SegmentPrefixes[b]; ok {
+				p.Segment = seg
+			}
+		}
+	}
+	if insn.Rex&0xf0 == 0x40 {
+		p.Rex = true
+		p.RexW = insn.Rex&8 != 0
+		p.RexR = insn.Rex&4 != 0
+		p.RexX = insn.Rex&2 != 0
+		p.RexB = insn.Rex&1 != 0
+	}
+	return p
+}
+
+// Which kind of vector extension prefix an instruction has
+type X86VexKind int
+
+const (
+	X86VexNone X86VexKind = iota
+	X86Vex2               // c5 - two byte VEX
+	X86Vex3               // c4 - three byte VEX
+	X86Xop                // 8f - AMD XOP, laid out like VEX3
+	X86Evex               // 62 - AVX-512
+)
+
+var x86VexKindNames = [...]string{
+	X86VexNone: "none",
+	X86Vex2:    "vex2",
+	X86Vex3:    "vex3",
+	X86Xop:     "xop",
+	X86Evex:    "evex",
+}
+
+func (k X86VexKind) String() string {
+	if k >= 0 && int(k) < len(x86VexKindNames) {
+		return x86VexKindNames[k]
+	}
+	return "unknown"
+}
+
+// The fields of a VEX, XOP or EVEX prefix. The inverted fields in the
+// encoding (R, X, B, R', vvvv, V') are stored un-inverted.
+type X86Vex struct {
+	Kind  X86VexKind
+	R     bool
+	X     bool
+	B     bool
+	W     bool
+	Vvvv  uint8 // extra source register, 0-15 (0-31 with EVEX.V')
+	L     uint8 // vector length: 0 = 128, 1 = 256, 2 = 512 (EVEX L'L, see Bcast)
+	PP    uint8 // implied prefix: 0 none, 1 66, 2 f3, 3 f2
+	MMMMM uint8 // opcode map: 1 0f, 2 0f38, 3 0f3a (XOP 8-10)
+
+	// EVEX only
+	RPrime bool  // R'
+	AAA    uint8 // opmask register k0-k7, 0 for none
+	Z      bool  // zeroing rather than merging masking
+	Bcast  bool  // EVEX.b: broadcast for memory forms, rounding (in L) / SAE for register forms
+}
+
+// Decode the VEX, XOP or EVEX prefix from the instruction bytes. Returns Kind
+// X86VexNone for instructions without one.
+//
+// When detail is available, the prefix is checked against it: EVEX.b must
+// match AvxSAE, AvxRM (with the rounding mode in L'L) or an operand AvxBcast,
+// and AvxCC must match the compare predicate immediate. Disagreements return
+// ErrX86VexMismatch along with the decoded fields.
+func (insn Instruction) X86Vex() (X86Vex, error) {
+	if insn.archOf() != CS_ARCH_X86 {
+		return X86Vex{}, ErrArch
+	}
+
+	b := insn.Bytes
+	i := 0
+	for i < len(b) && x86LegacyPrefix(b[i]) {
+		i++
+	}
+	if i >= len(b) {
+		return X86Vex{}, nil
+	}
+
+	// Outside 64-bit mode c4, c5 and 62 are les, lds and bound unless the
+	// next byte would be a register ModRM.
+	mode64 := insn.mode&CS_MODE_64 != 0
+	var v X86Vex
+	var size int
+	switch b[i] {
+	case 0xc5:
+		v.Kind, size = X86Vex2, 2
+	case 0xc4:
+		v.Kind, size = X86Vex3, 3
+	case 0x8f:
+		v.Kind, size = X86Xop, 3
+	case 0x62:
+		v.Kind, size = X86Evex, 4
+	default:
+		return X86Vex{}, nil
+	}
+	if i+1 < len(b) {
+		switch {
+		case v.Kind == X86Xop && b[i+1]&0x1f < 8:
+			return X86Vex{}, nil // pop r/m
+		case v.Kind != X86Xop && !mode64 && b[i+1]&0xc0 != 0xc0:
+			return X86Vex{}, nil
+		}
+	}
+	// prefix and opcode. vzeroupper / vzeroall have no ModRM.
+	if i+size+1 > len(b) {
+		return X86Vex{}, ErrX86Prefix
+	}
+	p := b[i+1 : i+size]
+	regForm := i+size+1 == len(b) || b[i+size+1]&0xc0 == 0xc0
+
+	switch v.Kind {
+	case X86Vex2:
+		v.R = p[0]&0x80 == 0
+		v.Vvvv = ^p[0] >> 3 & 0xf
+		v.L = p[0] >> 2 & 1
+		v.PP = p[0] & 3
+		v.MMMMM = 1
+	case X86Vex3, X86Xop:
+		v.R = p[0]&0x80 == 0
+		v.X = p[0]&0x40 == 0
+		v.B = p[0]&0x20 == 0
+		v.MMMMM = p[0] & 0x1f
+		v.W = p[1]&0x80 != 0
+		v.Vvvv = ^p[1] >> 3 & 0xf
+		v.L = p[1] >> 2 & 1
+		v.PP = p[1] & 3
+	case X86Evex:
+		v.R = p[0]&0x80 == 0
+		v.X = p[0]&0x40 == 0
+		v.B = p[0]&0x20 == 0
+		v.RPrime = p[0]&0x10 == 0
+		v.MMMMM = p[0] & 3
+		v.W = p[1]&0x80 != 0
+		v.Vvvv = ^p[1] >> 3 & 0xf
+		v.PP = p[1] & 3
+		v.Z = p[2]&0x80 != 0
+		v.L = p[2] >> 5 & 3
+		v.Bcast = p[2]&0x10 != 0
+		if p[2]&0x08 == 0 {
+			v.Vvvv |= 0x10
+		}
+		v.AAA = p[2] & 7
+	}
+
+	if insn.X86 != nil && !v.matches(insn.X86, regForm, b[len(b)-1]) {
+		return v, ErrX86VexMismatch
+	}
+	return v, nil
+}
+
+func x86LegacyPrefix(b byte) bool {
+	switch b {
+	case X86_PREFIX_LOCK, X86_PREFIX_REP, X86_PREFIX_REPNE, X86_PREFIX_OPSIZE,
+		X86_PREFIX_ADDRSIZE:
+		return true
+	}
+	_, ok := x86SegmentPrefixes[b]
+	return ok
+}
+
+// EVEX.b rounding modes, by L'L
+var x86RoundingModes = [...]uint{X86_AVX_RM_RN, X86_AVX_RM_RD, X86_AVX_RM_RU, X86_AVX_RM_RZ}
+
+// Cross check the decoded prefix with the detail Capstone reported. imm is
+// the last instruction byte, which holds the predicate of a compare.
+func (v X86Vex) matches(x86 *X86Instruction, regForm bool, imm byte) bool {
+	bcast := false
+	for _, op := range x86.Operands {
+		if op.AvxBcast != X86_AVX_BCAST_INVALID {
+			bcast = true
+		}
+	}
+
+	switch {
+	case v.Kind != X86Evex || !v.Bcast:
+		if bcast || x86.AvxSAE || x86.AvxRM != X86_AVX_RM_INVALID {
+			return false
+		}
+	case regForm:
+		if x86.AvxRM != X86_AVX_RM_INVALID && x86.AvxRM != x86RoundingModes[v.L] {
+			return false
+		}
+		if x86.AvxRM == X86_AVX_RM_INVALID && !x86.AvxSAE {
+			return false
+		}
+	default:
+		if !bcast {
+			return false
+		}
+	}
+
+	// AVX compare predicates run eq .. true_us in immediate order
+	if x86.AvxCC != X86_AVX_CC_INVALID && x86.AvxCC != X86_AVX_CC_EQ+uint(imm&0x1f) {
+		return false
+	}
+	return true
+}
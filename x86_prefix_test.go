@@ -0,0 +1,97 @@
+/*
+Gapstone is a Go binding for the Capstone disassembly library. For examples,
+try reading the *_test.go files.
+
+	Library Author: Nguyen Anh Quynh
+	Binding Author: Ben Nagy
+	License: BSD style - see LICENSE file for details
+    (c) 2013 COSEINC. All Rights Reserved.
+*/
+
+package gapstone
+
+import "testing"
+
+func TestX86Prefixes(t *testing.T) {
+	// lock add qword ptr fs:[r8], rax
+	insn := X86Instruction{
+		Prefix: []byte{X86_PREFIX_LOCK, X86_PREFIX_FS, 0, 0},
+		Rex:    0x49,
+	}
+	want := X86Prefixes{Lock: true, Segment: X86_REG_FS, Rex: true, RexW: true, RexB: true}
+	if got := insn.Prefixes(); got != want {
+		t.Errorf("Want %+v got %+v", want, got)
+	}
+
+	insn = X86Instruction{Prefix: []byte{X86_PREFIX_REPNE, 0, X86_PREFIX_OPSIZE, X86_PREFIX_ADDRSIZE}}
+	want = X86Prefixes{Repne: true, OpSize: true, AddrSize: true}
+	if got := insn.Prefixes(); got != want {
+		t.Errorf("Want %+v got %+v", want, got)
+	}
+}
+
+func TestX86Vex(t *testing.T) {
+	tests := []struct {
+		comment string
+		mode    uint
+		code    string
+		want    X86Vex
+	}{
+		{"vaddps ymm0, ymm1, ymm2", CS_MODE_64, "\xc5\xf4\x58\xc2",
+			X86Vex{Kind: X86Vex2, Vvvv: 1, L: 1, MMMMM: 1}},
+		{"vzeroupper", CS_MODE_32, "\xc5\xf8\x77",
+			X86Vex{Kind: X86Vex2, MMMMM: 1}},
+		{"vpermq ymm0, ymm1, 0x1b", CS_MODE_64, "\xc4\xe3\xfd\x00\xc1\x1b",
+			X86Vex{Kind: X86Vex3, W: true, L: 1, PP: 1, MMMMM: 3}},
+		{"vaddps zmm17 {k1}{z}, zmm2, dword ptr [rax]{1to16}", CS_MODE_64, "\x62\xe1\x6c\xd9\x58\x08",
+			X86Vex{Kind: X86Evex, RPrime: true, MMMMM: 1, Vvvv: 2, L: 2, AAA: 1, Z: true, Bcast: true}},
+		{"les eax, [ecx]", CS_MODE_32, "\xc4\x01", X86Vex{}},
+		{"pop qword ptr [rax]", CS_MODE_64, "\x8f\x00", X86Vex{}},
+		{"add eax, ebx", CS_MODE_32, "\x01\xd8", X86Vex{}},
+	}
+	for i, vt := range tests {
+		insn := bareInsn(CS_ARCH_X86, vt.mode, X86_INS_INVALID, 0x1000, vt.code)
+		v, err := insn.X86Vex()
+		if err != nil {
+			t.Errorf("%2d> %s: unexpected error %v", i, vt.comment, err)
+			continue
+		}
+		if v != vt.want {
+			t.Errorf("%2d> %s: want %+v got %+v", i, vt.comment, vt.want, v)
+		}
+	}
+
+	short := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_INVALID, 0x1000, "\x62\xf1\x7c")
+	if _, err := short.X86Vex(); err != ErrX86Prefix {
+		t.Errorf("Want ErrX86Prefix for a truncated EVEX prefix, got %v", err)
+	}
+}
+
+func TestX86VexDetail(t *testing.T) {
+	// vaddps zmm0, zmm1, zmm2, {rz-sae}
+	round := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_VADDPS, 0x1000, "\x62\xf1\x74\x78\x58\xc2")
+	round.X86 = &X86Instruction{AvxRM: X86_AVX_RM_RZ}
+	if v, err := round.X86Vex(); err != nil || !v.Bcast || v.L != 3 {
+		t.Errorf("rz-sae: want b set and L'L 3, got %+v, %v", v, err)
+	}
+	round.X86.AvxRM = X86_AVX_RM_RN
+	if _, err := round.X86Vex(); err != ErrX86VexMismatch {
+		t.Errorf("rz-sae: want ErrX86VexMismatch for the wrong rounding mode, got %v", err)
+	}
+
+	// vcmpps k1, zmm0, zmm1, 0x11
+	cmp := bareInsn(CS_ARCH_X86, CS_MODE_64, X86_INS_VCMPPS, 0x1000, "\x62\xf1\x7c\x48\xc2\xc9\x11")
+	cmp.X86 = &X86Instruction{AvxCC: X86_AVX_CC_LT_OQ}
+	if _, err := cmp.X86Vex(); err != nil {
+		t.Errorf("vcmpps: unexpected error %v", err)
+	}
+	cmp.X86.AvxCC = X86_AVX_CC_EQ
+	if _, err := cmp.X86Vex(); err != ErrX86VexMismatch {
+		t.Errorf("vcmpps: want ErrX86VexMismatch for the wrong predicate, got %v", err)
+	}
+
+	arm := bareInsn(CS_ARCH_ARM, CS_MODE_ARM, ARM_INS_ADD, 0x1000, "\x01\x00\x80\xe2")
+	if _, err := arm.X86Vex(); err != ErrArch {
+		t.Errorf("Want ErrArch for ARM, got %v", err)
+	}
+}